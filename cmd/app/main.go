@@ -2,12 +2,28 @@ package main
 
 import (
 	"classting/config"
+	"classting/domain"
+	"classting/internal/analytics"
+	"classting/internal/attachment"
+	"classting/internal/comment"
+	"classting/internal/denylist"
 	"classting/internal/news"
+	"classting/internal/outbox"
+	"classting/internal/reaction"
 	"classting/internal/school"
+	"classting/internal/search"
+	"classting/internal/stream"
 	"classting/internal/subscription"
+	"classting/internal/timeline"
 	"classting/internal/user"
+	"classting/internal/webhook"
 	"classting/pkg/db"
+	"classting/pkg/jwtkey"
+	"classting/pkg/migrate"
+	"classting/pkg/pagination"
+	"classting/pkg/pubsub"
 	"classting/pkg/router"
+	"classting/source"
 	"context"
 	"errors"
 	"log"
@@ -31,34 +47,155 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
-	router := router.NewServeRouter(cfg)
+	// 여러 인스턴스가 동시에 시작해도 마이그레이션 잠금을 잡은 한 곳에서만 적용한다.
+	if cfg.Mysql.AutoMigrate {
+		migrator, err := migrate.New(db, source.Migrations())
+		if err != nil {
+			log.Fatal(err)
+		}
+		if cfg.Mysql.BaselineVersion > 0 {
+			baselined, err := migrator.Baseline(context.Background(), cfg.Mysql.BaselineVersion)
+			if err != nil {
+				log.Fatal(err)
+			}
+			for _, migration := range baselined {
+				log.Println("Baselined:", migration)
+			}
+		}
+		applied, err := migrator.Up(context.Background())
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, migration := range applied {
+			log.Println("Migrated:", migration)
+		}
+		if cfg.Mysql.Seed {
+			seeded, err := migrator.SeedOnce(context.Background(), source.Seed)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if seeded {
+				log.Println("Seeded")
+			}
+		}
+	}
+	// 기본은 메모리 폐기 목록, 여러 인스턴스로 실행할 때는 auth.denylist를 mysql로 설정해 공유한다.
+	var tokenDenylist domain.TokenDenylist = denylist.NewMemoryDenylist()
+	if cfg.Auth.Denylist == "mysql" {
+		tokenDenylist = denylist.NewDenylistRepository(db)
+	}
+	keySet, err := jwtkey.Load(cfg.Auth)
+	if err != nil {
+		log.Fatal(err)
+	}
+	jwtAuth := router.NewJWTAuth(cfg.Auth.Secret, keySet, tokenDenylist)
+	blobStore, err := attachment.NewLocalBlobStore(cfg.Attachment.Dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	router := router.NewServeRouter(cfg, keySet)
+	newsHub := pubsub.NewHub[domain.NewsEvent](cfg.Stream.BufferSize)
+	subscriptionHub := pubsub.NewHub[domain.SubscriptionEvent](cfg.Stream.BufferSize)
 
 	// domain
 	userRepository := user.NewUserRepository(db)
 	schoolRepository := school.NewSchoolRepository(db)
 	newsRepository := news.NewNewsRepository(db)
 	subscriptionRepository := subscription.NewSubscriptionRepository(db)
+	timelineRepository := timeline.NewTimelineRepository(db)
+	webhookRepository := webhook.NewWebhookRepository(db)
+	outboxRepository := outbox.NewOutboxRepository(db)
+	attachmentRepository := attachment.NewAttachmentRepository(db)
+	analyticsRepository := analytics.NewAnalyticsRepository(db)
+	commentRepository := comment.NewCommentRepository(db)
+	reactionRepository := reaction.NewReactionRepository(db)
+	searchRepository := search.NewSearchRepository(db)
+
+	// 기본은 메모리 색인, 여러 인스턴스로 실행할 때는 search.index를 mysql로 설정해 FULLTEXT 색인을 공유한다.
+	var searchIndex domain.SearchIndex = search.NewFulltextIndex(db)
+	if cfg.Search.Index != "mysql" {
+		memoryIndex := search.NewMemoryIndex()
+		if err := memoryIndex.Load(context.Background(), searchRepository); err != nil {
+			log.Fatal(err)
+		}
+		searchIndex = memoryIndex
+	}
+
+	// 목록 커서 서명 키가 없으면 auth.secret을 쓰고, pagination.New에서 커서 전용 키를 따로 만들어 서명한다.
+	paginationSecret := cfg.Pagination.Secret
+	if paginationSecret == "" {
+		paginationSecret = cfg.Auth.Secret
+	}
+	paginator := pagination.New(paginationSecret, cfg.Pagination.PageSize, cfg.Pagination.MaxPageSize)
 
 	// service
-	userService := user.NewUserService(userRepository, cfg)
-	schoolService := school.NewSchoolService(schoolRepository, userRepository)
-	newsService := news.NewNewsService(newsRepository, schoolRepository)
-	subscriptionService := subscription.NewSubscriptionService(newsRepository, schoolRepository, subscriptionRepository)
+	userService := user.NewUserService(userRepository, tokenDenylist, keySet, cfg)
+	schoolService := school.NewSchoolService(schoolRepository, userRepository, searchIndex, paginator)
+	timelineService := timeline.NewTimelineService(timelineRepository, cfg)
+	streamService := stream.NewStreamService(newsHub, subscriptionHub, subscriptionRepository, timelineRepository)
+	webhookService := webhook.NewWebhookService(webhookRepository, schoolRepository, cfg)
+	attachmentService := attachment.NewAttachmentService(attachmentRepository, newsRepository, schoolRepository, blobStore, cfg)
+	reactionService := reaction.NewReactionService(reactionRepository, newsRepository, schoolRepository, subscriptionRepository)
+	newsService := news.NewNewsService(newsRepository, schoolRepository, timelineService, attachmentService, searchIndex, paginator, cfg)
+	subscriptionService := subscription.NewSubscriptionService(newsRepository, schoolRepository, subscriptionRepository, timelineRepository, timelineService, attachmentService, reactionService, paginator)
+	analyticsService := analytics.NewAnalyticsService(analyticsRepository, newsRepository, schoolRepository)
+	commentService := comment.NewCommentService(commentRepository, newsRepository, schoolRepository, subscriptionRepository)
+	searchService := search.NewSearchService(searchIndex, searchRepository, subscriptionRepository)
+
+	// 아웃박스 이벤트 싱크, 웹훅과 로그는 인스턴스 하나에서만 전달하고 실시간 허브는 인스턴스마다 전달한다.
+	outboxSinks := []outbox.Sink{
+		{Name: "webhook", OutboxSink: outbox.NewNewsEventSink(webhookService)},
+	}
+	if cfg.Outbox.LogEvents {
+		outboxSinks = append(outboxSinks, outbox.Sink{Name: "log", OutboxSink: outbox.NewLogSink()})
+	}
+	outboxRelay := outbox.NewOutboxRelay(outboxRepository, cfg, outboxSinks...)
+	outboxBroadcaster := outbox.NewOutboxBroadcaster(
+		outboxRepository,
+		cfg,
+		outbox.NewNewsEventSink(streamService),
+		outbox.NewSubscriptionEventSink(streamService),
+	)
+	newsScheduler := news.NewNewsScheduler(newsRepository, timelineService, searchIndex, cfg)
 
 	// controller
 	userController := user.NewUserController(userService)
 	schoolController := school.NewSchoolController(schoolService, cfg)
 	newsController := news.NewNewsController(newsService)
 	subscriptionController := subscription.NewSubscriptionController(subscriptionService)
+	streamController := stream.NewStreamController(streamService, cfg)
+	webhookController := webhook.NewWebhookController(webhookService)
+	attachmentController := attachment.NewAttachmentController(attachmentService, cfg)
+	analyticsController := analytics.NewAnalyticsController(analyticsService)
+	commentController := comment.NewCommentController(commentService)
+	reactionController := reaction.NewReactionController(reactionService)
+	searchController := search.NewSearchController(searchService)
 
 	// routes
-	user.RegisterRoutes(router, userController)
-	school.RegisterRoutes(router, schoolController, cfg)
-	news.RegisterRoutes(router, newsController, cfg)
-	subscription.RegisterRoutes(router, subscriptionController, cfg)
+	user.RegisterRoutes(router, userController, jwtAuth)
+	school.RegisterRoutes(router, schoolController, jwtAuth)
+	news.RegisterRoutes(router, newsController, jwtAuth)
+	subscription.RegisterRoutes(router, subscriptionController, jwtAuth)
+	stream.RegisterRoutes(router, streamController, jwtAuth)
+	webhook.RegisterRoutes(router, webhookController, jwtAuth)
+	attachment.RegisterRoutes(router, attachmentController, jwtAuth)
+	analytics.RegisterRoutes(router, analyticsController, jwtAuth)
+	comment.RegisterRoutes(router, commentController, jwtAuth)
+	reaction.RegisterRoutes(router, reactionController, jwtAuth)
+	search.RegisterRoutes(router, searchController, jwtAuth)
+
+	// background worker
+	timelineService.Run()
+	webhookService.Run()
+	outboxRelay.Run()
+	outboxBroadcaster.Run()
+	newsScheduler.Run()
 
 	// http server
 	srv := &http.Server{Addr: cfg.HTTP.Port, Handler: router}
+	// 스트림 연결은 종료되지 않으므로 서버 종료 시 허브를 닫아 연결을 정리한다.
+	srv.RegisterOnShutdown(newsHub.Close)
+	srv.RegisterOnShutdown(subscriptionHub.Close)
 
 	go func() {
 		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -66,20 +203,28 @@ func main() {
 		}
 	}()
 
-	quit := make(chan os.Signal)
+	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Println("Shutdown Server ...")
 
-	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	// 요청을 먼저 막고, 작업을 만드는 쪽(예약 발행, 아웃박스)부터 처리하는 쪽 순서로 종료한다.
+	// 워커마다 제한 시간을 따로 두어 앞의 종료가 늦어도 뒤의 워커가 큐를 비울 시간을 잃지 않는다.
+	shutdown("Server", 5*time.Second, srv.Shutdown)
+	shutdown("Stream", 1*time.Second, streamController.Shutdown)
+	shutdown("Outbox Broadcaster", 1*time.Second, outboxBroadcaster.Shutdown)
+	shutdown("News Scheduler", 5*time.Second, newsScheduler.Shutdown)
+	shutdown("Timeline", 10*time.Second, timelineService.Shutdown)
+	shutdown("Outbox", 5*time.Second, outboxRelay.Shutdown)
+	shutdown("Webhook", 10*time.Second, webhookService.Shutdown)
+	log.Println("Server exiting")
+}
+
+func shutdown(name string, timeout time.Duration, fn func(ctx context.Context) error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
-	if err := srv.Shutdown(ctx); err != nil {
-		log.Fatal("Server Shutdown:", err)
-	}
 
-	select {
-	case <-ctx.Done():
-		log.Println("timeout of 1 seconds.")
+	if err := fn(ctx); err != nil {
+		log.Println(name, "Shutdown:", err)
 	}
-	log.Println("Server exiting")
 }
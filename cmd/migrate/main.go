@@ -0,0 +1,139 @@
+package main
+
+import (
+	"classting/config"
+	"classting/pkg/db"
+	"classting/pkg/migrate"
+	"classting/source"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+const usage = `usage: migrate <command>
+
+commands:
+  up            적용하지 않은 마이그레이션을 모두 적용한다.
+  down [n]      마지막으로 적용한 마이그레이션부터 n개(기본 1개)를 되돌린다.
+  status        마이그레이션별 적용 여부와 샘플 데이터 적용 여부를 출력한다.
+  seed          source/seed.sql의 샘플 데이터를 넣는다.
+  baseline [v]  init.sql로 만든 데이터베이스를 v 버전(기본 1)까지 적용한 것으로 기록한다.`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	cfg, err := config.NewConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+	db, err := db.NewSql(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+	migrator, err := migrate.New(db, source.Migrations())
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx := context.Background()
+
+	switch os.Args[1] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, migration := range applied {
+			log.Println("Migrated:", migration)
+		}
+		if len(applied) == 0 {
+			log.Println("No migrations to apply")
+		}
+	case "down":
+		steps := 1
+		if len(os.Args) > 2 {
+			steps, err = strconv.Atoi(os.Args[2])
+			if err != nil || steps <= 0 {
+				log.Fatalf("invalid steps %q", os.Args[2])
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, migration := range reverted {
+			log.Println("Reverted:", migration)
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		seedStatus, err := migrator.SeedStatus(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		printStatus(statuses, seedStatus)
+	case "seed":
+		if err := migrator.Seed(ctx, source.Seed); err != nil {
+			log.Fatal(err)
+		}
+		log.Println("Seeded")
+	case "baseline":
+		version := 1
+		if len(os.Args) > 2 {
+			version, err = strconv.Atoi(os.Args[2])
+			if err != nil || version <= 0 {
+				log.Fatalf("invalid version %q", os.Args[2])
+			}
+		}
+		baselined, err := migrator.Baseline(ctx, version)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, migration := range baselined {
+			log.Println("Baselined:", migration)
+		}
+		if len(baselined) == 0 {
+			log.Println("No unversioned schema to baseline")
+		}
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
+
+func printStatus(statuses []migrate.Status, seedStatus *migrate.SeedStatus) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, status := range statuses {
+		state, appliedAt := "pending", "-"
+		if status.Applied {
+			state, appliedAt = "applied", status.ApplyDate.Format(time.DateTime)
+		}
+		if status.Modified {
+			state = "modified"
+		}
+		if status.Missing {
+			state = "missing"
+		}
+		fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", status.Version, status.Name, state, appliedAt)
+	}
+
+	state, appliedAt := "pending", "-"
+	if seedStatus != nil {
+		state, appliedAt = "applied", seedStatus.ApplyDate.Format(time.DateTime)
+		if seedStatus.Skipped {
+			state = "skipped"
+		}
+	}
+	fmt.Fprintf(w, "-\tseed\t%s\t%s\n", state, appliedAt)
+	w.Flush()
+}
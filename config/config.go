@@ -6,10 +6,18 @@ import (
 )
 
 type Config struct {
-	App   `mapstructure:"app"`
-	HTTP  `mapstructure:"http"`
-	Mysql `mapstructure:"mysql"`
-	Auth  `mapstructure:"auth"`
+	App        `mapstructure:"app"`
+	HTTP       `mapstructure:"http"`
+	Mysql      `mapstructure:"mysql"`
+	Auth       `mapstructure:"auth"`
+	Timeline   `mapstructure:"timeline"`
+	Stream     `mapstructure:"stream"`
+	Webhook    `mapstructure:"webhook"`
+	Outbox     `mapstructure:"outbox"`
+	Attachment `mapstructure:"attachment"`
+	News       `mapstructure:"news"`
+	Search     `mapstructure:"search"`
+	Pagination `mapstructure:"pagination"`
 }
 
 type App struct {
@@ -26,11 +34,85 @@ type Mysql struct {
 	User     string `mapstructure:"user"`
 	Password string `mapstructure:"password"`
 	DbName   string `mapstructure:"dbName"`
+	// AutoMigrate 앱을 시작할 때 적용하지 않은 스키마 마이그레이션을 적용한다.
+	AutoMigrate bool `mapstructure:"autoMigrate"`
+	// Seed AutoMigrate 후 샘플 데이터를 넣은 기록(schema_seeds)이 없으면 샘플 데이터를 넣는다.
+	Seed bool `mapstructure:"seed"`
+	// BaselineVersion 적용 기록 없이 테이블이 있는(init.sql로 만든) 데이터베이스를 AutoMigrate 전에 이 버전까지 적용한 것으로 기록한다.
+	BaselineVersion int `mapstructure:"baselineVersion"`
 }
 
 type Auth struct {
+	Secret             string `mapstructure:"secret"`
+	AccessTokenMinutes int    `mapstructure:"accessTokenMinutes"`
+	RefreshTokenHours  int    `mapstructure:"refreshTokenHours"`
+	Denylist           string `mapstructure:"denylist"` // memory(기본값) 또는 mysql
+	// SigningKeyID Keys 중 토큰 서명에 사용할 키, Keys가 비어 있으면 Secret으로 HS256 서명한다.
+	SigningKeyID string       `mapstructure:"signingKeyID"`
+	Keys         []SigningKey `mapstructure:"keys"`
+}
+
+// SigningKey 개인키가 없는 키는 검증에만 사용한다.
+type SigningKey struct {
+	ID             string `mapstructure:"id"`
+	Algorithm      string `mapstructure:"algorithm"` // RS256 또는 EdDSA
+	PrivateKeyPath string `mapstructure:"privateKeyPath"`
+	PublicKeyPath  string `mapstructure:"publicKeyPath"`
+}
+
+type Timeline struct {
+	Workers      int `mapstructure:"workers"`
+	QueueSize    int `mapstructure:"queueSize"`
+	BackfillSize int `mapstructure:"backfillSize"`
+}
+
+type Stream struct {
+	BufferSize       int `mapstructure:"bufferSize"`
+	HeartbeatSeconds int `mapstructure:"heartbeatSeconds"`
+	// AllowedOrigins 웹소켓 연결을 허용할 다른 출처, 같은 호스트의 출처는 설정하지 않아도 허용한다.
+	AllowedOrigins []string `mapstructure:"allowedOrigins"`
+}
+
+type Webhook struct {
+	PollIntervalSeconds int `mapstructure:"pollIntervalSeconds"`
+	BatchSize           int `mapstructure:"batchSize"`
+	MaxAttempts         int `mapstructure:"maxAttempts"`
+	BackoffSeconds      int `mapstructure:"backoffSeconds"`
+	TimeoutSeconds      int `mapstructure:"timeoutSeconds"`
+}
+
+type Outbox struct {
+	PollIntervalMillis int  `mapstructure:"pollIntervalMillis"`
+	BatchSize          int  `mapstructure:"batchSize"`
+	MaxAttempts        int  `mapstructure:"maxAttempts"`
+	LogEvents          bool `mapstructure:"logEvents"`
+}
+
+// Attachment Secret이 비어 있으면 auth.secret에서 다운로드 주소 전용 키를 만들어 서명한다.
+type Attachment struct {
+	Dir               string   `mapstructure:"dir"`
+	MaxSizeMB         int      `mapstructure:"maxSizeMB"`
+	AllowedTypes      []string `mapstructure:"allowedTypes"`
+	URLExpiresMinutes int      `mapstructure:"urlExpiresMinutes"`
+	Secret            string   `mapstructure:"secret"`
+}
+
+// News 예약 발행 스케줄러, 고정 소식 설정
+type News struct {
+	SchedulerIntervalSeconds int `mapstructure:"schedulerIntervalSeconds"`
+	SchedulerBatchSize       int `mapstructure:"schedulerBatchSize"`
+	MaxPins                  int `mapstructure:"maxPins"` // 학교마다 고정할 수 있는 소식 수
+}
+
+type Search struct {
+	Index string `mapstructure:"index"` // memory(기본값) 또는 mysql
+}
+
+// Pagination Secret이 비어 있으면 auth.secret에서 커서 전용 키를 만들어 목록 커서를 서명한다.
+type Pagination struct {
 	Secret      string `mapstructure:"secret"`
-	ExpiryHours int    `mapstructure:"expiryHours"`
+	PageSize    int    `mapstructure:"pageSize"`    // 페이지 크기를 요청하지 않았을 때의 크기
+	MaxPageSize int    `mapstructure:"maxPageSize"` // 요청할 수 있는 최대 페이지 크기
 }
 
 var configMode = "dev"
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"time"
+)
+
+type AnalyticsRepository interface {
+	CountSubscribersAt(ctx context.Context, params CountSubscribersAtParams) (int, error)
+	ListNewsDailyReaders(ctx context.Context, newsID int) ([]NewsDailyReaders, error)
+	ListSubscriptionDailyChanges(ctx context.Context, params ListSubscriptionDailyChangesParams) ([]SubscriptionDailyChange, error)
+}
+
+// AnalyticsService 학교 관리자에게 소식 도달률과 구독자 증감 통계를 제공한다.
+type AnalyticsService interface {
+	GetNewsAnalytics(ctx context.Context, req GetNewsAnalyticsRequest) (NewsAnalyticsDTO, error)
+	GetSchoolAnalytics(ctx context.Context, req GetSchoolAnalyticsRequest) (SchoolAnalyticsDTO, error)
+}
+
+type AnalyticsController interface {
+	GetNewsAnalytics(c *gin.Context)
+	GetSchoolAnalytics(c *gin.Context)
+}
+
+// NewsDailyReaders 날짜별로 소식을 처음 읽은 구독자 수
+type NewsDailyReaders struct {
+	Date    time.Time
+	Readers int
+}
+
+// SubscriptionDailyChange 날짜별 구독, 구독 취소 수
+type SubscriptionDailyChange struct {
+	Date         time.Time
+	Subscribed   int
+	Unsubscribed int
+}
+
+// CountSubscribersAtParams At 시각 직전까지 구독 중이던 구독자를 센다.
+type CountSubscribersAtParams struct {
+	SchoolID int
+	At       time.Time
+}
+
+// ListSubscriptionDailyChangesParams From 이상 To 미만 기간의 구독 변화를 조회한다.
+type ListSubscriptionDailyChangesParams struct {
+	SchoolID int
+	From     time.Time
+	To       time.Time
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"io"
+)
+
+type AttachmentRepository interface {
+	CreateAttachment(ctx context.Context, attachment Attachment) (int, error)
+	FindAttachmentByID(ctx context.Context, attachmentID int) (*Attachment, error)
+	ListAttachmentsByNewsIDs(ctx context.Context, newsIDs []int) ([]Attachment, error)
+	DeleteAttachmentsByNewsID(ctx context.Context, newsID int) error
+}
+
+// AttachmentService 소식 첨부 파일을 저장하고 만료되는 서명 URL로 내려준다.
+type AttachmentService interface {
+	UploadAttachment(ctx context.Context, req UploadAttachmentRequest) (AttachmentDTO, error)
+	DownloadAttachment(ctx context.Context, req DownloadAttachmentRequest) (AttachmentFile, error)
+	ListNewsAttachments(ctx context.Context, newsIDs []int) (map[int][]AttachmentDTO, error)
+	DeleteNewsAttachments(ctx context.Context, newsID int) error
+}
+
+type AttachmentController interface {
+	UploadAttachment(c *gin.Context)
+	DownloadAttachment(c *gin.Context)
+}
+
+// BlobStore 첨부 파일 내용을 저장하는 저장소, 키는 서비스에서 만들어 전달한다.
+type BlobStore interface {
+	// Put r의 내용을 key에 저장하고 저장한 바이트 수를 반환한다.
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete 없는 키를 삭제해도 에러를 반환하지 않는다.
+	Delete(ctx context.Context, key string) error
+}
+
+type Attachment struct {
+	Base
+	NewsID      int
+	UserID      int
+	FileName    string
+	ContentType string
+	Size        int64
+	StorageKey  string
+}
+
+// AttachmentFile 내려받을 첨부 파일, Content는 호출한 쪽에서 닫아야 한다.
+type AttachmentFile struct {
+	Attachment Attachment
+	Content    io.ReadCloser
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+)
+
+type CommentRepository interface {
+	CreateComment(ctx context.Context, comment Comment) (int, error)
+	FindCommentByID(ctx context.Context, commentID int) (*Comment, error)
+	ListComments(ctx context.Context, params ListCommentsParams) ([]Comment, error)
+	UpdateCommentHidden(ctx context.Context, params UpdateCommentHiddenParams) error
+	DeleteComment(ctx context.Context, commentID int) error
+	FindCommentsEnabled(ctx context.Context, newsID int) (bool, error)
+	UpdateCommentsEnabled(ctx context.Context, params UpdateCommentsEnabledParams) error
+}
+
+// CommentService 구독자는 구독한 학교의 발행된 소식에 댓글을 달고 학교 OWNER는 댓글을 관리한다.
+type CommentService interface {
+	CreateComment(ctx context.Context, req CreateCommentRequest) error
+	ListComments(ctx context.Context, req ListCommentsRequest) (ListCommentsResponse, error)
+	HideComment(ctx context.Context, req HideCommentRequest) error
+	DeleteComment(ctx context.Context, req DeleteCommentRequest) error
+	UpdateCommentSetting(ctx context.Context, req UpdateCommentSettingRequest) error
+}
+
+type CommentController interface {
+	CreateComment(c *gin.Context)
+	ListComments(c *gin.Context)
+	HideComment(c *gin.Context)
+	DeleteComment(c *gin.Context)
+	UpdateCommentSetting(c *gin.Context)
+}
+
+// Comment 숨긴 댓글은 학교 멤버에게만 보이고 삭제된 댓글은 아무에게도 보이지 않는다.
+type Comment struct {
+	Base
+	NewsID   int
+	UserID   int
+	UserName string
+	Body     string
+	Hidden   bool
+}
+
+type ListCommentsParams struct {
+	NewsID        int
+	Cursor        *int
+	IncludeHidden bool
+}
+
+type UpdateCommentHiddenParams struct {
+	CommentID int
+	Hidden    bool
+}
+
+type UpdateCommentsEnabledParams struct {
+	NewsID  int
+	Enabled bool
+}
@@ -1,17 +1,27 @@
 package domain
 
 import (
+	"classting/pkg/pagination"
 	"context"
-	"fmt"
+	"database/sql"
 	"github.com/gin-gonic/gin"
+	"time"
 )
 
 type NewsRepository interface {
 	CreateNews(ctx context.Context, news News) (int, error)
 	ListNews(ctx context.Context, params ListNewsParams) ([]News, error)
-	UpdateNews(ctx context.Context, news News) error
+	UpdateNews(ctx context.Context, params UpdateNewsParams) error
 	FindNewsByID(ctx context.Context, newsID int) (*News, error)
 	DeleteNews(ctx context.Context, newsID int) error
+	ListDueScheduledNews(ctx context.Context, params ListDueScheduledNewsParams) ([]News, error)
+	PublishScheduledNews(ctx context.Context, params PublishScheduledNewsParams) (bool, error)
+	ListNewsRevisions(ctx context.Context, newsID int) ([]NewsRevision, error)
+	FindNewsRevision(ctx context.Context, params FindNewsRevisionParams) (*NewsRevision, error)
+	UpdateNewsPriority(ctx context.Context, params UpdateNewsPriorityParams) error
+	PinNews(ctx context.Context, params PinNewsParams) (bool, error)
+	UnpinNews(ctx context.Context, newsID int) error
+	ListPinnedNews(ctx context.Context, params ListPinnedNewsParams) ([]News, error)
 }
 
 type NewsService interface {
@@ -19,6 +29,12 @@ type NewsService interface {
 	ListNews(ctx context.Context, req ListNewsRequest) (ListNewsResponse, error)
 	UpdateNews(ctx context.Context, req UpdateNewsRequest) error
 	DeleteNews(ctx context.Context, req DeleteNewsRequest) error
+	ListNewsRevisions(ctx context.Context, req ListNewsRevisionsRequest) (ListNewsRevisionsResponse, error)
+	DiffNewsRevisions(ctx context.Context, req DiffNewsRevisionsRequest) (NewsRevisionDiffDTO, error)
+	RollbackNews(ctx context.Context, req RollbackNewsRequest) error
+	UpdateNewsPriority(ctx context.Context, req UpdateNewsPriorityRequest) error
+	PinNews(ctx context.Context, req PinNewsRequest) error
+	UnpinNews(ctx context.Context, req UnpinNewsRequest) error
 }
 
 type NewsController interface {
@@ -26,41 +42,231 @@ type NewsController interface {
 	ListNews(c *gin.Context)
 	UpdateNews(c *gin.Context)
 	DeleteNews(c *gin.Context)
+	ListNewsRevisions(c *gin.Context)
+	DiffNewsRevisions(c *gin.Context)
+	RollbackNews(c *gin.Context)
+	UpdateNewsPriority(c *gin.Context)
+	PinNews(c *gin.Context)
+	UnpinNews(c *gin.Context)
+}
+
+// NewsEventPublisher 소식의 발행, 수정, 삭제 이벤트를 전달한다.
+type NewsEventPublisher interface {
+	PublishNewsEvent(ctx context.Context, event NewsEvent) error
+}
+
+type NewsEventType string
+
+const (
+	NewsEventTypeCreated NewsEventType = "news.created"
+	NewsEventTypeUpdated NewsEventType = "news.updated"
+	NewsEventTypeDeleted NewsEventType = "news.deleted"
+)
+
+type NewsEvent struct {
+	ID   int // 아웃박스 이벤트 ID, 같은 이벤트가 다시 전달되었는지 구분할 때 사용한다.
+	Type NewsEventType
+	News News
 }
 
 type News struct {
 	Base
-	SchoolID int
-	UserID   int
-	Title    string
+	SchoolID      int
+	UserID        int
+	Title         string
+	Summary       string
+	Body          string
+	ContentFormat NewsContentFormat
+	Status        NewsStatus
+	PublishDate   sql.NullTime
+	EditDate      sql.NullTime // 발행된 뒤 제목이나 본문이 마지막으로 수정된 시각
+	Priority      NewsPriority
 }
 
-type ListNewsParams struct {
-	UserID   *int
-	SchoolID *int
-	Cursor   *int
+// NewsRevision 소식을 수정할 때마다 수정 전 내용을 남긴다.
+// EditorID와 CreateDate는 이 내용을 수정한 유저와 시각이다.
+type NewsRevision struct {
+	ID            int
+	NewsID        int
+	Revision      int
+	EditorID      int
+	Title         string
+	Summary       string
+	Body          string
+	ContentFormat NewsContentFormat
+	CreateDate    time.Time
+}
+
+// ApplyTo 소식의 제목, 요약, 본문, 본문 형식을 리비전의 내용으로 바꾼다.
+func (r NewsRevision) ApplyTo(news News) News {
+	news.Title = r.Title
+	news.Summary = r.Summary
+	news.Body = r.Body
+	news.ContentFormat = r.ContentFormat
+
+	return news
+}
+
+// SameContent 제목, 요약, 본문, 본문 형식이 모두 같은지 비교한다.
+func (n News) SameContent(other News) bool {
+	return n.Title == other.Title &&
+		n.Summary == other.Summary &&
+		n.Body == other.Body &&
+		n.ContentFormat == other.ContentFormat
+}
+
+// NewsStatus 구독자에게는 PUBLISHED 상태의 소식만 노출된다.
+type NewsStatus string
+
+const (
+	NewsStatusDraft     NewsStatus = "DRAFT"
+	NewsStatusScheduled NewsStatus = "SCHEDULED"
+	NewsStatusPublished NewsStatus = "PUBLISHED"
+	NewsStatusArchived  NewsStatus = "ARCHIVED"
+)
+
+func (s NewsStatus) Valid() bool {
+	switch s {
+	case NewsStatusDraft, NewsStatusScheduled, NewsStatusPublished, NewsStatusArchived:
+		return true
+	}
+
+	return false
+}
+
+// NewsPriority URGENT 소식은 휴교 안내처럼 구독자가 바로 확인해야 하는 소식이다.
+type NewsPriority string
+
+const (
+	NewsPriorityNormal NewsPriority = "NORMAL"
+	NewsPriorityUrgent NewsPriority = "URGENT"
+)
+
+func (p NewsPriority) Valid() bool {
+	return p == NewsPriorityNormal || p == NewsPriorityUrgent
+}
+
+// CanTransitionTo 임시 저장, 예약 상태의 소식은 서로 바꾸거나 발행할 수 있고 발행된 소식은 보관만 할 수 있다. 보관된 소식은 상태를 바꿀 수 없다.
+func (s NewsStatus) CanTransitionTo(next NewsStatus) bool {
+	if s == next {
+		return s != NewsStatusArchived
+	}
+
+	switch s {
+	case NewsStatusDraft, NewsStatusScheduled:
+		return next == NewsStatusDraft || next == NewsStatusScheduled || next == NewsStatusPublished
+	case NewsStatusPublished:
+		return next == NewsStatusArchived
+	}
+
+	return false
 }
 
-func (lp ListNewsParams) AndSchoolID() string {
-	if lp.SchoolID == nil {
-		return ""
+// NewsEventTypeForTransition 구독자 입장에서 소식은 발행될 때 생성되고 보관될 때 삭제된다. 발행 전 소식의 변경은 이벤트를 남기지 않는다.
+func NewsEventTypeForTransition(from, to NewsStatus) (NewsEventType, bool) {
+	switch {
+	case from == NewsStatusPublished && to == NewsStatusPublished:
+		return NewsEventTypeUpdated, true
+	case from != NewsStatusPublished && to == NewsStatusPublished:
+		return NewsEventTypeCreated, true
+	case from == NewsStatusPublished && to == NewsStatusArchived:
+		return NewsEventTypeDeleted, true
 	}
 
-	return fmt.Sprintf("AND school_id = %d", *lp.SchoolID)
+	return "", false
 }
 
-func (lp ListNewsParams) AndUserID() string {
-	if lp.UserID == nil {
-		return ""
+// NewsContentFormat 소식 본문의 형식
+type NewsContentFormat string
+
+const (
+	NewsContentFormatPlain    NewsContentFormat = "PLAIN"
+	NewsContentFormatMarkdown NewsContentFormat = "MARKDOWN"
+	// NewsContentFormatHTML 저장하지 않고 ?format=html 로 조회할 때 렌더링한 본문의 형식
+	NewsContentFormatHTML NewsContentFormat = "HTML"
+)
+
+// NewsResponseFormat 소식 조회 시 본문을 원문 그대로 받을지 HTML로 렌더링해서 받을지 선택한다.
+type NewsResponseFormat string
+
+const (
+	NewsResponseFormatRaw  NewsResponseFormat = ""
+	NewsResponseFormatHTML NewsResponseFormat = "html"
+)
+
+func (f NewsResponseFormat) Valid() bool {
+	return f == NewsResponseFormatRaw || f == NewsResponseFormatHTML
+}
+
+// NormalizeNewsContent 형식을 지정하지 않으면 PLAIN으로 저장한다.
+// 요약과 본문은 입력한 그대로 저장하고 HTML 응답을 만들 때 content.RenderMarkdown, content.RenderPlain으로 안전하게 변환한다.
+func NormalizeNewsContent(news News) News {
+	if news.ContentFormat == "" {
+		news.ContentFormat = NewsContentFormatPlain
 	}
 
-	return fmt.Sprintf("AND user_id = %d", *lp.UserID)
+	return news
 }
 
-func (lp ListNewsParams) AfterCursor() string {
-	if lp.Cursor == nil {
-		return ""
+func NewsIDs(news []News) []int {
+	newsIDs := make([]int, 0, len(news))
+	for _, n := range news {
+		newsIDs = append(newsIDs, n.ID)
 	}
 
-	return fmt.Sprintf("AND id < %d", *lp.Cursor)
+	return newsIDs
+}
+
+// ListNewsParams 아이디 최신 순 목록에서 Cursor 다음의 소식을 Limit개 조회한다.
+type ListNewsParams struct {
+	UserID     *int
+	SchoolID   *int
+	Cursor     *pagination.Cursor
+	Limit      int
+	Status     NewsStatus // 비어 있으면 발행된 소식만 조회한다.
+	ExcludeIDs []int      // 상단에 고정된 소식처럼 따로 보여주는 소식을 제외한다.
+}
+
+// UpdateNewsParams PreviousStatus는 수정 전에 조회한 상태로, 그 사이에 상태가 바뀌었다면 수정하지 않는다.
+// 내용이 바뀌면 EditorID로 수정 전 내용을 리비전으로 남긴다.
+type UpdateNewsParams struct {
+	News           News
+	PreviousStatus NewsStatus
+	EditorID       int
+}
+
+type FindNewsRevisionParams struct {
+	NewsID   int
+	Revision int
+}
+
+type ListDueScheduledNewsParams struct {
+	Now   time.Time
+	Limit int
+}
+
+type PublishScheduledNewsParams struct {
+	NewsID int
+	Now    time.Time
+}
+
+type UpdateNewsPriorityParams struct {
+	NewsID   int
+	Priority NewsPriority
+}
+
+// PinNewsParams 학교마다 만료되지 않은 고정 소식이 MaxPins개를 넘지 않도록 한다.
+// 이미 고정된 소식이면 만료 시각만 바꾼다.
+type PinNewsParams struct {
+	NewsID     int
+	SchoolID   int
+	UserID     int
+	ExpireDate sql.NullTime
+	MaxPins    int
+	Now        time.Time
+}
+
+type ListPinnedNewsParams struct {
+	SchoolID int
+	Now      time.Time
 }
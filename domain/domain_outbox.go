@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type OutboxRepository interface {
+	ClaimOutboxEvents(ctx context.Context, params ClaimOutboxEventsParams) ([]OutboxEvent, error)
+	MarkOutboxEventDispatched(ctx context.Context, eventID int) error
+	MarkOutboxEventFailed(ctx context.Context, params MarkOutboxEventFailedParams) error
+	ListOutboxEvents(ctx context.Context, params ListOutboxEventsParams) ([]OutboxEvent, error)
+	FindLastOutboxEventID(ctx context.Context) (int, error)
+}
+
+// OutboxSink 아웃박스 이벤트를 전달 받는다.
+// 같은 이벤트가 두 번 이상 전달될 수 있으므로(at-least-once) 이벤트 ID로 멱등하게 처리해야 한다.
+type OutboxSink interface {
+	HandleOutboxEvent(ctx context.Context, event OutboxEvent) error
+}
+
+type OutboxAggregateType string
+
+const (
+	OutboxAggregateTypeNews         OutboxAggregateType = "news"
+	OutboxAggregateTypeSubscription OutboxAggregateType = "subscription"
+)
+
+// OutboxEvent 도메인 변경과 같은 트랜잭션에서 기록되는 이벤트
+type OutboxEvent struct {
+	ID            int
+	CreateDate    time.Time
+	AggregateType OutboxAggregateType
+	AggregateID   int
+	EventType     string
+	Payload       []byte
+	Attempts      int
+	// DispatchedSinks 이전 시도에서 전달에 성공한 싱크 이름
+	DispatchedSinks []string
+}
+
+// ClaimOutboxEventsParams Lease 동안 가져간 이벤트는 다른 인스턴스가 가져가지 않는다.
+type ClaimOutboxEventsParams struct {
+	Limit       int
+	MaxAttempts int
+	Lease       time.Duration
+}
+
+type MarkOutboxEventFailedParams struct {
+	ID              int
+	LastError       string
+	DispatchedSinks []string
+}
+
+// ListOutboxEventsParams After보다 아이디가 큰 이벤트와 IDs의 이벤트를 아이디 순으로 조회한다.
+type ListOutboxEventsParams struct {
+	After int
+	IDs   []int
+	Limit int
+}
+
+func OutboxEventFromNews(eventType NewsEventType, news News) (OutboxEvent, error) {
+	payload, err := json.Marshal(news)
+	if err != nil {
+		return OutboxEvent{}, err
+	}
+
+	return OutboxEvent{
+		AggregateType: OutboxAggregateTypeNews,
+		AggregateID:   news.ID,
+		EventType:     string(eventType),
+		Payload:       payload,
+	}, nil
+}
+
+func OutboxEventFromSubscription(eventType SubscriptionEventType, subscription Subscription) (OutboxEvent, error) {
+	payload, err := json.Marshal(subscription)
+	if err != nil {
+		return OutboxEvent{}, err
+	}
+
+	return OutboxEvent{
+		AggregateType: OutboxAggregateTypeSubscription,
+		AggregateID:   subscription.ID,
+		EventType:     string(eventType),
+		Payload:       payload,
+	}, nil
+}
+
+func (e OutboxEvent) NewsEvent() (NewsEvent, error) {
+	if e.AggregateType != OutboxAggregateTypeNews {
+		return NewsEvent{}, fmt.Errorf("outbox event %d is not a news event", e.ID)
+	}
+
+	var news News
+	if err := json.Unmarshal(e.Payload, &news); err != nil {
+		return NewsEvent{}, err
+	}
+
+	return NewsEvent{
+		ID:   e.ID,
+		Type: NewsEventType(e.EventType),
+		News: news,
+	}, nil
+}
+
+func (e OutboxEvent) SubscriptionEvent() (SubscriptionEvent, error) {
+	if e.AggregateType != OutboxAggregateTypeSubscription {
+		return SubscriptionEvent{}, fmt.Errorf("outbox event %d is not a subscription event", e.ID)
+	}
+
+	var subscription Subscription
+	if err := json.Unmarshal(e.Payload, &subscription); err != nil {
+		return SubscriptionEvent{}, err
+	}
+
+	return SubscriptionEvent{
+		ID:           e.ID,
+		Type:         SubscriptionEventType(e.EventType),
+		Subscription: subscription,
+	}, nil
+}
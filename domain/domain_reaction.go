@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"time"
+)
+
+type ReactionRepository interface {
+	CreateReaction(ctx context.Context, reaction Reaction) error
+	DeleteReaction(ctx context.Context, params DeleteReactionParams) error
+	ListReactionCounts(ctx context.Context, params ListReactionCountsParams) ([]ReactionCount, error)
+}
+
+// ReactionService 구독자는 구독한 학교의 발행된 소식에 이모지마다 한 번씩 반응을 남긴다.
+type ReactionService interface {
+	AddReaction(ctx context.Context, req AddReactionRequest) error
+	RemoveReaction(ctx context.Context, req RemoveReactionRequest) error
+	ListNewsReactions(ctx context.Context, params ListReactionCountsParams) (map[int][]ReactionDTO, error)
+}
+
+type ReactionController interface {
+	AddReaction(c *gin.Context)
+	RemoveReaction(c *gin.Context)
+}
+
+// ReactionEmoji 경로에 그대로 쓸 수 있도록 이모지 대신 이름을 사용한다.
+type ReactionEmoji string
+
+const (
+	ReactionEmojiThumbsUp  ReactionEmoji = "thumbs_up"
+	ReactionEmojiHeart     ReactionEmoji = "heart"
+	ReactionEmojiClap      ReactionEmoji = "clap"
+	ReactionEmojiLaugh     ReactionEmoji = "laugh"
+	ReactionEmojiSurprised ReactionEmoji = "surprised"
+)
+
+func (e ReactionEmoji) Valid() bool {
+	switch e {
+	case ReactionEmojiThumbsUp, ReactionEmojiHeart, ReactionEmojiClap, ReactionEmojiLaugh, ReactionEmojiSurprised:
+		return true
+	}
+
+	return false
+}
+
+type Reaction struct {
+	NewsID     int
+	UserID     int
+	Emoji      ReactionEmoji
+	CreateDate time.Time
+}
+
+// ReactionCount 소식, 이모지별 반응 수와 조회한 유저가 반응했는지 여부
+type ReactionCount struct {
+	NewsID      int
+	Emoji       ReactionEmoji
+	Count       int
+	ReactedByMe bool
+}
+
+type DeleteReactionParams struct {
+	NewsID int
+	UserID int
+	Emoji  ReactionEmoji
+}
+
+type ListReactionCountsParams struct {
+	UserID  int
+	NewsIDs []int
+}
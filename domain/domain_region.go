@@ -0,0 +1,64 @@
+package domain
+
+import "strings"
+
+// Region 시/도 단위 지역 분류, 학교 지역은 약칭(Code)으로 저장한다.
+type Region struct {
+	Code string
+	Name string
+}
+
+// regions 행정구역 순서로 정렬한 시/도 목록, source/migrations의 regions 테이블과 같아야 한다.
+var regions = []Region{
+	{Code: "서울", Name: "서울특별시"},
+	{Code: "부산", Name: "부산광역시"},
+	{Code: "대구", Name: "대구광역시"},
+	{Code: "인천", Name: "인천광역시"},
+	{Code: "광주", Name: "광주광역시"},
+	{Code: "대전", Name: "대전광역시"},
+	{Code: "울산", Name: "울산광역시"},
+	{Code: "세종", Name: "세종특별자치시"},
+	{Code: "경기", Name: "경기도"},
+	{Code: "강원", Name: "강원특별자치도"},
+	{Code: "충북", Name: "충청북도"},
+	{Code: "충남", Name: "충청남도"},
+	{Code: "전북", Name: "전북특별자치도"},
+	{Code: "전남", Name: "전라남도"},
+	{Code: "경북", Name: "경상북도"},
+	{Code: "경남", Name: "경상남도"},
+	{Code: "제주", Name: "제주특별자치도"},
+}
+
+// regionAliases 명칭이 바뀌기 전의 이름이나 흔히 쓰는 시/도 이름, source/migrations의 region_aliases 표와 같아야 한다.
+var regionAliases = map[string]string{
+	"강원도":  "강원",
+	"전라북도": "전북",
+	"제주도":  "제주",
+	"서울시":  "서울",
+	"세종시":  "세종",
+}
+
+var regionCodes = func() map[string]string {
+	codes := make(map[string]string, len(regions)*2+len(regionAliases))
+	for _, region := range regions {
+		codes[region.Code] = region.Code
+		codes[region.Name] = region.Code
+	}
+	for alias, code := range regionAliases {
+		codes[alias] = code
+	}
+
+	return codes
+}()
+
+// Regions 전체 시/도 목록을 반환한다.
+func Regions() []Region {
+	return append([]Region(nil), regions...)
+}
+
+// NormalizeRegion 약칭, 정식 명칭, 이전 명칭을 시/도 약칭으로 바꾼다. 분류에 없는 지역이면 false를 반환한다.
+func NormalizeRegion(region string) (string, bool) {
+	code, ok := regionCodes[strings.Join(strings.Fields(region), "")]
+
+	return code, ok
+}
@@ -1,26 +1,51 @@
 package domain
 
 import (
+	"classting/pkg/pagination"
 	"context"
-	"fmt"
 	"github.com/gin-gonic/gin"
+	"strconv"
 )
 
 type SchoolRepository interface {
 	CreateSchool(ctx context.Context, school School) (int, error)
-	ListSchools(ctx context.Context, params ListSchoolsParams) ([]School, error)
+	ListSchools(ctx context.Context, params ListSchoolsParams) ([]DirectorySchool, error)
 	FindSchoolByNameAndRegion(ctx context.Context, params FindSchoolByNameAndRegionParams) (*School, error)
 	FindSchoolByID(ctx context.Context, schoolID int) (*School, error)
+	UpdateSchool(ctx context.Context, school School) error
+	DeleteSchool(ctx context.Context, schoolID int) error
+	RestoreSchool(ctx context.Context, schoolID int) error
+	FindSchoolMember(ctx context.Context, params FindSchoolMemberParams) (*SchoolMember, error)
+	ListSchoolMembers(ctx context.Context, schoolID int) ([]SchoolMember, error)
+	CreateSchoolMember(ctx context.Context, member SchoolMember) (int, error)
+	DeleteSchoolMember(ctx context.Context, memberID int) error
+	TransferSchoolOwnership(ctx context.Context, params TransferSchoolOwnershipParams) error
 }
 
 type SchoolService interface {
 	CreateSchool(ctx context.Context, req CreateSchoolRequest) error
 	ListSchools(ctx context.Context, req ListSchoolsRequest) (ListSchoolsResponse, error)
+	ListRegions(ctx context.Context) (ListRegionsResponse, error)
+	UpdateSchool(ctx context.Context, req UpdateSchoolRequest) error
+	DeleteSchool(ctx context.Context, req DeleteSchoolRequest) error
+	RestoreSchool(ctx context.Context, req RestoreSchoolRequest) error
+	ListSchoolMembers(ctx context.Context, req ListSchoolMembersRequest) (ListSchoolMembersResponse, error)
+	InviteSchoolMember(ctx context.Context, req InviteSchoolMemberRequest) error
+	RemoveSchoolMember(ctx context.Context, req RemoveSchoolMemberRequest) error
+	TransferSchoolOwnership(ctx context.Context, req TransferSchoolOwnershipRequest) error
 }
 
 type SchoolController interface {
 	CreateSchool(c *gin.Context)
 	ListSchools(c *gin.Context)
+	ListRegions(c *gin.Context)
+	UpdateSchool(c *gin.Context)
+	DeleteSchool(c *gin.Context)
+	RestoreSchool(c *gin.Context)
+	ListSchoolMembers(c *gin.Context)
+	InviteSchoolMember(c *gin.Context)
+	RemoveSchoolMember(c *gin.Context)
+	TransferSchoolOwnership(c *gin.Context)
 }
 
 type School struct {
@@ -30,28 +55,119 @@ type School struct {
 	Region string
 }
 
+// Deleted 삭제된 학교는 목록, 새 구독, 소식 발행에서 제외된다.
+func (s School) Deleted() bool {
+	return s.DeleteDate.Valid
+}
+
+// SchoolRole 학교 멤버의 역할, OWNER는 학교마다 한 명이다.
+type SchoolRole string
+
+const (
+	SchoolRoleOwner  SchoolRole = "OWNER"
+	SchoolRoleEditor SchoolRole = "EDITOR"
+	SchoolRoleViewer SchoolRole = "VIEWER"
+)
+
+var schoolRoleLevels = map[SchoolRole]int{
+	SchoolRoleViewer: 1,
+	SchoolRoleEditor: 2,
+	SchoolRoleOwner:  3,
+}
+
+// Includes 역할이 role의 권한을 포함하는지 확인한다. (OWNER > EDITOR > VIEWER)
+func (r SchoolRole) Includes(role SchoolRole) bool {
+	level, ok := schoolRoleLevels[r]
+	if !ok {
+		return false
+	}
+
+	return level >= schoolRoleLevels[role]
+}
+
+// IncludingRoles role의 권한을 포함하는 역할 목록 (VIEWER, EDITOR, OWNER 순)
+func (r SchoolRole) IncludingRoles() []SchoolRole {
+	var roles []SchoolRole
+	for _, role := range []SchoolRole{SchoolRoleViewer, SchoolRoleEditor, SchoolRoleOwner} {
+		if role.Includes(r) {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles
+}
+
+// SchoolMember 학교를 함께 운영하는 관리자
+type SchoolMember struct {
+	Base
+	SchoolID int
+	UserID   int
+	UserName string
+	Role     SchoolRole
+}
+
+type FindSchoolMemberParams struct {
+	SchoolID int
+	UserID   int
+}
+
+type TransferSchoolOwnershipParams struct {
+	SchoolID   int
+	FromUserID int
+	ToUserID   int
+}
+
 type FindSchoolByNameAndRegionParams struct {
 	Name   string
 	Region string
 }
 
-type ListSchoolsParams struct {
-	UserID *int
-	Cursor *int
-}
+// SchoolSort 학교 목록 정렬 기준, 같은 값이면 아이디로 정렬해 페이지 경계가 흔들리지 않게 한다.
+type SchoolSort string
 
-func (lp ListSchoolsParams) AndUserID() string {
-	if lp.UserID == nil {
-		return ""
+const (
+	// SchoolSortLatest 최신 생성 순 (id DESC)
+	SchoolSortLatest SchoolSort = "latest"
+	// SchoolSortName 학교명 가나다 순 (name ASC, id ASC)
+	SchoolSortName SchoolSort = "name"
+	// SchoolSortSubscribers 구독자 많은 순 (subscriber_count DESC, id DESC)
+	SchoolSortSubscribers SchoolSort = "subscribers"
+)
+
+func (s SchoolSort) Valid() bool {
+	switch s {
+	case SchoolSortLatest, SchoolSortName, SchoolSortSubscribers:
+		return true
 	}
 
-	return fmt.Sprintf("AND user_id = %d", *lp.UserID)
+	return false
 }
 
-func (lp ListSchoolsParams) AfterCursor() string {
-	if lp.Cursor == nil {
-		return ""
+// SortKey 정렬 기준의 값을 커서에 담을 문자열로 반환한다. 최신 순은 아이디만으로 정렬하므로 비어 있다.
+func (s SchoolSort) SortKey(school DirectorySchool) string {
+	switch s {
+	case SchoolSortName:
+		return school.Name
+	case SchoolSortSubscribers:
+		return strconv.Itoa(school.SubscriberCount)
 	}
 
-	return fmt.Sprintf("AND id < %d", *lp.Cursor)
+	return ""
+}
+
+// DirectorySchool 학교 목록 조회 결과, Subscribed는 목록을 조회한 유저의 구독 여부
+type DirectorySchool struct {
+	School
+	SubscriberCount int
+	Subscribed      bool
+}
+
+// ListSchoolsParams 삭제되지 않은 학교를 Sort 순으로 정렬한 목록에서 Cursor 다음의 학교를 Limit개 조회한다.
+type ListSchoolsParams struct {
+	RequestUserID int
+	UserID        *int
+	Region        string
+	Sort          SchoolSort
+	Cursor        *pagination.Cursor
+	Limit         int
 }
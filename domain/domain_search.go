@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+)
+
+// SearchIndex 학교와 소식의 검색 색인, 검색 결과는 아이디 기반으로 최신 순 정렬한 아이디만 반환한다.
+// 학교, 소식이 생성, 수정, 삭제될 때 함께 갱신한다.
+type SearchIndex interface {
+	IndexSchool(ctx context.Context, school School) error
+	RemoveSchool(ctx context.Context, schoolID int) error
+	IndexNews(ctx context.Context, news News) error
+	RemoveNews(ctx context.Context, newsID int) error
+	SearchSchools(ctx context.Context, params SearchSchoolsParams) ([]int, error)
+	SearchNews(ctx context.Context, params SearchNewsParams) ([]int, error)
+}
+
+// SearchRepository 검색 결과의 아이디로 학교, 소식을 조회하고 메모리 색인을 만들 때 전체 문서를 읽는다.
+type SearchRepository interface {
+	ListSchoolsByIDs(ctx context.Context, schoolIDs []int) ([]School, error)
+	ListNewsByIDs(ctx context.Context, newsIDs []int) ([]News, error)
+	ListMemberSchoolIDs(ctx context.Context, params ListMemberSchoolIDsParams) ([]int, error)
+	ListIndexSchools(ctx context.Context, params ListIndexDocumentsParams) ([]School, error)
+	ListIndexNews(ctx context.Context, params ListIndexDocumentsParams) ([]News, error)
+}
+
+type SearchService interface {
+	SearchSchools(ctx context.Context, req SearchSchoolsRequest) (SearchSchoolsResponse, error)
+	SearchNews(ctx context.Context, req SearchNewsRequest) (SearchNewsResponse, error)
+}
+
+type SearchController interface {
+	SearchSchools(c *gin.Context)
+	SearchNews(c *gin.Context)
+}
+
+type SearchSchoolsParams struct {
+	Query  string
+	Cursor *int
+}
+
+// SearchNewsParams SchoolIDs에 속한 소식만 검색하고 Status가 비어 있으면 모든 상태의 소식을 검색한다.
+type SearchNewsParams struct {
+	Query     string
+	SchoolIDs []int
+	Status    NewsStatus
+	Cursor    *int
+}
+
+// ListMemberSchoolIDsParams 유저가 Role 이상의 역할로 속한 삭제되지 않은 학교를 찾는다.
+type ListMemberSchoolIDsParams struct {
+	UserID int
+	Role   SchoolRole
+}
+
+// ListIndexDocumentsParams 삭제되지 않은 문서를 AfterID 이후부터 아이디 순으로 Limit개씩 읽는다.
+type ListIndexDocumentsParams struct {
+	AfterID int
+	Limit   int
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+)
+
+type StreamService interface {
+	NewsEventPublisher
+	SubscriptionEventPublisher
+	OpenNewsStream(ctx context.Context, req OpenNewsStreamRequest) (NewsStream, error)
+	OpenGatewaySession(ctx context.Context, userID int) (GatewaySession, error)
+}
+
+type StreamController interface {
+	StreamSubscriptionNews(c *gin.Context)
+	ConnectGateway(c *gin.Context)
+}
+
+// NewsStream 구독 중인 학교의 소식 이벤트 스트림
+type NewsStream struct {
+	Missed []News           // Last-Event-ID 이후 발행되어 놓친 소식 (오래된 순)
+	Events <-chan NewsEvent // 실시간 소식 이벤트, 스트림이 종료되면 닫힌다.
+	Close  func()
+}
+
+// GatewaySession 웹소켓 연결 하나가 구독하는 학교 채널과 이벤트
+type GatewaySession interface {
+	// NewsEvents 참여 중인 학교 채널의 소식 이벤트, 세션이 종료되면 닫힌다.
+	NewsEvents() <-chan NewsEvent
+	// SubscriptionEvents 사용자의 구독 생성, 취소 이벤트, 세션이 종료되면 닫힌다.
+	SubscriptionEvents() <-chan SubscriptionEvent
+	// Join 구독 중인 학교인 경우에만 학교 채널에 참여한다.
+	Join(ctx context.Context, schoolID int) error
+	Leave(schoolID int)
+	Close()
+}
@@ -1,8 +1,9 @@
 package domain
 
 import (
+	"classting/pkg/pagination"
 	"context"
-	"fmt"
+	"database/sql"
 	"github.com/gin-gonic/gin"
 )
 
@@ -11,20 +12,49 @@ type SubscriptionRepository interface {
 	ListSubscriptionSchools(ctx context.Context, params ListSubscriptionSchoolsParams) ([]SubscriptionSchool, error)
 	DeleteSubscription(ctx context.Context, subscriptionID int) error
 	FindSubscriptionByUserIDAndSchoolID(ctx context.Context, params FindSubscriptionByUserIDAndSchoolIDParams) (*Subscription, error)
+	ListSubscriptionSchoolIDs(ctx context.Context, userID int) ([]int, error)
+	MarkNewsRead(ctx context.Context, params MarkNewsReadParams) error
+	MarkSchoolNewsReadUntil(ctx context.Context, params MarkSchoolNewsReadUntilParams) error
+	CountUnreadNews(ctx context.Context, params CountUnreadNewsParams) (map[int]int, error)
+	ListReadNewsIDs(ctx context.Context, params ListReadNewsIDsParams) (map[int]bool, error)
 }
 
 type SubscriptionService interface {
 	CreateSubscription(ctx context.Context, req CreateSubscriptionRequest) error
 	ListSubscriptionSchools(ctx context.Context, req ListSubscriptionSchoolsRequest) (ListSubscriptionSchoolsResponse, error)
 	ListSubscriptionSchoolNews(ctx context.Context, req ListSubscriptionSchoolNewsRequest) (ListSubscriptionSchoolNewsResponse, error)
+	ListSubscriptionFeed(ctx context.Context, req ListSubscriptionFeedRequest) (ListSubscriptionFeedResponse, error)
 	DeleteSubscription(ctx context.Context, req DeleteSubscriptionRequest) error
+	MarkNewsRead(ctx context.Context, req MarkNewsReadRequest) error
+	MarkSchoolNewsRead(ctx context.Context, req MarkSchoolNewsReadRequest) error
 }
 
 type SubscriptionController interface {
 	CreateSubscription(c *gin.Context)
 	ListSubscriptionSchools(c *gin.Context)
 	ListSubscriptionSchoolNews(c *gin.Context)
+	ListSubscriptionFeed(c *gin.Context)
 	DeleteSubscription(c *gin.Context)
+	MarkNewsRead(c *gin.Context)
+	MarkSchoolNewsRead(c *gin.Context)
+}
+
+// SubscriptionEventPublisher 구독 생성, 취소 이벤트를 전달한다.
+type SubscriptionEventPublisher interface {
+	PublishSubscriptionEvent(ctx context.Context, event SubscriptionEvent) error
+}
+
+type SubscriptionEventType string
+
+const (
+	SubscriptionEventTypeCreated SubscriptionEventType = "subscription.created"
+	SubscriptionEventTypeDeleted SubscriptionEventType = "subscription.deleted"
+)
+
+type SubscriptionEvent struct {
+	ID           int // 아웃박스 이벤트 ID
+	Type         SubscriptionEventType
+	Subscription Subscription
 }
 
 type Subscription struct {
@@ -35,9 +65,10 @@ type Subscription struct {
 
 type SubscriptionSchool struct {
 	Base
-	SchoolID int
-	Name     string
-	Region   string
+	SchoolID         int
+	Name             string
+	Region           string
+	SchoolDeleteDate sql.NullTime
 }
 
 type FindSubscriptionByUserIDAndSchoolIDParams struct {
@@ -45,15 +76,33 @@ type FindSubscriptionByUserIDAndSchoolIDParams struct {
 	SchoolID int
 }
 
+// ListSubscriptionSchoolsParams 구독 아이디 최신 순 목록에서 Cursor 다음의 구독을 Limit개 조회한다.
 type ListSubscriptionSchoolsParams struct {
 	UserID int
-	Cursor *int
+	Cursor *pagination.Cursor
+	Limit  int
 }
 
-func (lp ListSubscriptionSchoolsParams) AfterCursor() string {
-	if lp.Cursor == nil {
-		return ""
-	}
+// MarkNewsReadParams 구독 중인 학교의 소식만 읽음으로 기록된다.
+type MarkNewsReadParams struct {
+	UserID   int
+	SchoolID int
+	NewsID   int
+}
+
+// MarkSchoolNewsReadUntilParams NewsID 이하의 소식을 모두 읽음으로 처리한다.
+type MarkSchoolNewsReadUntilParams struct {
+	UserID   int
+	SchoolID int
+	NewsID   int
+}
+
+type CountUnreadNewsParams struct {
+	UserID    int
+	SchoolIDs []int
+}
 
-	return fmt.Sprintf("AND subscriptions.id < %d", *lp.Cursor)
+type ListReadNewsIDsParams struct {
+	UserID  int
+	NewsIDs []int
 }
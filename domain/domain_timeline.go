@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"classting/pkg/pagination"
+	"context"
+)
+
+type TimelineRepository interface {
+	CreateTimelines(ctx context.Context, params CreateTimelinesParams) error
+	BackfillTimelines(ctx context.Context, params BackfillTimelinesParams) error
+	ListTimelineNews(ctx context.Context, params ListTimelineNewsParams) ([]News, error)
+	ListTimelineNewsAfter(ctx context.Context, params ListTimelineNewsAfterParams) ([]News, error)
+	HideTimelinesByNewsID(ctx context.Context, newsID int) error
+}
+
+// TimelineService 소식 발행, 삭제, 구독 시 구독자별 타임라인을 비동기로 갱신한다.
+type TimelineService interface {
+	FanOutNews(ctx context.Context, news News) error
+	HideNews(ctx context.Context, newsID int) error
+	BackfillSubscription(ctx context.Context, subscription Subscription) error
+}
+
+type CreateTimelinesParams struct {
+	NewsID   int
+	SchoolID int
+}
+
+type BackfillTimelinesParams struct {
+	UserID   int
+	SchoolID int
+	Limit    int
+}
+
+type ListTimelineNewsParams struct {
+	UserID int
+	Cursor *pagination.Cursor
+	Limit  int
+}
+
+type ListTimelineNewsAfterParams struct {
+	UserID int
+	After  int
+	Limit  int
+}
@@ -2,22 +2,44 @@ package domain
 
 import (
 	"context"
+	"database/sql"
 	"github.com/gin-gonic/gin"
+	"time"
 )
 
 type UserRepository interface {
 	CreateUser(ctx context.Context, user User) (int, error)
 	FindUserByUserName(ctx context.Context, userName string) (*User, error)
+	FindUserByID(ctx context.Context, userID int) (*User, error)
+	CreateRefreshToken(ctx context.Context, token RefreshToken) (int, error)
+	FindRefreshTokenByHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, params RotateRefreshTokenParams) (bool, error)
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string) error
+	RevokeUserRefreshTokens(ctx context.Context, userID int) error
 }
 
 type UserService interface {
 	CreateUser(ctx context.Context, req CreateUserRequest) error
 	LoginUser(ctx context.Context, req LoginUserRequest) (LoginUserResponse, error)
+	RefreshToken(ctx context.Context, req RefreshTokenRequest) (LoginUserResponse, error)
+	LogoutUser(ctx context.Context, req LogoutUserRequest) error
+	RevokeUserSessions(ctx context.Context, req RevokeUserSessionsRequest) error
 }
 
 type UserController interface {
 	CreateUser(c *gin.Context)
 	LoginUser(c *gin.Context)
+	RefreshToken(c *gin.Context)
+	LogoutUser(c *gin.Context)
+	RevokeUserSessions(c *gin.Context)
+}
+
+// TokenDenylist 만료 전에 폐기된 액세스 토큰을 관리한다.
+// 토큰이 만료되면 더 이상 검증을 통과하지 못하므로 폐기 기록도 만료일까지만 유지하면 된다.
+type TokenDenylist interface {
+	RevokeToken(ctx context.Context, params RevokeTokenParams) error
+	RevokeUserTokens(ctx context.Context, params RevokeUserTokensParams) error
+	IsTokenRevoked(ctx context.Context, params IsTokenRevokedParams) (bool, error)
 }
 
 // UserType 고랭에는 별도의 enum 타입이 없어서 string으로 정의
@@ -34,3 +56,44 @@ type User struct {
 	Password string
 	Type     UserType
 }
+
+// RefreshToken 리프레시 토큰은 원문 대신 SHA-256 해시만 저장한다.
+// 같은 로그인에서 회전된 토큰은 모두 같은 FamilyID를 갖는다.
+type RefreshToken struct {
+	Base
+	UserID     int
+	FamilyID   string
+	TokenHash  string
+	ExpireDate time.Time
+	RotateDate sql.NullTime
+	RevokeDate sql.NullTime
+}
+
+type RotateRefreshTokenParams struct {
+	ID   int
+	Next RefreshToken
+}
+
+type RevokeTokenParams struct {
+	TokenID    string
+	ExpireDate time.Time
+}
+
+// RevokeUserTokensParams IssuedBefore 이전에 발행된 유저의 모든 토큰을 폐기한다.
+type RevokeUserTokensParams struct {
+	UserID       int
+	IssuedBefore time.Time
+	ExpireDate   time.Time
+}
+
+// TokenRevocationBoundary 토큰의 발행 시각(iat)은 초 단위라 revokeDate와 같은 초에 먼저 발행된 토큰도 폐기되도록 다음 초를 폐기 경계로 삼는다.
+// 폐기와 같은 초에 다시 발행된 토큰도 함께 폐기되므로 다음 초부터 다시 로그인해야 한다.
+func TokenRevocationBoundary(revokeDate time.Time) time.Time {
+	return revokeDate.Truncate(time.Second).Add(time.Second)
+}
+
+type IsTokenRevokedParams struct {
+	TokenID   string
+	UserID    int
+	IssueDate time.Time
+}
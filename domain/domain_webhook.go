@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"time"
+)
+
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook Webhook) (int, error)
+	FindWebhookByID(ctx context.Context, webhookID int) (*Webhook, error)
+	ListWebhooks(ctx context.Context, params ListWebhooksParams) ([]Webhook, error)
+	DeleteWebhook(ctx context.Context, webhookID int) error
+	CreateWebhookDeliveries(ctx context.Context, params CreateWebhookDeliveriesParams) error
+	ClaimWebhookDeliveries(ctx context.Context, params ClaimWebhookDeliveriesParams) ([]PendingWebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery WebhookDelivery) error
+	ListWebhookDeliveries(ctx context.Context, params ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+}
+
+// WebhookService 소식 이벤트를 학교에 등록된 웹훅으로 전달한다.
+type WebhookService interface {
+	NewsEventPublisher
+	CreateWebhook(ctx context.Context, req CreateWebhookRequest) (CreateWebhookResponse, error)
+	ListWebhooks(ctx context.Context, req ListWebhooksRequest) (ListWebhooksResponse, error)
+	DeleteWebhook(ctx context.Context, req DeleteWebhookRequest) error
+	ListWebhookDeliveries(ctx context.Context, req ListWebhookDeliveriesRequest) (ListWebhookDeliveriesResponse, error)
+}
+
+type WebhookController interface {
+	CreateWebhook(c *gin.Context)
+	ListWebhooks(c *gin.Context)
+	DeleteWebhook(c *gin.Context)
+	ListWebhookDeliveries(c *gin.Context)
+}
+
+type Webhook struct {
+	Base
+	SchoolID int
+	UserID   int
+	URL      string
+	Secret   string
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "DEAD"
+)
+
+// WebhookDelivery 웹훅 전송 기록, 전송에 실패하면 재시도 횟수를 모두 사용할 때까지 PENDING 상태로 남는다.
+type WebhookDelivery struct {
+	Base
+	WebhookID       int
+	EventID         int
+	EventType       NewsEventType
+	NewsID          int
+	Payload         string
+	Status          WebhookDeliveryStatus
+	Attempts        int
+	NextAttemptDate time.Time
+	ResponseCode    *int
+	LastError       *string
+}
+
+// PendingWebhookDelivery 전송할 웹훅 정보를 포함한 전송 기록
+type PendingWebhookDelivery struct {
+	WebhookDelivery
+	URL    string
+	Secret string
+}
+
+type ListWebhooksParams struct {
+	SchoolID int
+	Cursor   *int
+}
+
+type CreateWebhookDeliveriesParams struct {
+	EventID   int
+	SchoolID  int
+	EventType NewsEventType
+	NewsID    int
+	Payload   string
+}
+
+// ClaimWebhookDeliveriesParams 가져간 전송 기록은 Lease 동안 다른 인스턴스가 가져가지 않는다.
+type ClaimWebhookDeliveriesParams struct {
+	Limit int
+	Lease time.Duration
+}
+
+type ListWebhookDeliveriesParams struct {
+	WebhookID int
+	Cursor    *int
+}
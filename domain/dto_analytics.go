@@ -0,0 +1,191 @@
+package domain
+
+import (
+	"classting/pkg/cerrors"
+	"math"
+	"time"
+)
+
+const (
+	AnalyticsDateLayout = "2006-01-02"
+
+	defaultAnalyticsPeriodDays = 30
+	maxAnalyticsPeriodDays     = 366
+)
+
+// NewsAnalyticsDTO 소식의 도달 통계, 읽음률은 발행 시점 구독자 수 대비 읽은 구독자 수이다.
+// 발행 이후 구독한 학생도 읽은 구독자에 포함되므로 읽음률은 1을 넘을 수 있다.
+type NewsAnalyticsDTO struct {
+	NewsID          int               `json:"newsID" example:"1"`
+	SchoolID        int               `json:"schoolID" example:"1"`
+	PublishDate     time.Time         `json:"publishDate" example:"2024-03-04T08:00:00Z"`
+	SubscriberCount int               `json:"subscriberCount" example:"120"`
+	UniqueReaders   int               `json:"uniqueReaders" example:"84"`
+	ReadRate        float64           `json:"readRate" example:"0.7"`
+	Timeline        []NewsReadRateDTO `json:"timeline"`
+}
+
+// NewsReadRateDTO 날짜별 읽음 추이, uniqueReaders와 readRate는 해당 날짜까지의 누적 값이다.
+type NewsReadRateDTO struct {
+	Date          string  `json:"date" example:"2024-03-04"`
+	NewReaders    int     `json:"newReaders" example:"60"`
+	UniqueReaders int     `json:"uniqueReaders" example:"60"`
+	ReadRate      float64 `json:"readRate" example:"0.5"`
+}
+
+// NewsAnalyticsDTOFrom 날짜별로 처음 읽은 구독자 수를 누적해 읽음 추이를 만든다.
+func NewsAnalyticsDTOFrom(news News, subscriberCount int, dailyReaders []NewsDailyReaders) NewsAnalyticsDTO {
+	dto := NewsAnalyticsDTO{
+		NewsID:          news.ID,
+		SchoolID:        news.SchoolID,
+		PublishDate:     news.PublishDate.Time,
+		SubscriberCount: subscriberCount,
+		Timeline:        make([]NewsReadRateDTO, 0, len(dailyReaders)),
+	}
+
+	for _, daily := range dailyReaders {
+		dto.UniqueReaders += daily.Readers
+		dto.Timeline = append(dto.Timeline, NewsReadRateDTO{
+			Date:          daily.Date.Format(AnalyticsDateLayout),
+			NewReaders:    daily.Readers,
+			UniqueReaders: dto.UniqueReaders,
+			ReadRate:      ratio(dto.UniqueReaders, subscriberCount),
+		})
+	}
+	dto.ReadRate = ratio(dto.UniqueReaders, subscriberCount)
+
+	return dto
+}
+
+type GetNewsAnalyticsRequest struct {
+	UserID int `swaggerignore:"true"`
+	NewsID int `uri:"newsID"`
+}
+
+func (req GetNewsAnalyticsRequest) Validate() error {
+	const op cerrors.Op = "domain/GetNewsAnalyticsRequest.Validate"
+
+	if req.NewsID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "소식 ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+// GetSchoolAnalyticsRequest from, to는 모두 포함하는 날짜(UTC)이며 생략하면 오늘까지 최근 30일을 조회한다.
+type GetSchoolAnalyticsRequest struct {
+	UserID   int    `swaggerignore:"true"`
+	SchoolID int    `uri:"schoolID" swaggerignore:"true"`
+	From     string `form:"from" example:"2024-03-01"`
+	To       string `form:"to" example:"2024-03-31"`
+}
+
+func (req GetSchoolAnalyticsRequest) Validate() error {
+	const op cerrors.Op = "domain/GetSchoolAnalyticsRequest.Validate"
+
+	if req.SchoolID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "학교 ID를 확인해주세요.")
+	}
+
+	_, _, err := req.Period(time.Now().UTC())
+
+	return err
+}
+
+// Period 조회 기간의 시작일과 마지막 날짜를 반환한다. to를 생략하면 today를 마지막 날짜로 한다.
+func (req GetSchoolAnalyticsRequest) Period(today time.Time) (time.Time, time.Time, error) {
+	const op cerrors.Op = "domain/GetSchoolAnalyticsRequest.Period"
+
+	to := truncateDate(today)
+	if req.To != "" {
+		t, err := time.Parse(AnalyticsDateLayout, req.To)
+		if err != nil {
+			return time.Time{}, time.Time{}, cerrors.E(op, cerrors.Invalid, "to는 YYYY-MM-DD 형식이어야 합니다.")
+		}
+		to = t
+	}
+
+	from := to.AddDate(0, 0, -(defaultAnalyticsPeriodDays - 1))
+	if req.From != "" {
+		f, err := time.Parse(AnalyticsDateLayout, req.From)
+		if err != nil {
+			return time.Time{}, time.Time{}, cerrors.E(op, cerrors.Invalid, "from은 YYYY-MM-DD 형식이어야 합니다.")
+		}
+		from = f
+	}
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, cerrors.E(op, cerrors.Invalid, "from은 to보다 늦을 수 없습니다.")
+	}
+	if to.Sub(from) >= maxAnalyticsPeriodDays*24*time.Hour {
+		return time.Time{}, time.Time{}, cerrors.E(op, cerrors.Invalid, "조회 기간은 최대 366일입니다.")
+	}
+
+	return from, to, nil
+}
+
+// SchoolAnalyticsDTO 기간 중 구독자 증감 통계, 이탈률은 기간 중 구독한 적 있는 학생 대비 구독 취소 수이다.
+type SchoolAnalyticsDTO struct {
+	SchoolID             int                        `json:"schoolID" example:"1"`
+	From                 string                     `json:"from" example:"2024-03-01"`
+	To                   string                     `json:"to" example:"2024-03-31"`
+	StartSubscriberCount int                        `json:"startSubscriberCount" example:"100"`
+	EndSubscriberCount   int                        `json:"endSubscriberCount" example:"120"`
+	Subscribed           int                        `json:"subscribed" example:"30"`
+	Unsubscribed         int                        `json:"unsubscribed" example:"10"`
+	ChurnRate            float64                    `json:"churnRate" example:"0.0769"`
+	Days                 []SchoolSubscriberDailyDTO `json:"days"`
+}
+
+// SchoolSubscriberDailyDTO subscriberCount는 해당 날짜가 끝났을 때의 구독자 수이다.
+type SchoolSubscriberDailyDTO struct {
+	Date            string `json:"date" example:"2024-03-01"`
+	Subscribed      int    `json:"subscribed" example:"2"`
+	Unsubscribed    int    `json:"unsubscribed" example:"1"`
+	SubscriberCount int    `json:"subscriberCount" example:"101"`
+}
+
+// SchoolAnalyticsDTOFrom 구독 변화가 없는 날짜도 채워서 기간의 모든 날짜를 응답한다.
+func SchoolAnalyticsDTOFrom(schoolID int, from, to time.Time, startSubscriberCount int, changes []SubscriptionDailyChange) SchoolAnalyticsDTO {
+	changesByDate := make(map[string]SubscriptionDailyChange, len(changes))
+	for _, change := range changes {
+		changesByDate[change.Date.Format(AnalyticsDateLayout)] = change
+	}
+
+	dto := SchoolAnalyticsDTO{
+		SchoolID:             schoolID,
+		From:                 from.Format(AnalyticsDateLayout),
+		To:                   to.Format(AnalyticsDateLayout),
+		StartSubscriberCount: startSubscriberCount,
+		EndSubscriberCount:   startSubscriberCount,
+	}
+
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+		change := changesByDate[date.Format(AnalyticsDateLayout)]
+		dto.Subscribed += change.Subscribed
+		dto.Unsubscribed += change.Unsubscribed
+		dto.EndSubscriberCount += change.Subscribed - change.Unsubscribed
+		dto.Days = append(dto.Days, SchoolSubscriberDailyDTO{
+			Date:            date.Format(AnalyticsDateLayout),
+			Subscribed:      change.Subscribed,
+			Unsubscribed:    change.Unsubscribed,
+			SubscriberCount: dto.EndSubscriberCount,
+		})
+	}
+	dto.ChurnRate = ratio(dto.Unsubscribed, startSubscriberCount+dto.Subscribed)
+
+	return dto
+}
+
+// ratio 소수점 넷째 자리까지 반올림한 비율, 분모가 0이면 0이다.
+func ratio(numerator, denominator int) float64 {
+	if denominator == 0 {
+		return 0
+	}
+
+	return math.Round(float64(numerator)/float64(denominator)*10000) / 10000
+}
+
+func truncateDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
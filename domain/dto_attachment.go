@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"classting/pkg/cerrors"
+	"io"
+	"time"
+)
+
+type AttachmentDTO struct {
+	ID          int       `json:"id" example:"1"`
+	NewsID      int       `json:"newsID" example:"1"`
+	FileName    string    `json:"fileName" example:"3월 급식 식단표.pdf"`
+	ContentType string    `json:"contentType" example:"application/pdf"`
+	Size        int64     `json:"size" example:"102400"`
+	URL         string    `json:"url" example:"/attachments/1?expires=1709136000&signature=9b1f..."`
+	ExpireDate  time.Time `json:"expireDate" example:"2024-02-28T16:00:00Z"`
+}
+
+// UploadAttachmentRequest 파일 내용은 컨트롤러에서 multipart 요청의 file 필드를 열어 전달한다.
+type UploadAttachmentRequest struct {
+	UserID   int       `swaggerignore:"true"`
+	NewsID   int       `uri:"newsID"`
+	FileName string    `swaggerignore:"true"`
+	Size     int64     `swaggerignore:"true"`
+	Content  io.Reader `swaggerignore:"true"`
+}
+
+func (req UploadAttachmentRequest) Validate() error {
+	const op cerrors.Op = "domain/UploadAttachmentRequest.Validate"
+
+	if req.NewsID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "소식 ID를 확인해주세요.")
+	}
+
+	if req.FileName == "" || req.Content == nil {
+		return cerrors.E(op, cerrors.Invalid, "첨부할 파일을 확인해주세요.")
+	}
+
+	if req.Size <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "빈 파일은 첨부할 수 없습니다.")
+	}
+
+	return nil
+}
+
+type DownloadAttachmentRequest struct {
+	ID        int    `uri:"attachmentID"`
+	Expires   int64  `form:"expires"`
+	Signature string `form:"signature"`
+}
+
+func (req DownloadAttachmentRequest) Validate() error {
+	const op cerrors.Op = "domain/DownloadAttachmentRequest.Validate"
+
+	if req.ID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "첨부 파일 ID를 확인해주세요.")
+	}
+
+	if req.Expires <= 0 || req.Signature == "" {
+		return cerrors.E(op, cerrors.Invalid, "서명된 다운로드 주소를 확인해주세요.")
+	}
+
+	return nil
+}
+
+func AttachmentDTOFrom(attachment Attachment) AttachmentDTO {
+	return AttachmentDTO{
+		ID:          attachment.ID,
+		NewsID:      attachment.NewsID,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+	}
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"classting/pkg/cerrors"
+	"unicode/utf8"
+)
+
+const maxCommentBodyLength = 1000
+
+type CommentDTO struct {
+	BaseDTO
+	NewsID   int    `json:"newsID" example:"1"`
+	UserID   int    `json:"userID" example:"2"`
+	UserName string `json:"userName" example:"classting_student_1"`
+	Body     string `json:"body" example:"상담 신청은 어디서 하나요?"`
+	Hidden   bool   `json:"hidden" example:"false"`
+}
+
+func CommentDTOFrom(comment Comment) CommentDTO {
+	return CommentDTO{
+		BaseDTO: BaseDTO{
+			ID:         comment.ID,
+			CreateDate: comment.CreateDate,
+			UpdateDate: comment.UpdateDate,
+		},
+		NewsID:   comment.NewsID,
+		UserID:   comment.UserID,
+		UserName: comment.UserName,
+		Body:     comment.Body,
+		Hidden:   comment.Hidden,
+	}
+}
+
+type CreateCommentRequest struct {
+	UserID int    `swaggerignore:"true"`
+	NewsID int    `uri:"newsID" swaggerignore:"true"`
+	Body   string `json:"body" example:"상담 신청은 어디서 하나요?"`
+}
+
+func (req CreateCommentRequest) Validate() error {
+	const op cerrors.Op = "domain/CreateCommentRequest.Validate"
+
+	if req.NewsID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "소식 ID를 확인해주세요.")
+	}
+
+	if req.Body == "" {
+		return cerrors.E(op, cerrors.Invalid, "댓글 내용을 확인해주세요.")
+	}
+
+	if utf8.RuneCountInString(req.Body) > maxCommentBodyLength {
+		return cerrors.E(op, cerrors.Invalid, "댓글은 1000자를 넘을 수 없습니다.")
+	}
+
+	return nil
+}
+
+type ListCommentsRequest struct {
+	UserID int  `swaggerignore:"true"`
+	NewsID int  `uri:"newsID" swaggerignore:"true"`
+	Cursor *int `form:"cursor" example:"1"`
+}
+
+func (req ListCommentsRequest) Validate() error {
+	const op cerrors.Op = "domain/ListCommentsRequest.Validate"
+
+	if req.NewsID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "소식 ID를 확인해주세요.")
+	}
+
+	if req.Cursor != nil && *req.Cursor <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "커서를 확인해주세요.")
+	}
+
+	return nil
+}
+
+// ListCommentsResponse commentsEnabled가 false이면 새 댓글을 작성할 수 없다.
+type ListCommentsResponse struct {
+	CommentsEnabled bool         `json:"commentsEnabled" example:"true"`
+	Comments        []CommentDTO `json:"comments"`
+	Cursor          *int         `json:"cursor"`
+}
+
+type HideCommentRequest struct {
+	UserID    int  `swaggerignore:"true"`
+	NewsID    int  `uri:"newsID" swaggerignore:"true"`
+	CommentID int  `uri:"commentID" swaggerignore:"true"`
+	Hidden    bool `json:"hidden" example:"true"`
+}
+
+func (req HideCommentRequest) Validate() error {
+	const op cerrors.Op = "domain/HideCommentRequest.Validate"
+
+	if req.NewsID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "소식 ID를 확인해주세요.")
+	}
+
+	if req.CommentID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "댓글 ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type DeleteCommentRequest struct {
+	UserID    int `swaggerignore:"true"`
+	NewsID    int `uri:"newsID"`
+	CommentID int `uri:"commentID"`
+}
+
+func (req DeleteCommentRequest) Validate() error {
+	const op cerrors.Op = "domain/DeleteCommentRequest.Validate"
+
+	if req.NewsID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "소식 ID를 확인해주세요.")
+	}
+
+	if req.CommentID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "댓글 ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type UpdateCommentSettingRequest struct {
+	UserID  int  `swaggerignore:"true"`
+	NewsID  int  `uri:"newsID" swaggerignore:"true"`
+	Enabled bool `json:"enabled" example:"false"`
+}
+
+func (req UpdateCommentSettingRequest) Validate() error {
+	const op cerrors.Op = "domain/UpdateCommentSettingRequest.Validate"
+
+	if req.NewsID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "소식 ID를 확인해주세요.")
+	}
+
+	return nil
+}
@@ -2,18 +2,52 @@ package domain
 
 import (
 	"classting/pkg/cerrors"
+	"classting/pkg/content"
+	"database/sql"
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	maxNewsSummaryLength = 255
+	maxNewsBodyLength    = 20000
 )
 
 type NewsDTO struct {
 	BaseDTO
-	SchoolID int    `json:"schoolID"`
-	Title    string `json:"title"`
+	SchoolID      int               `json:"schoolID"`
+	Title         string            `json:"title"`
+	Summary       string            `json:"summary" example:"3월 학부모 상담 일정 안내"`
+	Body          string            `json:"body" example:"## 상담 일정\n\n- 3월 4일 ~ 3월 8일"`
+	ContentFormat NewsContentFormat `json:"contentFormat" enums:"PLAIN,MARKDOWN,HTML" example:"MARKDOWN"`
+	Status        NewsStatus        `json:"status" enums:"DRAFT,SCHEDULED,PUBLISHED,ARCHIVED" example:"PUBLISHED"`
+	PublishAt     *time.Time        `json:"publishAt" example:"2024-03-04T08:00:00+09:00"`
+	Edited        bool              `json:"edited" example:"true"`
+	EditDate      *time.Time        `json:"editDate" example:"2024-03-04T09:30:00+09:00"`
+	Priority      NewsPriority      `json:"priority" enums:"NORMAL,URGENT" example:"NORMAL"`
+	Attachments   []AttachmentDTO   `json:"attachments"`
+}
+
+// RenderHTML 본문을 안전한 HTML로 렌더링한 DTO를 반환한다.
+func (dto NewsDTO) RenderHTML() NewsDTO {
+	dto.Body = renderNewsBody(dto.ContentFormat, dto.Body)
+	dto.ContentFormat = NewsContentFormatHTML
+
+	return dto
 }
 
+// CreateNewsRequest 상태를 지정하지 않으면 publishAt이 있을 때는 예약, 없을 때는 바로 발행한다.
 type CreateNewsRequest struct {
-	UserID   int    `swaggerignore:"true"`
-	SchoolID int    `json:"schoolID"`
-	Title    string `json:"title"`
+	UserID        int               `swaggerignore:"true"`
+	SchoolID      int               `json:"schoolID"`
+	Title         string            `json:"title"`
+	Summary       string            `json:"summary" example:"3월 학부모 상담 일정 안내"`
+	Body          string            `json:"body" example:"## 상담 일정\n\n- 3월 4일 ~ 3월 8일"`
+	ContentFormat NewsContentFormat `json:"contentFormat" enums:"PLAIN,MARKDOWN" example:"MARKDOWN"`
+	Status        NewsStatus        `json:"status" enums:"DRAFT,SCHEDULED,PUBLISHED" example:"SCHEDULED"`
+	PublishAt     *time.Time        `json:"publishAt" example:"2024-03-04T08:00:00+09:00"`
+	Priority      NewsPriority      `json:"priority" enums:"NORMAL,URGENT" example:"NORMAL"`
 }
 
 func (req CreateNewsRequest) Validate() error {
@@ -27,13 +61,47 @@ func (req CreateNewsRequest) Validate() error {
 		return cerrors.E(op, cerrors.Invalid, "제목을 확인해주세요.")
 	}
 
-	return nil
+	if req.Status == NewsStatusArchived {
+		return cerrors.E(op, cerrors.Invalid, "소식은 DRAFT, SCHEDULED, PUBLISHED 상태로만 생성할 수 있습니다.")
+	}
+
+	if req.Priority != "" && !req.Priority.Valid() {
+		return cerrors.E(op, cerrors.Invalid, "우선순위는 NORMAL, URGENT 중 하나여야 합니다.")
+	}
+
+	if err := validateNewsSchedule(op, req.NewsStatus(), req.PublishAt); err != nil {
+		return err
+	}
+
+	return validateNewsContent(op, req.Summary, req.Body, req.ContentFormat)
+}
+
+func (req CreateNewsRequest) NewsStatus() NewsStatus {
+	if req.Status != "" {
+		return req.Status
+	}
+	if req.PublishAt != nil {
+		return NewsStatusScheduled
+	}
+
+	return NewsStatusPublished
+}
+
+func (req CreateNewsRequest) NewsPriority() NewsPriority {
+	if req.Priority != "" {
+		return req.Priority
+	}
+
+	return NewsPriorityNormal
 }
 
 type ListNewsRequest struct {
-	UserID   int  `swaggerignore:"true"`
-	SchoolID int  `form:"schoolID" validate:"required" example:"1"`
-	Cursor   *int `form:"cursor" validate:"optional" example:"1"`
+	UserID   int                `swaggerignore:"true"`
+	SchoolID int                `form:"schoolID" validate:"required" example:"1"`
+	Cursor   string             `form:"cursor" validate:"optional"`
+	Size     int                `form:"size" validate:"optional" example:"10"`
+	Format   NewsResponseFormat `form:"format" enums:"html"`
+	Status   NewsStatus         `form:"status" enums:"DRAFT,SCHEDULED,PUBLISHED,ARCHIVED"`
 }
 
 func (req ListNewsRequest) Validate() error {
@@ -43,22 +111,38 @@ func (req ListNewsRequest) Validate() error {
 		return cerrors.E(op, cerrors.Invalid, "학교 ID를 확인해주세요.")
 	}
 
-	if req.Cursor != nil && *req.Cursor <= 0 {
-		return cerrors.E(op, cerrors.Invalid, "커서를 확인해주세요.")
+	if req.Size < 0 {
+		return cerrors.E(op, cerrors.Invalid, "페이지 크기를 확인해주세요.")
+	}
+
+	if !req.Format.Valid() {
+		return cerrors.E(op, cerrors.Invalid, "응답 형식을 확인해주세요.")
+	}
+
+	if req.Status != "" && !req.Status.Valid() {
+		return cerrors.E(op, cerrors.Invalid, "소식 상태를 확인해주세요.")
 	}
 
 	return nil
 }
 
+// ListNewsResponse Cursor는 다음 페이지, PrevCursor는 이전 페이지의 커서이고 페이지가 없으면 null이다.
 type ListNewsResponse struct {
-	News   []NewsDTO `json:"news"`
-	Cursor *int      `json:"cursor"`
+	News       []NewsDTO `json:"news"`
+	Cursor     *string   `json:"cursor"`
+	PrevCursor *string   `json:"prevCursor"`
 }
 
+// UpdateNewsRequest 상태를 지정하지 않으면 현재 상태를 유지한다.
 type UpdateNewsRequest struct {
-	UserID int    `swaggerignore:"true"`
-	ID     int    `json:"id"`
-	Title  string `json:"title"`
+	UserID        int               `swaggerignore:"true"`
+	ID            int               `json:"id"`
+	Title         string            `json:"title"`
+	Summary       string            `json:"summary" example:"3월 학부모 상담 일정 안내"`
+	Body          string            `json:"body" example:"## 상담 일정\n\n- 3월 4일 ~ 3월 8일"`
+	ContentFormat NewsContentFormat `json:"contentFormat" enums:"PLAIN,MARKDOWN" example:"MARKDOWN"`
+	Status        NewsStatus        `json:"status" enums:"DRAFT,SCHEDULED,PUBLISHED,ARCHIVED" example:"SCHEDULED"`
+	PublishAt     *time.Time        `json:"publishAt" example:"2024-03-04T08:00:00+09:00"`
 }
 
 func (req UpdateNewsRequest) Validate() error {
@@ -72,7 +156,17 @@ func (req UpdateNewsRequest) Validate() error {
 		return cerrors.E(op, cerrors.Invalid, "제목을 확인해주세요.")
 	}
 
-	return nil
+	if req.Status != "" && !req.Status.Valid() {
+		return cerrors.E(op, cerrors.Invalid, "소식 상태를 확인해주세요.")
+	}
+
+	if req.Status != "" {
+		if err := validateNewsSchedule(op, req.Status, req.PublishAt); err != nil {
+			return err
+		}
+	}
+
+	return validateNewsContent(op, req.Summary, req.Body, req.ContentFormat)
 }
 
 type DeleteNewsRequest struct {
@@ -97,7 +191,249 @@ func NewsDTOFrom(news News) NewsDTO {
 			CreateDate: news.CreateDate,
 			UpdateDate: news.UpdateDate,
 		},
-		SchoolID: news.SchoolID,
-		Title:    news.Title,
+		SchoolID:      news.SchoolID,
+		Title:         news.Title,
+		Summary:       news.Summary,
+		Body:          news.Body,
+		ContentFormat: news.ContentFormat,
+		Status:        news.Status,
+		PublishAt:     nullTimePointer(news.PublishDate),
+		Edited:        news.EditDate.Valid,
+		EditDate:      nullTimePointer(news.EditDate),
+		Priority:      news.Priority,
+	}
+}
+
+// NewsRevisionDTO 수정 전 소식의 내용과 그 내용을 수정한 유저, 시각
+type NewsRevisionDTO struct {
+	Revision      int               `json:"revision" example:"1"`
+	NewsID        int               `json:"newsID" example:"1"`
+	EditorID      int               `json:"editorID" example:"1"`
+	Title         string            `json:"title" example:"클래스팅 새소식"`
+	Summary       string            `json:"summary" example:"3월 학부모 상담 일정 안내"`
+	Body          string            `json:"body" example:"## 상담 일정\n\n- 3월 4일 ~ 3월 8일"`
+	ContentFormat NewsContentFormat `json:"contentFormat" enums:"PLAIN,MARKDOWN" example:"MARKDOWN"`
+	EditDate      time.Time         `json:"editDate" example:"2024-03-04T09:30:00+09:00"`
+}
+
+func NewsRevisionDTOFrom(revision NewsRevision) NewsRevisionDTO {
+	return NewsRevisionDTO{
+		Revision:      revision.Revision,
+		NewsID:        revision.NewsID,
+		EditorID:      revision.EditorID,
+		Title:         revision.Title,
+		Summary:       revision.Summary,
+		Body:          revision.Body,
+		ContentFormat: revision.ContentFormat,
+		EditDate:      revision.CreateDate,
+	}
+}
+
+type ListNewsRevisionsRequest struct {
+	UserID int `swaggerignore:"true"`
+	NewsID int `uri:"newsID"`
+}
+
+func (req ListNewsRevisionsRequest) Validate() error {
+	const op cerrors.Op = "domain/ListNewsRevisionsRequest.Validate"
+
+	if req.NewsID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "소식 ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+// ListNewsRevisionsResponse 최근 리비전부터 정렬한다.
+type ListNewsRevisionsResponse struct {
+	Revisions []NewsRevisionDTO `json:"revisions"`
+}
+
+// DiffNewsRevisionsRequest To를 지정하지 않으면 현재 소식과 비교한다.
+type DiffNewsRevisionsRequest struct {
+	UserID int `swaggerignore:"true"`
+	NewsID int `uri:"newsID" swaggerignore:"true"`
+	From   int `form:"from" example:"1"`
+	To     int `form:"to" example:"2"`
+}
+
+func (req DiffNewsRevisionsRequest) Validate() error {
+	const op cerrors.Op = "domain/DiffNewsRevisionsRequest.Validate"
+
+	if req.NewsID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "소식 ID를 확인해주세요.")
 	}
+
+	if req.From <= 0 || req.To < 0 {
+		return cerrors.E(op, cerrors.Invalid, "비교할 리비전을 확인해주세요.")
+	}
+
+	if req.From == req.To {
+		return cerrors.E(op, cerrors.Invalid, "서로 다른 리비전을 비교해주세요.")
+	}
+
+	return nil
+}
+
+type NewsDiffLineDTO struct {
+	Op   content.DiffOp `json:"op" enums:"equal,insert,delete" example:"insert"`
+	Text string         `json:"text" example:"- 3월 4일 ~ 3월 8일"`
+}
+
+// NewsRevisionDiffDTO To가 0이면 현재 소식과 비교한 결과다.
+type NewsRevisionDiffDTO struct {
+	NewsID  int               `json:"newsID" example:"1"`
+	From    int               `json:"from" example:"1"`
+	To      int               `json:"to" example:"2"`
+	Title   []NewsDiffLineDTO `json:"title"`
+	Summary []NewsDiffLineDTO `json:"summary"`
+	Body    []NewsDiffLineDTO `json:"body"`
+}
+
+func NewsRevisionDiffDTOFrom(newsID, from, to int, before, after News) NewsRevisionDiffDTO {
+	return NewsRevisionDiffDTO{
+		NewsID:  newsID,
+		From:    from,
+		To:      to,
+		Title:   newsDiffLines(before.Title, after.Title),
+		Summary: newsDiffLines(before.Summary, after.Summary),
+		Body:    newsDiffLines(before.Body, after.Body),
+	}
+}
+
+type RollbackNewsRequest struct {
+	UserID   int `swaggerignore:"true"`
+	NewsID   int `uri:"newsID"`
+	Revision int `uri:"revision"`
+}
+
+func (req RollbackNewsRequest) Validate() error {
+	const op cerrors.Op = "domain/RollbackNewsRequest.Validate"
+
+	if req.NewsID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "소식 ID를 확인해주세요.")
+	}
+
+	if req.Revision <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "리비전을 확인해주세요.")
+	}
+
+	return nil
+}
+
+type UpdateNewsPriorityRequest struct {
+	UserID   int          `swaggerignore:"true"`
+	NewsID   int          `uri:"newsID" swaggerignore:"true"`
+	Priority NewsPriority `json:"priority" enums:"NORMAL,URGENT" example:"URGENT"`
+}
+
+func (req UpdateNewsPriorityRequest) Validate() error {
+	const op cerrors.Op = "domain/UpdateNewsPriorityRequest.Validate"
+
+	if req.NewsID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "소식 ID를 확인해주세요.")
+	}
+
+	if !req.Priority.Valid() {
+		return cerrors.E(op, cerrors.Invalid, "우선순위는 NORMAL, URGENT 중 하나여야 합니다.")
+	}
+
+	return nil
+}
+
+// PinNewsRequest ExpireAt을 지정하지 않으면 고정을 해제할 때까지 고정한다.
+type PinNewsRequest struct {
+	UserID   int        `swaggerignore:"true"`
+	NewsID   int        `uri:"newsID" swaggerignore:"true"`
+	ExpireAt *time.Time `json:"expireAt" example:"2024-03-08T18:00:00+09:00"`
+}
+
+func (req PinNewsRequest) Validate() error {
+	const op cerrors.Op = "domain/PinNewsRequest.Validate"
+
+	if req.NewsID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "소식 ID를 확인해주세요.")
+	}
+
+	if req.ExpireAt != nil && !req.ExpireAt.After(time.Now()) {
+		return cerrors.E(op, cerrors.Invalid, "고정 만료 시각은 현재 이후여야 합니다.")
+	}
+
+	return nil
+}
+
+type UnpinNewsRequest struct {
+	UserID int `swaggerignore:"true"`
+	NewsID int `uri:"newsID"`
+}
+
+func (req UnpinNewsRequest) Validate() error {
+	const op cerrors.Op = "domain/UnpinNewsRequest.Validate"
+
+	if req.NewsID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "소식 ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+func newsDiffLines(before, after string) []NewsDiffLineDTO {
+	lines := make([]NewsDiffLineDTO, 0)
+	for _, line := range content.DiffLines(before, after) {
+		lines = append(lines, NewsDiffLineDTO{
+			Op:   line.Op,
+			Text: line.Text,
+		})
+	}
+
+	return lines
+}
+
+func validateNewsContent(op cerrors.Op, summary, body string, format NewsContentFormat) error {
+	if utf8.RuneCountInString(summary) > maxNewsSummaryLength {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("요약은 %d자 이하로 입력해주세요.", maxNewsSummaryLength))
+	}
+
+	if utf8.RuneCountInString(body) > maxNewsBodyLength {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("본문은 %d자 이하로 입력해주세요.", maxNewsBodyLength))
+	}
+
+	if format != "" && format != NewsContentFormatPlain && format != NewsContentFormatMarkdown {
+		return cerrors.E(op, cerrors.Invalid, "본문 형식은 PLAIN, MARKDOWN 중 하나여야 합니다.")
+	}
+
+	return nil
+}
+
+// validateNewsSchedule 예약 발행은 현재 이후의 발행 시각이 있어야 한다.
+func validateNewsSchedule(op cerrors.Op, status NewsStatus, publishAt *time.Time) error {
+	if status != NewsStatusScheduled {
+		return nil
+	}
+
+	if publishAt == nil {
+		return cerrors.E(op, cerrors.Invalid, "예약 발행 시각을 입력해주세요.")
+	}
+
+	if !publishAt.After(time.Now()) {
+		return cerrors.E(op, cerrors.Invalid, "예약 발행 시각은 현재 이후여야 합니다.")
+	}
+
+	return nil
+}
+
+func nullTimePointer(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	return &t.Time
+}
+
+func renderNewsBody(format NewsContentFormat, body string) string {
+	if format == NewsContentFormatMarkdown {
+		return content.RenderMarkdown(body)
+	}
+
+	return content.RenderPlain(body)
 }
@@ -0,0 +1,57 @@
+package domain
+
+import "classting/pkg/cerrors"
+
+type ReactionDTO struct {
+	Emoji       ReactionEmoji `json:"emoji" enums:"thumbs_up,heart,clap,laugh,surprised" example:"thumbs_up"`
+	Count       int           `json:"count" example:"12"`
+	ReactedByMe bool          `json:"reactedByMe" example:"true"`
+}
+
+func ReactionDTOFrom(count ReactionCount) ReactionDTO {
+	return ReactionDTO{
+		Emoji:       count.Emoji,
+		Count:       count.Count,
+		ReactedByMe: count.ReactedByMe,
+	}
+}
+
+type AddReactionRequest struct {
+	UserID int           `swaggerignore:"true"`
+	NewsID int           `uri:"newsID" swaggerignore:"true"`
+	Emoji  ReactionEmoji `uri:"emoji" swaggerignore:"true"`
+}
+
+func (req AddReactionRequest) Validate() error {
+	const op cerrors.Op = "domain/AddReactionRequest.Validate"
+
+	if req.NewsID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "소식 ID를 확인해주세요.")
+	}
+
+	if !req.Emoji.Valid() {
+		return cerrors.E(op, cerrors.Invalid, "지원하지 않는 이모지입니다.")
+	}
+
+	return nil
+}
+
+type RemoveReactionRequest struct {
+	UserID int           `swaggerignore:"true"`
+	NewsID int           `uri:"newsID" swaggerignore:"true"`
+	Emoji  ReactionEmoji `uri:"emoji" swaggerignore:"true"`
+}
+
+func (req RemoveReactionRequest) Validate() error {
+	const op cerrors.Op = "domain/RemoveReactionRequest.Validate"
+
+	if req.NewsID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "소식 ID를 확인해주세요.")
+	}
+
+	if !req.Emoji.Valid() {
+		return cerrors.E(op, cerrors.Invalid, "지원하지 않는 이모지입니다.")
+	}
+
+	return nil
+}
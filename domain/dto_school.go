@@ -1,6 +1,9 @@
 package domain
 
-import "classting/pkg/cerrors"
+import (
+	"classting/pkg/cerrors"
+	"strings"
+)
 
 type SchoolDTO struct {
 	ID     int    `json:"id"`
@@ -22,16 +25,21 @@ func (req CreateSchoolRequest) Validate() error {
 		return cerrors.E(op, cerrors.Invalid, "학교명을 확인해주세요.")
 	}
 
-	if req.Region == "" {
-		return cerrors.E(op, cerrors.Invalid, "지역을 확인해주세요.")
+	if err := validateRegion(op, req.Region); err != nil {
+		return err
 	}
 
 	return nil
 }
 
+// ListSchoolsRequest UserID는 학교를 소유한 관리자로 거르는 조건이고, RequestUserID는 구독 여부를 확인할 조회 유저
 type ListSchoolsRequest struct {
-	UserID *int `form:"userID" example:"1"`
-	Cursor *int `form:"cursor"`
+	RequestUserID int        `swaggerignore:"true"`
+	UserID        *int       `form:"userID" example:"1"`
+	Region        string     `form:"region" example:"서울"`
+	Sort          SchoolSort `form:"sort" enums:"latest,name,subscribers" example:"latest"`
+	Cursor        string     `form:"cursor"`
+	Size          int        `form:"size" example:"10"`
 }
 
 func (req ListSchoolsRequest) Validate() error {
@@ -41,16 +49,134 @@ func (req ListSchoolsRequest) Validate() error {
 		return cerrors.E(op, cerrors.Invalid, "사용자 ID를 확인해주세요.")
 	}
 
-	if req.Cursor != nil && *req.Cursor <= 0 {
-		return cerrors.E(op, cerrors.Invalid, "커서를 확인해주세요.")
+	if req.Region != "" {
+		if _, ok := NormalizeRegion(req.Region); !ok {
+			return cerrors.E(op, cerrors.Invalid, "지원하지 않는 지역입니다.")
+		}
+	}
+
+	if req.Sort != "" && !req.Sort.Valid() {
+		return cerrors.E(op, cerrors.Invalid, "정렬 기준을 확인해주세요.")
+	}
+
+	if req.Size < 0 {
+		return cerrors.E(op, cerrors.Invalid, "페이지 크기를 확인해주세요.")
 	}
 
 	return nil
 }
 
+// SortOrDefault 정렬 기준이 없으면 최신 순으로 조회한다.
+func (req ListSchoolsRequest) SortOrDefault() SchoolSort {
+	if req.Sort == "" {
+		return SchoolSortLatest
+	}
+
+	return req.Sort
+}
+
+type UpdateSchoolRequest struct {
+	UserID   int    `json:"-" swaggerignore:"true"`
+	SchoolID int    `json:"-" uri:"schoolID" swaggerignore:"true"`
+	Name     string `json:"name" validate:"required" example:"클래스팅"`
+	Region   string `json:"region" validate:"required" example:"서울"`
+}
+
+func (req UpdateSchoolRequest) Validate() error {
+	var op cerrors.Op = "domain/UpdateSchoolRequest.Validate"
+
+	if req.SchoolID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "학교 ID를 확인해주세요.")
+	}
+
+	if req.Name == "" {
+		return cerrors.E(op, cerrors.Invalid, "학교명을 확인해주세요.")
+	}
+
+	if err := validateRegion(op, req.Region); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type DeleteSchoolRequest struct {
+	UserID   int `swaggerignore:"true"`
+	SchoolID int `uri:"schoolID"`
+}
+
+func (req DeleteSchoolRequest) Validate() error {
+	var op cerrors.Op = "domain/DeleteSchoolRequest.Validate"
+
+	if req.SchoolID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "학교 ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type RestoreSchoolRequest struct {
+	UserID   int `swaggerignore:"true"`
+	SchoolID int `uri:"schoolID"`
+}
+
+func (req RestoreSchoolRequest) Validate() error {
+	var op cerrors.Op = "domain/RestoreSchoolRequest.Validate"
+
+	if req.SchoolID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "학교 ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+// ListSchoolsResponse Cursor는 다음 페이지, PrevCursor는 이전 페이지의 커서이고 페이지가 없으면 null이다.
 type ListSchoolsResponse struct {
-	Schools []SchoolDTO `json:"schools"`
-	Cursor  *int        `json:"cursor"`
+	Schools    []DirectorySchoolDTO `json:"schools"`
+	Cursor     *string              `json:"cursor"`
+	PrevCursor *string              `json:"prevCursor"`
+}
+
+type DirectorySchoolDTO struct {
+	SchoolDTO
+	SubscriberCount int  `json:"subscriberCount" example:"12"`
+	Subscribed      bool `json:"subscribed"`
+}
+
+func DirectorySchoolDTOFrom(school DirectorySchool) DirectorySchoolDTO {
+	return DirectorySchoolDTO{
+		SchoolDTO:       SchoolDTOFrom(school.School),
+		SubscriberCount: school.SubscriberCount,
+		Subscribed:      school.Subscribed,
+	}
+}
+
+type RegionDTO struct {
+	Code string `json:"code" example:"서울"`
+	Name string `json:"name" example:"서울특별시"`
+}
+
+type ListRegionsResponse struct {
+	Regions []RegionDTO `json:"regions"`
+}
+
+func RegionDTOFrom(region Region) RegionDTO {
+	return RegionDTO{
+		Code: region.Code,
+		Name: region.Name,
+	}
+}
+
+func validateRegion(op cerrors.Op, region string) error {
+	if strings.TrimSpace(region) == "" {
+		return cerrors.E(op, cerrors.Invalid, "지역을 확인해주세요.")
+	}
+
+	if _, ok := NormalizeRegion(region); !ok {
+		return cerrors.E(op, cerrors.Invalid, "지원하지 않는 지역입니다.")
+	}
+
+	return nil
 }
 
 func SchoolDTOFrom(school School) SchoolDTO {
@@ -61,3 +187,103 @@ func SchoolDTOFrom(school School) SchoolDTO {
 		Region: school.Region,
 	}
 }
+
+type SchoolMemberDTO struct {
+	ID       int        `json:"id" example:"1"`
+	UserID   int        `json:"userID" example:"1"`
+	UserName string     `json:"userName" example:"classting_admin_1"`
+	Role     SchoolRole `json:"role" enum:"OWNER,EDITOR,VIEWER" example:"OWNER"`
+}
+
+func SchoolMemberDTOFrom(member SchoolMember) SchoolMemberDTO {
+	return SchoolMemberDTO{
+		ID:       member.ID,
+		UserID:   member.UserID,
+		UserName: member.UserName,
+		Role:     member.Role,
+	}
+}
+
+type ListSchoolMembersRequest struct {
+	UserID   int `swaggerignore:"true"`
+	SchoolID int `uri:"schoolID"`
+}
+
+func (req ListSchoolMembersRequest) Validate() error {
+	var op cerrors.Op = "domain/ListSchoolMembersRequest.Validate"
+
+	if req.SchoolID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "학교 ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type ListSchoolMembersResponse struct {
+	Members []SchoolMemberDTO `json:"members"`
+}
+
+type InviteSchoolMemberRequest struct {
+	UserID   int        `json:"-" swaggerignore:"true"`
+	SchoolID int        `json:"-" uri:"schoolID" swaggerignore:"true"`
+	UserName string     `json:"userName" validate:"required" example:"classting_admin_2"`
+	Role     SchoolRole `json:"role" validate:"required" enum:"EDITOR,VIEWER" example:"EDITOR"`
+}
+
+func (req InviteSchoolMemberRequest) Validate() error {
+	var op cerrors.Op = "domain/InviteSchoolMemberRequest.Validate"
+
+	if req.SchoolID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "학교 ID를 확인해주세요.")
+	}
+
+	if req.UserName == "" {
+		return cerrors.E(op, cerrors.Invalid, "초대할 유저 아이디를 확인해주세요.")
+	}
+
+	if req.Role != SchoolRoleEditor && req.Role != SchoolRoleViewer {
+		return cerrors.E(op, cerrors.Invalid, "역할은 EDITOR, VIEWER 중 하나여야 합니다.")
+	}
+
+	return nil
+}
+
+type RemoveSchoolMemberRequest struct {
+	UserID       int `swaggerignore:"true"`
+	SchoolID     int `uri:"schoolID"`
+	MemberUserID int `uri:"userID"`
+}
+
+func (req RemoveSchoolMemberRequest) Validate() error {
+	var op cerrors.Op = "domain/RemoveSchoolMemberRequest.Validate"
+
+	if req.SchoolID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "학교 ID를 확인해주세요.")
+	}
+
+	if req.MemberUserID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "유저 ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type TransferSchoolOwnershipRequest struct {
+	UserID   int `json:"-" swaggerignore:"true"`
+	SchoolID int `json:"-" uri:"schoolID" swaggerignore:"true"`
+	ToUserID int `json:"userID" validate:"required" example:"2"`
+}
+
+func (req TransferSchoolOwnershipRequest) Validate() error {
+	var op cerrors.Op = "domain/TransferSchoolOwnershipRequest.Validate"
+
+	if req.SchoolID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "학교 ID를 확인해주세요.")
+	}
+
+	if req.ToUserID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "유저 ID를 확인해주세요.")
+	}
+
+	return nil
+}
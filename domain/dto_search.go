@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"classting/pkg/cerrors"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxSearchQueryLength = 100
+
+type SearchSchoolsRequest struct {
+	Query  string `form:"q" example:"클래스팅 초등학교"`
+	Cursor *int   `form:"cursor"`
+}
+
+func (req SearchSchoolsRequest) Validate() error {
+	const op cerrors.Op = "domain/SearchSchoolsRequest.Validate"
+
+	if err := validateSearchQuery(op, req.Query); err != nil {
+		return err
+	}
+
+	if req.Cursor != nil && *req.Cursor <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "커서를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type SearchSchoolsResponse struct {
+	Schools []SchoolDTO `json:"schools"`
+	Cursor  *int        `json:"cursor"`
+}
+
+// SearchNewsRequest 학생은 구독 중인 학교의 발행된 소식을, 관리자는 멤버로 속한 학교의 모든 소식을 검색한다.
+type SearchNewsRequest struct {
+	UserID   int      `swaggerignore:"true"`
+	UserType UserType `swaggerignore:"true"`
+	Query    string   `form:"q" example:"학부모 상담"`
+	Cursor   *int     `form:"cursor"`
+}
+
+func (req SearchNewsRequest) Validate() error {
+	const op cerrors.Op = "domain/SearchNewsRequest.Validate"
+
+	if err := validateSearchQuery(op, req.Query); err != nil {
+		return err
+	}
+
+	if req.Cursor != nil && *req.Cursor <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "커서를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type SearchNewsResponse struct {
+	News   []NewsDTO `json:"news"`
+	Cursor *int      `json:"cursor"`
+}
+
+func validateSearchQuery(op cerrors.Op, query string) error {
+	if strings.TrimSpace(query) == "" {
+		return cerrors.E(op, cerrors.Invalid, "검색어를 확인해주세요.")
+	}
+
+	if utf8.RuneCountInString(query) > maxSearchQueryLength {
+		return cerrors.E(op, cerrors.Invalid, "검색어는 100자를 넘을 수 없습니다.")
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"classting/pkg/cerrors"
+)
+
+type OpenNewsStreamRequest struct {
+	UserID      int  `swaggerignore:"true"`
+	LastEventID *int `header:"Last-Event-ID"`
+}
+
+func (req OpenNewsStreamRequest) Validate() error {
+	const op cerrors.Op = "domain/OpenNewsStreamRequest.Validate"
+
+	if req.LastEventID != nil && *req.LastEventID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "Last-Event-ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type GatewayAction string
+
+const (
+	GatewayActionSubscribe   GatewayAction = "subscribe"
+	GatewayActionUnsubscribe GatewayAction = "unsubscribe"
+	GatewayActionPing        GatewayAction = "ping"
+)
+
+// GatewayRequest 웹소켓 클라이언트가 보내는 메시지
+type GatewayRequest struct {
+	Action   GatewayAction `json:"action" enums:"subscribe,unsubscribe,ping" example:"subscribe"`
+	SchoolID int           `json:"schoolID" example:"1"`
+}
+
+func (req GatewayRequest) Validate() error {
+	const op cerrors.Op = "domain/GatewayRequest.Validate"
+
+	switch req.Action {
+	case GatewayActionSubscribe, GatewayActionUnsubscribe:
+		if req.SchoolID <= 0 {
+			return cerrors.E(op, cerrors.Invalid, "학교 ID를 확인해주세요.")
+		}
+	case GatewayActionPing:
+	default:
+		return cerrors.E(op, cerrors.Invalid, "지원하지 않는 요청입니다.")
+	}
+
+	return nil
+}
+
+const (
+	GatewayMessageTypeSubscribed   = "subscribed"
+	GatewayMessageTypeUnsubscribed = "unsubscribed"
+	GatewayMessageTypePong         = "pong"
+	GatewayMessageTypeError        = "error"
+)
+
+// GatewayMessage 웹소켓 서버가 보내는 메시지
+// type은 news.created, news.updated, news.deleted, subscription.created, subscription.deleted 이벤트이거나
+// subscribed, unsubscribed, pong, error 응답이다.
+type GatewayMessage struct {
+	Type     string   `json:"type" example:"news.created"`
+	SchoolID int      `json:"schoolID,omitempty" example:"1"`
+	News     *NewsDTO `json:"news,omitempty"`
+	Message  string   `json:"message,omitempty"`
+}
+
+func GatewayMessageFromNewsEvent(event NewsEvent) GatewayMessage {
+	news := NewsDTOFrom(event.News)
+
+	return GatewayMessage{
+		Type:     string(event.Type),
+		SchoolID: event.News.SchoolID,
+		News:     &news,
+	}
+}
+
+func GatewayMessageFromSubscriptionEvent(event SubscriptionEvent) GatewayMessage {
+	return GatewayMessage{
+		Type:     string(event.Type),
+		SchoolID: event.Subscription.SchoolID,
+	}
+}
@@ -2,19 +2,41 @@ package domain
 
 import (
 	"classting/pkg/cerrors"
+	"time"
 )
 
 type SubscriptionSchoolDTO struct {
 	BaseDTO
-	SchoolID int    `json:"schoolID" validate:"required" example:"1"`
-	Name     string `json:"name" validate:"required" example:"클래스팅"`
-	Region   string `json:"region" validate:"required" example:"서울"`
+	SchoolID      int    `json:"schoolID" validate:"required" example:"1"`
+	Name          string `json:"name" validate:"required" example:"클래스팅"`
+	Region        string `json:"region" validate:"required" example:"서울"`
+	SchoolDeleted bool   `json:"schoolDeleted" example:"false"`
+	UnreadCount   int    `json:"unreadCount" example:"3"`
 }
 
 type SubscriptionSchoolNewsDTO struct {
 	BaseDTO
-	SchoolID int    `json:"schoolID" validate:"required" example:"1"`
-	Title    string `json:"title" validate:"required" example:"클래스팅 새소식"`
+	SchoolID      int               `json:"schoolID" validate:"required" example:"1"`
+	Title         string            `json:"title" validate:"required" example:"클래스팅 새소식"`
+	Summary       string            `json:"summary" example:"3월 학부모 상담 일정 안내"`
+	Body          string            `json:"body" example:"## 상담 일정\n\n- 3월 4일 ~ 3월 8일"`
+	ContentFormat NewsContentFormat `json:"contentFormat" enums:"PLAIN,MARKDOWN,HTML" example:"MARKDOWN"`
+	PublishAt     *time.Time        `json:"publishAt" example:"2024-03-04T08:00:00+09:00"`
+	Edited        bool              `json:"edited" example:"true"`
+	EditDate      *time.Time        `json:"editDate" example:"2024-03-04T09:30:00+09:00"`
+	Priority      NewsPriority      `json:"priority" enums:"NORMAL,URGENT" example:"URGENT"`
+	Pinned        bool              `json:"pinned" example:"true"`
+	Read          bool              `json:"read" example:"false"`
+	Attachments   []AttachmentDTO   `json:"attachments"`
+	Reactions     []ReactionDTO     `json:"reactions"`
+}
+
+// RenderHTML 본문을 안전한 HTML로 렌더링한 DTO를 반환한다.
+func (dto SubscriptionSchoolNewsDTO) RenderHTML() SubscriptionSchoolNewsDTO {
+	dto.Body = renderNewsBody(dto.ContentFormat, dto.Body)
+	dto.ContentFormat = NewsContentFormatHTML
+
+	return dto
 }
 
 type CreateSubscriptionRequest struct {
@@ -33,23 +55,26 @@ func (req CreateSubscriptionRequest) Validate() error {
 }
 
 type ListSubscriptionSchoolsRequest struct {
-	UserID int  `swaggerignore:"true"`
-	Cursor *int `form:"cursor" example:"1"`
+	UserID int    `swaggerignore:"true"`
+	Cursor string `form:"cursor"`
+	Size   int    `form:"size" example:"10"`
 }
 
 func (req ListSubscriptionSchoolsRequest) Validate() error {
 	const op cerrors.Op = "domain/ListSubscriptionSchoolsRequest.Validate"
 
-	if req.Cursor != nil && *req.Cursor <= 0 {
-		return cerrors.E(op, cerrors.Invalid, "커서를 확인해주세요.")
+	if req.Size < 0 {
+		return cerrors.E(op, cerrors.Invalid, "페이지 크기를 확인해주세요.")
 	}
 
 	return nil
 }
 
+// ListSubscriptionSchoolsResponse Cursor는 다음 페이지, PrevCursor는 이전 페이지의 커서이고 페이지가 없으면 null이다.
 type ListSubscriptionSchoolsResponse struct {
 	SubscriptionSchools []SubscriptionSchoolDTO `json:"subscriptionSchools"`
-	Cursor              *int                    `json:"cursor"`
+	Cursor              *string                 `json:"cursor"`
+	PrevCursor          *string                 `json:"prevCursor"`
 }
 
 type DeleteSubscriptionRequest struct {
@@ -68,9 +93,11 @@ func (req DeleteSubscriptionRequest) Validate() error {
 }
 
 type ListSubscriptionSchoolNewsRequest struct {
-	UserID   int  `swaggerignore:"true"`
-	SchoolID int  `uri:"schoolID" validate:"required" example:"1"`
-	Cursor   *int `form:"cursor"`
+	UserID   int                `swaggerignore:"true"`
+	SchoolID int                `uri:"schoolID" validate:"required" example:"1"`
+	Cursor   string             `form:"cursor"`
+	Size     int                `form:"size" example:"10"`
+	Format   NewsResponseFormat `form:"format" enums:"html"`
 }
 
 func (req ListSubscriptionSchoolNewsRequest) Validate() error {
@@ -80,16 +107,86 @@ func (req ListSubscriptionSchoolNewsRequest) Validate() error {
 		return cerrors.E(op, cerrors.Invalid, "학교 ID를 확인해주세요.")
 	}
 
-	if req.Cursor != nil && *req.Cursor <= 0 {
-		return cerrors.E(op, cerrors.Invalid, "커서를 확인해주세요.")
+	if req.Size < 0 {
+		return cerrors.E(op, cerrors.Invalid, "페이지 크기를 확인해주세요.")
+	}
+
+	if !req.Format.Valid() {
+		return cerrors.E(op, cerrors.Invalid, "응답 형식을 확인해주세요.")
 	}
 
 	return nil
 }
 
+// ListSubscriptionSchoolNewsResponse Cursor는 다음 페이지, PrevCursor는 이전 페이지의 커서이고 페이지가 없으면 null이다.
 type ListSubscriptionSchoolNewsResponse struct {
 	SubscriptionSchoolNews []SubscriptionSchoolNewsDTO `json:"subscriptionSchoolNews"`
-	Cursor                 *int                        `json:"cursor"`
+	Cursor                 *string                     `json:"cursor"`
+	PrevCursor             *string                     `json:"prevCursor"`
+}
+
+type ListSubscriptionFeedRequest struct {
+	UserID int                `swaggerignore:"true"`
+	Cursor string             `form:"cursor"`
+	Size   int                `form:"size" example:"10"`
+	Format NewsResponseFormat `form:"format" enums:"html"`
+}
+
+func (req ListSubscriptionFeedRequest) Validate() error {
+	const op cerrors.Op = "domain/ListSubscriptionFeedRequest.Validate"
+
+	if req.Size < 0 {
+		return cerrors.E(op, cerrors.Invalid, "페이지 크기를 확인해주세요.")
+	}
+
+	if !req.Format.Valid() {
+		return cerrors.E(op, cerrors.Invalid, "응답 형식을 확인해주세요.")
+	}
+
+	return nil
+}
+
+// ListSubscriptionFeedResponse Cursor는 다음 페이지, PrevCursor는 이전 페이지의 커서이고 페이지가 없으면 null이다.
+type ListSubscriptionFeedResponse struct {
+	News       []SubscriptionSchoolNewsDTO `json:"news"`
+	Cursor     *string                     `json:"cursor"`
+	PrevCursor *string                     `json:"prevCursor"`
+}
+
+type MarkNewsReadRequest struct {
+	UserID int `swaggerignore:"true"`
+	NewsID int `uri:"newsID" validate:"required" example:"1"`
+}
+
+func (req MarkNewsReadRequest) Validate() error {
+	const op cerrors.Op = "domain/MarkNewsReadRequest.Validate"
+
+	if req.NewsID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "소식 ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+// MarkSchoolNewsReadRequest Cursor 이하 ID의 소식을 모두 읽음으로 처리한다. 가장 최근에 본 소식 ID를 보내면 된다.
+type MarkSchoolNewsReadRequest struct {
+	UserID   int `swaggerignore:"true"`
+	SchoolID int `uri:"schoolID" swaggerignore:"true"`
+	Cursor   int `json:"cursor" validate:"required" example:"17"`
+}
+
+func (req MarkSchoolNewsReadRequest) Validate() error {
+	const op cerrors.Op = "domain/MarkSchoolNewsReadRequest.Validate"
+
+	if req.SchoolID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "학교 ID를 확인해주세요.")
+	}
+
+	if req.Cursor <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "커서를 확인해주세요.")
+	}
+
+	return nil
 }
 
 func SubscriptionSchoolDTOFrom(subscriptionSchool SubscriptionSchool) SubscriptionSchoolDTO {
@@ -99,9 +196,10 @@ func SubscriptionSchoolDTOFrom(subscriptionSchool SubscriptionSchool) Subscripti
 			CreateDate: subscriptionSchool.CreateDate,
 			UpdateDate: subscriptionSchool.UpdateDate,
 		},
-		SchoolID: subscriptionSchool.SchoolID,
-		Name:     subscriptionSchool.Name,
-		Region:   subscriptionSchool.Region,
+		SchoolID:      subscriptionSchool.SchoolID,
+		Name:          subscriptionSchool.Name,
+		Region:        subscriptionSchool.Region,
+		SchoolDeleted: subscriptionSchool.SchoolDeleteDate.Valid,
 	}
 }
 
@@ -112,7 +210,14 @@ func SubscriptionSchoolNewsDTOFrom(news News) SubscriptionSchoolNewsDTO {
 			CreateDate: news.CreateDate,
 			UpdateDate: news.UpdateDate,
 		},
-		SchoolID: news.SchoolID,
-		Title:    news.Title,
+		SchoolID:      news.SchoolID,
+		Title:         news.Title,
+		Summary:       news.Summary,
+		Body:          news.Body,
+		ContentFormat: news.ContentFormat,
+		PublishAt:     nullTimePointer(news.PublishDate),
+		Edited:        news.EditDate.Valid,
+		EditDate:      nullTimePointer(news.EditDate),
+		Priority:      news.Priority,
 	}
 }
@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"classting/pkg/cerrors"
+	"net/url"
+	"time"
+)
+
+type WebhookDTO struct {
+	BaseDTO
+	SchoolID int    `json:"schoolID" example:"1"`
+	URL      string `json:"url" example:"https://example.com/webhooks/classting"`
+}
+
+type CreateWebhookRequest struct {
+	UserID   int    `swaggerignore:"true"`
+	SchoolID int    `json:"schoolID" example:"1"`
+	URL      string `json:"url" example:"https://example.com/webhooks/classting"`
+}
+
+func (req CreateWebhookRequest) Validate() error {
+	const op cerrors.Op = "domain/CreateWebhookRequest.Validate"
+
+	if req.SchoolID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "학교 ID를 확인해주세요.")
+	}
+
+	u, err := url.Parse(req.URL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return cerrors.E(op, cerrors.Invalid, "웹훅 주소는 https 주소여야 합니다.")
+	}
+
+	return nil
+}
+
+// CreateWebhookResponse 서명 검증용 시크릿은 웹훅 생성 시에만 전달한다.
+type CreateWebhookResponse struct {
+	WebhookDTO
+	Secret string `json:"secret" example:"3f1c9a..."`
+}
+
+type ListWebhooksRequest struct {
+	UserID   int  `swaggerignore:"true"`
+	SchoolID int  `form:"schoolID" example:"1"`
+	Cursor   *int `form:"cursor"`
+}
+
+func (req ListWebhooksRequest) Validate() error {
+	const op cerrors.Op = "domain/ListWebhooksRequest.Validate"
+
+	if req.SchoolID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "학교 ID를 확인해주세요.")
+	}
+
+	if req.Cursor != nil && *req.Cursor <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "커서를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type ListWebhooksResponse struct {
+	Webhooks []WebhookDTO `json:"webhooks"`
+	Cursor   *int         `json:"cursor"`
+}
+
+type DeleteWebhookRequest struct {
+	UserID int `swaggerignore:"true"`
+	ID     int `uri:"webhookID"`
+}
+
+func (req DeleteWebhookRequest) Validate() error {
+	const op cerrors.Op = "domain/DeleteWebhookRequest.Validate"
+
+	if req.ID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "웹훅 ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type WebhookDeliveryDTO struct {
+	BaseDTO
+	WebhookID       int                   `json:"webhookID" example:"1"`
+	EventID         int                   `json:"eventID" example:"1"`
+	EventType       NewsEventType         `json:"eventType" example:"news.created"`
+	NewsID          int                   `json:"newsID" example:"1"`
+	Status          WebhookDeliveryStatus `json:"status" enums:"PENDING,SUCCEEDED,DEAD" example:"SUCCEEDED"`
+	Attempts        int                   `json:"attempts" example:"1"`
+	NextAttemptDate *time.Time            `json:"nextAttemptDate,omitempty" example:"2024-02-28T15:04:05Z"`
+	ResponseCode    *int                  `json:"responseCode,omitempty" example:"200"`
+	LastError       *string               `json:"lastError,omitempty"`
+}
+
+type ListWebhookDeliveriesRequest struct {
+	UserID    int  `swaggerignore:"true"`
+	WebhookID int  `uri:"webhookID" swaggerignore:"true"`
+	Cursor    *int `form:"cursor"`
+}
+
+func (req ListWebhookDeliveriesRequest) Validate() error {
+	const op cerrors.Op = "domain/ListWebhookDeliveriesRequest.Validate"
+
+	if req.WebhookID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "웹훅 ID를 확인해주세요.")
+	}
+
+	if req.Cursor != nil && *req.Cursor <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "커서를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type ListWebhookDeliveriesResponse struct {
+	Deliveries []WebhookDeliveryDTO `json:"deliveries"`
+	Cursor     *int                 `json:"cursor"`
+}
+
+// WebhookPayload 웹훅으로 전송하는 본문, 같은 이벤트는 재전송되어도 id가 같다.
+type WebhookPayload struct {
+	ID         int           `json:"id" example:"1"`
+	Type       NewsEventType `json:"type" example:"news.created"`
+	OccurredAt time.Time     `json:"occurredAt" example:"2024-02-28T15:04:05Z"`
+	News       NewsDTO       `json:"news"`
+}
+
+func WebhookDTOFrom(webhook Webhook) WebhookDTO {
+	return WebhookDTO{
+		BaseDTO: BaseDTO{
+			ID:         webhook.ID,
+			CreateDate: webhook.CreateDate,
+			UpdateDate: webhook.UpdateDate,
+		},
+		SchoolID: webhook.SchoolID,
+		URL:      webhook.URL,
+	}
+}
+
+func WebhookDeliveryDTOFrom(delivery WebhookDelivery) WebhookDeliveryDTO {
+	dto := WebhookDeliveryDTO{
+		BaseDTO: BaseDTO{
+			ID:         delivery.ID,
+			CreateDate: delivery.CreateDate,
+			UpdateDate: delivery.UpdateDate,
+		},
+		WebhookID:    delivery.WebhookID,
+		EventID:      delivery.EventID,
+		EventType:    delivery.EventType,
+		NewsID:       delivery.NewsID,
+		Status:       delivery.Status,
+		Attempts:     delivery.Attempts,
+		ResponseCode: delivery.ResponseCode,
+		LastError:    delivery.LastError,
+	}
+	if delivery.Status == WebhookDeliveryStatusPending {
+		dto.NextAttemptDate = &delivery.NextAttemptDate
+	}
+
+	return dto
+}
@@ -0,0 +1,124 @@
+package analytics
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"classting/pkg/router"
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+func RegisterRoutes(e *gin.Engine, controller domain.AnalyticsController, auth *router.JWTAuth) {
+	api := e.Group("/analytics")
+	{
+		api.GET("/news/:newsID", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.GetNewsAnalytics)
+		api.GET("/schools/:schoolID", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.GetSchoolAnalytics)
+	}
+}
+
+type analyticsController struct {
+	service domain.AnalyticsService
+}
+
+func NewAnalyticsController(service domain.AnalyticsService) *analyticsController {
+	return &analyticsController{
+		service: service,
+	}
+}
+
+var _ domain.AnalyticsController = (*analyticsController)(nil)
+
+// GetNewsAnalytics
+// @Tags Analytics
+// @Summary 소식 도달 통계 조회 [추가 구현] 권한 - 관리자
+// @Description 소식이 속한 학교의 관리자가 발행 시점 구독자 수, 읽은 구독자 수, 읽음률과 날짜별 읽음 추이를 조회합니다.
+// @Description 읽음은 구독자가 소식을 하나씩 읽음 처리할 때 기록되며 모두 읽음 처리는 포함하지 않습니다.
+// @Description 발행 이후 구독한 학생도 읽은 구독자에 포함되므로 읽음률은 1을 넘을 수 있습니다.
+// @Produce json
+// @Security BearerAuth
+// @Param newsID path int true "소식 ID"
+// @Success 200 {object} domain.NewsAnalyticsDTO "소식 도달 통계"
+// @Router /analytics/news/{newsID} [get]
+func (a analyticsController) GetNewsAnalytics(c *gin.Context) {
+	var req domain.GetNewsAnalyticsRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := a.service.GetNewsAnalytics(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
+}
+
+// GetSchoolAnalytics
+// @Tags Analytics
+// @Summary 학교 구독자 통계 조회 [추가 구현] 권한 - 관리자
+// @Description 학교의 관리자가 기간 중 날짜별 구독, 구독 취소 수와 구독자 수, 이탈률을 조회합니다.
+// @Description from, to는 모두 포함하는 UTC 날짜이며 생략하면 오늘까지 최근 30일을 조회합니다. (최대 366일)
+// @Description 이탈률은 기간 시작 시 구독자와 기간 중 구독한 학생 대비 구독 취소 수입니다.
+// @Produce json
+// @Security BearerAuth
+// @Param schoolID path int true "학교 ID"
+// @Param from query string false "시작일 (YYYY-MM-DD)"
+// @Param to query string false "종료일 (YYYY-MM-DD)"
+// @Success 200 {object} domain.SchoolAnalyticsDTO "학교 구독자 통계"
+// @Router /analytics/schools/{schoolID} [get]
+func (a analyticsController) GetSchoolAnalytics(c *gin.Context) {
+	var req domain.GetSchoolAnalyticsRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := a.service.GetSchoolAnalytics(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
+}
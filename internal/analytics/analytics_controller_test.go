@@ -0,0 +1,169 @@
+package analytics
+
+import (
+	"classting/config"
+	"classting/domain"
+	"classting/internal/user"
+	"classting/mocks"
+	"classting/pkg/router"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type analyticsControllerTestSuite struct {
+	router              *gin.Engine
+	cfg                 *config.Config
+	analyticsService    *mocks.AnalyticsService
+	analyticsController domain.AnalyticsController
+}
+
+func setupAnalyticsControllerTestSuite(t *testing.T) analyticsControllerTestSuite {
+	var us analyticsControllerTestSuite
+
+	gin.SetMode(gin.TestMode)
+	us.router = gin.Default()
+	us.analyticsService = mocks.NewAnalyticsService(t)
+	us.cfg = &config.Config{
+		Auth: config.Auth{
+			Secret: "classting_test_secret",
+		},
+	}
+
+	us.analyticsController = NewAnalyticsController(us.analyticsService)
+	RegisterRoutes(
+		us.router, us.analyticsController,
+		router.NewJWTAuth(us.cfg.Auth.Secret, nil, nil),
+	)
+
+	return us
+}
+
+func userToken(ts analyticsControllerTestSuite, userType domain.UserType) string {
+	token, _ := user.CreateAccessToken(domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+		Type: userType,
+	}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+
+	return token
+}
+
+func Test_analyticsController_GetNewsAnalytics(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		userType domain.UserType
+		mock     func(ts analyticsControllerTestSuite)
+		code     int
+	}{
+		{
+			name:     "PASS - 소식 도달 통계 조회",
+			path:     "/analytics/news/3",
+			userType: domain.UserUseTypeAdmin,
+			mock: func(ts analyticsControllerTestSuite) {
+				ts.analyticsService.EXPECT().GetNewsAnalytics(mock.Anything, domain.GetNewsAnalyticsRequest{
+					UserID: 1,
+					NewsID: 3,
+				}).Return(domain.NewsAnalyticsDTO{NewsID: 3}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name:     "FAIL - 잘못된 소식 ID",
+			path:     "/analytics/news/0",
+			userType: domain.UserUseTypeAdmin,
+			mock:     func(ts analyticsControllerTestSuite) {},
+			code:     http.StatusBadRequest,
+		},
+		{
+			name:     "FAIL - 학생 권한",
+			path:     "/analytics/news/3",
+			userType: domain.UserUseTypeStudent,
+			mock:     func(ts analyticsControllerTestSuite) {},
+			code:     http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupAnalyticsControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer "+userToken(ts, tt.userType))
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.analyticsService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_analyticsController_GetSchoolAnalytics(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		mock func(ts analyticsControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 기간을 지정한 구독자 통계 조회",
+			path: "/analytics/schools/1?from=2024-03-01&to=2024-03-31",
+			mock: func(ts analyticsControllerTestSuite) {
+				ts.analyticsService.EXPECT().GetSchoolAnalytics(mock.Anything, domain.GetSchoolAnalyticsRequest{
+					UserID:   1,
+					SchoolID: 1,
+					From:     "2024-03-01",
+					To:       "2024-03-31",
+				}).Return(domain.SchoolAnalyticsDTO{SchoolID: 1}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 잘못된 날짜 형식",
+			path: "/analytics/schools/1?from=2024/03/01",
+			mock: func(ts analyticsControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 시작일이 종료일보다 늦은 경우",
+			path: "/analytics/schools/1?from=2024-03-31&to=2024-03-01",
+			mock: func(ts analyticsControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 최대 조회 기간 초과",
+			path: "/analytics/schools/1?from=2023-01-01&to=2024-03-01",
+			mock: func(ts analyticsControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupAnalyticsControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer "+userToken(ts, domain.UserUseTypeAdmin))
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.analyticsService.AssertExpectations(t)
+		})
+	}
+}
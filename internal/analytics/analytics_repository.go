@@ -0,0 +1,82 @@
+package analytics
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"context"
+	"database/sql"
+)
+
+type analyticsRepository struct {
+	sqlDB *sql.DB
+}
+
+func NewAnalyticsRepository(sqlDB *sql.DB) *analyticsRepository {
+	return &analyticsRepository{
+		sqlDB: sqlDB,
+	}
+}
+
+var _ domain.AnalyticsRepository = (*analyticsRepository)(nil)
+
+func (a analyticsRepository) CountSubscribersAt(ctx context.Context, params domain.CountSubscribersAtParams) (int, error) {
+	const op cerrors.Op = "analytics/analyticsRepository/CountSubscribersAt"
+
+	var count int
+	if err := a.sqlDB.QueryRowContext(ctx, countSubscribersAtQuery, params.SchoolID, params.At, params.At).Scan(&count); err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return count, nil
+}
+
+func (a analyticsRepository) ListNewsDailyReaders(ctx context.Context, newsID int) ([]domain.NewsDailyReaders, error) {
+	const op cerrors.Op = "analytics/analyticsRepository/ListNewsDailyReaders"
+
+	rows, err := a.sqlDB.QueryContext(ctx, listNewsDailyReadersQuery, newsID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var dailyReaders []domain.NewsDailyReaders
+	for rows.Next() {
+		var daily domain.NewsDailyReaders
+		if err := rows.Scan(&daily.Date, &daily.Readers); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		dailyReaders = append(dailyReaders, daily)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return dailyReaders, nil
+}
+
+func (a analyticsRepository) ListSubscriptionDailyChanges(ctx context.Context, params domain.ListSubscriptionDailyChangesParams) ([]domain.SubscriptionDailyChange, error) {
+	const op cerrors.Op = "analytics/analyticsRepository/ListSubscriptionDailyChanges"
+
+	rows, err := a.sqlDB.QueryContext(ctx, listSubscriptionDailyChangesQuery,
+		params.SchoolID, params.From, params.To,
+		params.SchoolID, params.From, params.To,
+	)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var changes []domain.SubscriptionDailyChange
+	for rows.Next() {
+		var change domain.SubscriptionDailyChange
+		if err := rows.Scan(&change.Date, &change.Subscribed, &change.Unsubscribed); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,186 @@
+package analytics
+
+import (
+	"classting/domain"
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type analyticsRepositoryTestSuite struct {
+	sqlDB               *sql.DB
+	sqlMock             sqlmock.Sqlmock
+	analyticsRepository domain.AnalyticsRepository
+}
+
+func setupAnalyticsRepositoryTestSuite() analyticsRepositoryTestSuite {
+	var us analyticsRepositoryTestSuite
+
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	us.sqlDB = mockDB
+	us.sqlMock = mock
+	us.analyticsRepository = NewAnalyticsRepository(mockDB)
+
+	return us
+}
+
+func Test_analyticsRepository_CountSubscribersAt(t *testing.T) {
+	at := time.Date(2024, 3, 4, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		params  domain.CountSubscribersAtParams
+		mock    func(ts analyticsRepositoryTestSuite)
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "PASS - 발행 시점 구독자 수 조회",
+			params: domain.CountSubscribersAtParams{
+				SchoolID: 1,
+				At:       at,
+			},
+			mock: func(ts analyticsRepositoryTestSuite) {
+				ts.sqlMock.ExpectQuery(`SELECT COUNT\(\*\) FROM subscriptions WHERE school_id = \? AND create_date < \? AND \(delete_date IS NULL OR delete_date >= \?\)`).
+					WithArgs(1, at, at).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(120))
+			},
+			want:    120,
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 서버 에러",
+			params: domain.CountSubscribersAtParams{
+				SchoolID: 1,
+				At:       at,
+			},
+			mock: func(ts analyticsRepositoryTestSuite) {
+				ts.sqlMock.ExpectQuery(`SELECT COUNT\(\*\) FROM subscriptions`).WillReturnError(sql.ErrConnDone)
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupAnalyticsRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.analyticsRepository.CountSubscribersAt(context.Background(), tt.params)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_analyticsRepository_ListNewsDailyReaders(t *testing.T) {
+	day1 := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		newsID  int
+		mock    func(ts analyticsRepositoryTestSuite)
+		want    []domain.NewsDailyReaders
+		wantErr bool
+	}{
+		{
+			name:   "PASS - 날짜별 처음 읽은 구독자 수 조회",
+			newsID: 1,
+			mock: func(ts analyticsRepositoryTestSuite) {
+				rows := sqlmock.NewRows([]string{"read_date", "count"}).AddRow(day1, 60).AddRow(day2, 24)
+				ts.sqlMock.ExpectQuery(`SELECT DATE\(first_read_date\) AS read_date, COUNT\(\*\) FROM \(SELECT user_id, MIN\(create_date\) AS first_read_date FROM news_read_events WHERE news_id = \? GROUP BY user_id\)`).
+					WithArgs(1).
+					WillReturnRows(rows)
+			},
+			want: []domain.NewsDailyReaders{
+				{Date: day1, Readers: 60},
+				{Date: day2, Readers: 24},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupAnalyticsRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.analyticsRepository.ListNewsDailyReaders(context.Background(), tt.newsID)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_analyticsRepository_ListSubscriptionDailyChanges(t *testing.T) {
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC)
+	day := time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		params  domain.ListSubscriptionDailyChangesParams
+		mock    func(ts analyticsRepositoryTestSuite)
+		want    []domain.SubscriptionDailyChange
+		wantErr bool
+	}{
+		{
+			name: "PASS - 날짜별 구독 변화 조회",
+			params: domain.ListSubscriptionDailyChangesParams{
+				SchoolID: 1,
+				From:     from,
+				To:       to,
+			},
+			mock: func(ts analyticsRepositoryTestSuite) {
+				rows := sqlmock.NewRows([]string{"change_date", "subscribed", "unsubscribed"}).AddRow(day, 3, 1)
+				ts.sqlMock.ExpectQuery(`SELECT change_date, SUM\(subscribed\), SUM\(unsubscribed\) FROM (.+) UNION ALL (.+) GROUP BY change_date ORDER BY change_date`).
+					WithArgs(1, from, to, 1, from, to).
+					WillReturnRows(rows)
+			},
+			want: []domain.SubscriptionDailyChange{
+				{Date: day, Subscribed: 3, Unsubscribed: 1},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupAnalyticsRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.analyticsRepository.ListSubscriptionDailyChanges(context.Background(), tt.params)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package analytics
+
+import (
+	"classting/domain"
+	"classting/internal/school"
+	"classting/pkg/cerrors"
+	"context"
+	"time"
+)
+
+type analyticsService struct {
+	analyticsRepository domain.AnalyticsRepository
+	newsRepository      domain.NewsRepository
+	schoolRepository    domain.SchoolRepository
+	now                 func() time.Time
+}
+
+func NewAnalyticsService(
+	analyticsRepository domain.AnalyticsRepository,
+	newsRepository domain.NewsRepository,
+	schoolRepository domain.SchoolRepository,
+) *analyticsService {
+	return &analyticsService{
+		analyticsRepository: analyticsRepository,
+		newsRepository:      newsRepository,
+		schoolRepository:    schoolRepository,
+		now:                 time.Now,
+	}
+}
+
+var _ domain.AnalyticsService = (*analyticsService)(nil)
+
+func (s analyticsService) GetNewsAnalytics(ctx context.Context, req domain.GetNewsAnalyticsRequest) (domain.NewsAnalyticsDTO, error) {
+	const op cerrors.Op = "analytics/service/GetNewsAnalytics"
+
+	news, err := s.newsRepository.FindNewsByID(ctx, req.NewsID)
+	if err != nil {
+		return domain.NewsAnalyticsDTO{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	if news == nil || news.DeleteDate.Valid {
+		return domain.NewsAnalyticsDTO{}, cerrors.E(op, cerrors.NotExist, "소식을 찾을 수 없습니다.")
+	}
+	if _, err := school.AuthorizeMember(ctx, s.schoolRepository, op, news.SchoolID, req.UserID, domain.SchoolRoleViewer); err != nil {
+		return domain.NewsAnalyticsDTO{}, err
+	}
+	// 보관된 소식도 발행된 적이 있으므로 통계를 볼 수 있다.
+	if news.Status != domain.NewsStatusPublished && news.Status != domain.NewsStatusArchived {
+		return domain.NewsAnalyticsDTO{}, cerrors.E(op, cerrors.Invalid, "발행된 소식만 통계를 확인할 수 있습니다.")
+	}
+
+	subscriberCount, err := s.analyticsRepository.CountSubscribersAt(ctx, domain.CountSubscribersAtParams{
+		SchoolID: news.SchoolID,
+		At:       news.PublishDate.Time,
+	})
+	if err != nil {
+		return domain.NewsAnalyticsDTO{}, cerrors.E(op, cerrors.Internal, err, "소식 통계를 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	dailyReaders, err := s.analyticsRepository.ListNewsDailyReaders(ctx, news.ID)
+	if err != nil {
+		return domain.NewsAnalyticsDTO{}, cerrors.E(op, cerrors.Internal, err, "소식 통계를 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	return domain.NewsAnalyticsDTOFrom(*news, subscriberCount, dailyReaders), nil
+}
+
+func (s analyticsService) GetSchoolAnalytics(ctx context.Context, req domain.GetSchoolAnalyticsRequest) (domain.SchoolAnalyticsDTO, error) {
+	const op cerrors.Op = "analytics/service/GetSchoolAnalytics"
+
+	from, to, err := req.Period(s.now().UTC())
+	if err != nil {
+		return domain.SchoolAnalyticsDTO{}, err
+	}
+
+	if _, err := school.AuthorizeMember(ctx, s.schoolRepository, op, req.SchoolID, req.UserID, domain.SchoolRoleViewer); err != nil {
+		return domain.SchoolAnalyticsDTO{}, err
+	}
+
+	startSubscriberCount, err := s.analyticsRepository.CountSubscribersAt(ctx, domain.CountSubscribersAtParams{
+		SchoolID: req.SchoolID,
+		At:       from,
+	})
+	if err != nil {
+		return domain.SchoolAnalyticsDTO{}, cerrors.E(op, cerrors.Internal, err, "구독자 통계를 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	changes, err := s.analyticsRepository.ListSubscriptionDailyChanges(ctx, domain.ListSubscriptionDailyChangesParams{
+		SchoolID: req.SchoolID,
+		From:     from,
+		To:       to.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		return domain.SchoolAnalyticsDTO{}, cerrors.E(op, cerrors.Internal, err, "구독자 통계를 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	return domain.SchoolAnalyticsDTOFrom(req.SchoolID, from, to, startSubscriberCount, changes), nil
+}
@@ -0,0 +1,324 @@
+package analytics
+
+import (
+	"classting/domain"
+	"classting/mocks"
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2024, 3, 10, 9, 0, 0, 0, time.UTC)
+
+type analyticsServiceTestSuite struct {
+	analyticsRepository *mocks.AnalyticsRepository
+	newsRepository      *mocks.NewsRepository
+	schoolRepository    *mocks.SchoolRepository
+	service             *analyticsService
+}
+
+func setupAnalyticsServiceTestSuite(t *testing.T) analyticsServiceTestSuite {
+	var us analyticsServiceTestSuite
+
+	us.analyticsRepository = mocks.NewAnalyticsRepository(t)
+	us.newsRepository = mocks.NewNewsRepository(t)
+	us.schoolRepository = mocks.NewSchoolRepository(t)
+	us.service = NewAnalyticsService(us.analyticsRepository, us.newsRepository, us.schoolRepository)
+	us.service.now = func() time.Time {
+		return testNow
+	}
+
+	return us
+}
+
+func expectSchoolMember(ts analyticsServiceTestSuite, role domain.SchoolRole) {
+	ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+		Base:   domain.Base{ID: 1},
+		UserID: 1,
+	}, nil).Once()
+	ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+		SchoolID: 1,
+		UserID:   1,
+	}).Return(&domain.SchoolMember{
+		SchoolID: 1,
+		UserID:   1,
+		Role:     role,
+	}, nil).Once()
+}
+
+func Test_analyticsService_GetNewsAnalytics(t *testing.T) {
+	publishDate := time.Date(2024, 3, 4, 8, 0, 0, 0, time.UTC)
+	news := func(status domain.NewsStatus) *domain.News {
+		return &domain.News{
+			Base:        domain.Base{ID: 3},
+			SchoolID:    1,
+			Status:      status,
+			PublishDate: sql.NullTime{Time: publishDate, Valid: status != domain.NewsStatusDraft},
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		req domain.GetNewsAnalyticsRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts analyticsServiceTestSuite)
+		want    domain.NewsAnalyticsDTO
+		wantErr bool
+	}{
+		{
+			name: "PASS - 발행된 소식의 도달 통계 조회",
+			args: args{
+				ctx: context.Background(),
+				req: domain.GetNewsAnalyticsRequest{
+					UserID: 1,
+					NewsID: 3,
+				},
+			},
+			mock: func(ts analyticsServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(news(domain.NewsStatusPublished), nil).Once()
+				expectSchoolMember(ts, domain.SchoolRoleViewer)
+				ts.analyticsRepository.EXPECT().CountSubscribersAt(mock.Anything, domain.CountSubscribersAtParams{
+					SchoolID: 1,
+					At:       publishDate,
+				}).Return(8, nil).Once()
+				ts.analyticsRepository.EXPECT().ListNewsDailyReaders(mock.Anything, 3).Return([]domain.NewsDailyReaders{
+					{Date: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC), Readers: 4},
+					{Date: time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC), Readers: 2},
+				}, nil).Once()
+			},
+			want: domain.NewsAnalyticsDTO{
+				NewsID:          3,
+				SchoolID:        1,
+				PublishDate:     publishDate,
+				SubscriberCount: 8,
+				UniqueReaders:   6,
+				ReadRate:        0.75,
+				Timeline: []domain.NewsReadRateDTO{
+					{Date: "2024-03-04", NewReaders: 4, UniqueReaders: 4, ReadRate: 0.5},
+					{Date: "2024-03-06", NewReaders: 2, UniqueReaders: 6, ReadRate: 0.75},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 발행 시점에 구독자가 없는 소식",
+			args: args{
+				ctx: context.Background(),
+				req: domain.GetNewsAnalyticsRequest{
+					UserID: 1,
+					NewsID: 3,
+				},
+			},
+			mock: func(ts analyticsServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(news(domain.NewsStatusArchived), nil).Once()
+				expectSchoolMember(ts, domain.SchoolRoleViewer)
+				ts.analyticsRepository.EXPECT().CountSubscribersAt(mock.Anything, domain.CountSubscribersAtParams{
+					SchoolID: 1,
+					At:       publishDate,
+				}).Return(0, nil).Once()
+				ts.analyticsRepository.EXPECT().ListNewsDailyReaders(mock.Anything, 3).Return(nil, nil).Once()
+			},
+			want: domain.NewsAnalyticsDTO{
+				NewsID:      3,
+				SchoolID:    1,
+				PublishDate: publishDate,
+				Timeline:    []domain.NewsReadRateDTO{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 발행되지 않은 소식",
+			args: args{
+				ctx: context.Background(),
+				req: domain.GetNewsAnalyticsRequest{
+					UserID: 1,
+					NewsID: 3,
+				},
+			},
+			mock: func(ts analyticsServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(news(domain.NewsStatusDraft), nil).Once()
+				expectSchoolMember(ts, domain.SchoolRoleViewer)
+			},
+			want:    domain.NewsAnalyticsDTO{},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 학교 멤버가 아닌 관리자",
+			args: args{
+				ctx: context.Background(),
+				req: domain.GetNewsAnalyticsRequest{
+					UserID: 1,
+					NewsID: 3,
+				},
+			},
+			mock: func(ts analyticsServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(news(domain.NewsStatusPublished), nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{ID: 1},
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(nil, nil).Once()
+			},
+			want:    domain.NewsAnalyticsDTO{},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 존재하지 않는 소식",
+			args: args{
+				ctx: context.Background(),
+				req: domain.GetNewsAnalyticsRequest{
+					UserID: 1,
+					NewsID: 3,
+				},
+			},
+			mock: func(ts analyticsServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(nil, nil).Once()
+			},
+			want:    domain.NewsAnalyticsDTO{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupAnalyticsServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.service.GetNewsAnalytics(tt.args.ctx, tt.args.req)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_analyticsService_GetSchoolAnalytics(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.GetSchoolAnalyticsRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts analyticsServiceTestSuite)
+		want    domain.SchoolAnalyticsDTO
+		wantErr bool
+	}{
+		{
+			name: "PASS - 기간별 구독자 증감 조회",
+			args: args{
+				ctx: context.Background(),
+				req: domain.GetSchoolAnalyticsRequest{
+					UserID:   1,
+					SchoolID: 1,
+					From:     "2024-03-01",
+					To:       "2024-03-03",
+				},
+			},
+			mock: func(ts analyticsServiceTestSuite) {
+				expectSchoolMember(ts, domain.SchoolRoleViewer)
+				ts.analyticsRepository.EXPECT().CountSubscribersAt(mock.Anything, domain.CountSubscribersAtParams{
+					SchoolID: 1,
+					At:       time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+				}).Return(10, nil).Once()
+				ts.analyticsRepository.EXPECT().ListSubscriptionDailyChanges(mock.Anything, domain.ListSubscriptionDailyChangesParams{
+					SchoolID: 1,
+					From:     time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+					To:       time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC),
+				}).Return([]domain.SubscriptionDailyChange{
+					{Date: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Subscribed: 2},
+					{Date: time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC), Subscribed: 3, Unsubscribed: 3},
+				}, nil).Once()
+			},
+			want: domain.SchoolAnalyticsDTO{
+				SchoolID:             1,
+				From:                 "2024-03-01",
+				To:                   "2024-03-03",
+				StartSubscriberCount: 10,
+				EndSubscriberCount:   12,
+				Subscribed:           5,
+				Unsubscribed:         3,
+				ChurnRate:            0.2,
+				Days: []domain.SchoolSubscriberDailyDTO{
+					{Date: "2024-03-01", Subscribed: 2, SubscriberCount: 12},
+					{Date: "2024-03-02", SubscriberCount: 12},
+					{Date: "2024-03-03", Subscribed: 3, Unsubscribed: 3, SubscriberCount: 12},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 기간을 생략하면 최근 30일 조회",
+			args: args{
+				ctx: context.Background(),
+				req: domain.GetSchoolAnalyticsRequest{
+					UserID:   1,
+					SchoolID: 1,
+				},
+			},
+			mock: func(ts analyticsServiceTestSuite) {
+				expectSchoolMember(ts, domain.SchoolRoleViewer)
+				ts.analyticsRepository.EXPECT().CountSubscribersAt(mock.Anything, domain.CountSubscribersAtParams{
+					SchoolID: 1,
+					At:       time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC),
+				}).Return(0, nil).Once()
+				ts.analyticsRepository.EXPECT().ListSubscriptionDailyChanges(mock.Anything, domain.ListSubscriptionDailyChangesParams{
+					SchoolID: 1,
+					From:     time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC),
+					To:       time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC),
+				}).Return(nil, nil).Once()
+			},
+			want:    domain.SchoolAnalyticsDTOFrom(1, time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), 0, nil),
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 학교 멤버가 아닌 관리자",
+			args: args{
+				ctx: context.Background(),
+				req: domain.GetSchoolAnalyticsRequest{
+					UserID:   1,
+					SchoolID: 1,
+				},
+			},
+			mock: func(ts analyticsServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{ID: 1},
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(nil, nil).Once()
+			},
+			want:    domain.SchoolAnalyticsDTO{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupAnalyticsServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.service.GetSchoolAnalytics(tt.args.ctx, tt.args.req)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package analytics
+
+const countSubscribersAtQuery = `SELECT COUNT(*) FROM subscriptions WHERE school_id = ? AND create_date < ? AND (delete_date IS NULL OR delete_date >= ?)`
+
+// listNewsDailyReadersQuery 구독자마다 처음 읽은 날짜에만 센다.
+const listNewsDailyReadersQuery = `SELECT DATE(first_read_date) AS read_date, COUNT(*) FROM (SELECT user_id, MIN(create_date) AS first_read_date FROM news_read_events WHERE news_id = ? GROUP BY user_id) AS first_reads GROUP BY read_date ORDER BY read_date`
+
+const listSubscriptionDailyChangesQuery = `SELECT change_date, SUM(subscribed), SUM(unsubscribed) FROM (SELECT DATE(create_date) AS change_date, 1 AS subscribed, 0 AS unsubscribed FROM subscriptions WHERE school_id = ? AND create_date >= ? AND create_date < ? UNION ALL SELECT DATE(delete_date), 0, 1 FROM subscriptions WHERE school_id = ? AND delete_date >= ? AND delete_date < ?) AS changes GROUP BY change_date ORDER BY change_date`
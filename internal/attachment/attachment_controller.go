@@ -0,0 +1,165 @@
+package attachment
+
+import (
+	"classting/config"
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"classting/pkg/router"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"mime"
+	"net/http"
+	"time"
+)
+
+// multipartOverhead 파일 외에 multipart 본문에 들어가는 경계, 헤더, 다른 필드의 여유분
+const multipartOverhead = 1 << 20
+
+func RegisterRoutes(e *gin.Engine, controller domain.AttachmentController, auth *router.JWTAuth) {
+	news := e.Group("/news")
+	{
+		news.POST("/:newsID/attachments", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.UploadAttachment)
+	}
+	// 다운로드 주소는 서명으로 검증하므로 토큰 없이 내려받을 수 있다.
+	api := e.Group("/attachments")
+	{
+		api.GET("/:attachmentID", controller.DownloadAttachment)
+	}
+}
+
+type attachmentController struct {
+	service domain.AttachmentService
+	maxSize int64
+}
+
+func NewAttachmentController(service domain.AttachmentService, cfg *config.Config) *attachmentController {
+	return &attachmentController{
+		service: service,
+		maxSize: maxUploadSize(cfg),
+	}
+}
+
+var _ domain.AttachmentController = (*attachmentController)(nil)
+
+// UploadAttachment
+// @Tags Attachment
+// @Summary 소식 첨부 파일 업로드 [추가 구현] 권한 - 관리자
+// @Description OWNER 또는 EDITOR 역할로 속한 학교의 소식에 PDF, 이미지 파일을 첨부합니다.
+// @Description 파일 형식은 파일 내용으로 판별하며 허용된 형식이 아니면 업로드할 수 없습니다.
+// @Description 최대 크기(기본 10MB)를 넘는 파일은 본문을 모두 받기 전에 413으로 거절합니다.
+// @Description 응답의 url은 만료 시각까지 토큰 없이 내려받을 수 있는 서명된 주소입니다.
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param newsID path int true "소식 ID"
+// @Param file formData file true "첨부 파일"
+// @Success 200 {object} domain.AttachmentDTO "첨부 파일"
+// @Router /news/{newsID}/attachments [post]
+func (a attachmentController) UploadAttachment(c *gin.Context) {
+	var req domain.UploadAttachmentRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	// 본문을 읽기 전에 크기를 제한해 최대 크기를 넘는 파일을 메모리나 디스크에 받지 않는다.
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, a.maxSize+multipartOverhead)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(a.tooLarge())
+			return
+		}
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	if fileHeader.Size > a.maxSize {
+		c.JSON(a.tooLarge())
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	defer file.Close()
+	req.FileName = fileHeader.Filename
+	req.Size = fileHeader.Size
+	req.Content = file
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := a.service.UploadAttachment(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
+}
+
+// DownloadAttachment
+// @Tags Attachment
+// @Summary 소식 첨부 파일 다운로드 [추가 구현] 권한 - 서명된 주소
+// @Description 소식 조회 응답의 attachments.url로 첨부 파일을 내려받습니다. 만료 시각이 지났거나 서명이 올바르지 않으면 내려받을 수 없습니다.
+// @Produce octet-stream
+// @Param attachmentID path int true "첨부 파일 ID"
+// @Param expires query int true "만료 시각 (Unix 초)"
+// @Param signature query string true "서명"
+// @Success 200 {file} file "첨부 파일"
+// @Router /attachments/{attachmentID} [get]
+func (a attachmentController) DownloadAttachment(c *gin.Context) {
+	var req domain.DownloadAttachmentRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	file, err := a.service.DownloadAttachment(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	defer file.Content.Close()
+
+	c.DataFromReader(http.StatusOK, file.Attachment.Size, file.Attachment.ContentType, file.Content, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": file.Attachment.FileName}),
+		"Cache-Control":          "private, no-store",
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+func (a attachmentController) tooLarge() (int, cerrors.SentinelAPIError) {
+	return cerrors.NewSentinelAPIError(http.StatusRequestEntityTooLarge, fmt.Sprintf("첨부 파일은 %dMB 이하만 업로드할 수 있습니다.", a.maxSize>>20))
+}
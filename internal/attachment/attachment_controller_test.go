@@ -0,0 +1,202 @@
+package attachment
+
+import (
+	"bytes"
+	"classting/config"
+	"classting/domain"
+	"classting/internal/user"
+	"classting/mocks"
+	"classting/pkg/router"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type attachmentControllerTestSuite struct {
+	router               *gin.Engine
+	cfg                  *config.Config
+	attachmentService    *mocks.AttachmentService
+	attachmentController domain.AttachmentController
+}
+
+func setupAttachmentControllerTestSuite(t *testing.T) attachmentControllerTestSuite {
+	var us attachmentControllerTestSuite
+
+	gin.SetMode(gin.TestMode)
+	us.router = gin.Default()
+	us.attachmentService = mocks.NewAttachmentService(t)
+	us.cfg = &config.Config{
+		Auth: config.Auth{
+			Secret: "classting_test_secret",
+		},
+		Attachment: config.Attachment{
+			MaxSizeMB: 1,
+		},
+	}
+
+	us.attachmentController = NewAttachmentController(us.attachmentService, us.cfg)
+	RegisterRoutes(
+		us.router, us.attachmentController,
+		router.NewJWTAuth(us.cfg.Auth.Secret, nil, nil),
+	)
+
+	return us
+}
+
+func Test_attachmentController_UploadAttachment(t *testing.T) {
+	tests := []struct {
+		name string
+		body func() (*bytes.Buffer, string)
+		mock func(ts attachmentControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 소식에 파일 첨부",
+			body: func() (*bytes.Buffer, string) {
+				body := new(bytes.Buffer)
+				writer := multipart.NewWriter(body)
+				part, _ := writer.CreateFormFile("file", "급식표.pdf")
+				part.Write([]byte("%PDF-1.4"))
+				writer.Close()
+
+				return body, writer.FormDataContentType()
+			},
+			mock: func(ts attachmentControllerTestSuite) {
+				ts.attachmentService.EXPECT().UploadAttachment(mock.Anything, mock.MatchedBy(func(req domain.UploadAttachmentRequest) bool {
+					return req.UserID == 1 && req.NewsID == 1 && req.FileName == "급식표.pdf" && req.Size == int64(len("%PDF-1.4"))
+				})).Return(domain.AttachmentDTO{ID: 3, NewsID: 1}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 파일 누락",
+			body: func() (*bytes.Buffer, string) {
+				body := new(bytes.Buffer)
+				writer := multipart.NewWriter(body)
+				writer.WriteField("name", "급식표.pdf")
+				writer.Close()
+
+				return body, writer.FormDataContentType()
+			},
+			mock: func(ts attachmentControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 최대 크기를 넘는 파일",
+			body: func() (*bytes.Buffer, string) {
+				return multipartFile(1<<20 + 1)
+			},
+			mock: func(ts attachmentControllerTestSuite) {},
+			code: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name: "FAIL - 본문을 모두 받기 전에 최대 크기를 넘는 요청 거절",
+			body: func() (*bytes.Buffer, string) {
+				return multipartFile(8 << 20)
+			},
+			mock: func(ts attachmentControllerTestSuite) {},
+			code: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupAttachmentControllerTestSuite(t)
+			tt.mock(ts)
+			body, contentType := tt.body()
+			req, _ := http.NewRequest(http.MethodPost, "/news/1/attachments", body)
+			req.Header.Set("Content-Type", contentType)
+			token, _ := user.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+				Type: domain.UserUseTypeAdmin,
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.attachmentService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_attachmentController_DownloadAttachment(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		mock func(ts attachmentControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 서명된 주소로 다운로드",
+			path: "/attachments/3?expires=1709287200&signature=abc",
+			mock: func(ts attachmentControllerTestSuite) {
+				ts.attachmentService.EXPECT().DownloadAttachment(mock.Anything, domain.DownloadAttachmentRequest{
+					ID:        3,
+					Expires:   1709287200,
+					Signature: "abc",
+				}).Return(domain.AttachmentFile{
+					Attachment: domain.Attachment{
+						FileName:    "급식표.pdf",
+						ContentType: "application/pdf",
+						Size:        9,
+					},
+					Content: io.NopCloser(strings.NewReader("classting")),
+				}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 서명 누락",
+			path: "/attachments/3?expires=1709287200",
+			mock: func(ts attachmentControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupAttachmentControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			if tt.code == http.StatusOK {
+				assert.Equal(t, "classting", rec.Body.String())
+				assert.Contains(t, rec.Header().Get("Content-Disposition"), "attachment")
+			}
+			ts.attachmentService.AssertExpectations(t)
+		})
+	}
+}
+
+// multipartFile size 바이트의 PDF 파일을 담은 multipart 본문
+func multipartFile(size int) (*bytes.Buffer, string) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", "급식표.pdf")
+	part.Write([]byte("%PDF-1.4"))
+	part.Write(bytes.Repeat([]byte("a"), size-len("%PDF-1.4")))
+	writer.Close()
+
+	return body, writer.FormDataContentType()
+}
@@ -0,0 +1,124 @@
+package attachment
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"classting/pkg/db"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+type attachmentRepository struct {
+	sqlDB *sql.DB
+}
+
+func NewAttachmentRepository(sqlDB *sql.DB) *attachmentRepository {
+	return &attachmentRepository{
+		sqlDB: sqlDB,
+	}
+}
+
+var _ domain.AttachmentRepository = (*attachmentRepository)(nil)
+
+func (a attachmentRepository) CreateAttachment(ctx context.Context, attachment domain.Attachment) (int, error) {
+	const op cerrors.Op = "attachment/attachmentRepository/CreateAttachment"
+
+	result, err := a.sqlDB.ExecContext(ctx, createAttachmentQuery,
+		attachment.NewsID,
+		attachment.UserID,
+		attachment.FileName,
+		attachment.ContentType,
+		attachment.Size,
+		attachment.StorageKey,
+	)
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	attachmentID, err := result.LastInsertId()
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return int(attachmentID), nil
+}
+
+func (a attachmentRepository) FindAttachmentByID(ctx context.Context, attachmentID int) (*domain.Attachment, error) {
+	const op cerrors.Op = "attachment/attachmentRepository/FindAttachmentByID"
+
+	var attachment domain.Attachment
+
+	err := scanAttachment(a.sqlDB.QueryRowContext(ctx, findAttachmentByIDQuery, attachmentID), &attachment)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return &attachment, nil
+}
+
+func (a attachmentRepository) ListAttachmentsByNewsIDs(ctx context.Context, newsIDs []int) ([]domain.Attachment, error) {
+	const op cerrors.Op = "attachment/attachmentRepository/ListAttachmentsByNewsIDs"
+
+	if len(newsIDs) == 0 {
+		return nil, nil
+	}
+
+	query, args := db.Select(listAttachmentsByNewsIDsQuery).
+		Where(db.In("news_id", newsIDs), db.Expr("delete_date IS NULL")).
+		OrderBy("id", false).
+		Build()
+
+	rows, err := a.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var attachments []domain.Attachment
+	for rows.Next() {
+		var attachment domain.Attachment
+		if err := scanAttachment(rows, &attachment); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		attachments = append(attachments, attachment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return attachments, nil
+}
+
+func (a attachmentRepository) DeleteAttachmentsByNewsID(ctx context.Context, newsID int) error {
+	const op cerrors.Op = "attachment/attachmentRepository/DeleteAttachmentsByNewsID"
+
+	if _, err := a.sqlDB.ExecContext(ctx, deleteAttachmentsByNewsIDQuery, time.Now().UTC(), newsID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAttachment(row scanner, attachment *domain.Attachment) error {
+	return row.Scan(
+		&attachment.ID,
+		&attachment.CreateDate,
+		&attachment.UpdateDate,
+		&attachment.DeleteDate,
+		&attachment.NewsID,
+		&attachment.UserID,
+		&attachment.FileName,
+		&attachment.ContentType,
+		&attachment.Size,
+		&attachment.StorageKey,
+	)
+}
@@ -0,0 +1,152 @@
+package attachment
+
+import (
+	"classting/domain"
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type attachmentRepositoryTestSuite struct {
+	sqlDB                *sql.DB
+	sqlMock              sqlmock.Sqlmock
+	attachmentRepository domain.AttachmentRepository
+}
+
+func setupAttachmentRepositoryTestSuite() attachmentRepositoryTestSuite {
+	var us attachmentRepositoryTestSuite
+
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	us.sqlDB = mockDB
+	us.sqlMock = mock
+	us.attachmentRepository = NewAttachmentRepository(mockDB)
+
+	return us
+}
+
+var attachmentColumns = []string{"id", "create_date", "update_date", "delete_date", "news_id", "user_id", "file_name", "content_type", "size", "storage_key"}
+
+func Test_attachmentRepository_CreateAttachment(t *testing.T) {
+	// given
+	ts := setupAttachmentRepositoryTestSuite()
+	ts.sqlMock.ExpectExec("INSERT INTO attachments").
+		WithArgs(1, 1, "급식표.pdf", "application/pdf", 1024, "news/1/abc").
+		WillReturnResult(sqlmock.NewResult(3, 1))
+
+	// when
+	got, err := ts.attachmentRepository.CreateAttachment(context.Background(), domain.Attachment{
+		NewsID:      1,
+		UserID:      1,
+		FileName:    "급식표.pdf",
+		ContentType: "application/pdf",
+		Size:        1024,
+		StorageKey:  "news/1/abc",
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, 3, got)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_attachmentRepository_FindAttachmentByID(t *testing.T) {
+	createDate := time.Now()
+	updateDate := time.Now()
+
+	tests := []struct {
+		name    string
+		mock    func(ts attachmentRepositoryTestSuite)
+		want    *domain.Attachment
+		wantErr bool
+	}{
+		{
+			name: "PASS - 첨부 파일 조회",
+			mock: func(ts attachmentRepositoryTestSuite) {
+				rows := sqlmock.NewRows(attachmentColumns).AddRow(3, createDate, updateDate, nil, 1, 1, "급식표.pdf", "application/pdf", 1024, "news/1/abc")
+				ts.sqlMock.ExpectQuery(`SELECT (.+) FROM attachments WHERE id = \? AND delete_date IS NULL`).WithArgs(3).WillReturnRows(rows)
+			},
+			want: &domain.Attachment{
+				Base: domain.Base{
+					ID:         3,
+					CreateDate: createDate,
+					UpdateDate: updateDate,
+				},
+				NewsID:      1,
+				UserID:      1,
+				FileName:    "급식표.pdf",
+				ContentType: "application/pdf",
+				Size:        1024,
+				StorageKey:  "news/1/abc",
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 삭제되었거나 없는 첨부 파일",
+			mock: func(ts attachmentRepositoryTestSuite) {
+				ts.sqlMock.ExpectQuery(`SELECT (.+) FROM attachments WHERE id = \? AND delete_date IS NULL`).WithArgs(3).WillReturnError(sql.ErrNoRows)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupAttachmentRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.attachmentRepository.FindAttachmentByID(context.Background(), 3)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_attachmentRepository_ListAttachmentsByNewsIDs(t *testing.T) {
+	// given
+	ts := setupAttachmentRepositoryTestSuite()
+	createDate := time.Now()
+	updateDate := time.Now()
+	rows := sqlmock.NewRows(attachmentColumns).
+		AddRow(3, createDate, updateDate, nil, 1, 1, "급식표.pdf", "application/pdf", 1024, "news/1/abc").
+		AddRow(4, createDate, updateDate, nil, 2, 1, "안내문.png", "image/png", 2048, "news/2/def")
+	ts.sqlMock.ExpectQuery(`SELECT (.+) FROM attachments WHERE news_id IN \(\?, \?\) AND delete_date IS NULL ORDER BY id`).
+		WithArgs(1, 2).
+		WillReturnRows(rows)
+
+	// when
+	got, err := ts.attachmentRepository.ListAttachmentsByNewsIDs(context.Background(), []int{1, 2})
+
+	// then
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, 2, got[1].NewsID)
+	assert.Equal(t, "news/2/def", got[1].StorageKey)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_attachmentRepository_DeleteAttachmentsByNewsID(t *testing.T) {
+	// given
+	ts := setupAttachmentRepositoryTestSuite()
+	ts.sqlMock.ExpectExec(`UPDATE attachments SET delete_date = \? WHERE news_id = \? AND delete_date IS NULL`).
+		WithArgs(sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	// when
+	err := ts.attachmentRepository.DeleteAttachmentsByNewsID(context.Background(), 1)
+
+	// then
+	assert.NoError(t, err)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
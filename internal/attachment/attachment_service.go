@@ -0,0 +1,276 @@
+package attachment
+
+import (
+	"bytes"
+	"classting/config"
+	"classting/domain"
+	"classting/internal/school"
+	"classting/pkg/cerrors"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMaxSizeMB  = 10
+	defaultURLExpires = time.Hour
+	// sniffLength http.DetectContentType은 앞의 512바이트만 확인한다.
+	sniffLength = 512
+	// keyPurpose 다운로드 주소 서명 키를 만들 때 쓰는 용도 구분 값
+	keyPurpose = "classting/attachment/url"
+)
+
+var defaultAllowedTypes = []string{"application/pdf", "image/png", "image/jpeg", "image/gif", "image/webp"}
+
+// attachmentService 첨부 파일의 형식은 요청 헤더 대신 파일 내용으로 판별한다.
+type attachmentService struct {
+	attachmentRepository domain.AttachmentRepository
+	newsRepository       domain.NewsRepository
+	schoolRepository     domain.SchoolRepository
+	blobStore            domain.BlobStore
+	maxSize              int64
+	allowedTypes         map[string]bool
+	urlExpires           time.Duration
+	secret               []byte
+	now                  func() time.Time
+}
+
+func NewAttachmentService(
+	attachmentRepository domain.AttachmentRepository,
+	newsRepository domain.NewsRepository,
+	schoolRepository domain.SchoolRepository,
+	blobStore domain.BlobStore,
+	cfg *config.Config,
+) *attachmentService {
+	allowedTypes := cfg.Attachment.AllowedTypes
+	if len(allowedTypes) == 0 {
+		allowedTypes = defaultAllowedTypes
+	}
+	allowed := make(map[string]bool, len(allowedTypes))
+	for _, contentType := range allowedTypes {
+		allowed[contentType] = true
+	}
+
+	secret := cfg.Attachment.Secret
+	if secret == "" {
+		secret = cfg.Auth.Secret
+	}
+
+	urlExpires := defaultURLExpires
+	if cfg.Attachment.URLExpiresMinutes > 0 {
+		urlExpires = time.Duration(cfg.Attachment.URLExpiresMinutes) * time.Minute
+	}
+
+	return &attachmentService{
+		attachmentRepository: attachmentRepository,
+		newsRepository:       newsRepository,
+		schoolRepository:     schoolRepository,
+		blobStore:            blobStore,
+		maxSize:              maxUploadSize(cfg),
+		allowedTypes:         allowed,
+		urlExpires:           urlExpires,
+		secret:               deriveKey(secret),
+		now:                  time.Now,
+	}
+}
+
+var _ domain.AttachmentService = (*attachmentService)(nil)
+
+func (s attachmentService) UploadAttachment(ctx context.Context, req domain.UploadAttachmentRequest) (domain.AttachmentDTO, error) {
+	const op cerrors.Op = "attachment/service/UploadAttachment"
+
+	news, err := s.newsRepository.FindNewsByID(ctx, req.NewsID)
+	if err != nil {
+		return domain.AttachmentDTO{}, err
+	}
+	if news == nil || news.DeleteDate.Valid {
+		return domain.AttachmentDTO{}, cerrors.E(op, cerrors.NotExist, "소식을 찾을 수 없습니다.")
+	}
+	if _, err := school.AuthorizeActiveMember(ctx, s.schoolRepository, op, news.SchoolID, req.UserID, domain.SchoolRoleEditor); err != nil {
+		return domain.AttachmentDTO{}, err
+	}
+
+	if req.Size > s.maxSize {
+		return domain.AttachmentDTO{}, cerrors.E(op, cerrors.Invalid, fmt.Sprintf("첨부 파일은 %dMB 이하만 업로드할 수 있습니다.", s.maxSize>>20))
+	}
+
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(req.Content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return domain.AttachmentDTO{}, cerrors.E(op, cerrors.Invalid, err, "첨부할 파일을 확인해주세요.")
+	}
+	head = head[:n]
+
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	if !s.allowedTypes[contentType] {
+		return domain.AttachmentDTO{}, cerrors.E(op, cerrors.Invalid, "PDF 또는 이미지 파일만 첨부할 수 있습니다.")
+	}
+
+	key, err := newStorageKey(news.ID)
+	if err != nil {
+		return domain.AttachmentDTO{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	// 요청 헤더의 크기를 믿지 않고 최대 크기보다 1바이트 더 읽어 초과 여부를 확인한다.
+	size, err := s.blobStore.Put(ctx, key, io.LimitReader(io.MultiReader(bytes.NewReader(head), req.Content), s.maxSize+1))
+	if err != nil {
+		s.deleteBlob(ctx, key)
+		return domain.AttachmentDTO{}, cerrors.E(op, cerrors.Internal, err, "첨부 파일을 저장하는 중에 에러가 발생했습니다.")
+	}
+	if size > s.maxSize {
+		s.deleteBlob(ctx, key)
+		return domain.AttachmentDTO{}, cerrors.E(op, cerrors.Invalid, fmt.Sprintf("첨부 파일은 %dMB 이하만 업로드할 수 있습니다.", s.maxSize>>20))
+	}
+
+	attachment := domain.Attachment{
+		NewsID:      news.ID,
+		UserID:      req.UserID,
+		FileName:    filepath.Base(req.FileName),
+		ContentType: contentType,
+		Size:        size,
+		StorageKey:  key,
+	}
+	attachment.ID, err = s.attachmentRepository.CreateAttachment(ctx, attachment)
+	if err != nil {
+		s.deleteBlob(ctx, key)
+		return domain.AttachmentDTO{}, err
+	}
+
+	return s.signedAttachmentDTO(attachment), nil
+}
+
+func (s attachmentService) DownloadAttachment(ctx context.Context, req domain.DownloadAttachmentRequest) (domain.AttachmentFile, error) {
+	const op cerrors.Op = "attachment/service/DownloadAttachment"
+
+	if !hmac.Equal([]byte(req.Signature), []byte(s.sign(req.ID, req.Expires))) {
+		return domain.AttachmentFile{}, cerrors.E(op, cerrors.Permission, "다운로드 주소의 서명이 올바르지 않습니다.")
+	}
+	if s.now().Unix() > req.Expires {
+		return domain.AttachmentFile{}, cerrors.E(op, cerrors.Permission, "다운로드 주소가 만료되었습니다.")
+	}
+
+	attachment, err := s.attachmentRepository.FindAttachmentByID(ctx, req.ID)
+	if err != nil {
+		return domain.AttachmentFile{}, err
+	}
+	if attachment == nil {
+		return domain.AttachmentFile{}, cerrors.E(op, cerrors.NotExist, "첨부 파일을 찾을 수 없습니다.")
+	}
+
+	content, err := s.blobStore.Open(ctx, attachment.StorageKey)
+	if err != nil {
+		return domain.AttachmentFile{}, cerrors.E(op, cerrors.Internal, err, "첨부 파일을 읽는 중에 에러가 발생했습니다.")
+	}
+
+	return domain.AttachmentFile{
+		Attachment: *attachment,
+		Content:    content,
+	}, nil
+}
+
+// ListNewsAttachments 소식 ID별 첨부 파일 목록을 서명된 다운로드 주소와 함께 반환한다.
+func (s attachmentService) ListNewsAttachments(ctx context.Context, newsIDs []int) (map[int][]domain.AttachmentDTO, error) {
+	attachments, err := s.attachmentRepository.ListAttachmentsByNewsIDs(ctx, newsIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	attachmentDTOs := make(map[int][]domain.AttachmentDTO)
+	for _, attachment := range attachments {
+		attachmentDTOs[attachment.NewsID] = append(attachmentDTOs[attachment.NewsID], s.signedAttachmentDTO(attachment))
+	}
+
+	return attachmentDTOs, nil
+}
+
+// DeleteNewsAttachments 첨부 파일 기록을 먼저 삭제해 더 이상 내려받을 수 없게 한 다음 저장된 파일을 지운다.
+func (s attachmentService) DeleteNewsAttachments(ctx context.Context, newsID int) error {
+	attachments, err := s.attachmentRepository.ListAttachmentsByNewsIDs(ctx, []int{newsID})
+	if err != nil {
+		return err
+	}
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	if err := s.attachmentRepository.DeleteAttachmentsByNewsID(ctx, newsID); err != nil {
+		return err
+	}
+
+	for _, attachment := range attachments {
+		s.deleteBlob(ctx, attachment.StorageKey)
+	}
+
+	return nil
+}
+
+func (s attachmentService) signedAttachmentDTO(attachment domain.Attachment) domain.AttachmentDTO {
+	expireDate := s.now().Add(s.urlExpires).UTC().Truncate(time.Second)
+	expires := expireDate.Unix()
+
+	attachmentDTO := domain.AttachmentDTOFrom(attachment)
+	attachmentDTO.URL = fmt.Sprintf("/attachments/%d?expires=%d&signature=%s", attachment.ID, expires, s.sign(attachment.ID, expires))
+	attachmentDTO.ExpireDate = expireDate
+
+	return attachmentDTO
+}
+
+// deriveKey 설정한 시크릿에서 다운로드 주소 서명 전용 키를 만든다.
+// attachment.secret이 없어 auth.secret을 쓰더라도 토큰 서명에 쓰는 키로 주소를 직접 서명하지 않는다.
+func deriveKey(secret string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(keyPurpose))
+
+	return mac.Sum(nil)
+}
+
+// sign 첨부 파일 ID와 만료 시각을 "."으로 이어 붙여 HMAC-SHA256으로 서명한다.
+func (s attachmentService) sign(attachmentID int, expires int64) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(strconv.Itoa(attachmentID)))
+	mac.Write([]byte("."))
+	mac.Write([]byte(strconv.FormatInt(expires, 10)))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// deleteBlob 저장된 파일 삭제 실패는 요청 결과에 영향을 주지 않으므로 로그만 남긴다.
+func (s attachmentService) deleteBlob(ctx context.Context, key string) {
+	if err := s.blobStore.Delete(ctx, key); err != nil {
+		log.Printf("attachment: delete blob %s: %v", key, err)
+	}
+}
+
+// newStorageKey 사용자가 보낸 파일 이름은 저장 키에 사용하지 않는다.
+func newStorageKey(newsID int) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("news/%d/%s", newsID, hex.EncodeToString(b)), nil
+}
+
+// maxUploadSize 첨부 파일 최대 크기(바이트), 설정이 없으면 기본 10MB다.
+func maxUploadSize(cfg *config.Config) int64 {
+	return int64(intOr(cfg.Attachment.MaxSizeMB, defaultMaxSizeMB)) << 20
+}
+
+func intOr(value int, fallback int) int {
+	if value <= 0 {
+		return fallback
+	}
+
+	return value
+}
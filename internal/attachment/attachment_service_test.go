@@ -0,0 +1,381 @@
+package attachment
+
+import (
+	"bytes"
+	"classting/config"
+	"classting/domain"
+	"classting/mocks"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+type attachmentServiceTestSuite struct {
+	attachmentRepository *mocks.AttachmentRepository
+	newsRepository       *mocks.NewsRepository
+	schoolRepository     *mocks.SchoolRepository
+	blobStore            *mocks.BlobStore
+	service              *attachmentService
+}
+
+var testNow = time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+func setupAttachmentServiceTestSuite(t *testing.T) attachmentServiceTestSuite {
+	var us attachmentServiceTestSuite
+
+	us.attachmentRepository = mocks.NewAttachmentRepository(t)
+	us.newsRepository = mocks.NewNewsRepository(t)
+	us.schoolRepository = mocks.NewSchoolRepository(t)
+	us.blobStore = mocks.NewBlobStore(t)
+	us.service = NewAttachmentService(us.attachmentRepository, us.newsRepository, us.schoolRepository, us.blobStore, &config.Config{
+		Attachment: config.Attachment{
+			MaxSizeMB:         1,
+			URLExpiresMinutes: 60,
+			Secret:            "classting_attachment_secret",
+		},
+	})
+	us.service.now = func() time.Time { return testNow }
+
+	return us
+}
+
+func expectSchoolMember(ts attachmentServiceTestSuite, role domain.SchoolRole) {
+	ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 1).Return(&domain.News{
+		Base: domain.Base{
+			ID: 1,
+		},
+		SchoolID: 1,
+		UserID:   1,
+		Title:    "클래스팅 소식",
+	}, nil).Once()
+	ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+		Base: domain.Base{
+			ID: 1,
+		},
+		UserID: 1,
+		Name:   "클래스팅",
+		Region: "서울",
+	}, nil).Once()
+	ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+		SchoolID: 1,
+		UserID:   1,
+	}).Return(&domain.SchoolMember{
+		SchoolID: 1,
+		UserID:   1,
+		Role:     role,
+	}, nil).Once()
+}
+
+func putBlob(ctx context.Context, key string, r io.Reader) (int64, error) {
+	return io.Copy(io.Discard, r)
+}
+
+func Test_attachmentService_UploadAttachment(t *testing.T) {
+	pdf := "%PDF-1.4\n1 0 obj\n<<>>\nendobj\n"
+	storageKey := mock.MatchedBy(func(key string) bool { return strings.HasPrefix(key, "news/1/") })
+
+	tests := []struct {
+		name    string
+		req     domain.UploadAttachmentRequest
+		mock    func(ts attachmentServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - EDITOR 멤버의 PDF 첨부",
+			req: domain.UploadAttachmentRequest{
+				UserID:   1,
+				NewsID:   1,
+				FileName: "급식표.pdf",
+				Size:     int64(len(pdf)),
+				Content:  strings.NewReader(pdf),
+			},
+			mock: func(ts attachmentServiceTestSuite) {
+				expectSchoolMember(ts, domain.SchoolRoleEditor)
+				ts.blobStore.EXPECT().Put(mock.Anything, storageKey, mock.Anything).RunAndReturn(putBlob).Once()
+				ts.attachmentRepository.EXPECT().CreateAttachment(mock.Anything, mock.MatchedBy(func(attachment domain.Attachment) bool {
+					return attachment.NewsID == 1 &&
+						attachment.FileName == "급식표.pdf" &&
+						attachment.ContentType == "application/pdf" &&
+						attachment.Size == int64(len(pdf))
+				})).Return(3, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - VIEWER 멤버의 첨부",
+			req: domain.UploadAttachmentRequest{
+				UserID:   1,
+				NewsID:   1,
+				FileName: "급식표.pdf",
+				Size:     int64(len(pdf)),
+				Content:  strings.NewReader(pdf),
+			},
+			mock: func(ts attachmentServiceTestSuite) {
+				expectSchoolMember(ts, domain.SchoolRoleViewer)
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 삭제된 학교의 소식에 첨부",
+			req: domain.UploadAttachmentRequest{
+				UserID:   1,
+				NewsID:   1,
+				FileName: "급식표.pdf",
+				Size:     int64(len(pdf)),
+				Content:  strings.NewReader(pdf),
+			},
+			mock: func(ts attachmentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 1).Return(&domain.News{
+					Base:     domain.Base{ID: 1},
+					SchoolID: 1,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID:         1,
+						DeleteDate: sql.NullTime{Time: testNow, Valid: true},
+					},
+					UserID: 1,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleEditor,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 삭제된 소식에 첨부",
+			req: domain.UploadAttachmentRequest{
+				UserID:   1,
+				NewsID:   1,
+				FileName: "급식표.pdf",
+				Size:     int64(len(pdf)),
+				Content:  strings.NewReader(pdf),
+			},
+			mock: func(ts attachmentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 1).Return(&domain.News{
+					Base: domain.Base{
+						ID:         1,
+						DeleteDate: sql.NullTime{Time: testNow, Valid: true},
+					},
+					SchoolID: 1,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 허용되지 않은 파일 형식",
+			req: domain.UploadAttachmentRequest{
+				UserID:   1,
+				NewsID:   1,
+				FileName: "급식표.pdf",
+				Size:     int64(len("<html><script>alert(1)</script></html>")),
+				Content:  strings.NewReader("<html><script>alert(1)</script></html>"),
+			},
+			mock: func(ts attachmentServiceTestSuite) {
+				expectSchoolMember(ts, domain.SchoolRoleEditor)
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 최대 크기를 넘는 파일",
+			req: domain.UploadAttachmentRequest{
+				UserID:   1,
+				NewsID:   1,
+				FileName: "급식표.pdf",
+				Size:     2 << 20,
+				Content:  strings.NewReader(pdf),
+			},
+			mock: func(ts attachmentServiceTestSuite) {
+				expectSchoolMember(ts, domain.SchoolRoleEditor)
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 요청한 크기보다 실제 내용이 큰 파일은 저장 후 삭제",
+			req: domain.UploadAttachmentRequest{
+				UserID:   1,
+				NewsID:   1,
+				FileName: "급식표.pdf",
+				Size:     int64(len(pdf)),
+				Content:  io.MultiReader(strings.NewReader(pdf), bytes.NewReader(make([]byte, 1<<20))),
+			},
+			mock: func(ts attachmentServiceTestSuite) {
+				expectSchoolMember(ts, domain.SchoolRoleEditor)
+				ts.blobStore.EXPECT().Put(mock.Anything, storageKey, mock.Anything).RunAndReturn(putBlob).Once()
+				ts.blobStore.EXPECT().Delete(mock.Anything, storageKey).Return(nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupAttachmentServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.service.UploadAttachment(context.Background(), tt.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(t, 3, got.ID)
+				assert.Equal(t, testNow.Add(time.Hour), got.ExpireDate)
+				assert.Contains(t, got.URL, "/attachments/3?expires=")
+			}
+		})
+	}
+}
+
+func Test_attachmentService_DownloadAttachment(t *testing.T) {
+	ts := setupAttachmentServiceTestSuite(t)
+	expires := testNow.Add(time.Hour).Unix()
+	signature := ts.service.sign(3, expires)
+
+	tests := []struct {
+		name    string
+		req     domain.DownloadAttachmentRequest
+		now     time.Time
+		mock    func(ts attachmentServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 서명된 주소로 다운로드",
+			req:  domain.DownloadAttachmentRequest{ID: 3, Expires: expires, Signature: signature},
+			now:  testNow,
+			mock: func(ts attachmentServiceTestSuite) {
+				ts.attachmentRepository.EXPECT().FindAttachmentByID(mock.Anything, 3).Return(&domain.Attachment{
+					Base: domain.Base{
+						ID: 3,
+					},
+					NewsID:      1,
+					FileName:    "급식표.pdf",
+					ContentType: "application/pdf",
+					Size:        9,
+					StorageKey:  "news/1/abc",
+				}, nil).Once()
+				ts.blobStore.EXPECT().Open(mock.Anything, "news/1/abc").Return(io.NopCloser(strings.NewReader("classting")), nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name:    "FAIL - 다른 첨부 파일의 서명",
+			req:     domain.DownloadAttachmentRequest{ID: 4, Expires: expires, Signature: signature},
+			now:     testNow,
+			mock:    func(ts attachmentServiceTestSuite) {},
+			wantErr: true,
+		},
+		{
+			name:    "FAIL - 만료 시각을 변경한 주소",
+			req:     domain.DownloadAttachmentRequest{ID: 3, Expires: expires + 3600, Signature: signature},
+			now:     testNow,
+			mock:    func(ts attachmentServiceTestSuite) {},
+			wantErr: true,
+		},
+		{
+			name:    "FAIL - 만료된 주소",
+			req:     domain.DownloadAttachmentRequest{ID: 3, Expires: expires, Signature: signature},
+			now:     testNow.Add(2 * time.Hour),
+			mock:    func(ts attachmentServiceTestSuite) {},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 소식과 함께 삭제된 첨부 파일",
+			req:  domain.DownloadAttachmentRequest{ID: 3, Expires: expires, Signature: signature},
+			now:  testNow,
+			mock: func(ts attachmentServiceTestSuite) {
+				ts.attachmentRepository.EXPECT().FindAttachmentByID(mock.Anything, 3).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupAttachmentServiceTestSuite(t)
+			ts.service.now = func() time.Time { return tt.now }
+			tt.mock(ts)
+
+			// when
+			got, err := ts.service.DownloadAttachment(context.Background(), tt.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+			if !tt.wantErr {
+				b, _ := io.ReadAll(got.Content)
+				assert.Equal(t, "classting", string(b))
+			}
+		})
+	}
+}
+
+func Test_attachmentService_DeleteNewsAttachments(t *testing.T) {
+	// given
+	ts := setupAttachmentServiceTestSuite(t)
+	ts.attachmentRepository.EXPECT().ListAttachmentsByNewsIDs(mock.Anything, []int{1}).Return([]domain.Attachment{
+		{Base: domain.Base{ID: 3}, NewsID: 1, StorageKey: "news/1/abc"},
+		{Base: domain.Base{ID: 4}, NewsID: 1, StorageKey: "news/1/def"},
+	}, nil).Once()
+	ts.attachmentRepository.EXPECT().DeleteAttachmentsByNewsID(mock.Anything, 1).Return(nil).Once()
+	ts.blobStore.EXPECT().Delete(mock.Anything, "news/1/abc").Return(nil).Once()
+	ts.blobStore.EXPECT().Delete(mock.Anything, "news/1/def").Return(nil).Once()
+
+	// when
+	err := ts.service.DeleteNewsAttachments(context.Background(), 1)
+
+	// then
+	assert.NoError(t, err)
+}
+
+func Test_attachmentService_ListNewsAttachments(t *testing.T) {
+	// given
+	ts := setupAttachmentServiceTestSuite(t)
+	ts.attachmentRepository.EXPECT().ListAttachmentsByNewsIDs(mock.Anything, []int{1, 2}).Return([]domain.Attachment{
+		{Base: domain.Base{ID: 3}, NewsID: 1, FileName: "급식표.pdf"},
+		{Base: domain.Base{ID: 4}, NewsID: 1, FileName: "안내문.png"},
+	}, nil).Once()
+
+	// when
+	got, err := ts.service.ListNewsAttachments(context.Background(), []int{1, 2})
+
+	// then
+	assert.NoError(t, err)
+	assert.Len(t, got[1], 2)
+	assert.Empty(t, got[2])
+	assert.Equal(t, "/attachments/3?expires=1709287200&signature="+ts.service.sign(3, 1709287200), got[1][0].URL)
+}
+
+func Test_attachmentService_sign(t *testing.T) {
+	// given
+	cfg := &config.Config{
+		Auth: config.Auth{
+			Secret: "classting_test_secret",
+		},
+	}
+	service := NewAttachmentService(nil, nil, nil, nil, cfg)
+	tokenKeyMAC := hmac.New(sha256.New, []byte(cfg.Auth.Secret))
+	tokenKeyMAC.Write([]byte("3.1709287200"))
+
+	// when
+	signature := service.sign(3, 1709287200)
+
+	// then
+	assert.Equal(t, deriveKey(cfg.Auth.Secret), service.secret)
+	assert.NotEqual(t, hex.EncodeToString(tokenKeyMAC.Sum(nil)), signature)
+	assert.Equal(t, signature, NewAttachmentService(nil, nil, nil, nil, cfg).sign(3, 1709287200))
+}
@@ -0,0 +1,90 @@
+package attachment
+
+import (
+	"classting/domain"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// localBlobStore 로컬 디렉터리에 첨부 파일을 저장한다. 여러 인스턴스로 실행하면 공유 볼륨을 사용해야 한다.
+type localBlobStore struct {
+	dir string
+}
+
+func NewLocalBlobStore(dir string) (*localBlobStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &localBlobStore{
+		dir: dir,
+	}, nil
+}
+
+var _ domain.BlobStore = (*localBlobStore)(nil)
+
+// Put 임시 파일에 모두 쓴 다음 이름을 바꿔 저장 중인 파일이 읽히지 않도록 한다.
+func (l *localBlobStore) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return 0, err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+		return written, err
+	}
+	if err := tmp.Close(); err != nil {
+		return written, err
+	}
+
+	return written, os.Rename(tmp.Name(), path)
+}
+
+func (l *localBlobStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.Open(path)
+}
+
+func (l *localBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// path 키가 저장 디렉터리 밖을 가리키지 않는지 확인한다.
+func (l *localBlobStore) path(key string) (string, error) {
+	cleaned := filepath.Clean(filepath.FromSlash(key))
+	if key == "" || filepath.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+
+	return filepath.Join(l.dir, cleaned), nil
+}
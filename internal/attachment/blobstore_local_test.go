@@ -0,0 +1,59 @@
+package attachment
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strings"
+	"testing"
+)
+
+func Test_localBlobStore_PutOpenDelete(t *testing.T) {
+	// given
+	ctx := context.Background()
+	store, err := NewLocalBlobStore(t.TempDir())
+	assert.NoError(t, err)
+
+	// when
+	written, err := store.Put(ctx, "news/1/abc", strings.NewReader("classting"))
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len("classting")), written)
+
+	content, err := store.Open(ctx, "news/1/abc")
+	assert.NoError(t, err)
+	b, _ := io.ReadAll(content)
+	content.Close()
+	assert.Equal(t, "classting", string(b))
+
+	assert.NoError(t, store.Delete(ctx, "news/1/abc"))
+	assert.NoError(t, store.Delete(ctx, "news/1/abc"))
+	_, err = store.Open(ctx, "news/1/abc")
+	assert.Error(t, err)
+}
+
+func Test_localBlobStore_InvalidKey(t *testing.T) {
+	store, err := NewLocalBlobStore(t.TempDir())
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name string
+		key  string
+	}{
+		{name: "FAIL - 빈 키", key: ""},
+		{name: "FAIL - 상위 디렉터리", key: "../secret"},
+		{name: "FAIL - 중간에 상위 디렉터리", key: "news/../../secret"},
+		{name: "FAIL - 절대 경로", key: "/etc/passwd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			_, err := store.Put(context.Background(), tt.key, strings.NewReader("classting"))
+
+			// then
+			assert.Error(t, err)
+		})
+	}
+}
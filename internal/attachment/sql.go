@@ -0,0 +1,10 @@
+package attachment
+
+const createAttachmentQuery = `INSERT INTO attachments (news_id, user_id, file_name, content_type, size, storage_key) VALUES (?, ?, ?, ?, ?, ?)`
+
+const findAttachmentByIDQuery = `SELECT id, create_date, update_date, delete_date, news_id, user_id, file_name, content_type, size, storage_key FROM attachments WHERE id = ? AND delete_date IS NULL`
+
+// listAttachmentsByNewsIDsQuery 조건과 정렬은 db.Query로 붙인다.
+const listAttachmentsByNewsIDsQuery = `SELECT id, create_date, update_date, delete_date, news_id, user_id, file_name, content_type, size, storage_key FROM attachments`
+
+const deleteAttachmentsByNewsIDQuery = `UPDATE attachments SET delete_date = ? WHERE news_id = ? AND delete_date IS NULL`
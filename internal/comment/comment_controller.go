@@ -0,0 +1,266 @@
+package comment
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"classting/pkg/router"
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+func RegisterRoutes(e *gin.Engine, controller domain.CommentController, auth *router.JWTAuth) {
+	api := e.Group("/news")
+	{
+		api.POST("/:newsID/comments", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeStudent}), controller.CreateComment)
+		api.GET("/:newsID/comments", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin, domain.UserUseTypeStudent}), controller.ListComments)
+		api.PUT("/:newsID/comments/:commentID/hidden", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.HideComment)
+		api.DELETE("/:newsID/comments/:commentID", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.DeleteComment)
+		api.PUT("/:newsID/commenting", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.UpdateCommentSetting)
+	}
+}
+
+type commentController struct {
+	service domain.CommentService
+}
+
+func NewCommentController(service domain.CommentService) *commentController {
+	return &commentController{
+		service: service,
+	}
+}
+
+var _ domain.CommentController = (*commentController)(nil)
+
+// CreateComment
+// @Tags Comment
+// @Summary 댓글 작성 [추가 구현] 권한 - 학생
+// @Description 구독 중인 학교의 발행된 소식에 댓글을 작성합니다. 댓글 작성이 꺼진 소식에는 작성할 수 없습니다.
+// @Description 댓글은 입력한 그대로 저장하므로 HTML로 해석하지 않고 텍스트로 표시해야 합니다. (최대 1000자)
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param newsID path int true "소식 ID"
+// @Param CreateCommentRequest body domain.CreateCommentRequest true "댓글 작성 요청"
+// @Success 204
+// @Router /news/{newsID}/comments [post]
+func (n commentController) CreateComment(c *gin.Context) {
+	var req domain.CreateCommentRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := n.service.CreateComment(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListComments
+// @Tags Comment
+// @Summary 댓글 조회 [추가 구현] 권한 - 학생/관리자
+// @Description 소식의 댓글을 10개씩 최신순으로 조회합니다 (커서로 페이징 가능)
+// @Description 학생은 구독 중인 학교의 발행된 소식에서 숨기지 않은 댓글만, 학교 멤버인 관리자는 숨긴 댓글까지 조회할 수 있습니다.
+// @Produce json
+// @Security BearerAuth
+// @Param newsID path int true "소식 ID"
+// @Param cursor query int false "커서"
+// @Success 200 {object} domain.ListCommentsResponse "댓글 목록"
+// @Router /news/{newsID}/comments [get]
+func (n commentController) ListComments(c *gin.Context) {
+	var req domain.ListCommentsRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := n.service.ListComments(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
+}
+
+// HideComment
+// @Tags Comment
+// @Summary 댓글 숨기기 [추가 구현] 권한 - 관리자
+// @Description 소식이 속한 학교의 OWNER가 댓글을 숨기거나 다시 보이게 합니다. 숨긴 댓글은 학생에게 보이지 않습니다.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param newsID path int true "소식 ID"
+// @Param commentID path int true "댓글 ID"
+// @Param HideCommentRequest body domain.HideCommentRequest true "댓글 숨기기 요청"
+// @Success 204
+// @Router /news/{newsID}/comments/{commentID}/hidden [put]
+func (n commentController) HideComment(c *gin.Context) {
+	var req domain.HideCommentRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := n.service.HideComment(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// DeleteComment
+// @Tags Comment
+// @Summary 댓글 삭제 [추가 구현] 권한 - 관리자
+// @Description 소식이 속한 학교의 OWNER가 댓글을 삭제합니다. (소프트 딜리트)
+// @Produce json
+// @Security BearerAuth
+// @Param newsID path int true "소식 ID"
+// @Param commentID path int true "댓글 ID"
+// @Success 204
+// @Router /news/{newsID}/comments/{commentID} [delete]
+func (n commentController) DeleteComment(c *gin.Context) {
+	var req domain.DeleteCommentRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := n.service.DeleteComment(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// UpdateCommentSetting
+// @Tags Comment
+// @Summary 댓글 작성 허용 설정 [추가 구현] 권한 - 관리자
+// @Description 소식이 속한 학교의 OWNER가 소식별로 댓글 작성을 켜거나 끕니다. 꺼도 이미 작성된 댓글은 계속 조회할 수 있습니다.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param newsID path int true "소식 ID"
+// @Param UpdateCommentSettingRequest body domain.UpdateCommentSettingRequest true "댓글 작성 허용 설정 요청"
+// @Success 204
+// @Router /news/{newsID}/commenting [put]
+func (n commentController) UpdateCommentSetting(c *gin.Context) {
+	var req domain.UpdateCommentSettingRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := n.service.UpdateCommentSetting(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
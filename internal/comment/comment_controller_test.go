@@ -0,0 +1,338 @@
+package comment
+
+import (
+	"classting/config"
+	"classting/domain"
+	"classting/internal/user"
+	"classting/mocks"
+	"classting/pkg/router"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type commentControllerTestSuite struct {
+	router            *gin.Engine
+	cfg               *config.Config
+	commentService    *mocks.CommentService
+	commentController domain.CommentController
+}
+
+func setupCommentControllerTestSuite(t *testing.T) commentControllerTestSuite {
+	var us commentControllerTestSuite
+
+	gin.SetMode(gin.TestMode)
+	us.router = gin.Default()
+	us.commentService = mocks.NewCommentService(t)
+	us.cfg = &config.Config{
+		Auth: config.Auth{
+			Secret: "classting_test_secret",
+		},
+	}
+
+	us.commentController = NewCommentController(us.commentService)
+	RegisterRoutes(
+		us.router, us.commentController,
+		router.NewJWTAuth(us.cfg.Auth.Secret, nil, nil),
+	)
+
+	return us
+}
+
+func userToken(ts commentControllerTestSuite, userType domain.UserType) string {
+	token, _ := user.CreateAccessToken(domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+		Type: userType,
+	}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+
+	return token
+}
+
+func Test_commentController_CreateComment(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		body     string
+		userType domain.UserType
+		mock     func(ts commentControllerTestSuite)
+		code     int
+	}{
+		{
+			name:     "PASS - 댓글 작성",
+			path:     "/news/3/comments",
+			body:     `{"body":"상담 신청은 어디서 하나요?"}`,
+			userType: domain.UserUseTypeStudent,
+			mock: func(ts commentControllerTestSuite) {
+				ts.commentService.EXPECT().CreateComment(mock.Anything, domain.CreateCommentRequest{
+					UserID: 1,
+					NewsID: 3,
+					Body:   "상담 신청은 어디서 하나요?",
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name:     "FAIL - 빈 댓글",
+			path:     "/news/3/comments",
+			body:     `{"body":""}`,
+			userType: domain.UserUseTypeStudent,
+			mock:     func(ts commentControllerTestSuite) {},
+			code:     http.StatusBadRequest,
+		},
+		{
+			name:     "FAIL - 1000자를 넘는 댓글",
+			path:     "/news/3/comments",
+			body:     `{"body":"` + strings.Repeat("가", 1001) + `"}`,
+			userType: domain.UserUseTypeStudent,
+			mock:     func(ts commentControllerTestSuite) {},
+			code:     http.StatusBadRequest,
+		},
+		{
+			name:     "FAIL - 관리자 권한",
+			path:     "/news/3/comments",
+			body:     `{"body":"댓글"}`,
+			userType: domain.UserUseTypeAdmin,
+			mock:     func(ts commentControllerTestSuite) {},
+			code:     http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCommentControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+userToken(ts, tt.userType))
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.commentService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_commentController_ListComments(t *testing.T) {
+	cursor := 8
+
+	tests := []struct {
+		name     string
+		path     string
+		userType domain.UserType
+		mock     func(ts commentControllerTestSuite)
+		code     int
+	}{
+		{
+			name:     "PASS - 학생의 댓글 조회",
+			path:     "/news/3/comments?cursor=8",
+			userType: domain.UserUseTypeStudent,
+			mock: func(ts commentControllerTestSuite) {
+				ts.commentService.EXPECT().ListComments(mock.Anything, domain.ListCommentsRequest{
+					UserID: 1,
+					NewsID: 3,
+					Cursor: &cursor,
+				}).Return(domain.ListCommentsResponse{CommentsEnabled: true}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name:     "PASS - 관리자의 댓글 조회",
+			path:     "/news/3/comments",
+			userType: domain.UserUseTypeAdmin,
+			mock: func(ts commentControllerTestSuite) {
+				ts.commentService.EXPECT().ListComments(mock.Anything, domain.ListCommentsRequest{
+					UserID: 1,
+					NewsID: 3,
+				}).Return(domain.ListCommentsResponse{CommentsEnabled: true}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name:     "FAIL - 잘못된 커서",
+			path:     "/news/3/comments?cursor=0",
+			userType: domain.UserUseTypeStudent,
+			mock:     func(ts commentControllerTestSuite) {},
+			code:     http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCommentControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer "+userToken(ts, tt.userType))
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.commentService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_commentController_HideComment(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		body string
+		mock func(ts commentControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 댓글 숨기기",
+			path: "/news/3/comments/5/hidden",
+			body: `{"hidden":true}`,
+			mock: func(ts commentControllerTestSuite) {
+				ts.commentService.EXPECT().HideComment(mock.Anything, domain.HideCommentRequest{
+					UserID:    1,
+					NewsID:    3,
+					CommentID: 5,
+					Hidden:    true,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "FAIL - 잘못된 댓글 ID",
+			path: "/news/3/comments/0/hidden",
+			body: `{"hidden":true}`,
+			mock: func(ts commentControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCommentControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodPut, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+userToken(ts, domain.UserUseTypeAdmin))
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.commentService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_commentController_DeleteComment(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		userType domain.UserType
+		mock     func(ts commentControllerTestSuite)
+		code     int
+	}{
+		{
+			name:     "PASS - 댓글 삭제",
+			path:     "/news/3/comments/5",
+			userType: domain.UserUseTypeAdmin,
+			mock: func(ts commentControllerTestSuite) {
+				ts.commentService.EXPECT().DeleteComment(mock.Anything, domain.DeleteCommentRequest{
+					UserID:    1,
+					NewsID:    3,
+					CommentID: 5,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name:     "FAIL - 학생 권한",
+			path:     "/news/3/comments/5",
+			userType: domain.UserUseTypeStudent,
+			mock:     func(ts commentControllerTestSuite) {},
+			code:     http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCommentControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodDelete, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer "+userToken(ts, tt.userType))
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.commentService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_commentController_UpdateCommentSetting(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		body string
+		mock func(ts commentControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 댓글 작성 끄기",
+			path: "/news/3/commenting",
+			body: `{"enabled":false}`,
+			mock: func(ts commentControllerTestSuite) {
+				ts.commentService.EXPECT().UpdateCommentSetting(mock.Anything, domain.UpdateCommentSettingRequest{
+					UserID:  1,
+					NewsID:  3,
+					Enabled: false,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "FAIL - 잘못된 소식 ID",
+			path: "/news/0/commenting",
+			body: `{"enabled":true}`,
+			mock: func(ts commentControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCommentControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodPut, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+userToken(ts, domain.UserUseTypeAdmin))
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.commentService.AssertExpectations(t)
+		})
+	}
+}
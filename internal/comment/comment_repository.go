@@ -0,0 +1,146 @@
+package comment
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"classting/pkg/db"
+	"classting/pkg/pagination"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+type commentRepository struct {
+	sqlDB *sql.DB
+}
+
+func NewCommentRepository(sqlDB *sql.DB) *commentRepository {
+	return &commentRepository{
+		sqlDB: sqlDB,
+	}
+}
+
+var _ domain.CommentRepository = (*commentRepository)(nil)
+
+func (r commentRepository) CreateComment(ctx context.Context, comment domain.Comment) (int, error) {
+	const op cerrors.Op = "comment/commentRepository/CreateComment"
+
+	result, err := r.sqlDB.ExecContext(ctx, createCommentQuery, comment.NewsID, comment.UserID, comment.Body)
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	commentID, err := result.LastInsertId()
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return int(commentID), nil
+}
+
+func (r commentRepository) FindCommentByID(ctx context.Context, commentID int) (*domain.Comment, error) {
+	const op cerrors.Op = "comment/commentRepository/FindCommentByID"
+
+	var comment domain.Comment
+	if err := scanComment(r.sqlDB.QueryRowContext(ctx, findCommentByIDQuery, commentID), &comment); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return &comment, nil
+}
+
+func (r commentRepository) ListComments(ctx context.Context, params domain.ListCommentsParams) ([]domain.Comment, error) {
+	const op cerrors.Op = "comment/commentRepository/ListComments"
+
+	q := db.Select(listCommentsQuery).Where(db.Eq("comments.news_id", params.NewsID), db.Expr("comments.delete_date IS NULL"))
+	if !params.IncludeHidden {
+		q.Where(db.Expr("comments.hidden = FALSE"))
+	}
+	if params.Cursor != nil {
+		q.Where(db.Expr("comments.id < ?", *params.Cursor))
+	}
+	query, args := q.OrderBy("comments.id", true).Limit(pagination.DefaultSize).Build()
+
+	rows, err := r.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var comments []domain.Comment
+	for rows.Next() {
+		var comment domain.Comment
+		if err := scanComment(rows, &comment); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		comments = append(comments, comment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return comments, nil
+}
+
+func (r commentRepository) UpdateCommentHidden(ctx context.Context, params domain.UpdateCommentHiddenParams) error {
+	const op cerrors.Op = "comment/commentRepository/UpdateCommentHidden"
+
+	if _, err := r.sqlDB.ExecContext(ctx, updateCommentHiddenQuery, params.Hidden, params.CommentID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (r commentRepository) DeleteComment(ctx context.Context, commentID int) error {
+	const op cerrors.Op = "comment/commentRepository/DeleteComment"
+
+	if _, err := r.sqlDB.ExecContext(ctx, deleteCommentQuery, time.Now().UTC(), commentID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (r commentRepository) FindCommentsEnabled(ctx context.Context, newsID int) (bool, error) {
+	const op cerrors.Op = "comment/commentRepository/FindCommentsEnabled"
+
+	var enabled bool
+	if err := r.sqlDB.QueryRowContext(ctx, findCommentsEnabledQuery, newsID).Scan(&enabled); err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return enabled, nil
+}
+
+func (r commentRepository) UpdateCommentsEnabled(ctx context.Context, params domain.UpdateCommentsEnabledParams) error {
+	const op cerrors.Op = "comment/commentRepository/UpdateCommentsEnabled"
+
+	if _, err := r.sqlDB.ExecContext(ctx, updateCommentsEnabledQuery, params.Enabled, params.NewsID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanComment(row scanner, comment *domain.Comment) error {
+	return row.Scan(
+		&comment.ID,
+		&comment.CreateDate,
+		&comment.UpdateDate,
+		&comment.DeleteDate,
+		&comment.NewsID,
+		&comment.UserID,
+		&comment.UserName,
+		&comment.Body,
+		&comment.Hidden,
+	)
+}
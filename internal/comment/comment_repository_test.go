@@ -0,0 +1,444 @@
+package comment
+
+import (
+	"classting/domain"
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type commentRepositoryTestSuite struct {
+	sqlDB             *sql.DB
+	sqlMock           sqlmock.Sqlmock
+	commentRepository domain.CommentRepository
+}
+
+func setupCommentRepositoryTestSuite() commentRepositoryTestSuite {
+	var us commentRepositoryTestSuite
+
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	us.sqlDB = mockDB
+	us.sqlMock = mock
+	us.commentRepository = NewCommentRepository(mockDB)
+
+	return us
+}
+
+var commentColumns = []string{"id", "create_date", "update_date", "delete_date", "news_id", "user_id", "user_name", "body", "hidden"}
+
+func Test_commentRepository_CreateComment(t *testing.T) {
+	tests := []struct {
+		name    string
+		comment domain.Comment
+		mock    func(ts commentRepositoryTestSuite)
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "PASS - 댓글 작성",
+			comment: domain.Comment{
+				NewsID: 1,
+				UserID: 2,
+				Body:   "상담 신청은 어디서 하나요?",
+			},
+			mock: func(ts commentRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`INSERT INTO comments \(news_id, user_id, body\) VALUES \(\?, \?, \?\)`).
+					WithArgs(1, 2, "상담 신청은 어디서 하나요?").
+					WillReturnResult(sqlmock.NewResult(5, 1))
+			},
+			want:    5,
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 서버 에러",
+			comment: domain.Comment{
+				NewsID: 1,
+				UserID: 2,
+				Body:   "상담 신청은 어디서 하나요?",
+			},
+			mock: func(ts commentRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`INSERT INTO comments`).WillReturnError(sql.ErrConnDone)
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCommentRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.commentRepository.CreateComment(context.Background(), tt.comment)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_commentRepository_FindCommentByID(t *testing.T) {
+	now := time.Date(2024, 3, 4, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		commentID int
+		mock      func(ts commentRepositoryTestSuite)
+		want      *domain.Comment
+		wantErr   bool
+	}{
+		{
+			name:      "PASS - 댓글 조회",
+			commentID: 5,
+			mock: func(ts commentRepositoryTestSuite) {
+				rows := sqlmock.NewRows(commentColumns).AddRow(5, now, now, nil, 1, 2, "classting_student_1", "댓글", true)
+				ts.sqlMock.ExpectQuery(`SELECT (.+) FROM comments JOIN users ON users.id = comments.user_id WHERE comments.id = \?`).
+					WithArgs(5).
+					WillReturnRows(rows)
+			},
+			want: &domain.Comment{
+				Base:     domain.Base{ID: 5, CreateDate: now, UpdateDate: now},
+				NewsID:   1,
+				UserID:   2,
+				UserName: "classting_student_1",
+				Body:     "댓글",
+				Hidden:   true,
+			},
+			wantErr: false,
+		},
+		{
+			name:      "PASS - 존재하지 않는 댓글",
+			commentID: 5,
+			mock: func(ts commentRepositoryTestSuite) {
+				ts.sqlMock.ExpectQuery(`SELECT (.+) FROM comments`).WithArgs(5).WillReturnError(sql.ErrNoRows)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:      "FAIL - 서버 에러",
+			commentID: 5,
+			mock: func(ts commentRepositoryTestSuite) {
+				ts.sqlMock.ExpectQuery(`SELECT (.+) FROM comments`).WithArgs(5).WillReturnError(sql.ErrConnDone)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCommentRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.commentRepository.FindCommentByID(context.Background(), tt.commentID)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_commentRepository_ListComments(t *testing.T) {
+	now := time.Date(2024, 3, 4, 8, 0, 0, 0, time.UTC)
+	cursor := 10
+
+	tests := []struct {
+		name    string
+		params  domain.ListCommentsParams
+		mock    func(ts commentRepositoryTestSuite)
+		want    []domain.Comment
+		wantErr bool
+	}{
+		{
+			name: "PASS - 숨긴 댓글을 제외하고 조회",
+			params: domain.ListCommentsParams{
+				NewsID: 1,
+			},
+			mock: func(ts commentRepositoryTestSuite) {
+				rows := sqlmock.NewRows(commentColumns).AddRow(6, now, now, nil, 1, 2, "classting_student_1", "댓글", false)
+				ts.sqlMock.ExpectQuery(`WHERE comments.news_id = \? AND comments.delete_date IS NULL AND comments.hidden = FALSE ORDER BY comments.id DESC LIMIT \?`).
+					WithArgs(1, 10).
+					WillReturnRows(rows)
+			},
+			want: []domain.Comment{
+				{
+					Base:     domain.Base{ID: 6, CreateDate: now, UpdateDate: now},
+					NewsID:   1,
+					UserID:   2,
+					UserName: "classting_student_1",
+					Body:     "댓글",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 숨긴 댓글을 포함하고 커서 이후 조회",
+			params: domain.ListCommentsParams{
+				NewsID:        1,
+				Cursor:        &cursor,
+				IncludeHidden: true,
+			},
+			mock: func(ts commentRepositoryTestSuite) {
+				rows := sqlmock.NewRows(commentColumns).AddRow(6, now, now, nil, 1, 2, "classting_student_1", "댓글", true)
+				ts.sqlMock.ExpectQuery(`WHERE comments.news_id = \? AND comments.delete_date IS NULL AND comments.id < \? ORDER BY comments.id DESC LIMIT \?`).
+					WithArgs(1, 10, 10).
+					WillReturnRows(rows)
+			},
+			want: []domain.Comment{
+				{
+					Base:     domain.Base{ID: 6, CreateDate: now, UpdateDate: now},
+					NewsID:   1,
+					UserID:   2,
+					UserName: "classting_student_1",
+					Body:     "댓글",
+					Hidden:   true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 서버 에러",
+			params: domain.ListCommentsParams{
+				NewsID: 1,
+			},
+			mock: func(ts commentRepositoryTestSuite) {
+				ts.sqlMock.ExpectQuery(`SELECT (.+) FROM comments`).WillReturnError(sql.ErrConnDone)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCommentRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.commentRepository.ListComments(context.Background(), tt.params)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_commentRepository_UpdateCommentHidden(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  domain.UpdateCommentHiddenParams
+		mock    func(ts commentRepositoryTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 댓글 숨기기",
+			params: domain.UpdateCommentHiddenParams{
+				CommentID: 5,
+				Hidden:    true,
+			},
+			mock: func(ts commentRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`UPDATE comments SET hidden = \? WHERE id = \?`).
+					WithArgs(true, 5).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 서버 에러",
+			params: domain.UpdateCommentHiddenParams{
+				CommentID: 5,
+				Hidden:    true,
+			},
+			mock: func(ts commentRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`UPDATE comments SET hidden`).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCommentRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			err := ts.commentRepository.UpdateCommentHidden(context.Background(), tt.params)
+
+			// then
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_commentRepository_DeleteComment(t *testing.T) {
+	tests := []struct {
+		name      string
+		commentID int
+		mock      func(ts commentRepositoryTestSuite)
+		wantErr   bool
+	}{
+		{
+			name:      "PASS - 댓글 삭제",
+			commentID: 5,
+			mock: func(ts commentRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`UPDATE comments SET delete_date = \? WHERE id = \?`).
+					WithArgs(sqlmock.AnyArg(), 5).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name:      "FAIL - 서버 에러",
+			commentID: 5,
+			mock: func(ts commentRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`UPDATE comments SET delete_date`).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCommentRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			err := ts.commentRepository.DeleteComment(context.Background(), tt.commentID)
+
+			// then
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_commentRepository_FindCommentsEnabled(t *testing.T) {
+	tests := []struct {
+		name    string
+		newsID  int
+		mock    func(ts commentRepositoryTestSuite)
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:   "PASS - 댓글 작성 허용 여부 조회",
+			newsID: 1,
+			mock: func(ts commentRepositoryTestSuite) {
+				ts.sqlMock.ExpectQuery(`SELECT comments_enabled FROM news WHERE id = \?`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"comments_enabled"}).AddRow(true))
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name:   "FAIL - 서버 에러",
+			newsID: 1,
+			mock: func(ts commentRepositoryTestSuite) {
+				ts.sqlMock.ExpectQuery(`SELECT comments_enabled FROM news`).WillReturnError(sql.ErrConnDone)
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCommentRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.commentRepository.FindCommentsEnabled(context.Background(), tt.newsID)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_commentRepository_UpdateCommentsEnabled(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  domain.UpdateCommentsEnabledParams
+		mock    func(ts commentRepositoryTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 댓글 작성 끄기",
+			params: domain.UpdateCommentsEnabledParams{
+				NewsID:  1,
+				Enabled: false,
+			},
+			mock: func(ts commentRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`UPDATE news SET comments_enabled = \? WHERE id = \?`).
+					WithArgs(false, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 서버 에러",
+			params: domain.UpdateCommentsEnabledParams{
+				NewsID:  1,
+				Enabled: false,
+			},
+			mock: func(ts commentRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`UPDATE news SET comments_enabled`).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCommentRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			err := ts.commentRepository.UpdateCommentsEnabled(context.Background(), tt.params)
+
+			// then
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package comment
+
+import (
+	"classting/domain"
+	"classting/internal/school"
+	"classting/pkg/cerrors"
+	"context"
+	"strings"
+)
+
+type commentService struct {
+	commentRepository      domain.CommentRepository
+	newsRepository         domain.NewsRepository
+	schoolRepository       domain.SchoolRepository
+	subscriptionRepository domain.SubscriptionRepository
+}
+
+func NewCommentService(
+	commentRepository domain.CommentRepository,
+	newsRepository domain.NewsRepository,
+	schoolRepository domain.SchoolRepository,
+	subscriptionRepository domain.SubscriptionRepository,
+) *commentService {
+	return &commentService{
+		commentRepository:      commentRepository,
+		newsRepository:         newsRepository,
+		schoolRepository:       schoolRepository,
+		subscriptionRepository: subscriptionRepository,
+	}
+}
+
+var _ domain.CommentService = (*commentService)(nil)
+
+func (s commentService) CreateComment(ctx context.Context, req domain.CreateCommentRequest) error {
+	const op cerrors.Op = "comment/service/CreateComment"
+
+	news, err := s.findNews(ctx, op, req.NewsID)
+	if err != nil {
+		return err
+	}
+	if news.Status != domain.NewsStatusPublished {
+		return cerrors.E(op, cerrors.NotExist, "소식을 찾을 수 없습니다.")
+	}
+
+	subscription, err := s.subscriptionRepository.FindSubscriptionByUserIDAndSchoolID(ctx, domain.FindSubscriptionByUserIDAndSchoolIDParams{
+		UserID:   req.UserID,
+		SchoolID: news.SchoolID,
+	})
+	if err != nil {
+		return err
+	}
+	if subscription == nil {
+		return cerrors.E(op, cerrors.Permission, "구독한 학교의 소식에만 댓글을 작성할 수 있습니다.")
+	}
+	if _, err := school.FindActiveSchool(ctx, s.schoolRepository, op, news.SchoolID); err != nil {
+		return err
+	}
+
+	enabled, err := s.commentRepository.FindCommentsEnabled(ctx, news.ID)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return cerrors.E(op, cerrors.Invalid, "댓글 작성이 허용되지 않은 소식입니다.")
+	}
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return cerrors.E(op, cerrors.Invalid, "댓글 내용을 확인해주세요.")
+	}
+
+	if _, err := s.commentRepository.CreateComment(ctx, domain.Comment{
+		NewsID: news.ID,
+		UserID: req.UserID,
+		Body:   body,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListComments 구독자는 발행된 소식의 숨기지 않은 댓글을, 학교 멤버는 숨긴 댓글까지 조회한다.
+func (s commentService) ListComments(ctx context.Context, req domain.ListCommentsRequest) (domain.ListCommentsResponse, error) {
+	const op cerrors.Op = "comment/service/ListComments"
+
+	news, err := s.findNews(ctx, op, req.NewsID)
+	if err != nil {
+		return domain.ListCommentsResponse{}, err
+	}
+
+	subscription, err := s.subscriptionRepository.FindSubscriptionByUserIDAndSchoolID(ctx, domain.FindSubscriptionByUserIDAndSchoolIDParams{
+		UserID:   req.UserID,
+		SchoolID: news.SchoolID,
+	})
+	if err != nil {
+		return domain.ListCommentsResponse{}, err
+	}
+
+	var includeHidden bool
+	if subscription != nil {
+		if news.Status != domain.NewsStatusPublished {
+			return domain.ListCommentsResponse{}, cerrors.E(op, cerrors.NotExist, "소식을 찾을 수 없습니다.")
+		}
+	} else {
+		member, err := s.schoolRepository.FindSchoolMember(ctx, domain.FindSchoolMemberParams{
+			SchoolID: news.SchoolID,
+			UserID:   req.UserID,
+		})
+		if err != nil {
+			return domain.ListCommentsResponse{}, err
+		}
+		if member == nil || !member.Role.Includes(domain.SchoolRoleViewer) {
+			return domain.ListCommentsResponse{}, cerrors.E(op, cerrors.Permission, "구독한 학교의 소식에만 댓글을 볼 수 있습니다.")
+		}
+		includeHidden = true
+	}
+
+	enabled, err := s.commentRepository.FindCommentsEnabled(ctx, news.ID)
+	if err != nil {
+		return domain.ListCommentsResponse{}, err
+	}
+
+	comments, err := s.commentRepository.ListComments(ctx, domain.ListCommentsParams{
+		NewsID:        news.ID,
+		Cursor:        req.Cursor,
+		IncludeHidden: includeHidden,
+	})
+	if err != nil {
+		return domain.ListCommentsResponse{}, cerrors.E(op, cerrors.Internal, err, "댓글을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	var commentDTOs []domain.CommentDTO
+	for _, comment := range comments {
+		commentDTOs = append(commentDTOs, domain.CommentDTOFrom(comment))
+	}
+
+	var cursor *int
+	if len(commentDTOs) > 0 {
+		cursor = &commentDTOs[len(commentDTOs)-1].ID
+	}
+
+	return domain.ListCommentsResponse{
+		CommentsEnabled: enabled,
+		Comments:        commentDTOs,
+		Cursor:          cursor,
+	}, nil
+}
+
+func (s commentService) HideComment(ctx context.Context, req domain.HideCommentRequest) error {
+	const op cerrors.Op = "comment/service/HideComment"
+
+	comment, err := s.findModeratedComment(ctx, op, req.NewsID, req.CommentID, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	return s.commentRepository.UpdateCommentHidden(ctx, domain.UpdateCommentHiddenParams{
+		CommentID: comment.ID,
+		Hidden:    req.Hidden,
+	})
+}
+
+func (s commentService) DeleteComment(ctx context.Context, req domain.DeleteCommentRequest) error {
+	const op cerrors.Op = "comment/service/DeleteComment"
+
+	comment, err := s.findModeratedComment(ctx, op, req.NewsID, req.CommentID, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	return s.commentRepository.DeleteComment(ctx, comment.ID)
+}
+
+func (s commentService) UpdateCommentSetting(ctx context.Context, req domain.UpdateCommentSettingRequest) error {
+	const op cerrors.Op = "comment/service/UpdateCommentSetting"
+
+	news, err := s.findNews(ctx, op, req.NewsID)
+	if err != nil {
+		return err
+	}
+	if _, err := school.AuthorizeActiveMember(ctx, s.schoolRepository, op, news.SchoolID, req.UserID, domain.SchoolRoleOwner); err != nil {
+		return err
+	}
+
+	return s.commentRepository.UpdateCommentsEnabled(ctx, domain.UpdateCommentsEnabledParams{
+		NewsID:  news.ID,
+		Enabled: req.Enabled,
+	})
+}
+
+// findNews 삭제된 소식의 댓글은 조회하거나 관리할 수 없다.
+func (s commentService) findNews(ctx context.Context, op cerrors.Op, newsID int) (*domain.News, error) {
+	news, err := s.newsRepository.FindNewsByID(ctx, newsID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	if news == nil || news.DeleteDate.Valid {
+		return nil, cerrors.E(op, cerrors.NotExist, "소식을 찾을 수 없습니다.")
+	}
+
+	return news, nil
+}
+
+// findModeratedComment 소식이 속한 학교의 OWNER인지 확인하고 소식에 달린 댓글을 조회한다.
+func (s commentService) findModeratedComment(ctx context.Context, op cerrors.Op, newsID, commentID, userID int) (*domain.Comment, error) {
+	news, err := s.findNews(ctx, op, newsID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := school.AuthorizeActiveMember(ctx, s.schoolRepository, op, news.SchoolID, userID, domain.SchoolRoleOwner); err != nil {
+		return nil, err
+	}
+
+	comment, err := s.commentRepository.FindCommentByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment == nil || comment.DeleteDate.Valid || comment.NewsID != news.ID {
+		return nil, cerrors.E(op, cerrors.NotExist, "댓글을 찾을 수 없습니다.")
+	}
+
+	return comment, nil
+}
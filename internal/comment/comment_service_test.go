@@ -0,0 +1,521 @@
+package comment
+
+import (
+	"classting/domain"
+	"classting/mocks"
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+type commentServiceTestSuite struct {
+	commentRepository      *mocks.CommentRepository
+	newsRepository         *mocks.NewsRepository
+	schoolRepository       *mocks.SchoolRepository
+	subscriptionRepository *mocks.SubscriptionRepository
+	service                domain.CommentService
+}
+
+func setupCommentServiceTestSuite(t *testing.T) commentServiceTestSuite {
+	var us commentServiceTestSuite
+
+	us.commentRepository = mocks.NewCommentRepository(t)
+	us.newsRepository = mocks.NewNewsRepository(t)
+	us.schoolRepository = mocks.NewSchoolRepository(t)
+	us.subscriptionRepository = mocks.NewSubscriptionRepository(t)
+	us.service = NewCommentService(us.commentRepository, us.newsRepository, us.schoolRepository, us.subscriptionRepository)
+
+	return us
+}
+
+func testNews(status domain.NewsStatus) *domain.News {
+	return &domain.News{
+		Base:     domain.Base{ID: 3},
+		SchoolID: 1,
+		Status:   status,
+	}
+}
+
+func expectSubscription(ts commentServiceTestSuite, subscription *domain.Subscription) {
+	ts.subscriptionRepository.EXPECT().FindSubscriptionByUserIDAndSchoolID(mock.Anything, domain.FindSubscriptionByUserIDAndSchoolIDParams{
+		UserID:   1,
+		SchoolID: 1,
+	}).Return(subscription, nil).Once()
+}
+
+func expectSchool(ts commentServiceTestSuite, deleted bool) {
+	school := &domain.School{Base: domain.Base{ID: 1}, UserID: 1}
+	if deleted {
+		school.DeleteDate = sql.NullTime{Time: time.Now(), Valid: true}
+	}
+	ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(school, nil).Once()
+}
+
+func expectSchoolMember(ts commentServiceTestSuite, role domain.SchoolRole) {
+	ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+		Base:   domain.Base{ID: 1},
+		UserID: 1,
+	}, nil).Once()
+	ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+		SchoolID: 1,
+		UserID:   1,
+	}).Return(&domain.SchoolMember{
+		SchoolID: 1,
+		UserID:   1,
+		Role:     role,
+	}, nil).Once()
+}
+
+func Test_commentService_CreateComment(t *testing.T) {
+	subscription := &domain.Subscription{Base: domain.Base{ID: 1}, UserID: 1, SchoolID: 1}
+
+	tests := []struct {
+		name    string
+		req     domain.CreateCommentRequest
+		mock    func(ts commentServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 구독한 학교의 소식에 댓글 작성, 본문은 입력한 그대로 저장",
+			req: domain.CreateCommentRequest{
+				UserID: 1,
+				NewsID: 3,
+				Body:   "<b>상담 신청</b>은 어디서 하나요?",
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusPublished), nil).Once()
+				expectSubscription(ts, subscription)
+				expectSchool(ts, false)
+				ts.commentRepository.EXPECT().FindCommentsEnabled(mock.Anything, 3).Return(true, nil).Once()
+				ts.commentRepository.EXPECT().CreateComment(mock.Anything, domain.Comment{
+					NewsID: 3,
+					UserID: 1,
+					Body:   "<b>상담 신청</b>은 어디서 하나요?",
+				}).Return(5, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 삭제된 소식",
+			req: domain.CreateCommentRequest{
+				UserID: 1,
+				NewsID: 3,
+				Body:   "댓글",
+			},
+			mock: func(ts commentServiceTestSuite) {
+				news := testNews(domain.NewsStatusPublished)
+				news.DeleteDate = sql.NullTime{Time: time.Now(), Valid: true}
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(news, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 발행되지 않은 소식",
+			req: domain.CreateCommentRequest{
+				UserID: 1,
+				NewsID: 3,
+				Body:   "댓글",
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusDraft), nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 구독하지 않은 학교의 소식",
+			req: domain.CreateCommentRequest{
+				UserID: 1,
+				NewsID: 3,
+				Body:   "댓글",
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusPublished), nil).Once()
+				expectSubscription(ts, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 삭제된 학교의 소식",
+			req: domain.CreateCommentRequest{
+				UserID: 1,
+				NewsID: 3,
+				Body:   "댓글",
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusPublished), nil).Once()
+				expectSubscription(ts, subscription)
+				expectSchool(ts, true)
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 댓글 작성이 꺼진 소식",
+			req: domain.CreateCommentRequest{
+				UserID: 1,
+				NewsID: 3,
+				Body:   "댓글",
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusPublished), nil).Once()
+				expectSubscription(ts, subscription)
+				expectSchool(ts, false)
+				ts.commentRepository.EXPECT().FindCommentsEnabled(mock.Anything, 3).Return(false, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 공백만 있는 댓글",
+			req: domain.CreateCommentRequest{
+				UserID: 1,
+				NewsID: 3,
+				Body:   "  \n ",
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusPublished), nil).Once()
+				expectSubscription(ts, subscription)
+				expectSchool(ts, false)
+				ts.commentRepository.EXPECT().FindCommentsEnabled(mock.Anything, 3).Return(true, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCommentServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.CreateComment(context.Background(), tt.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_commentService_ListComments(t *testing.T) {
+	subscription := &domain.Subscription{Base: domain.Base{ID: 1}, UserID: 1, SchoolID: 1}
+	comments := []domain.Comment{
+		{Base: domain.Base{ID: 8}, NewsID: 3, UserID: 2, Body: "댓글"},
+		{Base: domain.Base{ID: 6}, NewsID: 3, UserID: 2, Body: "숨긴 댓글", Hidden: true},
+	}
+	cursor := 6
+
+	tests := []struct {
+		name    string
+		req     domain.ListCommentsRequest
+		mock    func(ts commentServiceTestSuite)
+		want    domain.ListCommentsResponse
+		wantErr bool
+	}{
+		{
+			name: "PASS - 구독자는 숨긴 댓글을 제외하고 조회",
+			req: domain.ListCommentsRequest{
+				UserID: 1,
+				NewsID: 3,
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusPublished), nil).Once()
+				expectSubscription(ts, subscription)
+				ts.commentRepository.EXPECT().FindCommentsEnabled(mock.Anything, 3).Return(true, nil).Once()
+				ts.commentRepository.EXPECT().ListComments(mock.Anything, domain.ListCommentsParams{
+					NewsID:        3,
+					IncludeHidden: false,
+				}).Return(comments[:1], nil).Once()
+			},
+			want: domain.ListCommentsResponse{
+				CommentsEnabled: true,
+				Comments:        []domain.CommentDTO{domain.CommentDTOFrom(comments[0])},
+				Cursor:          &comments[0].ID,
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 학교 멤버는 숨긴 댓글까지 조회",
+			req: domain.ListCommentsRequest{
+				UserID: 1,
+				NewsID: 3,
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusArchived), nil).Once()
+				expectSubscription(ts, nil)
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{SchoolID: 1, UserID: 1, Role: domain.SchoolRoleViewer}, nil).Once()
+				ts.commentRepository.EXPECT().FindCommentsEnabled(mock.Anything, 3).Return(false, nil).Once()
+				ts.commentRepository.EXPECT().ListComments(mock.Anything, domain.ListCommentsParams{
+					NewsID:        3,
+					IncludeHidden: true,
+				}).Return(comments, nil).Once()
+			},
+			want: domain.ListCommentsResponse{
+				CommentsEnabled: false,
+				Comments:        []domain.CommentDTO{domain.CommentDTOFrom(comments[0]), domain.CommentDTOFrom(comments[1])},
+				Cursor:          &cursor,
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 구독자는 발행되지 않은 소식의 댓글을 조회할 수 없음",
+			req: domain.ListCommentsRequest{
+				UserID: 1,
+				NewsID: 3,
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusArchived), nil).Once()
+				expectSubscription(ts, subscription)
+			},
+			want:    domain.ListCommentsResponse{},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 구독하지 않았고 학교 멤버도 아닌 경우",
+			req: domain.ListCommentsRequest{
+				UserID: 1,
+				NewsID: 3,
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusPublished), nil).Once()
+				expectSubscription(ts, nil)
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(nil, nil).Once()
+			},
+			want:    domain.ListCommentsResponse{},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 존재하지 않는 소식",
+			req: domain.ListCommentsRequest{
+				UserID: 1,
+				NewsID: 3,
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(nil, nil).Once()
+			},
+			want:    domain.ListCommentsResponse{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCommentServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.service.ListComments(context.Background(), tt.req)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_commentService_HideComment(t *testing.T) {
+	comment := &domain.Comment{Base: domain.Base{ID: 5}, NewsID: 3, UserID: 2}
+
+	tests := []struct {
+		name    string
+		req     domain.HideCommentRequest
+		mock    func(ts commentServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - OWNER가 댓글 숨기기",
+			req: domain.HideCommentRequest{
+				UserID:    1,
+				NewsID:    3,
+				CommentID: 5,
+				Hidden:    true,
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusPublished), nil).Once()
+				expectSchoolMember(ts, domain.SchoolRoleOwner)
+				ts.commentRepository.EXPECT().FindCommentByID(mock.Anything, 5).Return(comment, nil).Once()
+				ts.commentRepository.EXPECT().UpdateCommentHidden(mock.Anything, domain.UpdateCommentHiddenParams{
+					CommentID: 5,
+					Hidden:    true,
+				}).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - OWNER가 아닌 멤버",
+			req: domain.HideCommentRequest{
+				UserID:    1,
+				NewsID:    3,
+				CommentID: 5,
+				Hidden:    true,
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusPublished), nil).Once()
+				expectSchoolMember(ts, domain.SchoolRoleEditor)
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 다른 소식의 댓글",
+			req: domain.HideCommentRequest{
+				UserID:    1,
+				NewsID:    3,
+				CommentID: 5,
+				Hidden:    true,
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusPublished), nil).Once()
+				expectSchoolMember(ts, domain.SchoolRoleOwner)
+				ts.commentRepository.EXPECT().FindCommentByID(mock.Anything, 5).Return(&domain.Comment{Base: domain.Base{ID: 5}, NewsID: 4}, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCommentServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.HideComment(context.Background(), tt.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_commentService_DeleteComment(t *testing.T) {
+	comment := &domain.Comment{Base: domain.Base{ID: 5}, NewsID: 3, UserID: 2}
+
+	tests := []struct {
+		name    string
+		req     domain.DeleteCommentRequest
+		mock    func(ts commentServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - OWNER가 댓글 삭제",
+			req: domain.DeleteCommentRequest{
+				UserID:    1,
+				NewsID:    3,
+				CommentID: 5,
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusPublished), nil).Once()
+				expectSchoolMember(ts, domain.SchoolRoleOwner)
+				ts.commentRepository.EXPECT().FindCommentByID(mock.Anything, 5).Return(comment, nil).Once()
+				ts.commentRepository.EXPECT().DeleteComment(mock.Anything, 5).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 이미 삭제된 댓글",
+			req: domain.DeleteCommentRequest{
+				UserID:    1,
+				NewsID:    3,
+				CommentID: 5,
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusPublished), nil).Once()
+				expectSchoolMember(ts, domain.SchoolRoleOwner)
+				ts.commentRepository.EXPECT().FindCommentByID(mock.Anything, 5).Return(&domain.Comment{
+					Base:   domain.Base{ID: 5, DeleteDate: sql.NullTime{Time: time.Now(), Valid: true}},
+					NewsID: 3,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 서버 에러",
+			req: domain.DeleteCommentRequest{
+				UserID:    1,
+				NewsID:    3,
+				CommentID: 5,
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(nil, errors.New("db error")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCommentServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.DeleteComment(context.Background(), tt.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_commentService_UpdateCommentSetting(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     domain.UpdateCommentSettingRequest
+		mock    func(ts commentServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - OWNER가 댓글 작성 끄기",
+			req: domain.UpdateCommentSettingRequest{
+				UserID:  1,
+				NewsID:  3,
+				Enabled: false,
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusPublished), nil).Once()
+				expectSchoolMember(ts, domain.SchoolRoleOwner)
+				ts.commentRepository.EXPECT().UpdateCommentsEnabled(mock.Anything, domain.UpdateCommentsEnabledParams{
+					NewsID:  3,
+					Enabled: false,
+				}).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - OWNER가 아닌 멤버",
+			req: domain.UpdateCommentSettingRequest{
+				UserID:  1,
+				NewsID:  3,
+				Enabled: false,
+			},
+			mock: func(ts commentServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusPublished), nil).Once()
+				expectSchoolMember(ts, domain.SchoolRoleViewer)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCommentServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.UpdateCommentSetting(context.Background(), tt.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package comment
+
+const createCommentQuery = `INSERT INTO comments (news_id, user_id, body) VALUES (?, ?, ?)`
+
+const findCommentByIDQuery = `SELECT comments.id, comments.create_date, comments.update_date, comments.delete_date, comments.news_id, comments.user_id, users.user_name, comments.body, comments.hidden FROM comments JOIN users ON users.id = comments.user_id WHERE comments.id = ?`
+
+// listCommentsQuery 조건, 정렬, 조회 개수는 db.Query로 붙인다.
+const listCommentsQuery = `SELECT comments.id, comments.create_date, comments.update_date, comments.delete_date, comments.news_id, comments.user_id, users.user_name, comments.body, comments.hidden FROM comments JOIN users ON users.id = comments.user_id`
+
+const updateCommentHiddenQuery = `UPDATE comments SET hidden = ? WHERE id = ?`
+
+const deleteCommentQuery = `UPDATE comments SET delete_date = ? WHERE id = ?`
+
+const findCommentsEnabledQuery = `SELECT comments_enabled FROM news WHERE id = ?`
+
+const updateCommentsEnabledQuery = `UPDATE news SET comments_enabled = ? WHERE id = ?`
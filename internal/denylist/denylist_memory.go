@@ -0,0 +1,88 @@
+package denylist
+
+import (
+	"classting/domain"
+	"context"
+	"sync"
+	"time"
+)
+
+type userRevocation struct {
+	issuedBefore time.Time
+	expireDate   time.Time
+}
+
+// memoryDenylist 프로세스 메모리에 폐기 기록을 저장한다. 여러 인스턴스로 실행하면 인스턴스 간에 공유되지 않는다.
+type memoryDenylist struct {
+	mu     sync.Mutex
+	tokens map[string]time.Time
+	users  map[int]userRevocation
+	now    func() time.Time
+}
+
+func NewMemoryDenylist() *memoryDenylist {
+	return &memoryDenylist{
+		tokens: make(map[string]time.Time),
+		users:  make(map[int]userRevocation),
+		now:    time.Now,
+	}
+}
+
+var _ domain.TokenDenylist = (*memoryDenylist)(nil)
+
+func (d *memoryDenylist) RevokeToken(ctx context.Context, params domain.RevokeTokenParams) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.purgeExpired()
+	d.tokens[params.TokenID] = params.ExpireDate
+
+	return nil
+}
+
+func (d *memoryDenylist) RevokeUserTokens(ctx context.Context, params domain.RevokeUserTokensParams) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.purgeExpired()
+	revocation := d.users[params.UserID]
+	if revocation.issuedBefore.Before(params.IssuedBefore) {
+		revocation.issuedBefore = params.IssuedBefore
+	}
+	if revocation.expireDate.Before(params.ExpireDate) {
+		revocation.expireDate = params.ExpireDate
+	}
+	d.users[params.UserID] = revocation
+
+	return nil
+}
+
+func (d *memoryDenylist) IsTokenRevoked(ctx context.Context, params domain.IsTokenRevokedParams) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	if expireDate, ok := d.tokens[params.TokenID]; ok && now.Before(expireDate) {
+		return true, nil
+	}
+	if revocation, ok := d.users[params.UserID]; ok && now.Before(revocation.expireDate) {
+		return params.IssueDate.Before(revocation.issuedBefore), nil
+	}
+
+	return false, nil
+}
+
+// purgeExpired 만료된 토큰은 검증 단계에서 거부되므로 폐기 기록에서 지운다.
+func (d *memoryDenylist) purgeExpired() {
+	now := d.now()
+	for tokenID, expireDate := range d.tokens {
+		if !now.Before(expireDate) {
+			delete(d.tokens, tokenID)
+		}
+	}
+	for userID, revocation := range d.users {
+		if !now.Before(revocation.expireDate) {
+			delete(d.users, userID)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package denylist
+
+import (
+	"classting/domain"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_memoryDenylist_IsTokenRevoked(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		revoke func(d *memoryDenylist)
+		params domain.IsTokenRevokedParams
+		want   bool
+	}{
+		{
+			name:   "PASS - 폐기되지 않은 토큰",
+			revoke: func(d *memoryDenylist) {},
+			params: domain.IsTokenRevokedParams{TokenID: "token", UserID: 1, IssueDate: now},
+			want:   false,
+		},
+		{
+			name: "PASS - 로그아웃한 토큰",
+			revoke: func(d *memoryDenylist) {
+				_ = d.RevokeToken(context.Background(), domain.RevokeTokenParams{TokenID: "token", ExpireDate: now.Add(time.Hour)})
+			},
+			params: domain.IsTokenRevokedParams{TokenID: "token", UserID: 1, IssueDate: now},
+			want:   true,
+		},
+		{
+			name: "PASS - 전체 세션 폐기 이전에 발행된 토큰",
+			revoke: func(d *memoryDenylist) {
+				_ = d.RevokeUserTokens(context.Background(), domain.RevokeUserTokensParams{UserID: 1, IssuedBefore: now, ExpireDate: now.Add(time.Hour)})
+			},
+			params: domain.IsTokenRevokedParams{TokenID: "token", UserID: 1, IssueDate: now.Add(-time.Minute)},
+			want:   true,
+		},
+		{
+			name: "PASS - 전체 세션 폐기 이후에 발행된 토큰",
+			revoke: func(d *memoryDenylist) {
+				_ = d.RevokeUserTokens(context.Background(), domain.RevokeUserTokensParams{UserID: 1, IssuedBefore: now, ExpireDate: now.Add(time.Hour)})
+			},
+			params: domain.IsTokenRevokedParams{TokenID: "token", UserID: 1, IssueDate: now.Add(time.Minute)},
+			want:   false,
+		},
+		{
+			name: "PASS - 전체 세션 폐기와 같은 초에 먼저 발행된 토큰",
+			revoke: func(d *memoryDenylist) {
+				_ = d.RevokeUserTokens(context.Background(), domain.RevokeUserTokensParams{UserID: 1, IssuedBefore: domain.TokenRevocationBoundary(now), ExpireDate: now.Add(time.Hour)})
+			},
+			params: domain.IsTokenRevokedParams{TokenID: "token", UserID: 1, IssueDate: now.Truncate(time.Second)},
+			want:   true,
+		},
+		{
+			name: "PASS - 전체 세션 폐기 다음 초에 다시 로그인해 발행된 토큰",
+			revoke: func(d *memoryDenylist) {
+				_ = d.RevokeUserTokens(context.Background(), domain.RevokeUserTokensParams{UserID: 1, IssuedBefore: domain.TokenRevocationBoundary(now), ExpireDate: now.Add(time.Hour)})
+			},
+			params: domain.IsTokenRevokedParams{TokenID: "token", UserID: 1, IssueDate: now.Truncate(time.Second).Add(time.Second)},
+			want:   false,
+		},
+		{
+			name: "PASS - 만료일이 지난 폐기 기록은 무시",
+			revoke: func(d *memoryDenylist) {
+				_ = d.RevokeToken(context.Background(), domain.RevokeTokenParams{TokenID: "token", ExpireDate: now.Add(-time.Second)})
+			},
+			params: domain.IsTokenRevokedParams{TokenID: "token", UserID: 1, IssueDate: now},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			d := NewMemoryDenylist()
+			d.now = func() time.Time { return now }
+			tt.revoke(d)
+
+			// when
+			got, err := d.IsTokenRevoked(context.Background(), tt.params)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_memoryDenylist_purgeExpired(t *testing.T) {
+	// given
+	now := time.Now()
+	d := NewMemoryDenylist()
+	d.now = func() time.Time { return now }
+	_ = d.RevokeToken(context.Background(), domain.RevokeTokenParams{TokenID: "expired", ExpireDate: now.Add(time.Minute)})
+	_ = d.RevokeUserTokens(context.Background(), domain.RevokeUserTokensParams{UserID: 1, IssuedBefore: now, ExpireDate: now.Add(time.Minute)})
+
+	// when
+	d.now = func() time.Time { return now.Add(time.Hour) }
+	_ = d.RevokeToken(context.Background(), domain.RevokeTokenParams{TokenID: "token", ExpireDate: now.Add(2 * time.Hour)})
+
+	// then
+	assert.Len(t, d.tokens, 1)
+	assert.Len(t, d.users, 0)
+}
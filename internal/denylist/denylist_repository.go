@@ -0,0 +1,74 @@
+package denylist
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"context"
+	"database/sql"
+	"time"
+)
+
+// denylistRepository 폐기 기록을 데이터베이스에 저장해 여러 인스턴스가 공유한다.
+type denylistRepository struct {
+	sqlDB *sql.DB
+}
+
+func NewDenylistRepository(sqlDB *sql.DB) *denylistRepository {
+	return &denylistRepository{
+		sqlDB: sqlDB,
+	}
+}
+
+var _ domain.TokenDenylist = (*denylistRepository)(nil)
+
+func (d denylistRepository) RevokeToken(ctx context.Context, params domain.RevokeTokenParams) error {
+	const op cerrors.Op = "denylist/denylistRepository/RevokeToken"
+
+	if err := d.deleteExpired(ctx, deleteExpiredRevokedTokensQuery); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	_, err := d.sqlDB.ExecContext(ctx, revokeTokenQuery, params.TokenID, params.ExpireDate.UTC())
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (d denylistRepository) RevokeUserTokens(ctx context.Context, params domain.RevokeUserTokensParams) error {
+	const op cerrors.Op = "denylist/denylistRepository/RevokeUserTokens"
+
+	if err := d.deleteExpired(ctx, deleteExpiredRevokedUserTokensQuery); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	_, err := d.sqlDB.ExecContext(ctx, revokeUserTokensQuery, params.UserID, params.IssuedBefore.UTC(), params.ExpireDate.UTC())
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (d denylistRepository) IsTokenRevoked(ctx context.Context, params domain.IsTokenRevokedParams) (bool, error) {
+	const op cerrors.Op = "denylist/denylistRepository/IsTokenRevoked"
+
+	now := time.Now().UTC()
+
+	var revoked bool
+	err := d.sqlDB.QueryRowContext(ctx, isTokenRevokedQuery, params.TokenID, now, params.UserID, params.IssueDate.UTC(), now).
+		Scan(&revoked)
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return revoked, nil
+}
+
+// deleteExpired 만료된 토큰은 검증 단계에서 거부되므로 폐기 기록에서 지운다.
+func (d denylistRepository) deleteExpired(ctx context.Context, query string) error {
+	_, err := d.sqlDB.ExecContext(ctx, query, time.Now().UTC())
+
+	return err
+}
@@ -0,0 +1,86 @@
+package denylist
+
+import (
+	"classting/domain"
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type denylistRepositoryTestSuite struct {
+	sqlDB              *sql.DB
+	sqlMock            sqlmock.Sqlmock
+	denylistRepository domain.TokenDenylist
+}
+
+func setupDenylistRepositoryTestSuite() denylistRepositoryTestSuite {
+	var us denylistRepositoryTestSuite
+
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	us.sqlDB = mockDB
+	us.sqlMock = mock
+	us.denylistRepository = NewDenylistRepository(mockDB)
+
+	return us
+}
+
+func Test_denylistRepository_RevokeToken(t *testing.T) {
+	// given
+	ts := setupDenylistRepositoryTestSuite()
+	expireDate := time.Now().Add(time.Hour).UTC()
+	ts.sqlMock.ExpectExec(`DELETE FROM revoked_tokens WHERE expire_date <= \?`).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	ts.sqlMock.ExpectExec("INSERT INTO revoked_tokens").
+		WithArgs("token", expireDate).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// when
+	err := ts.denylistRepository.RevokeToken(context.Background(), domain.RevokeTokenParams{
+		TokenID:    "token",
+		ExpireDate: expireDate,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_denylistRepository_IsTokenRevoked(t *testing.T) {
+	tests := []struct {
+		name    string
+		revoked bool
+	}{
+		{name: "PASS - 폐기된 토큰", revoked: true},
+		{name: "PASS - 폐기되지 않은 토큰", revoked: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupDenylistRepositoryTestSuite()
+			issueDate := time.Now().UTC()
+			ts.sqlMock.ExpectQuery(`SELECT EXISTS (.+) FROM revoked_tokens (.+) FROM revoked_user_tokens`).
+				WithArgs("token", sqlmock.AnyArg(), 1, issueDate, sqlmock.AnyArg()).
+				WillReturnRows(sqlmock.NewRows([]string{"revoked"}).AddRow(tt.revoked))
+
+			// when
+			got, err := ts.denylistRepository.IsTokenRevoked(context.Background(), domain.IsTokenRevokedParams{
+				TokenID:   "token",
+				UserID:    1,
+				IssueDate: issueDate,
+			})
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.revoked, got)
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package denylist
+
+const revokeTokenQuery = `INSERT INTO revoked_tokens (token_id, expire_date) VALUES (?, ?) ON DUPLICATE KEY UPDATE expire_date = GREATEST(expire_date, VALUES(expire_date))`
+
+const revokeUserTokensQuery = `INSERT INTO revoked_user_tokens (user_id, issued_before, expire_date) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE issued_before = GREATEST(issued_before, VALUES(issued_before)), expire_date = GREATEST(expire_date, VALUES(expire_date))`
+
+const isTokenRevokedQuery = `SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE token_id = ? AND expire_date > ?) OR EXISTS (SELECT 1 FROM revoked_user_tokens WHERE user_id = ? AND issued_before > ? AND expire_date > ?)`
+
+const deleteExpiredRevokedTokensQuery = `DELETE FROM revoked_tokens WHERE expire_date <= ?`
+
+const deleteExpiredRevokedUserTokensQuery = `DELETE FROM revoked_user_tokens WHERE expire_date <= ?`
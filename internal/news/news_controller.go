@@ -1,7 +1,6 @@
 package news
 
 import (
-	"classting/config"
 	"classting/domain"
 	"classting/pkg/cerrors"
 	"classting/pkg/router"
@@ -11,13 +10,19 @@ import (
 	"time"
 )
 
-func RegisterRoutes(e *gin.Engine, controller domain.NewsController, cfg *config.Config) {
+func RegisterRoutes(e *gin.Engine, controller domain.NewsController, auth *router.JWTAuth) {
 	api := e.Group("/news")
 	{
-		api.POST("", router.JWTMiddleware(cfg.Auth.Secret, []domain.UserType{domain.UserUseTypeAdmin}), controller.CreateNews)
-		api.GET("", router.JWTMiddleware(cfg.Auth.Secret, []domain.UserType{domain.UserUseTypeAdmin}), controller.ListNews)
-		api.PUT("", router.JWTMiddleware(cfg.Auth.Secret, []domain.UserType{domain.UserUseTypeAdmin}), controller.UpdateNews)
-		api.DELETE("/:newsID", router.JWTMiddleware(cfg.Auth.Secret, []domain.UserType{domain.UserUseTypeAdmin}), controller.DeleteNews)
+		api.POST("", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.CreateNews)
+		api.GET("", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.ListNews)
+		api.PUT("", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.UpdateNews)
+		api.DELETE("/:newsID", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.DeleteNews)
+		api.GET("/:newsID/revisions", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.ListNewsRevisions)
+		api.GET("/:newsID/revisions/diff", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.DiffNewsRevisions)
+		api.POST("/:newsID/revisions/:revision/rollback", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.RollbackNews)
+		api.PUT("/:newsID/priority", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.UpdateNewsPriority)
+		api.PUT("/:newsID/pin", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.PinNews)
+		api.DELETE("/:newsID/pin", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.UnpinNews)
 	}
 }
 
@@ -36,10 +41,11 @@ var _ domain.NewsController = (*newsController)(nil)
 // CreateNews
 // @Tags News
 // @Summary 소식 생성 [필수 구현] 권한 - 관리자
-// @Description 자신이 소유한 학교의 소식을 생성합니다.
+// @Description OWNER 또는 EDITOR 역할로 속한 학교의 소식을 생성합니다.
 // @Description schoolID는 학교 아이디, title은 소식 제목
 // @Description classting_admin_1은 schoolID 1, 2의 소식을 생성할 수 있습니다.
 // @Description classting_admin_2은 schoolID 3의 소식을 생성할 수 있습니다.
+// @Description status는 DRAFT(임시 저장), SCHEDULED(예약), PUBLISHED(발행) 중 하나이며 publishAt만 보내면 예약, 둘 다 없으면 바로 발행합니다.
 // @Accept json
 // @Produce json
 // @Security BearerAuth
@@ -79,14 +85,16 @@ func (n newsController) CreateNews(c *gin.Context) {
 
 // ListNews
 // @Summary 학교 소식 목록 조회 [테스트 도우미] 권한 - 관리자
-// @Description 소유하고 있는 학교 소식 목록을 조회합니다. (학교 ID로 조회 가능, 커서로 페이징 가능) 10개씩 조회합니다.
+// @Description 멤버로 속한 학교 소식 목록을 조회합니다. (학교 ID로 조회 가능, 커서로 페이징 가능) 10개씩 조회합니다.
 // @Description classting_admin_1은 schoolID 1, 2의 소식을 조회할 수 있습니다.
 // @Description classting_admin_2은 schoolID 3의 소식을 조회할 수 있습니다.
 // @Tags News
 // @Produce json
 // @Security BearerAuth
-// @Param cursor query int false "커서"
+// @Param cursor query string false "이전 응답의 cursor 또는 prevCursor"
+// @Param size query int false "페이지 크기"
 // @Param schoolID query int false "학교 ID"
+// @Param status query string false "소식 상태 (DRAFT, SCHEDULED, PUBLISHED, ARCHIVED) 기본값 PUBLISHED"
 // @Success 200 {object} domain.ListNewsResponse "학교 목록"
 // @Router /news [get]
 func (n newsController) ListNews(c *gin.Context) {
@@ -122,10 +130,11 @@ func (n newsController) ListNews(c *gin.Context) {
 
 // UpdateNews
 // @Summary 소식 수정 [필수 구현] 권한 - 관리자
-// @Description 자신이 소유한 학교의 소식을 수정합니다 (소식ID로 소식을 수정합니다).
+// @Description OWNER 또는 EDITOR 역할로 속한 학교의 소식을 수정합니다 (소식ID로 소식을 수정합니다).
 // @Description id는 소식ID, title은 소식 제목
 // @Description classting_admin_1은 schoolID 1, 2의 소식을 수정할 수 있습니다. 미리 삽입된 데이터 아이디(공백으로 구분) : 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16
 // @Description classting_admin_2은 schoolID 3의 소식을 수정할 수 있습니다. 미리 삽입된 데이터 아이디(공백으로 구분) : 17
+// @Description status로 임시 저장, 예약 소식을 발행하거나 발행된 소식을 보관(ARCHIVED)할 수 있고 보관된 소식은 수정할 수 없습니다.
 // @Tags News
 // @Accept json
 // @Produce json
@@ -166,7 +175,7 @@ func (n newsController) UpdateNews(c *gin.Context) {
 
 // DeleteNews
 // @Summary 소식 삭제 [필수 구현] 권한 - 관리자
-// @Description 자신이 소유한 학교의 소식을 삭제합니다 (소식ID로 소식을 삭제합니다).
+// @Description OWNER 또는 EDITOR 역할로 속한 학교의 소식을 삭제합니다 (소식ID로 소식을 삭제합니다).
 // @Description classting_admin_1은 schoolID 1, 2의 소식을 삭제할 수 있습니다. 미리 삽입된 데이터 아이디(공백으로 구분) : 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16
 // @Description classting_admin_2은 schoolID 3의 소식을 삭제할 수 있습니다. 미리 삽입된 데이터 아이디(공백으로 구분) : 17
 // @Tags News
@@ -205,3 +214,268 @@ func (n newsController) DeleteNews(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// ListNewsRevisions
+// @Summary 소식 수정 이력 조회 [추가 구현] 권한 - 관리자
+// @Description 멤버로 속한 학교 소식의 수정 이력을 최근 리비전부터 조회합니다.
+// @Description 각 리비전은 수정 전 내용과 그 내용을 수정한 유저(editorID), 시각(editDate)입니다.
+// @Tags News
+// @Produce json
+// @Security BearerAuth
+// @Param newsID path int true "소식 ID"
+// @Success 200 {object} domain.ListNewsRevisionsResponse "수정 이력"
+// @Router /news/{newsID}/revisions [get]
+func (n newsController) ListNewsRevisions(c *gin.Context) {
+	var req domain.ListNewsRevisionsRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := n.service.ListNewsRevisions(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
+}
+
+// DiffNewsRevisions
+// @Summary 소식 리비전 비교 [추가 구현] 권한 - 관리자
+// @Description 두 리비전의 제목, 요약, 본문을 줄 단위로 비교합니다. to를 지정하지 않으면 현재 소식과 비교합니다.
+// @Tags News
+// @Produce json
+// @Security BearerAuth
+// @Param newsID path int true "소식 ID"
+// @Param from query int true "비교 기준 리비전"
+// @Param to query int false "비교 대상 리비전 (없으면 현재 소식)"
+// @Success 200 {object} domain.NewsRevisionDiffDTO "리비전 비교"
+// @Router /news/{newsID}/revisions/diff [get]
+func (n newsController) DiffNewsRevisions(c *gin.Context) {
+	var req domain.DiffNewsRevisionsRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := n.service.DiffNewsRevisions(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
+}
+
+// RollbackNews
+// @Summary 소식 되돌리기 [추가 구현] 권한 - 관리자
+// @Description OWNER 역할로 속한 학교의 소식을 선택한 리비전의 내용으로 되돌립니다. 되돌리기 전 내용도 새 리비전으로 남습니다.
+// @Tags News
+// @Produce json
+// @Security BearerAuth
+// @Param newsID path int true "소식 ID"
+// @Param revision path int true "되돌릴 리비전"
+// @Success 204
+// @Router /news/{newsID}/revisions/{revision}/rollback [post]
+func (n newsController) RollbackNews(c *gin.Context) {
+	var req domain.RollbackNewsRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := n.service.RollbackNews(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// UpdateNewsPriority
+// @Summary 소식 우선순위 변경 [추가 구현] 권한 - 관리자
+// @Description OWNER, EDITOR 역할로 속한 학교의 소식을 URGENT 또는 NORMAL로 바꿉니다. URGENT 소식은 구독자 응답의 priority로 구분됩니다.
+// @Tags News
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param newsID path int true "소식 ID"
+// @Param UpdateNewsPriorityRequest body domain.UpdateNewsPriorityRequest true "우선순위 변경 요청"
+// @Success 204
+// @Router /news/{newsID}/priority [put]
+func (n newsController) UpdateNewsPriority(c *gin.Context) {
+	var req domain.UpdateNewsPriorityRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := n.service.UpdateNewsPriority(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// PinNews
+// @Summary 소식 고정 [추가 구현] 권한 - 관리자
+// @Description OWNER, EDITOR 역할로 속한 학교의 발행된 소식을 구독자 학교 소식 목록 상단에 고정합니다. 학교마다 최대 고정 개수(news.maxPins)까지 고정할 수 있고 expireAt이 지나면 고정이 풀립니다.
+// @Tags News
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param newsID path int true "소식 ID"
+// @Param PinNewsRequest body domain.PinNewsRequest true "소식 고정 요청"
+// @Success 204
+// @Router /news/{newsID}/pin [put]
+func (n newsController) PinNews(c *gin.Context) {
+	var req domain.PinNewsRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := n.service.PinNews(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// UnpinNews
+// @Summary 소식 고정 해제 [추가 구현] 권한 - 관리자
+// @Description OWNER, EDITOR 역할로 속한 학교의 소식 고정을 해제합니다.
+// @Tags News
+// @Produce json
+// @Security BearerAuth
+// @Param newsID path int true "소식 ID"
+// @Success 204
+// @Router /news/{newsID}/pin [delete]
+func (n newsController) UnpinNews(c *gin.Context) {
+	var req domain.UnpinNewsRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := n.service.UnpinNews(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -6,11 +6,11 @@ import (
 	"classting/domain"
 	"classting/internal/user"
 	"classting/mocks"
+	"classting/pkg/router"
 	"encoding/json"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
-	"k8s.io/utils/pointer"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -40,7 +40,7 @@ func setupNewsControllerTestSuite(t *testing.T) newsControllerTestSuite {
 	us.newsController = NewNewsController(us.newsService)
 	RegisterRoutes(
 		us.router, us.newsController,
-		us.cfg,
+		router.NewJWTAuth(us.cfg.Auth.Secret, nil, nil),
 	)
 
 	return us
@@ -89,6 +89,22 @@ func Test_newsController_CreateNews(t *testing.T) {
 			mock: func(ts newsControllerTestSuite) {},
 			code: http.StatusBadRequest,
 		},
+		{
+			name: "FAIL - 지원하지 않는 본문 형식",
+			body: func() *bytes.Reader {
+				req := domain.CreateNewsRequest{
+					UserID:        1,
+					SchoolID:      1,
+					Title:         "지원하지 않는 본문 형식",
+					ContentFormat: domain.NewsContentFormatHTML,
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts newsControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
 		{
 			name: "FAIL - 학교 아이디 누락",
 			body: func() *bytes.Reader {
@@ -150,16 +166,16 @@ func Test_newsController_ListNews(t *testing.T) {
 				ts.newsService.EXPECT().ListNews(mock.Anything, domain.ListNewsRequest{
 					UserID:   1,
 					SchoolID: 1,
-					Cursor:   nil,
 				}).Return(domain.ListNewsResponse{}, nil).Once()
 			},
 			code: http.StatusOK,
 		},
 		{
-			name: "PASS - 일부 조회 (커서 입력)",
+			name: "PASS - 일부 조회 (커서, 페이지 크기 입력)",
 			query: func() string {
 				params := url.Values{}
-				params.Add("cursor", "1")
+				params.Add("cursor", "eyJpIjoxLCJkIjoibmV4dCJ9.c2lnbmF0dXJl")
+				params.Add("size", "20")
 				params.Add("schoolID", "1")
 				return params.Encode()
 			},
@@ -167,11 +183,51 @@ func Test_newsController_ListNews(t *testing.T) {
 				ts.newsService.EXPECT().ListNews(mock.Anything, domain.ListNewsRequest{
 					UserID:   1,
 					SchoolID: 1,
-					Cursor:   pointer.Int(1),
+					Cursor:   "eyJpIjoxLCJkIjoibmV4dCJ9.c2lnbmF0dXJl",
+					Size:     20,
 				}).Return(domain.ListNewsResponse{}, nil).Once()
 			},
 			code: http.StatusOK,
 		},
+		{
+			name: "FAIL - 음수 페이지 크기",
+			query: func() string {
+				params := url.Values{}
+				params.Add("size", "-1")
+				params.Add("schoolID", "1")
+				return params.Encode()
+			},
+			mock: func(ts newsControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "PASS - HTML 형식 조회",
+			query: func() string {
+				params := url.Values{}
+				params.Add("schoolID", "1")
+				params.Add("format", "html")
+				return params.Encode()
+			},
+			mock: func(ts newsControllerTestSuite) {
+				ts.newsService.EXPECT().ListNews(mock.Anything, domain.ListNewsRequest{
+					UserID:   1,
+					SchoolID: 1,
+					Format:   domain.NewsResponseFormatHTML,
+				}).Return(domain.ListNewsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 지원하지 않는 응답 형식",
+			query: func() string {
+				params := url.Values{}
+				params.Add("schoolID", "1")
+				params.Add("format", "pdf")
+				return params.Encode()
+			},
+			mock: func(ts newsControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -343,3 +399,182 @@ func Test_newsController_DeleteNews(t *testing.T) {
 		})
 	}
 }
+
+func Test_newsController_NewsRevisions(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		mock   func(ts newsControllerTestSuite)
+		code   int
+	}{
+		{
+			name:   "PASS - 수정 이력 조회",
+			method: http.MethodGet,
+			path:   "/news/1/revisions",
+			mock: func(ts newsControllerTestSuite) {
+				ts.newsService.EXPECT().ListNewsRevisions(mock.Anything, domain.ListNewsRevisionsRequest{
+					UserID: 1,
+					NewsID: 1,
+				}).Return(domain.ListNewsRevisionsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name:   "PASS - 리비전과 현재 소식 비교",
+			method: http.MethodGet,
+			path:   "/news/1/revisions/diff?from=1",
+			mock: func(ts newsControllerTestSuite) {
+				ts.newsService.EXPECT().DiffNewsRevisions(mock.Anything, domain.DiffNewsRevisionsRequest{
+					UserID: 1,
+					NewsID: 1,
+					From:   1,
+				}).Return(domain.NewsRevisionDiffDTO{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name:   "FAIL - 같은 리비전 비교",
+			method: http.MethodGet,
+			path:   "/news/1/revisions/diff?from=2&to=2",
+			mock:   func(ts newsControllerTestSuite) {},
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:   "PASS - 이전 리비전으로 되돌리기",
+			method: http.MethodPost,
+			path:   "/news/1/revisions/2/rollback",
+			mock: func(ts newsControllerTestSuite) {
+				ts.newsService.EXPECT().RollbackNews(mock.Anything, domain.RollbackNewsRequest{
+					UserID:   1,
+					NewsID:   1,
+					Revision: 2,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name:   "FAIL - 잘못된 리비전으로 되돌리기",
+			method: http.MethodPost,
+			path:   "/news/1/revisions/0/rollback",
+			mock:   func(ts newsControllerTestSuite) {},
+			code:   http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupNewsControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(tt.method, tt.path, nil)
+			token, _ := user.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+				Type: domain.UserUseTypeAdmin,
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.newsService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_newsController_NewsPin(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		mock   func(ts newsControllerTestSuite)
+		code   int
+	}{
+		{
+			name:   "PASS - 긴급 소식으로 변경",
+			method: http.MethodPut,
+			path:   "/news/1/priority",
+			body:   `{"priority":"URGENT"}`,
+			mock: func(ts newsControllerTestSuite) {
+				ts.newsService.EXPECT().UpdateNewsPriority(mock.Anything, domain.UpdateNewsPriorityRequest{
+					UserID:   1,
+					NewsID:   1,
+					Priority: domain.NewsPriorityUrgent,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name:   "FAIL - 잘못된 우선순위",
+			method: http.MethodPut,
+			path:   "/news/1/priority",
+			body:   `{"priority":"HIGH"}`,
+			mock:   func(ts newsControllerTestSuite) {},
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:   "PASS - 소식 고정",
+			method: http.MethodPut,
+			path:   "/news/1/pin",
+			body:   `{}`,
+			mock: func(ts newsControllerTestSuite) {
+				ts.newsService.EXPECT().PinNews(mock.Anything, domain.PinNewsRequest{
+					UserID: 1,
+					NewsID: 1,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name:   "FAIL - 지난 시각으로 만료 시각 지정",
+			method: http.MethodPut,
+			path:   "/news/1/pin",
+			body:   `{"expireAt":"2020-01-01T00:00:00Z"}`,
+			mock:   func(ts newsControllerTestSuite) {},
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:   "PASS - 소식 고정 해제",
+			method: http.MethodDelete,
+			path:   "/news/1/pin",
+			mock: func(ts newsControllerTestSuite) {
+				ts.newsService.EXPECT().UnpinNews(mock.Anything, domain.UnpinNewsRequest{
+					UserID: 1,
+					NewsID: 1,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupNewsControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(tt.method, tt.path, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			token, _ := user.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+				Type: domain.UserUseTypeAdmin,
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.newsService.AssertExpectations(t)
+		})
+	}
+}
@@ -2,13 +2,17 @@ package news
 
 import (
 	"classting/domain"
+	"classting/internal/outbox"
 	"classting/pkg/cerrors"
+	"classting/pkg/db"
 	"context"
 	"database/sql"
-	"fmt"
+	"errors"
 	"time"
 )
 
+var errNewsStatusChanged = errors.New("news status changed")
+
 type newsRepository struct {
 	sqlDB *sql.DB
 }
@@ -24,17 +28,31 @@ var _ domain.NewsRepository = (*newsRepository)(nil)
 func (n newsRepository) CreateNews(ctx context.Context, news domain.News) (int, error) {
 	const op cerrors.Op = "news/schoolRepository/CreateNews"
 
-	result, err := n.sqlDB.ExecContext(ctx, createNewsQuery, news.SchoolID, news.UserID, news.Title)
-	if err != nil {
-		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
-	}
+	var newsID int
+	err := db.WithTx(ctx, n.sqlDB, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, createNewsQuery, news.SchoolID, news.UserID, news.Title, news.Summary, news.Body, news.ContentFormat, news.Status, news.PublishDate, news.Priority)
+		if err != nil {
+			return err
+		}
+
+		newID, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		newsID = int(newID)
+
+		// 발행 전 소식은 구독자에게 전달하지 않는다.
+		if news.Status != domain.NewsStatusPublished {
+			return nil
+		}
 
-	newID, err := result.LastInsertId()
+		return appendNewsEvent(ctx, tx, domain.NewsEventTypeCreated, newsID)
+	})
 	if err != nil {
 		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 	}
 
-	return int(newID), nil
+	return newsID, nil
 }
 
 func (n newsRepository) ListNews(ctx context.Context, params domain.ListNewsParams) ([]domain.News, error) {
@@ -42,13 +60,24 @@ func (n newsRepository) ListNews(ctx context.Context, params domain.ListNewsPara
 
 	var news []domain.News
 
-	query := fmt.Sprintf(listNewsQuery,
-		params.AndSchoolID(),
-		params.AndUserID(),
-		params.AfterCursor(),
-	)
+	status := params.Status
+	if status == "" {
+		status = domain.NewsStatusPublished
+	}
+
+	q := db.Select(listNewsQuery).Where(db.Expr("delete_date IS NULL"))
+	if params.SchoolID != nil {
+		q.Where(db.Eq("school_id", *params.SchoolID))
+	}
+	if params.UserID != nil {
+		q.Where(db.Eq("user_id", *params.UserID))
+	}
+	query, args := q.Where(db.Eq("status", status), db.NotIn("id", params.ExcludeIDs)).
+		Seek(params.Cursor, true, "id").
+		Limit(params.Limit).
+		Build()
 
-	rows, err := n.sqlDB.QueryContext(ctx, query)
+	rows, err := n.sqlDB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 	}
@@ -56,16 +85,7 @@ func (n newsRepository) ListNews(ctx context.Context, params domain.ListNewsPara
 
 	for rows.Next() {
 		var product domain.News
-		err := rows.Scan(
-			&product.ID,
-			&product.CreateDate,
-			&product.UpdateDate,
-			&product.DeleteDate,
-			&product.SchoolID,
-			&product.UserID,
-			&product.Title,
-		)
-		if err != nil {
+		if err := scanNews(rows, &product); err != nil {
 			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 		}
 		news = append(news, product)
@@ -74,11 +94,47 @@ func (n newsRepository) ListNews(ctx context.Context, params domain.ListNewsPara
 	return news, nil
 }
 
-func (n newsRepository) UpdateNews(ctx context.Context, news domain.News) error {
+func (n newsRepository) UpdateNews(ctx context.Context, params domain.UpdateNewsParams) error {
 	const op cerrors.Op = "news/newsRepository/UpdateNews"
 
-	updateNewsQuery := `UPDATE news SET title = ? WHERE id = ?`
-	_, err := n.sqlDB.ExecContext(ctx, updateNewsQuery, news.Title, news.ID)
+	news := params.News
+	err := db.WithTx(ctx, n.sqlDB, func(tx *sql.Tx) error {
+		var current domain.News
+		err := tx.QueryRowContext(ctx, findNewsContentForUpdateQuery, news.ID).
+			Scan(&current.Status, &current.Title, &current.Summary, &current.Body, &current.ContentFormat)
+		if err != nil {
+			return err
+		}
+		if current.Status != params.PreviousStatus {
+			return errNewsStatusChanged
+		}
+
+		// 구독자가 이미 본 내용이 바뀐 경우에만 수정 시각을 남긴다.
+		var editDate sql.NullTime
+		if !current.SameContent(news) {
+			_, err := tx.ExecContext(ctx, createNewsRevisionQuery, news.ID, params.EditorID, current.Title, current.Summary, current.Body, current.ContentFormat, news.ID)
+			if err != nil {
+				return err
+			}
+			if current.Status == domain.NewsStatusPublished {
+				editDate = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+			}
+		}
+
+		if _, err := tx.ExecContext(ctx, updateNewsQuery, news.Title, news.Summary, news.Body, news.ContentFormat, news.Status, news.PublishDate, editDate, news.ID); err != nil {
+			return err
+		}
+
+		eventType, ok := domain.NewsEventTypeForTransition(params.PreviousStatus, news.Status)
+		if !ok {
+			return nil
+		}
+
+		return appendNewsEvent(ctx, tx, eventType, news.ID)
+	})
+	if errors.Is(err, errNewsStatusChanged) {
+		return cerrors.E(op, cerrors.Exist, err, "소식의 상태가 변경되었습니다. 다시 조회한 후 수정해주세요.")
+	}
 	if err != nil {
 		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 	}
@@ -91,15 +147,7 @@ func (n newsRepository) FindNewsByID(ctx context.Context, newsID int) (*domain.N
 
 	var news domain.News
 
-	err := n.sqlDB.QueryRowContext(ctx, findNewsByIDQuery, newsID).Scan(
-		&news.ID,
-		&news.CreateDate,
-		&news.UpdateDate,
-		&news.DeleteDate,
-		&news.SchoolID,
-		&news.UserID,
-		&news.Title,
-	)
+	err := scanNews(n.sqlDB.QueryRowContext(ctx, findNewsByIDQuery, newsID), &news)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -113,10 +161,248 @@ func (n newsRepository) FindNewsByID(ctx context.Context, newsID int) (*domain.N
 func (n newsRepository) DeleteNews(ctx context.Context, newsID int) error {
 	const op cerrors.Op = "news/newsRepository/DeleteNews"
 
-	_, err := n.sqlDB.ExecContext(ctx, deleteNewsQuery, time.Now().UTC(), newsID)
+	err := db.WithTx(ctx, n.sqlDB, func(tx *sql.Tx) error {
+		var status domain.NewsStatus
+		if err := tx.QueryRowContext(ctx, findNewsStatusForUpdateQuery, newsID).Scan(&status); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, deleteNewsQuery, time.Now().UTC(), newsID); err != nil {
+			return err
+		}
+
+		// 발행된 적 없거나 이미 보관된 소식은 구독자에게 삭제를 알리지 않는다.
+		if status != domain.NewsStatusPublished {
+			return nil
+		}
+
+		return appendNewsEvent(ctx, tx, domain.NewsEventTypeDeleted, newsID)
+	})
 	if err != nil {
 		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 	}
 
 	return nil
 }
+
+func (n newsRepository) ListDueScheduledNews(ctx context.Context, params domain.ListDueScheduledNewsParams) ([]domain.News, error) {
+	const op cerrors.Op = "news/newsRepository/ListDueScheduledNews"
+
+	rows, err := n.sqlDB.QueryContext(ctx, listDueScheduledNewsQuery, params.Now, params.Limit)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var news []domain.News
+	for rows.Next() {
+		var n domain.News
+		if err := scanNews(rows, &n); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		news = append(news, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return news, nil
+}
+
+// PublishScheduledNews 예약 시각이 지난 소식을 발행한다. 다른 인스턴스가 먼저 발행했거나 예약이 취소되었다면 false를 반환한다.
+func (n newsRepository) PublishScheduledNews(ctx context.Context, params domain.PublishScheduledNewsParams) (bool, error) {
+	const op cerrors.Op = "news/newsRepository/PublishScheduledNews"
+
+	var published bool
+	err := db.WithTx(ctx, n.sqlDB, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, publishScheduledNewsQuery, params.NewsID, params.Now)
+		if err != nil {
+			return err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return nil
+		}
+		published = true
+
+		return appendNewsEvent(ctx, tx, domain.NewsEventTypeCreated, params.NewsID)
+	})
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return published, nil
+}
+
+func (n newsRepository) ListNewsRevisions(ctx context.Context, newsID int) ([]domain.NewsRevision, error) {
+	const op cerrors.Op = "news/newsRepository/ListNewsRevisions"
+
+	rows, err := n.sqlDB.QueryContext(ctx, listNewsRevisionsQuery, newsID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var revisions []domain.NewsRevision
+	for rows.Next() {
+		var revision domain.NewsRevision
+		if err := scanNewsRevision(rows, &revision); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		revisions = append(revisions, revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return revisions, nil
+}
+
+func (n newsRepository) FindNewsRevision(ctx context.Context, params domain.FindNewsRevisionParams) (*domain.NewsRevision, error) {
+	const op cerrors.Op = "news/newsRepository/FindNewsRevision"
+
+	var revision domain.NewsRevision
+	err := scanNewsRevision(n.sqlDB.QueryRowContext(ctx, findNewsRevisionQuery, params.NewsID, params.Revision), &revision)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return &revision, nil
+}
+
+// appendNewsEvent 변경된 소식을 같은 트랜잭션에서 다시 조회해 아웃박스에 기록한다.
+func appendNewsEvent(ctx context.Context, tx *sql.Tx, eventType domain.NewsEventType, newsID int) error {
+	var news domain.News
+	if err := scanNews(tx.QueryRowContext(ctx, findNewsByIDQuery, newsID), &news); err != nil {
+		return err
+	}
+
+	event, err := domain.OutboxEventFromNews(eventType, news)
+	if err != nil {
+		return err
+	}
+
+	return outbox.Append(ctx, tx, event)
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func (n newsRepository) UpdateNewsPriority(ctx context.Context, params domain.UpdateNewsPriorityParams) error {
+	const op cerrors.Op = "news/newsRepository/UpdateNewsPriority"
+
+	if _, err := n.sqlDB.ExecContext(ctx, updateNewsPriorityQuery, params.Priority, params.NewsID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// PinNews 학교의 고정 소식이 이미 MaxPins개라면 고정하지 않고 false를 반환한다.
+func (n newsRepository) PinNews(ctx context.Context, params domain.PinNewsParams) (bool, error) {
+	const op cerrors.Op = "news/newsRepository/PinNews"
+
+	var pinned bool
+	err := db.WithTx(ctx, n.sqlDB, func(tx *sql.Tx) error {
+		var schoolID int
+		if err := tx.QueryRowContext(ctx, lockSchoolForPinQuery, params.SchoolID).Scan(&schoolID); err != nil {
+			return err
+		}
+
+		var count int
+		if err := tx.QueryRowContext(ctx, countActivePinsQuery, params.SchoolID, params.NewsID, params.Now).Scan(&count); err != nil {
+			return err
+		}
+		if count >= params.MaxPins {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, upsertNewsPinQuery, params.NewsID, params.SchoolID, params.UserID, params.ExpireDate); err != nil {
+			return err
+		}
+		pinned = true
+
+		return nil
+	})
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return pinned, nil
+}
+
+func (n newsRepository) UnpinNews(ctx context.Context, newsID int) error {
+	const op cerrors.Op = "news/newsRepository/UnpinNews"
+
+	if _, err := n.sqlDB.ExecContext(ctx, deleteNewsPinQuery, newsID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// ListPinnedNews 최근에 고정한 소식부터 정렬한다.
+func (n newsRepository) ListPinnedNews(ctx context.Context, params domain.ListPinnedNewsParams) ([]domain.News, error) {
+	const op cerrors.Op = "news/newsRepository/ListPinnedNews"
+
+	rows, err := n.sqlDB.QueryContext(ctx, listPinnedNewsQuery, params.SchoolID, params.Now)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var news []domain.News
+	for rows.Next() {
+		var n domain.News
+		if err := scanNews(rows, &n); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		news = append(news, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return news, nil
+}
+
+func scanNews(row scanner, news *domain.News) error {
+	return row.Scan(
+		&news.ID,
+		&news.CreateDate,
+		&news.UpdateDate,
+		&news.DeleteDate,
+		&news.SchoolID,
+		&news.UserID,
+		&news.Title,
+		&news.Summary,
+		&news.Body,
+		&news.ContentFormat,
+		&news.Status,
+		&news.PublishDate,
+		&news.EditDate,
+		&news.Priority,
+	)
+}
+
+func scanNewsRevision(row scanner, revision *domain.NewsRevision) error {
+	return row.Scan(
+		&revision.ID,
+		&revision.NewsID,
+		&revision.Revision,
+		&revision.EditorID,
+		&revision.Title,
+		&revision.Summary,
+		&revision.Body,
+		&revision.ContentFormat,
+		&revision.CreateDate,
+	)
+}
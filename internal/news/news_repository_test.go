@@ -2,6 +2,7 @@ package news
 
 import (
 	"classting/domain"
+	"classting/pkg/pagination"
 	"context"
 	"database/sql"
 	"github.com/DATA-DOG/go-sqlmock"
@@ -42,6 +43,8 @@ func Test_newsRepository_CreateNews(t *testing.T) {
 		news domain.News
 	}
 
+	publishDate := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		name    string
 		args    args
@@ -54,19 +57,81 @@ func Test_newsRepository_CreateNews(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				news: domain.News{
-					SchoolID: 1,
-					UserID:   1,
-					Title:    "클래스팅 새소식",
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 새소식",
+					Body:          "클래스팅 새소식 본문",
+					ContentFormat: domain.NewsContentFormatPlain,
+					Status:        domain.NewsStatusPublished,
+					PublishDate:   sql.NullTime{Time: publishDate, Valid: true},
+					Priority:      domain.NewsPriorityNormal,
+				},
+			},
+			mock: func(ts newsRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectExec(`INSERT INTO news`).
+					WithArgs(1, 1, "클래스팅 새소식", "", "클래스팅 새소식 본문", domain.NewsContentFormatPlain, domain.NewsStatusPublished, publishDate, domain.NewsPriorityNormal).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectNewsOutboxEvent(ts, 1, "news.created")
+				ts.sqlMock.ExpectCommit()
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "PASS - 예약 소식은 아웃박스에 기록하지 않음",
+			args: args{
+				ctx: context.Background(),
+				news: domain.News{
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 새소식",
+					Body:          "클래스팅 새소식 본문",
+					ContentFormat: domain.NewsContentFormatPlain,
+					Status:        domain.NewsStatusScheduled,
+					PublishDate:   sql.NullTime{Time: publishDate, Valid: true},
+					Priority:      domain.NewsPriorityNormal,
 				},
 			},
 			mock: func(ts newsRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
 				ts.sqlMock.ExpectExec(`INSERT INTO news`).
-					WithArgs(1, 1, "클래스팅 새소식").
+					WithArgs(1, 1, "클래스팅 새소식", "", "클래스팅 새소식 본문", domain.NewsContentFormatPlain, domain.NewsStatusScheduled, publishDate, domain.NewsPriorityNormal).
 					WillReturnResult(sqlmock.NewResult(1, 1))
+				ts.sqlMock.ExpectCommit()
 			},
 			want:    1,
 			wantErr: false,
 		},
+		{
+			name: "FAIL - 아웃박스 기록 실패 시 롤백",
+			args: args{
+				ctx: context.Background(),
+				news: domain.News{
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 새소식",
+					Body:          "클래스팅 새소식 본문",
+					ContentFormat: domain.NewsContentFormatPlain,
+					Status:        domain.NewsStatusPublished,
+					PublishDate:   sql.NullTime{Time: publishDate, Valid: true},
+					Priority:      domain.NewsPriorityNormal,
+				},
+			},
+			mock: func(ts newsRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectExec(`INSERT INTO news`).
+					WithArgs(1, 1, "클래스팅 새소식", "", "클래스팅 새소식 본문", domain.NewsContentFormatPlain, domain.NewsStatusPublished, publishDate, domain.NewsPriorityNormal).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				columns := []string{"id", "create_date", "update_date", "delete_date", "school_id", "user_id", "title", "summary", "body", "content_format", "status", "publish_date", "edit_date", "priority"}
+				ts.sqlMock.ExpectQuery("SELECT (.+) FROM news WHERE id = ?").WithArgs(1).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(1, time.Now(), time.Now(), nil, 1, 1, "클래스팅 새소식", "", "클래스팅 새소식 본문", domain.NewsContentFormatPlain, domain.NewsStatusPublished, nil, nil, domain.NewsPriorityNormal))
+				ts.sqlMock.ExpectExec("INSERT INTO outbox").WillReturnError(sql.ErrConnDone)
+				ts.sqlMock.ExpectRollback()
+			},
+			want:    0,
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -80,6 +145,7 @@ func Test_newsRepository_CreateNews(t *testing.T) {
 
 			// then
 			assert.Equal(t, tt.want, got)
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
 			if err != nil {
 				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
 			}
@@ -109,13 +175,14 @@ func Test_newsRepository_ListNews(t *testing.T) {
 				ctx: context.Background(),
 				params: domain.ListNewsParams{
 					UserID: pointer.Int(1),
+					Limit:  11,
 				},
 			},
 			mock: func(ts newsRepositoryTestSuite) {
-				query := `SELECT id, create_date, update_date, delete_date, school_id, user_id, title FROM news`
-				columns := []string{"id", "create_date", "update_date", "delete_date", "school_id", "user_id", "title"}
-				rows := sqlmock.NewRows(columns).AddRow(100, createDate, updateDate, nil, 1, 1, "클래스팅 새소식")
-				ts.sqlMock.ExpectQuery(query).WillReturnRows(rows)
+				query := `SELECT id, create_date, update_date, delete_date, school_id, user_id, title, summary, body, content_format, status, publish_date, edit_date, priority FROM news WHERE delete_date IS NULL AND user_id = \? AND status = \? ORDER BY id DESC LIMIT \?`
+				columns := []string{"id", "create_date", "update_date", "delete_date", "school_id", "user_id", "title", "summary", "body", "content_format", "status", "publish_date", "edit_date", "priority"}
+				rows := sqlmock.NewRows(columns).AddRow(100, createDate, updateDate, nil, 1, 1, "클래스팅 새소식", "", "클래스팅 새소식 본문", domain.NewsContentFormatPlain, domain.NewsStatusPublished, nil, nil, domain.NewsPriorityNormal)
+				ts.sqlMock.ExpectQuery(query).WithArgs(1, domain.NewsStatusPublished, 11).WillReturnRows(rows)
 			},
 			want: []domain.News{
 				{
@@ -124,9 +191,13 @@ func Test_newsRepository_ListNews(t *testing.T) {
 						CreateDate: createDate,
 						UpdateDate: updateDate,
 					},
-					SchoolID: 1,
-					UserID:   1,
-					Title:    "클래스팅 새소식",
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 새소식",
+					Body:          "클래스팅 새소식 본문",
+					ContentFormat: domain.NewsContentFormatPlain,
+					Status:        domain.NewsStatusPublished,
+					Priority:      domain.NewsPriorityNormal,
 				},
 			},
 			wantErr: false,
@@ -137,14 +208,15 @@ func Test_newsRepository_ListNews(t *testing.T) {
 				ctx: context.Background(),
 				params: domain.ListNewsParams{
 					UserID: pointer.Int(1),
-					Cursor: pointer.Int(1),
+					Cursor: &pagination.Cursor{ID: 101, Direction: pagination.Forward},
+					Limit:  11,
 				},
 			},
 			mock: func(ts newsRepositoryTestSuite) {
-				query := `SELECT id, create_date, update_date, delete_date, school_id, user_id, title FROM news`
-				columns := []string{"id", "create_date", "update_date", "delete_date", "school_id", "user_id", "title"}
-				rows := sqlmock.NewRows(columns).AddRow(100, createDate, updateDate, nil, 1, 1, "클래스팅 새소식")
-				ts.sqlMock.ExpectQuery(query).WillReturnRows(rows)
+				query := `AND user_id = \? AND status = \? AND id < \? ORDER BY id DESC LIMIT \?`
+				columns := []string{"id", "create_date", "update_date", "delete_date", "school_id", "user_id", "title", "summary", "body", "content_format", "status", "publish_date", "edit_date", "priority"}
+				rows := sqlmock.NewRows(columns).AddRow(100, createDate, updateDate, nil, 1, 1, "클래스팅 새소식", "", "클래스팅 새소식 본문", domain.NewsContentFormatPlain, domain.NewsStatusPublished, nil, nil, domain.NewsPriorityNormal)
+				ts.sqlMock.ExpectQuery(query).WithArgs(1, domain.NewsStatusPublished, 101, 11).WillReturnRows(rows)
 			},
 			want: []domain.News{
 				{
@@ -153,13 +225,53 @@ func Test_newsRepository_ListNews(t *testing.T) {
 						CreateDate: createDate,
 						UpdateDate: updateDate,
 					},
-					SchoolID: 1,
-					UserID:   1,
-					Title:    "클래스팅 새소식",
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 새소식",
+					Body:          "클래스팅 새소식 본문",
+					ContentFormat: domain.NewsContentFormatPlain,
+					Status:        domain.NewsStatusPublished,
+					Priority:      domain.NewsPriorityNormal,
 				},
 			},
 			wantErr: false,
 		},
+		{
+			name: "PASS - 이전 페이지 소식 조회 성공",
+			args: args{
+				ctx: context.Background(),
+				params: domain.ListNewsParams{
+					SchoolID: pointer.Int(1),
+					Cursor:   &pagination.Cursor{ID: 99, Direction: pagination.Backward},
+					Limit:    11,
+				},
+			},
+			mock: func(ts newsRepositoryTestSuite) {
+				query := `AND school_id = \? AND status = \? AND id > \? ORDER BY id ASC LIMIT \?`
+				columns := []string{"id", "create_date", "update_date", "delete_date", "school_id", "user_id", "title", "summary", "body", "content_format", "status", "publish_date", "edit_date", "priority"}
+				ts.sqlMock.ExpectQuery(query).WithArgs(1, domain.NewsStatusPublished, 99, 11).WillReturnRows(sqlmock.NewRows(columns))
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "PASS - 고정된 소식을 제외하고 조회",
+			args: args{
+				ctx: context.Background(),
+				params: domain.ListNewsParams{
+					SchoolID:   pointer.Int(1),
+					ExcludeIDs: []int{3, 4},
+					Limit:      10,
+				},
+			},
+			mock: func(ts newsRepositoryTestSuite) {
+				query := `AND school_id = \? AND status = \? AND id NOT IN \(\?, \?\) ORDER BY id DESC LIMIT \?`
+				columns := []string{"id", "create_date", "update_date", "delete_date", "school_id", "user_id", "title", "summary", "body", "content_format", "status", "publish_date", "edit_date", "priority"}
+				ts.sqlMock.ExpectQuery(query).WithArgs(1, domain.NewsStatusPublished, 3, 4, 10).WillReturnRows(sqlmock.NewRows(columns))
+			},
+			want:    nil,
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -206,9 +318,9 @@ func Test_newsRepository_FindNewsByID(t *testing.T) {
 				newsID: 1,
 			},
 			mock: func(ts newsRepositoryTestSuite) {
-				query := "SELECT id, create_date, update_date, delete_date, school_id, user_id, title FROM news"
-				columns := []string{"id", "create_date", "update_date", "delete_date", "school_id", "user_id", "title"}
-				rows := sqlmock.NewRows(columns).AddRow(1, createDate, updateDate, nil, 1, 1, "클래스팅 소식")
+				query := "SELECT id, create_date, update_date, delete_date, school_id, user_id, title, summary, body, content_format, status, publish_date, edit_date, priority FROM news"
+				columns := []string{"id", "create_date", "update_date", "delete_date", "school_id", "user_id", "title", "summary", "body", "content_format", "status", "publish_date", "edit_date", "priority"}
+				rows := sqlmock.NewRows(columns).AddRow(1, createDate, updateDate, nil, 1, 1, "클래스팅 소식", "", "클래스팅 소식 본문", domain.NewsContentFormatPlain, domain.NewsStatusPublished, nil, nil, domain.NewsPriorityNormal)
 				ts.sqlMock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
 			},
 			want: &domain.News{
@@ -217,9 +329,13 @@ func Test_newsRepository_FindNewsByID(t *testing.T) {
 					CreateDate: createDate,
 					UpdateDate: updateDate,
 				},
-				SchoolID: 1,
-				UserID:   1,
-				Title:    "클래스팅 소식",
+				SchoolID:      1,
+				UserID:        1,
+				Title:         "클래스팅 소식",
+				Body:          "클래스팅 소식 본문",
+				ContentFormat: domain.NewsContentFormatPlain,
+				Status:        domain.NewsStatusPublished,
+				Priority:      domain.NewsPriorityNormal,
 			},
 			wantErr: false,
 		},
@@ -230,7 +346,7 @@ func Test_newsRepository_FindNewsByID(t *testing.T) {
 				newsID: 7777,
 			},
 			mock: func(ts newsRepositoryTestSuite) {
-				query := "SELECT id, create_date, update_date, delete_date, school_id, user_id, title FROM news"
+				query := "SELECT id, create_date, update_date, delete_date, school_id, user_id, title, summary, body, content_format, status, publish_date, edit_date, priority FROM news"
 				ts.sqlMock.ExpectQuery(query).WithArgs(7777).WillReturnError(sql.ErrNoRows)
 			},
 			want:    nil,
@@ -258,10 +374,24 @@ func Test_newsRepository_FindNewsByID(t *testing.T) {
 
 func Test_newsRepository_UpdateNews(t *testing.T) {
 	type args struct {
-		ctx  context.Context
-		news domain.News
+		ctx    context.Context
+		params domain.UpdateNewsParams
 	}
 
+	news := domain.News{
+		Base: domain.Base{
+			ID: 1,
+		},
+		SchoolID:      1,
+		UserID:        1,
+		Title:         "소식 수정",
+		Body:          "소식 수정 본문",
+		ContentFormat: domain.NewsContentFormatPlain,
+		Status:        domain.NewsStatusPublished,
+	}
+	draft := news
+	draft.Status = domain.NewsStatusDraft
+
 	tests := []struct {
 		name    string
 		args    args
@@ -269,25 +399,90 @@ func Test_newsRepository_UpdateNews(t *testing.T) {
 		wantErr bool
 	}{
 		{
-			name: "PASS - 소식 수정",
+			name: "PASS - 발행된 소식 수정은 수정 전 내용을 리비전으로 남기고 수정 시각을 기록",
 			args: args{
 				ctx: context.Background(),
-				news: domain.News{
-					Base: domain.Base{
-						ID: 1,
-					},
-					SchoolID: 1,
-					UserID:   1,
-					Title:    "소식 수정",
+				params: domain.UpdateNewsParams{
+					News:           news,
+					PreviousStatus: domain.NewsStatusPublished,
+					EditorID:       2,
+				},
+			},
+			mock: func(ts newsRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				expectNewsContentLock(ts, domain.NewsStatusPublished, "소식 원본", "소식 원본 본문")
+				ts.sqlMock.ExpectExec("INSERT INTO news_revisions").
+					WithArgs(1, 2, "소식 원본", "", "소식 원본 본문", domain.NewsContentFormatPlain, 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				ts.sqlMock.ExpectExec("UPDATE news").
+					WithArgs("소식 수정", "", "소식 수정 본문", domain.NewsContentFormatPlain, domain.NewsStatusPublished, nil, sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectNewsOutboxEvent(ts, 1, "news.updated")
+				ts.sqlMock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 임시 저장 소식 수정은 리비전만 남기고 아웃박스에 기록하지 않음",
+			args: args{
+				ctx: context.Background(),
+				params: domain.UpdateNewsParams{
+					News:           draft,
+					PreviousStatus: domain.NewsStatusDraft,
+					EditorID:       1,
+				},
+			},
+			mock: func(ts newsRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				expectNewsContentLock(ts, domain.NewsStatusDraft, "소식 원본", "소식 원본 본문")
+				ts.sqlMock.ExpectExec("INSERT INTO news_revisions").
+					WithArgs(1, 1, "소식 원본", "", "소식 원본 본문", domain.NewsContentFormatPlain, 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				ts.sqlMock.ExpectExec("UPDATE news").
+					WithArgs("소식 수정", "", "소식 수정 본문", domain.NewsContentFormatPlain, domain.NewsStatusDraft, nil, nil, 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				ts.sqlMock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 내용을 바꾸지 않고 임시 저장 소식 발행",
+			args: args{
+				ctx: context.Background(),
+				params: domain.UpdateNewsParams{
+					News:           news,
+					PreviousStatus: domain.NewsStatusDraft,
+					EditorID:       1,
 				},
 			},
 			mock: func(ts newsRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				expectNewsContentLock(ts, domain.NewsStatusDraft, "소식 수정", "소식 수정 본문")
 				ts.sqlMock.ExpectExec("UPDATE news").
-					WithArgs("소식 수정", 1).
+					WithArgs("소식 수정", "", "소식 수정 본문", domain.NewsContentFormatPlain, domain.NewsStatusPublished, nil, nil, 1).
 					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectNewsOutboxEvent(ts, 1, "news.created")
+				ts.sqlMock.ExpectCommit()
 			},
 			wantErr: false,
 		},
+		{
+			name: "FAIL - 스케줄러가 먼저 발행한 예약 소식",
+			args: args{
+				ctx: context.Background(),
+				params: domain.UpdateNewsParams{
+					News:           draft,
+					PreviousStatus: domain.NewsStatusScheduled,
+					EditorID:       1,
+				},
+			},
+			mock: func(ts newsRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				expectNewsContentLock(ts, domain.NewsStatusPublished, "소식 원본", "소식 원본 본문")
+				ts.sqlMock.ExpectRollback()
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -297,19 +492,55 @@ func Test_newsRepository_UpdateNews(t *testing.T) {
 			tt.mock(ts)
 
 			// when
-			err := ts.newsRepository.UpdateNews(tt.args.ctx, tt.args.news)
+			err := ts.newsRepository.UpdateNews(tt.args.ctx, tt.args.params)
 
 			// then
-			if ts.sqlMock.ExpectationsWereMet() != nil {
-				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
-			}
-			if err != nil {
-				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
-			}
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
 		})
 	}
 }
 
+func Test_newsRepository_ListNewsRevisions(t *testing.T) {
+	// given
+	ts := setupNewsRepositoryTestSuite()
+	editDate := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	columns := []string{"id", "news_id", "revision", "editor_id", "title", "summary", "body", "content_format", "create_date"}
+	ts.sqlMock.ExpectQuery("SELECT (.+) FROM news_revisions WHERE news_id = (.+) ORDER BY revision DESC").WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(2, 1, 2, 1, "두 번째 제목", "", "두 번째 본문", domain.NewsContentFormatPlain, editDate).
+			AddRow(1, 1, 1, 2, "첫 번째 제목", "", "첫 번째 본문", domain.NewsContentFormatPlain, editDate))
+
+	// when
+	got, err := ts.newsRepository.ListNewsRevisions(context.Background(), 1)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.NewsRevision{
+		{ID: 2, NewsID: 1, Revision: 2, EditorID: 1, Title: "두 번째 제목", Body: "두 번째 본문", ContentFormat: domain.NewsContentFormatPlain, CreateDate: editDate},
+		{ID: 1, NewsID: 1, Revision: 1, EditorID: 2, Title: "첫 번째 제목", Body: "첫 번째 본문", ContentFormat: domain.NewsContentFormatPlain, CreateDate: editDate},
+	}, got)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_newsRepository_FindNewsRevision(t *testing.T) {
+	// given
+	ts := setupNewsRepositoryTestSuite()
+	ts.sqlMock.ExpectQuery("SELECT (.+) FROM news_revisions WHERE news_id = (.+) AND revision = ?").WithArgs(1, 7).
+		WillReturnError(sql.ErrNoRows)
+
+	// when
+	got, err := ts.newsRepository.FindNewsRevision(context.Background(), domain.FindNewsRevisionParams{
+		NewsID:   1,
+		Revision: 7,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
 func Test_newsRepository_DeleteNews(t *testing.T) {
 	type args struct {
 		ctx    context.Context
@@ -329,7 +560,27 @@ func Test_newsRepository_DeleteNews(t *testing.T) {
 				newsID: 1,
 			},
 			mock: func(ts newsRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectQuery("SELECT status FROM news WHERE id = (.+) FOR UPDATE").WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(domain.NewsStatusPublished))
 				ts.sqlMock.ExpectExec("UPDATE news").WillReturnResult(sqlmock.NewResult(1, 1))
+				expectNewsOutboxEvent(ts, 1, "news.deleted")
+				ts.sqlMock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 예약 소식 삭제는 아웃박스에 기록하지 않음",
+			args: args{
+				ctx:    context.Background(),
+				newsID: 1,
+			},
+			mock: func(ts newsRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectQuery("SELECT status FROM news WHERE id = (.+) FOR UPDATE").WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(domain.NewsStatusScheduled))
+				ts.sqlMock.ExpectExec("UPDATE news").WillReturnResult(sqlmock.NewResult(1, 1))
+				ts.sqlMock.ExpectCommit()
 			},
 			wantErr: false,
 		},
@@ -354,3 +605,235 @@ func Test_newsRepository_DeleteNews(t *testing.T) {
 		})
 	}
 }
+
+func Test_newsRepository_ListDueScheduledNews(t *testing.T) {
+	// given
+	ts := setupNewsRepositoryTestSuite()
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	columns := []string{"id", "create_date", "update_date", "delete_date", "school_id", "user_id", "title", "summary", "body", "content_format", "status", "publish_date", "edit_date", "priority"}
+	rows := sqlmock.NewRows(columns).
+		AddRow(1, now, now, nil, 2, 3, "예약 소식", "", "본문", "PLAIN", "SCHEDULED", now, nil, "NORMAL")
+	ts.sqlMock.ExpectQuery(`SELECT (.+) FROM news WHERE status = 'SCHEDULED' AND publish_date <= \? AND delete_date IS NULL AND school_id IN \(SELECT id FROM schools WHERE delete_date IS NULL\)`).
+		WithArgs(now, 10).
+		WillReturnRows(rows)
+
+	// when
+	got, err := ts.newsRepository.ListDueScheduledNews(context.Background(), domain.ListDueScheduledNewsParams{
+		Now:   now,
+		Limit: 10,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, domain.NewsStatusScheduled, got[0].Status)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_newsRepository_PublishScheduledNews(t *testing.T) {
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		mock    func(ts newsRepositoryTestSuite)
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "PASS - 예약 시각이 지난 소식 발행",
+			mock: func(ts newsRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectExec("UPDATE news SET status = 'PUBLISHED'").WithArgs(1, now).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectNewsOutboxEvent(ts, 1, "news.created")
+				ts.sqlMock.ExpectCommit()
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "PASS - 다른 인스턴스가 먼저 발행했거나 학교가 삭제된 소식",
+			mock: func(ts newsRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectExec("UPDATE news SET status = 'PUBLISHED'").WithArgs(1, now).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				ts.sqlMock.ExpectCommit()
+			},
+			want:    false,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupNewsRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.newsRepository.PublishScheduledNews(context.Background(), domain.PublishScheduledNewsParams{
+				NewsID: 1,
+				Now:    now,
+			})
+
+			// then
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_newsRepository_PinNews(t *testing.T) {
+	now := time.Date(2024, 3, 4, 8, 0, 0, 0, time.UTC)
+	params := domain.PinNewsParams{
+		NewsID:   1,
+		SchoolID: 2,
+		UserID:   3,
+		MaxPins:  3,
+		Now:      now,
+	}
+
+	tests := []struct {
+		name    string
+		mock    func(ts newsRepositoryTestSuite)
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "PASS - 소식 고정",
+			mock: func(ts newsRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectQuery(`SELECT id FROM schools WHERE id = \? FOR UPDATE`).WithArgs(2).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+				ts.sqlMock.ExpectQuery(`SELECT COUNT\(\*\) FROM news_pins WHERE school_id = \? AND news_id <> \? AND \(expire_date IS NULL OR expire_date > \?\)`).
+					WithArgs(2, 1, now).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+				ts.sqlMock.ExpectExec(`INSERT INTO news_pins \(news_id, school_id, user_id, expire_date\) VALUES \(\?, \?, \?, \?\) ON DUPLICATE KEY UPDATE`).
+					WithArgs(1, 2, 3, nil).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				ts.sqlMock.ExpectCommit()
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "PASS - 최대 고정 개수에 도달하면 고정하지 않음",
+			mock: func(ts newsRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectQuery(`SELECT id FROM schools`).WithArgs(2).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+				ts.sqlMock.ExpectQuery(`SELECT COUNT\(\*\) FROM news_pins`).WithArgs(2, 1, now).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+				ts.sqlMock.ExpectCommit()
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 서버 에러 시 롤백",
+			mock: func(ts newsRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectQuery(`SELECT id FROM schools`).WithArgs(2).WillReturnError(sql.ErrConnDone)
+				ts.sqlMock.ExpectRollback()
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupNewsRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.newsRepository.PinNews(context.Background(), params)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_newsRepository_ListPinnedNews(t *testing.T) {
+	// given
+	ts := setupNewsRepositoryTestSuite()
+	now := time.Date(2024, 3, 4, 8, 0, 0, 0, time.UTC)
+	columns := []string{"id", "create_date", "update_date", "delete_date", "school_id", "user_id", "title", "summary", "body", "content_format", "status", "publish_date", "edit_date", "priority"}
+	ts.sqlMock.ExpectQuery(`FROM news_pins JOIN news ON news.id = news_pins.news_id WHERE news_pins.school_id = \? AND \(news_pins.expire_date IS NULL OR news_pins.expire_date > \?\) AND news.status = 'PUBLISHED' AND news.delete_date IS NULL ORDER BY news_pins.create_date DESC`).
+		WithArgs(1, now).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(5, now, now, nil, 1, 1, "휴교 안내", "", "휴교 안내 본문", domain.NewsContentFormatPlain, domain.NewsStatusPublished, now, nil, domain.NewsPriorityUrgent))
+
+	// when
+	got, err := ts.newsRepository.ListPinnedNews(context.Background(), domain.ListPinnedNewsParams{SchoolID: 1, Now: now})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.News{
+		{
+			Base:          domain.Base{ID: 5, CreateDate: now, UpdateDate: now},
+			SchoolID:      1,
+			UserID:        1,
+			Title:         "휴교 안내",
+			Body:          "휴교 안내 본문",
+			ContentFormat: domain.NewsContentFormatPlain,
+			Status:        domain.NewsStatusPublished,
+			PublishDate:   sql.NullTime{Time: now, Valid: true},
+			Priority:      domain.NewsPriorityUrgent,
+		},
+	}, got)
+	if ts.sqlMock.ExpectationsWereMet() != nil {
+		t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+	}
+}
+
+func Test_newsRepository_UnpinNews(t *testing.T) {
+	// given
+	ts := setupNewsRepositoryTestSuite()
+	ts.sqlMock.ExpectExec(`DELETE FROM news_pins WHERE news_id = \?`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// when
+	err := ts.newsRepository.UnpinNews(context.Background(), 1)
+
+	// then
+	assert.NoError(t, err)
+	if ts.sqlMock.ExpectationsWereMet() != nil {
+		t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+	}
+}
+
+func Test_newsRepository_UpdateNewsPriority(t *testing.T) {
+	// given
+	ts := setupNewsRepositoryTestSuite()
+	ts.sqlMock.ExpectExec(`UPDATE news SET priority = \? WHERE id = \?`).WithArgs(domain.NewsPriorityUrgent, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// when
+	err := ts.newsRepository.UpdateNewsPriority(context.Background(), domain.UpdateNewsPriorityParams{NewsID: 1, Priority: domain.NewsPriorityUrgent})
+
+	// then
+	assert.NoError(t, err)
+	if ts.sqlMock.ExpectationsWereMet() != nil {
+		t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+	}
+}
+
+func expectNewsOutboxEvent(ts newsRepositoryTestSuite, newsID int, eventType string) {
+	columns := []string{"id", "create_date", "update_date", "delete_date", "school_id", "user_id", "title", "summary", "body", "content_format", "status", "publish_date", "edit_date", "priority"}
+	rows := sqlmock.NewRows(columns).AddRow(newsID, time.Now(), time.Now(), nil, 1, 1, "클래스팅 새소식", "", "클래스팅 새소식 본문", domain.NewsContentFormatPlain, domain.NewsStatusPublished, nil, nil, domain.NewsPriorityNormal)
+	ts.sqlMock.ExpectQuery("SELECT (.+) FROM news WHERE id = ?").WithArgs(newsID).WillReturnRows(rows)
+	ts.sqlMock.ExpectExec("INSERT INTO outbox").
+		WithArgs(domain.OutboxAggregateTypeNews, newsID, eventType, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+}
+
+func expectNewsContentLock(ts newsRepositoryTestSuite, status domain.NewsStatus, title, body string) {
+	columns := []string{"status", "title", "summary", "body", "content_format"}
+	ts.sqlMock.ExpectQuery("SELECT (.+) FROM news WHERE id = (.+) FOR UPDATE").WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(status, title, "", body, domain.NewsContentFormatPlain))
+}
@@ -0,0 +1,142 @@
+package news
+
+import (
+	"classting/config"
+	"classting/domain"
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	defaultSchedulerInterval  = 10 * time.Second
+	defaultSchedulerBatchSize = 100
+)
+
+// newsScheduler 발행 시각이 지난 예약 소식을 발행한다.
+// 여러 인스턴스에서 함께 실행되어도 PublishScheduledNews가 한 곳에서만 성공하므로 소식은 한 번만 발행된다.
+type newsScheduler struct {
+	newsRepository  domain.NewsRepository
+	timelineService domain.TimelineService
+	searchIndex     domain.SearchIndex
+	interval        time.Duration
+	batchSize       int
+	now             func() time.Time
+
+	done     chan struct{}
+	doneOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+func NewNewsScheduler(
+	newsRepository domain.NewsRepository,
+	timelineService domain.TimelineService,
+	searchIndex domain.SearchIndex,
+	cfg *config.Config,
+) *newsScheduler {
+	interval := defaultSchedulerInterval
+	if cfg.News.SchedulerIntervalSeconds > 0 {
+		interval = time.Duration(cfg.News.SchedulerIntervalSeconds) * time.Second
+	}
+	batchSize := defaultSchedulerBatchSize
+	if cfg.News.SchedulerBatchSize > 0 {
+		batchSize = cfg.News.SchedulerBatchSize
+	}
+
+	return &newsScheduler{
+		newsRepository:  newsRepository,
+		timelineService: timelineService,
+		searchIndex:     searchIndex,
+		interval:        interval,
+		batchSize:       batchSize,
+		now:             time.Now,
+		done:            make(chan struct{}),
+	}
+}
+
+// Run 예약 소식을 주기적으로 발행하는 스케줄러를 실행한다.
+func (s *newsScheduler) Run() {
+	s.wg.Add(1)
+	go s.schedule()
+}
+
+// Shutdown 스케줄러를 멈추고 진행 중인 발행이 끝날 때까지 기다린다.
+func (s *newsScheduler) Shutdown(ctx context.Context) error {
+	s.doneOnce.Do(func() {
+		close(s.done)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *newsScheduler) schedule() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			if err := s.publishDue(context.Background()); err != nil {
+				log.Printf("news: publish scheduled news: %v", err)
+			}
+		}
+	}
+}
+
+func (s *newsScheduler) publishDue(ctx context.Context) error {
+	now := s.now().UTC()
+	news, err := s.newsRepository.ListDueScheduledNews(ctx, domain.ListDueScheduledNewsParams{
+		Now:   now,
+		Limit: s.batchSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, n := range news {
+		select {
+		case <-s.done:
+			return nil
+		default:
+		}
+
+		published, err := s.newsRepository.PublishScheduledNews(ctx, domain.PublishScheduledNewsParams{
+			NewsID: n.ID,
+			Now:    now,
+		})
+		if err != nil {
+			log.Printf("news: publish scheduled news %d: %v", n.ID, err)
+			continue
+		}
+		// 다른 인스턴스가 먼저 발행했거나 그 사이 수정, 삭제된 소식이다.
+		if !published {
+			continue
+		}
+
+		n.Status = domain.NewsStatusPublished
+		if err := s.timelineService.FanOutNews(ctx, n); err != nil {
+			log.Printf("news: fan out news %d: %v", n.ID, err)
+		}
+		if err := s.searchIndex.IndexNews(ctx, n); err != nil {
+			log.Printf("news: index news %d: %v", n.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package news
+
+import (
+	"classting/config"
+	"classting/domain"
+	"classting/mocks"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+type newsSchedulerTestSuite struct {
+	newsRepository  *mocks.NewsRepository
+	timelineService *mocks.TimelineService
+	searchIndex     *mocks.SearchIndex
+	scheduler       *newsScheduler
+}
+
+func setupNewsSchedulerTestSuite(t *testing.T) newsSchedulerTestSuite {
+	var us newsSchedulerTestSuite
+
+	us.newsRepository = mocks.NewNewsRepository(t)
+	us.timelineService = mocks.NewTimelineService(t)
+	us.searchIndex = mocks.NewSearchIndex(t)
+	us.scheduler = NewNewsScheduler(us.newsRepository, us.timelineService, us.searchIndex, &config.Config{})
+	us.scheduler.now = func() time.Time { return testNow }
+
+	return us
+}
+
+func Test_newsScheduler_publishDue(t *testing.T) {
+	scheduled := domain.News{
+		Base: domain.Base{
+			ID: 1,
+		},
+		SchoolID: 1,
+		Title:    "예약 소식",
+		Status:   domain.NewsStatusScheduled,
+	}
+	published := scheduled
+	published.Status = domain.NewsStatusPublished
+
+	tests := []struct {
+		name    string
+		mock    func(ts newsSchedulerTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 발행 시각이 지난 예약 소식을 발행하고 타임라인에 반영",
+			mock: func(ts newsSchedulerTestSuite) {
+				ts.newsRepository.EXPECT().ListDueScheduledNews(mock.Anything, domain.ListDueScheduledNewsParams{
+					Now:   testNow,
+					Limit: defaultSchedulerBatchSize,
+				}).Return([]domain.News{scheduled}, nil).Once()
+				ts.newsRepository.EXPECT().PublishScheduledNews(mock.Anything, domain.PublishScheduledNewsParams{
+					NewsID: 1,
+					Now:    testNow,
+				}).Return(true, nil).Once()
+				ts.timelineService.EXPECT().FanOutNews(mock.Anything, published).Return(nil).Once()
+				ts.searchIndex.EXPECT().IndexNews(mock.Anything, published).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 다른 인스턴스가 먼저 발행한 소식은 타임라인에 반영하지 않음",
+			mock: func(ts newsSchedulerTestSuite) {
+				ts.newsRepository.EXPECT().ListDueScheduledNews(mock.Anything, mock.Anything).Return([]domain.News{scheduled}, nil).Once()
+				ts.newsRepository.EXPECT().PublishScheduledNews(mock.Anything, mock.Anything).Return(false, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 예약 소식 조회 실패",
+			mock: func(ts newsSchedulerTestSuite) {
+				ts.newsRepository.EXPECT().ListDueScheduledNews(mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupNewsSchedulerTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.scheduler.publishDue(context.Background())
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
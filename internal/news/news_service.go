@@ -1,24 +1,55 @@
 package news
 
 import (
+	"classting/config"
 	"classting/domain"
+	"classting/internal/school"
 	"classting/pkg/cerrors"
+	"classting/pkg/pagination"
 	"context"
+	"database/sql"
+	"fmt"
 	"k8s.io/utils/pointer"
+	"log"
+	"time"
 )
 
 type newsService struct {
-	newsRepository   domain.NewsRepository
-	schoolRepository domain.SchoolRepository
+	newsRepository    domain.NewsRepository
+	schoolRepository  domain.SchoolRepository
+	timelineService   domain.TimelineService
+	attachmentService domain.AttachmentService
+	searchIndex       domain.SearchIndex
+	paginator         *pagination.Paginator
+	maxPins           int
+	now               func() time.Time
 }
 
+const defaultMaxPins = 3
+
 func NewNewsService(
 	newsRepository domain.NewsRepository,
 	schoolRepository domain.SchoolRepository,
+	timelineService domain.TimelineService,
+	attachmentService domain.AttachmentService,
+	searchIndex domain.SearchIndex,
+	paginator *pagination.Paginator,
+	cfg *config.Config,
 ) *newsService {
+	maxPins := defaultMaxPins
+	if cfg.News.MaxPins > 0 {
+		maxPins = cfg.News.MaxPins
+	}
+
 	return &newsService{
-		newsRepository:   newsRepository,
-		schoolRepository: schoolRepository,
+		newsRepository:    newsRepository,
+		schoolRepository:  schoolRepository,
+		timelineService:   timelineService,
+		attachmentService: attachmentService,
+		searchIndex:       searchIndex,
+		paginator:         paginator,
+		maxPins:           maxPins,
+		now:               time.Now,
 	}
 }
 
@@ -27,25 +58,42 @@ var _ domain.NewsService = (*newsService)(nil)
 func (s newsService) CreateNews(ctx context.Context, req domain.CreateNewsRequest) error {
 	const op cerrors.Op = "news/service/createNews"
 
-	school, err := s.schoolRepository.FindSchoolByID(ctx, req.SchoolID)
-	if err != nil {
+	if _, err := school.AuthorizeActiveMember(ctx, s.schoolRepository, op, req.SchoolID, req.UserID, domain.SchoolRoleEditor); err != nil {
 		return err
 	}
-	if school == nil {
-		return cerrors.E(op, cerrors.Invalid, "해당 학교가 존재하지 않습니다.")
-	}
-	if school.UserID != req.UserID {
-		return cerrors.E(op, cerrors.Permission, "해당 학교에 대한 권한이 없습니다.")
-	}
 
-	_, err = s.newsRepository.CreateNews(ctx, domain.News{
-		SchoolID: req.SchoolID,
-		UserID:   req.UserID,
-		Title:    req.Title,
+	news := domain.NormalizeNewsContent(domain.News{
+		SchoolID:      req.SchoolID,
+		UserID:        req.UserID,
+		Title:         req.Title,
+		Summary:       req.Summary,
+		Body:          req.Body,
+		ContentFormat: req.ContentFormat,
+		Status:        req.NewsStatus(),
+		Priority:      req.NewsPriority(),
 	})
+	if news.Status == domain.NewsStatusPublished {
+		news.PublishDate = sql.NullTime{Time: s.now().UTC(), Valid: true}
+	} else if req.PublishAt != nil {
+		news.PublishDate = sql.NullTime{Time: req.PublishAt.UTC(), Valid: true}
+	}
+	newsID, err := s.newsRepository.CreateNews(ctx, news)
 	if err != nil {
 		return err
 	}
+	news.ID = newsID
+
+	s.indexNews(ctx, news)
+
+	// 임시 저장, 예약한 소식은 발행될 때 타임라인에 반영한다.
+	if news.Status != domain.NewsStatusPublished {
+		return nil
+	}
+
+	// 소식은 이미 발행되었으므로 타임라인 반영 실패는 로그만 남긴다.
+	if err := s.timelineService.FanOutNews(ctx, news); err != nil {
+		log.Printf("news: fan out news %d: %v", news.ID, err)
+	}
 
 	return nil
 }
@@ -53,49 +101,117 @@ func (s newsService) CreateNews(ctx context.Context, req domain.CreateNewsReques
 func (s newsService) ListNews(ctx context.Context, req domain.ListNewsRequest) (domain.ListNewsResponse, error) {
 	const op cerrors.Op = "news/service/ListNews"
 
-	news, err := s.newsRepository.ListNews(ctx, domain.ListNewsParams{
-		UserID:   pointer.Int(req.UserID),
+	if _, err := school.AuthorizeMember(ctx, s.schoolRepository, op, req.SchoolID, req.UserID, domain.SchoolRoleViewer); err != nil {
+		return domain.ListNewsResponse{}, err
+	}
+
+	filter := pagination.Fingerprint("news", req.SchoolID, req.Status)
+	pageReq, err := s.paginator.Request(req.Cursor, req.Size, filter)
+	if err != nil {
+		return domain.ListNewsResponse{}, cerrors.E(op, cerrors.Invalid, err, "커서를 확인해주세요.")
+	}
+
+	listed, err := s.newsRepository.ListNews(ctx, domain.ListNewsParams{
 		SchoolID: pointer.Int(req.SchoolID),
-		Cursor:   req.Cursor,
+		Cursor:   pageReq.Cursor,
+		Limit:    pageReq.Limit(),
+		Status:   req.Status,
 	})
 	if err != nil {
 		return domain.ListNewsResponse{}, cerrors.E(op, cerrors.Internal, err, "소식을 조회하는 중에 에러가 발생했습니다.")
 	}
 
-	var newsDTOS []domain.NewsDTO
-	for _, n := range news {
-		newsDTOS = append(newsDTOS, domain.NewsDTOFrom(n))
+	page := pagination.NewPage(listed, pageReq, func(n domain.News) pagination.Cursor {
+		return pagination.Cursor{ID: n.ID, Filter: filter}
+	})
+	news := page.Items
+
+	attachments, err := s.attachmentService.ListNewsAttachments(ctx, domain.NewsIDs(news))
+	if err != nil {
+		return domain.ListNewsResponse{}, err
 	}
 
-	var cursor *int
-	if len(newsDTOS) > 0 {
-		cursor = &newsDTOS[len(newsDTOS)-1].ID
+	var newsDTOS []domain.NewsDTO
+	for _, n := range news {
+		newsDTO := domain.NewsDTOFrom(n)
+		newsDTO.Attachments = attachments[n.ID]
+		if req.Format == domain.NewsResponseFormatHTML {
+			newsDTO = newsDTO.RenderHTML()
+		}
+		newsDTOS = append(newsDTOS, newsDTO)
 	}
 
 	return domain.ListNewsResponse{
-		News:   newsDTOS,
-		Cursor: cursor,
+		News:       newsDTOS,
+		Cursor:     s.paginator.Encode(page.Next),
+		PrevCursor: s.paginator.Encode(page.Prev),
 	}, nil
 }
 
 func (s newsService) UpdateNews(ctx context.Context, req domain.UpdateNewsRequest) error {
 	const op cerrors.Op = "news/service/UpdateNews"
 
-	news, err := s.newsRepository.FindNewsByID(ctx, req.ID)
+	news, err := s.findEditableNews(ctx, op, req.ID, req.UserID, domain.SchoolRoleEditor)
 	if err != nil {
-		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		return err
+	}
+
+	if news.Status == domain.NewsStatusArchived {
+		return cerrors.E(op, cerrors.Invalid, "보관된 소식은 수정할 수 없습니다.")
 	}
-	if news == nil {
-		return cerrors.E(op, cerrors.NotExist, "소식을 찾을 수 없습니다.")
+
+	previousStatus := news.Status
+	status := req.Status
+	if status == "" {
+		status = previousStatus
+	}
+	if !previousStatus.CanTransitionTo(status) {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("%s 상태의 소식은 %s 상태로 변경할 수 없습니다.", previousStatus, status))
 	}
-	if news.UserID != req.UserID {
-		return cerrors.E(op, cerrors.Permission, "소식을 수정할 권한이 없습니다.")
+
+	switch status {
+	case domain.NewsStatusDraft, domain.NewsStatusScheduled:
+		if req.PublishAt != nil {
+			news.PublishDate = sql.NullTime{Time: req.PublishAt.UTC(), Valid: true}
+		}
+		if status == domain.NewsStatusScheduled && (!news.PublishDate.Valid || !news.PublishDate.Time.After(s.now())) {
+			return cerrors.E(op, cerrors.Invalid, "예약 발행 시각은 현재 이후여야 합니다.")
+		}
+	case domain.NewsStatusPublished:
+		if previousStatus != domain.NewsStatusPublished {
+			news.PublishDate = sql.NullTime{Time: s.now().UTC(), Valid: true}
+		}
 	}
 
 	news.Title = req.Title
+	news.Summary = req.Summary
+	news.Body = req.Body
+	news.ContentFormat = req.ContentFormat
+	news.Status = status
+	*news = domain.NormalizeNewsContent(*news)
+
+	err = s.newsRepository.UpdateNews(ctx, domain.UpdateNewsParams{
+		News:           *news,
+		PreviousStatus: previousStatus,
+		EditorID:       req.UserID,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.indexNews(ctx, *news)
 
-	if err := s.newsRepository.UpdateNews(ctx, *news); err != nil {
-		return cerrors.E(op, cerrors.Internal, err, "소식을 수정하는 중에 에러가 발생했습니다.")
+	// 발행되면 타임라인에 반영하고 보관되면 타임라인에서 숨긴다.
+	eventType, _ := domain.NewsEventTypeForTransition(previousStatus, status)
+	switch eventType {
+	case domain.NewsEventTypeCreated:
+		if err := s.timelineService.FanOutNews(ctx, *news); err != nil {
+			log.Printf("news: fan out news %d: %v", news.ID, err)
+		}
+	case domain.NewsEventTypeDeleted:
+		if err := s.timelineService.HideNews(ctx, news.ID); err != nil {
+			log.Printf("news: hide news %d: %v", news.ID, err)
+		}
 	}
 
 	return nil
@@ -104,20 +220,225 @@ func (s newsService) UpdateNews(ctx context.Context, req domain.UpdateNewsReques
 func (s newsService) DeleteNews(ctx context.Context, req domain.DeleteNewsRequest) error {
 	const op cerrors.Op = "news/service/DeleteNews"
 
-	news, err := s.newsRepository.FindNewsByID(ctx, req.ID)
+	if _, err := s.findEditableNews(ctx, op, req.ID, req.UserID, domain.SchoolRoleEditor); err != nil {
+		return err
+	}
+
+	if err := s.newsRepository.DeleteNews(ctx, req.ID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "소식을 삭제하는 중에 에러가 발생했습니다.")
+	}
+
+	if err := s.timelineService.HideNews(ctx, req.ID); err != nil {
+		log.Printf("news: hide news %d: %v", req.ID, err)
+	}
+
+	if err := s.searchIndex.RemoveNews(ctx, req.ID); err != nil {
+		log.Printf("news: remove news %d from search index: %v", req.ID, err)
+	}
+
+	if err := s.attachmentService.DeleteNewsAttachments(ctx, req.ID); err != nil {
+		log.Printf("news: delete attachments of news %d: %v", req.ID, err)
+	}
+
+	return nil
+}
+
+func (s newsService) ListNewsRevisions(ctx context.Context, req domain.ListNewsRevisionsRequest) (domain.ListNewsRevisionsResponse, error) {
+	const op cerrors.Op = "news/service/ListNewsRevisions"
+
+	if _, err := s.findMemberNews(ctx, op, req.NewsID, req.UserID, domain.SchoolRoleViewer); err != nil {
+		return domain.ListNewsRevisionsResponse{}, err
+	}
+
+	revisions, err := s.newsRepository.ListNewsRevisions(ctx, req.NewsID)
 	if err != nil {
-		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		return domain.ListNewsRevisionsResponse{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 	}
-	if news == nil {
-		return cerrors.E(op, cerrors.NotExist, "소식을 찾을 수 없습니다.")
+
+	revisionDTOs := make([]domain.NewsRevisionDTO, 0, len(revisions))
+	for _, revision := range revisions {
+		revisionDTOs = append(revisionDTOs, domain.NewsRevisionDTOFrom(revision))
 	}
-	if news.UserID != req.UserID {
-		return cerrors.E(op, cerrors.Permission, "소식을 삭제할 권한이 없습니다.")
+
+	return domain.ListNewsRevisionsResponse{
+		Revisions: revisionDTOs,
+	}, nil
+}
+
+func (s newsService) DiffNewsRevisions(ctx context.Context, req domain.DiffNewsRevisionsRequest) (domain.NewsRevisionDiffDTO, error) {
+	const op cerrors.Op = "news/service/DiffNewsRevisions"
+
+	news, err := s.findMemberNews(ctx, op, req.NewsID, req.UserID, domain.SchoolRoleViewer)
+	if err != nil {
+		return domain.NewsRevisionDiffDTO{}, err
 	}
 
-	if err := s.newsRepository.DeleteNews(ctx, req.ID); err != nil {
-		return cerrors.E(op, cerrors.Internal, err, "소식을 삭제하는 중에 에러가 발생했습니다.")
+	before, err := s.findNewsRevision(ctx, op, req.NewsID, req.From)
+	if err != nil {
+		return domain.NewsRevisionDiffDTO{}, err
+	}
+
+	after := *news
+	if req.To > 0 {
+		revision, err := s.findNewsRevision(ctx, op, req.NewsID, req.To)
+		if err != nil {
+			return domain.NewsRevisionDiffDTO{}, err
+		}
+		after = revision.ApplyTo(domain.News{})
+	}
+
+	return domain.NewsRevisionDiffDTOFrom(req.NewsID, req.From, req.To, before.ApplyTo(domain.News{}), after), nil
+}
+
+// RollbackNews 소식을 선택한 리비전의 내용으로 되돌린다. 되돌리기 전 내용도 새 리비전으로 남는다.
+func (s newsService) RollbackNews(ctx context.Context, req domain.RollbackNewsRequest) error {
+	const op cerrors.Op = "news/service/RollbackNews"
+
+	news, err := s.findEditableNews(ctx, op, req.NewsID, req.UserID, domain.SchoolRoleOwner)
+	if err != nil {
+		return err
+	}
+	if news.Status == domain.NewsStatusArchived {
+		return cerrors.E(op, cerrors.Invalid, "보관된 소식은 되돌릴 수 없습니다.")
+	}
+
+	revision, err := s.findNewsRevision(ctx, op, req.NewsID, req.Revision)
+	if err != nil {
+		return err
+	}
+
+	rollback := revision.ApplyTo(*news)
+	if rollback.SameContent(*news) {
+		return nil
+	}
+
+	err = s.newsRepository.UpdateNews(ctx, domain.UpdateNewsParams{
+		News:           rollback,
+		PreviousStatus: news.Status,
+		EditorID:       req.UserID,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.indexNews(ctx, rollback)
+
+	return nil
+}
+
+// UpdateNewsPriority 우선순위는 소식 내용이 아니므로 리비전이나 수정 시각을 남기지 않는다.
+func (s newsService) UpdateNewsPriority(ctx context.Context, req domain.UpdateNewsPriorityRequest) error {
+	const op cerrors.Op = "news/service/UpdateNewsPriority"
+
+	news, err := s.findEditableNews(ctx, op, req.NewsID, req.UserID, domain.SchoolRoleEditor)
+	if err != nil {
+		return err
+	}
+	if news.Priority == req.Priority {
+		return nil
+	}
+
+	return s.newsRepository.UpdateNewsPriority(ctx, domain.UpdateNewsPriorityParams{
+		NewsID:   news.ID,
+		Priority: req.Priority,
+	})
+}
+
+// PinNews 발행된 소식만 학교 소식 목록 상단에 고정할 수 있다.
+func (s newsService) PinNews(ctx context.Context, req domain.PinNewsRequest) error {
+	const op cerrors.Op = "news/service/PinNews"
+
+	news, err := s.findEditableNews(ctx, op, req.NewsID, req.UserID, domain.SchoolRoleEditor)
+	if err != nil {
+		return err
+	}
+	if news.Status != domain.NewsStatusPublished {
+		return cerrors.E(op, cerrors.Invalid, "발행된 소식만 고정할 수 있습니다.")
+	}
+
+	var expireDate sql.NullTime
+	if req.ExpireAt != nil {
+		expireDate = sql.NullTime{Time: req.ExpireAt.UTC(), Valid: true}
+	}
+
+	pinned, err := s.newsRepository.PinNews(ctx, domain.PinNewsParams{
+		NewsID:     news.ID,
+		SchoolID:   news.SchoolID,
+		UserID:     req.UserID,
+		ExpireDate: expireDate,
+		MaxPins:    s.maxPins,
+		Now:        s.now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+	if !pinned {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("학교마다 소식은 최대 %d개까지 고정할 수 있습니다.", s.maxPins))
 	}
 
 	return nil
 }
+
+func (s newsService) UnpinNews(ctx context.Context, req domain.UnpinNewsRequest) error {
+	const op cerrors.Op = "news/service/UnpinNews"
+
+	news, err := s.findEditableNews(ctx, op, req.NewsID, req.UserID, domain.SchoolRoleEditor)
+	if err != nil {
+		return err
+	}
+
+	return s.newsRepository.UnpinNews(ctx, news.ID)
+}
+
+// indexNews 소식은 이미 저장되었으므로 검색 색인 반영 실패는 로그만 남긴다.
+func (s newsService) indexNews(ctx context.Context, news domain.News) {
+	if err := s.searchIndex.IndexNews(ctx, news); err != nil {
+		log.Printf("news: index news %d: %v", news.ID, err)
+	}
+}
+
+// findMemberNews 삭제되지 않은 소식을 조회하고 유저가 소식이 속한 학교에서 role 이상의 역할인지 확인한다.
+func (s newsService) findMemberNews(ctx context.Context, op cerrors.Op, newsID, userID int, role domain.SchoolRole) (*domain.News, error) {
+	return s.findNews(ctx, op, newsID, userID, role, school.AuthorizeMember)
+}
+
+// findEditableNews findMemberNews에 더해 삭제된 학교의 소식은 바꿀 수 없도록 거부한다.
+func (s newsService) findEditableNews(ctx context.Context, op cerrors.Op, newsID, userID int, role domain.SchoolRole) (*domain.News, error) {
+	return s.findNews(ctx, op, newsID, userID, role, school.AuthorizeActiveMember)
+}
+
+func (s newsService) findNews(
+	ctx context.Context,
+	op cerrors.Op,
+	newsID, userID int,
+	role domain.SchoolRole,
+	authorize func(context.Context, domain.SchoolRepository, cerrors.Op, int, int, domain.SchoolRole) (*domain.School, error),
+) (*domain.News, error) {
+	news, err := s.newsRepository.FindNewsByID(ctx, newsID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	if news == nil || news.DeleteDate.Valid {
+		return nil, cerrors.E(op, cerrors.NotExist, "소식을 찾을 수 없습니다.")
+	}
+	if _, err := authorize(ctx, s.schoolRepository, op, news.SchoolID, userID, role); err != nil {
+		return nil, err
+	}
+
+	return news, nil
+}
+
+func (s newsService) findNewsRevision(ctx context.Context, op cerrors.Op, newsID, revision int) (*domain.NewsRevision, error) {
+	newsRevision, err := s.newsRepository.FindNewsRevision(ctx, domain.FindNewsRevisionParams{
+		NewsID:   newsID,
+		Revision: revision,
+	})
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	if newsRevision == nil {
+		return nil, cerrors.E(op, cerrors.NotExist, fmt.Sprintf("%d번 리비전을 찾을 수 없습니다.", revision))
+	}
+
+	return newsRevision, nil
+}
@@ -1,27 +1,42 @@
 package news
 
 import (
+	"classting/config"
 	"classting/domain"
 	"classting/mocks"
+	"classting/pkg/pagination"
 	"context"
+	"database/sql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"k8s.io/utils/pointer"
 	"testing"
+	"time"
 )
 
 type newsServiceTestSuite struct {
-	schoolRepository *mocks.SchoolRepository
-	newsRepository   *mocks.NewsRepository
-	service          domain.NewsService
+	schoolRepository  *mocks.SchoolRepository
+	newsRepository    *mocks.NewsRepository
+	timelineService   *mocks.TimelineService
+	attachmentService *mocks.AttachmentService
+	searchIndex       *mocks.SearchIndex
+	service           *newsService
 }
 
+var testNow = time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+var testPaginator = pagination.New("classting_test_secret", 2, 5)
+
 func setupNewsServiceTestSuite(t *testing.T) newsServiceTestSuite {
 	var us newsServiceTestSuite
 
 	us.schoolRepository = mocks.NewSchoolRepository(t)
 	us.newsRepository = mocks.NewNewsRepository(t)
-	us.service = NewNewsService(us.newsRepository, us.schoolRepository)
+	us.timelineService = mocks.NewTimelineService(t)
+	us.attachmentService = mocks.NewAttachmentService(t)
+	us.searchIndex = mocks.NewSearchIndex(t)
+	us.service = NewNewsService(us.newsRepository, us.schoolRepository, us.timelineService, us.attachmentService, us.searchIndex, testPaginator, &config.Config{})
+	us.service.now = func() time.Time { return testNow }
 
 	return us
 }
@@ -39,13 +54,159 @@ func Test_newsService_CreateNews(t *testing.T) {
 		wantErr bool
 	}{
 		{
-			name: "PASS - 새로운 소식 발행",
+			name: "PASS - EDITOR 멤버의 새로운 소식 발행",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateNewsRequest{
+					UserID:        1,
+					SchoolID:      1,
+					Title:         "클래스팅 소식",
+					Summary:       "요약",
+					Body:          "**클래스팅** 소식 본문",
+					ContentFormat: domain.NewsContentFormatMarkdown,
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 2,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleEditor,
+				}, nil).Once()
+				ts.newsRepository.EXPECT().CreateNews(mock.Anything, domain.News{
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 소식",
+					Summary:       "요약",
+					Body:          "**클래스팅** 소식 본문",
+					ContentFormat: domain.NewsContentFormatMarkdown,
+					Status:        domain.NewsStatusPublished,
+					PublishDate:   sql.NullTime{Time: testNow, Valid: true},
+					Priority:      domain.NewsPriorityNormal,
+				}).Return(1, nil).Once()
+				ts.searchIndex.EXPECT().IndexNews(mock.Anything, domain.News{
+					Base: domain.Base{
+						ID: 1,
+					},
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 소식",
+					Summary:       "요약",
+					Body:          "**클래스팅** 소식 본문",
+					ContentFormat: domain.NewsContentFormatMarkdown,
+					Status:        domain.NewsStatusPublished,
+					PublishDate:   sql.NullTime{Time: testNow, Valid: true},
+					Priority:      domain.NewsPriorityNormal,
+				}).Return(nil).Once()
+				ts.timelineService.EXPECT().FanOutNews(mock.Anything, domain.News{
+					Base: domain.Base{
+						ID: 1,
+					},
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 소식",
+					Summary:       "요약",
+					Body:          "**클래스팅** 소식 본문",
+					ContentFormat: domain.NewsContentFormatMarkdown,
+					Status:        domain.NewsStatusPublished,
+					PublishDate:   sql.NullTime{Time: testNow, Valid: true},
+					Priority:      domain.NewsPriorityNormal,
+				}).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 긴급 소식 발행",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateNewsRequest{
+					UserID:        1,
+					SchoolID:      1,
+					Title:         "클래스팅 소식",
+					Summary:       "요약",
+					Body:          "**클래스팅** 소식 본문",
+					ContentFormat: domain.NewsContentFormatMarkdown,
+					Priority:      domain.NewsPriorityUrgent,
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 2,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleEditor,
+				}, nil).Once()
+				ts.newsRepository.EXPECT().CreateNews(mock.Anything, domain.News{
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 소식",
+					Summary:       "요약",
+					Body:          "**클래스팅** 소식 본문",
+					ContentFormat: domain.NewsContentFormatMarkdown,
+					Status:        domain.NewsStatusPublished,
+					PublishDate:   sql.NullTime{Time: testNow, Valid: true},
+					Priority:      domain.NewsPriorityUrgent,
+				}).Return(1, nil).Once()
+				ts.searchIndex.EXPECT().IndexNews(mock.Anything, domain.News{
+					Base: domain.Base{
+						ID: 1,
+					},
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 소식",
+					Summary:       "요약",
+					Body:          "**클래스팅** 소식 본문",
+					ContentFormat: domain.NewsContentFormatMarkdown,
+					Status:        domain.NewsStatusPublished,
+					PublishDate:   sql.NullTime{Time: testNow, Valid: true},
+					Priority:      domain.NewsPriorityUrgent,
+				}).Return(nil).Once()
+				ts.timelineService.EXPECT().FanOutNews(mock.Anything, domain.News{
+					Base: domain.Base{
+						ID: 1,
+					},
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 소식",
+					Summary:       "요약",
+					Body:          "**클래스팅** 소식 본문",
+					ContentFormat: domain.NewsContentFormatMarkdown,
+					Status:        domain.NewsStatusPublished,
+					PublishDate:   sql.NullTime{Time: testNow, Valid: true},
+					Priority:      domain.NewsPriorityUrgent,
+				}).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 본문은 입력한 그대로 저장",
 			args: args{
 				ctx: context.Background(),
 				req: domain.CreateNewsRequest{
 					UserID:   1,
 					SchoolID: 1,
 					Title:    "클래스팅 소식",
+					Body:     "<b>클래스팅</b> 소식<script>alert(1)</script> 본문",
 				},
 			},
 			mock: func(ts newsServiceTestSuite) {
@@ -57,16 +218,142 @@ func Test_newsService_CreateNews(t *testing.T) {
 					Name:   "클래스팅",
 					Region: "서울",
 				}, nil).Once()
-				ts.newsRepository.EXPECT().CreateNews(mock.Anything, domain.News{
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
 					SchoolID: 1,
 					UserID:   1,
-					Title:    "클래스팅 소식",
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+				ts.newsRepository.EXPECT().CreateNews(mock.Anything, domain.News{
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 소식",
+					Body:          "<b>클래스팅</b> 소식<script>alert(1)</script> 본문",
+					ContentFormat: domain.NewsContentFormatPlain,
+					Status:        domain.NewsStatusPublished,
+					PublishDate:   sql.NullTime{Time: testNow, Valid: true},
+					Priority:      domain.NewsPriorityNormal,
+				}).Return(1, nil).Once()
+				ts.searchIndex.EXPECT().IndexNews(mock.Anything, domain.News{
+					Base: domain.Base{
+						ID: 1,
+					},
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 소식",
+					Body:          "<b>클래스팅</b> 소식<script>alert(1)</script> 본문",
+					ContentFormat: domain.NewsContentFormatPlain,
+					Status:        domain.NewsStatusPublished,
+					PublishDate:   sql.NullTime{Time: testNow, Valid: true},
+					Priority:      domain.NewsPriorityNormal,
+				}).Return(nil).Once()
+				ts.timelineService.EXPECT().FanOutNews(mock.Anything, domain.News{
+					Base: domain.Base{
+						ID: 1,
+					},
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 소식",
+					Body:          "<b>클래스팅</b> 소식<script>alert(1)</script> 본문",
+					ContentFormat: domain.NewsContentFormatPlain,
+					Status:        domain.NewsStatusPublished,
+					PublishDate:   sql.NullTime{Time: testNow, Valid: true},
+					Priority:      domain.NewsPriorityNormal,
+				}).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 예약 소식은 발행 시각 전까지 타임라인에 반영하지 않음",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateNewsRequest{
+					UserID:    1,
+					SchoolID:  1,
+					Title:     "클래스팅 소식",
+					Body:      "클래스팅 소식 본문",
+					PublishAt: timePtr(testNow.Add(time.Hour)),
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				expectSchoolMember(ts, domain.SchoolRoleEditor)
+				ts.newsRepository.EXPECT().CreateNews(mock.Anything, domain.News{
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 소식",
+					Body:          "클래스팅 소식 본문",
+					ContentFormat: domain.NewsContentFormatPlain,
+					Status:        domain.NewsStatusScheduled,
+					PublishDate:   sql.NullTime{Time: testNow.Add(time.Hour), Valid: true},
+					Priority:      domain.NewsPriorityNormal,
 				}).Return(1, nil).Once()
+				ts.searchIndex.EXPECT().IndexNews(mock.Anything, mock.MatchedBy(func(news domain.News) bool {
+					return news.ID == 1 && news.Status == domain.NewsStatusScheduled
+				})).Return(nil).Once()
 			},
 			wantErr: false,
 		},
 		{
-			name: "FAIL - 타인의 학교 소식 발행",
+			name: "FAIL - 학교 멤버가 아닌 유저의 소식 발행",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateNewsRequest{
+					UserID:   1,
+					SchoolID: 1,
+					Title:    "클래스팅 소식",
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 2,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 삭제된 학교에 소식 발행",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateNewsRequest{
+					UserID:   1,
+					SchoolID: 1,
+					Title:    "클래스팅 소식",
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID:         1,
+						DeleteDate: sql.NullTime{Time: time.Now(), Valid: true},
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - VIEWER 멤버의 소식 발행",
 			args: args{
 				ctx: context.Background(),
 				req: domain.CreateNewsRequest{
@@ -84,6 +371,14 @@ func Test_newsService_CreateNews(t *testing.T) {
 					Name:   "클래스팅",
 					Region: "서울",
 				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleViewer,
+				}, nil).Once()
 			},
 			wantErr: true,
 		},
@@ -101,6 +396,7 @@ func Test_newsService_CreateNews(t *testing.T) {
 			// then
 			ts.schoolRepository.AssertExpectations(t)
 			ts.newsRepository.AssertExpectations(t)
+			ts.timelineService.AssertExpectations(t)
 			if err != nil {
 				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
 			}
@@ -114,6 +410,26 @@ func Test_newsService_ListNews(t *testing.T) {
 		req domain.ListNewsRequest
 	}
 
+	filter := pagination.Fingerprint("news", 1, domain.NewsStatus(""))
+	authorize := func(ts newsServiceTestSuite) {
+		ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+			Base: domain.Base{
+				ID: 1,
+			},
+			UserID: 2,
+			Name:   "클래스팅",
+			Region: "서울",
+		}, nil).Once()
+		ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+			SchoolID: 1,
+			UserID:   1,
+		}).Return(&domain.SchoolMember{
+			SchoolID: 1,
+			UserID:   1,
+			Role:     domain.SchoolRoleViewer,
+		}, nil).Once()
+	}
+
 	tests := []struct {
 		name    string
 		args    args
@@ -128,14 +444,13 @@ func Test_newsService_ListNews(t *testing.T) {
 				req: domain.ListNewsRequest{
 					UserID:   1,
 					SchoolID: 1,
-					Cursor:   nil,
 				},
 			},
 			mock: func(ts newsServiceTestSuite) {
+				authorize(ts)
 				ts.newsRepository.EXPECT().ListNews(mock.Anything, domain.ListNewsParams{
-					UserID:   pointer.Int(1),
 					SchoolID: pointer.Int(1),
-					Cursor:   nil,
+					Limit:    3,
 				}).Return([]domain.News{
 					{
 						Base: domain.Base{
@@ -146,6 +461,16 @@ func Test_newsService_ListNews(t *testing.T) {
 						Title:    "클래스팅 소식",
 					},
 				}, nil).Once()
+				ts.attachmentService.EXPECT().ListNewsAttachments(mock.Anything, []int{1}).Return(map[int][]domain.AttachmentDTO{
+					1: {
+						{
+							ID:          1,
+							NewsID:      1,
+							FileName:    "급식표.pdf",
+							ContentType: "application/pdf",
+						},
+					},
+				}, nil).Once()
 			},
 			want: domain.ListNewsResponse{
 				News: []domain.NewsDTO{
@@ -155,52 +480,188 @@ func Test_newsService_ListNews(t *testing.T) {
 						},
 						SchoolID: 1,
 						Title:    "클래스팅 소식",
+						Attachments: []domain.AttachmentDTO{
+							{
+								ID:          1,
+								NewsID:      1,
+								FileName:    "급식표.pdf",
+								ContentType: "application/pdf",
+							},
+						},
 					},
 				},
-				Cursor: pointer.Int(1),
 			},
 			wantErr: false,
 		},
 		{
-			name: "PASS - 페이징 조회",
+			name: "PASS - 마크다운 본문을 HTML로 렌더링해 조회",
 			args: args{
 				ctx: context.Background(),
 				req: domain.ListNewsRequest{
 					UserID:   1,
 					SchoolID: 1,
-					Cursor:   pointer.Int(1),
+					Format:   domain.NewsResponseFormatHTML,
 				},
 			},
 			mock: func(ts newsServiceTestSuite) {
+				authorize(ts)
 				ts.newsRepository.EXPECT().ListNews(mock.Anything, domain.ListNewsParams{
-					UserID:   pointer.Int(1),
 					SchoolID: pointer.Int(1),
-					Cursor:   pointer.Int(1),
+					Limit:    3,
 				}).Return([]domain.News{
 					{
 						Base: domain.Base{
-							ID: 2,
+							ID: 1,
 						},
-						SchoolID: 1,
-						UserID:   1,
-						Title:    "클래스팅 소식",
+						SchoolID:      1,
+						UserID:        1,
+						Title:         "클래스팅 소식",
+						Body:          "**클래스팅** [링크](javascript:alert(1))",
+						ContentFormat: domain.NewsContentFormatMarkdown,
 					},
 				}, nil).Once()
+				ts.attachmentService.EXPECT().ListNewsAttachments(mock.Anything, []int{1}).Return(nil, nil).Once()
 			},
 			want: domain.ListNewsResponse{
 				News: []domain.NewsDTO{
 					{
 						BaseDTO: domain.BaseDTO{
-							ID: 2,
+							ID: 1,
 						},
-						SchoolID: 1,
-						Title:    "클래스팅 소식",
+						SchoolID:      1,
+						Title:         "클래스팅 소식",
+						Body:          "<p><strong>클래스팅</strong> 링크</p>",
+						ContentFormat: domain.NewsContentFormatHTML,
 					},
 				},
-				Cursor: pointer.Int(2),
 			},
 			wantErr: false,
 		},
+		{
+			name: "PASS - 다음 페이지가 있으면 다음 페이지 커서 반환",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListNewsRequest{
+					UserID:   1,
+					SchoolID: 1,
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				authorize(ts)
+				ts.newsRepository.EXPECT().ListNews(mock.Anything, domain.ListNewsParams{
+					SchoolID: pointer.Int(1),
+					Limit:    3,
+				}).Return([]domain.News{
+					{Base: domain.Base{ID: 5}, SchoolID: 1},
+					{Base: domain.Base{ID: 4}, SchoolID: 1},
+					{Base: domain.Base{ID: 3}, SchoolID: 1},
+				}, nil).Once()
+				ts.attachmentService.EXPECT().ListNewsAttachments(mock.Anything, []int{5, 4}).Return(nil, nil).Once()
+			},
+			want: domain.ListNewsResponse{
+				News: []domain.NewsDTO{
+					{BaseDTO: domain.BaseDTO{ID: 5}, SchoolID: 1},
+					{BaseDTO: domain.BaseDTO{ID: 4}, SchoolID: 1},
+				},
+				Cursor: testPaginator.Encode(&pagination.Cursor{ID: 4, Direction: pagination.Forward, Filter: filter}),
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 커서로 마지막 페이지 조회",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListNewsRequest{
+					UserID:   1,
+					SchoolID: 1,
+					Cursor:   *testPaginator.Encode(&pagination.Cursor{ID: 4, Direction: pagination.Forward, Filter: filter}),
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				authorize(ts)
+				ts.newsRepository.EXPECT().ListNews(mock.Anything, domain.ListNewsParams{
+					SchoolID: pointer.Int(1),
+					Cursor:   &pagination.Cursor{ID: 4, Direction: pagination.Forward, Filter: filter},
+					Limit:    3,
+				}).Return([]domain.News{
+					{Base: domain.Base{ID: 3}, SchoolID: 1},
+				}, nil).Once()
+				ts.attachmentService.EXPECT().ListNewsAttachments(mock.Anything, []int{3}).Return(nil, nil).Once()
+			},
+			want: domain.ListNewsResponse{
+				News: []domain.NewsDTO{
+					{BaseDTO: domain.BaseDTO{ID: 3}, SchoolID: 1},
+				},
+				PrevCursor: testPaginator.Encode(&pagination.Cursor{ID: 3, Direction: pagination.Backward, Filter: filter}),
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 이전 페이지 조회는 역순으로 조회한 뒤 최신 순으로 되돌림",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListNewsRequest{
+					UserID:   1,
+					SchoolID: 1,
+					Cursor:   *testPaginator.Encode(&pagination.Cursor{ID: 3, Direction: pagination.Backward, Filter: filter}),
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				authorize(ts)
+				ts.newsRepository.EXPECT().ListNews(mock.Anything, domain.ListNewsParams{
+					SchoolID: pointer.Int(1),
+					Cursor:   &pagination.Cursor{ID: 3, Direction: pagination.Backward, Filter: filter},
+					Limit:    3,
+				}).Return([]domain.News{
+					{Base: domain.Base{ID: 4}, SchoolID: 1},
+					{Base: domain.Base{ID: 5}, SchoolID: 1},
+					{Base: domain.Base{ID: 6}, SchoolID: 1},
+				}, nil).Once()
+				ts.attachmentService.EXPECT().ListNewsAttachments(mock.Anything, []int{5, 4}).Return(nil, nil).Once()
+			},
+			want: domain.ListNewsResponse{
+				News: []domain.NewsDTO{
+					{BaseDTO: domain.BaseDTO{ID: 5}, SchoolID: 1},
+					{BaseDTO: domain.BaseDTO{ID: 4}, SchoolID: 1},
+				},
+				Cursor:     testPaginator.Encode(&pagination.Cursor{ID: 4, Direction: pagination.Forward, Filter: filter}),
+				PrevCursor: testPaginator.Encode(&pagination.Cursor{ID: 5, Direction: pagination.Backward, Filter: filter}),
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 변조된 커서",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListNewsRequest{
+					UserID:   1,
+					SchoolID: 1,
+					Cursor:   *pagination.New("classting_other_secret", 0, 0).Encode(&pagination.Cursor{ID: 4, Direction: pagination.Forward, Filter: filter}),
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				authorize(ts)
+			},
+			want:    domain.ListNewsResponse{},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 다른 상태의 목록에서 받은 커서",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListNewsRequest{
+					UserID:   1,
+					SchoolID: 1,
+					Status:   domain.NewsStatusDraft,
+					Cursor:   *testPaginator.Encode(&pagination.Cursor{ID: 4, Direction: pagination.Forward, Filter: filter}),
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				authorize(ts)
+			},
+			want:    domain.ListNewsResponse{},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -216,9 +677,7 @@ func Test_newsService_ListNews(t *testing.T) {
 			ts.newsRepository.AssertExpectations(t)
 			ts.schoolRepository.AssertExpectations(t)
 			assert.Equal(t, tt.want, got)
-			if err != nil {
-				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
-			}
+			assert.Equal(t, tt.wantErr, err != nil)
 		})
 	}
 }
@@ -236,13 +695,14 @@ func Test_newsService_UpdateNews(t *testing.T) {
 		wantErr bool
 	}{
 		{
-			name: "PASS - 정상 수정",
+			name: "PASS - 같은 학교 EDITOR 멤버가 작성한 소식 수정",
 			args: args{
 				ctx: context.Background(),
 				req: domain.UpdateNewsRequest{
 					UserID: 1,
 					ID:     1,
 					Title:  "타이틀 수정",
+					Body:   "본문 수정",
 				},
 			},
 			mock: func(ts newsServiceTestSuite) {
@@ -251,20 +711,169 @@ func Test_newsService_UpdateNews(t *testing.T) {
 						ID: 1,
 					},
 					SchoolID: 1,
-					UserID:   1,
+					UserID:   7777,
 					Title:    "타이틀 원본",
+					Status:   domain.NewsStatusPublished,
 				}, nil).Once()
-				ts.newsRepository.EXPECT().UpdateNews(mock.Anything, domain.News{
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
 					Base: domain.Base{
 						ID: 1,
 					},
+					UserID: 2,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
 					SchoolID: 1,
 					UserID:   1,
-					Title:    "타이틀 수정",
-				}).Return(nil).Once()
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleEditor,
+				}, nil).Once()
+				ts.newsRepository.EXPECT().UpdateNews(mock.Anything, domain.UpdateNewsParams{
+					News: domain.News{
+						Base: domain.Base{
+							ID: 1,
+						},
+						SchoolID:      1,
+						UserID:        7777,
+						Title:         "타이틀 수정",
+						Body:          "본문 수정",
+						ContentFormat: domain.NewsContentFormatPlain,
+						Status:        domain.NewsStatusPublished,
+					},
+					PreviousStatus: domain.NewsStatusPublished,
+					EditorID:       1,
+				}).Return(nil).Once()
+				ts.searchIndex.EXPECT().IndexNews(mock.Anything, mock.MatchedBy(func(news domain.News) bool {
+					return news.ID == 1 && news.Title == "타이틀 수정"
+				})).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 임시 저장 소식을 발행하면 타임라인에 반영",
+			args: args{
+				ctx: context.Background(),
+				req: domain.UpdateNewsRequest{
+					UserID: 1,
+					ID:     1,
+					Title:  "타이틀 수정",
+					Body:   "본문 수정",
+					Status: domain.NewsStatusPublished,
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				expectNewsSchoolMember(ts, domain.NewsStatusDraft, domain.SchoolRoleEditor)
+				published := domain.News{
+					Base: domain.Base{
+						ID: 1,
+					},
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "타이틀 수정",
+					Body:          "본문 수정",
+					ContentFormat: domain.NewsContentFormatPlain,
+					Status:        domain.NewsStatusPublished,
+					PublishDate:   sql.NullTime{Time: testNow, Valid: true},
+				}
+				ts.newsRepository.EXPECT().UpdateNews(mock.Anything, domain.UpdateNewsParams{
+					News:           published,
+					PreviousStatus: domain.NewsStatusDraft,
+					EditorID:       1,
+				}).Return(nil).Once()
+				ts.searchIndex.EXPECT().IndexNews(mock.Anything, published).Return(nil).Once()
+				ts.timelineService.EXPECT().FanOutNews(mock.Anything, published).Return(nil).Once()
 			},
 			wantErr: false,
 		},
+		{
+			name: "PASS - 발행된 소식을 보관하면 타임라인에서 숨김",
+			args: args{
+				ctx: context.Background(),
+				req: domain.UpdateNewsRequest{
+					UserID: 1,
+					ID:     1,
+					Title:  "타이틀 수정",
+					Status: domain.NewsStatusArchived,
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				expectNewsSchoolMember(ts, domain.NewsStatusPublished, domain.SchoolRoleEditor)
+				ts.newsRepository.EXPECT().UpdateNews(mock.Anything, mock.MatchedBy(func(params domain.UpdateNewsParams) bool {
+					return params.PreviousStatus == domain.NewsStatusPublished && params.News.Status == domain.NewsStatusArchived
+				})).Return(nil).Once()
+				ts.searchIndex.EXPECT().IndexNews(mock.Anything, mock.MatchedBy(func(news domain.News) bool {
+					return news.Status == domain.NewsStatusArchived
+				})).Return(nil).Once()
+				ts.timelineService.EXPECT().HideNews(mock.Anything, 1).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 발행된 소식을 예약 소식으로 변경",
+			args: args{
+				ctx: context.Background(),
+				req: domain.UpdateNewsRequest{
+					UserID:    1,
+					ID:        1,
+					Title:     "타이틀 수정",
+					Status:    domain.NewsStatusScheduled,
+					PublishAt: timePtr(testNow.Add(time.Hour)),
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				expectNewsSchoolMember(ts, domain.NewsStatusPublished, domain.SchoolRoleEditor)
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 보관된 소식 수정",
+			args: args{
+				ctx: context.Background(),
+				req: domain.UpdateNewsRequest{
+					UserID: 1,
+					ID:     1,
+					Title:  "타이틀 수정",
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				expectNewsSchoolMember(ts, domain.NewsStatusArchived, domain.SchoolRoleEditor)
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 삭제된 학교의 소식 수정",
+			args: args{
+				ctx: context.Background(),
+				req: domain.UpdateNewsRequest{
+					UserID: 1,
+					ID:     1,
+					Title:  "타이틀 수정",
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				expectDeletedSchoolNews(ts, domain.SchoolRoleEditor)
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 예약 시각이 지난 예약 소식의 시각을 과거로 변경",
+			args: args{
+				ctx: context.Background(),
+				req: domain.UpdateNewsRequest{
+					UserID:    1,
+					ID:        1,
+					Title:     "타이틀 수정",
+					PublishAt: timePtr(testNow.Add(-time.Hour)),
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				expectNewsSchoolMember(ts, domain.NewsStatusScheduled, domain.SchoolRoleEditor)
+			},
+			wantErr: true,
+		},
 		{
 			name: "PASS - 요청 된 소식이 없음",
 			args: args{
@@ -281,7 +890,33 @@ func Test_newsService_UpdateNews(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "PASS - 요청 된 소식의 작성자가 아님",
+			name: "FAIL - 삭제된 임시 저장 소식 발행",
+			args: args{
+				ctx: context.Background(),
+				req: domain.UpdateNewsRequest{
+					UserID: 1,
+					ID:     1,
+					Title:  "타이틀 수정",
+					Body:   "본문 수정",
+					Status: domain.NewsStatusPublished,
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 1).Return(&domain.News{
+					Base: domain.Base{
+						ID:         1,
+						DeleteDate: sql.NullTime{Time: testNow, Valid: true},
+					},
+					SchoolID: 1,
+					UserID:   1,
+					Title:    "타이틀 원본",
+					Status:   domain.NewsStatusDraft,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - VIEWER 멤버의 소식 수정",
 			args: args{
 				ctx: context.Background(),
 				req: domain.UpdateNewsRequest{
@@ -299,6 +934,22 @@ func Test_newsService_UpdateNews(t *testing.T) {
 					UserID:   7777,
 					Title:    "소유주가 다름",
 				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 2,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleViewer,
+				}, nil).Once()
 			},
 			wantErr: true,
 		},
@@ -316,9 +967,7 @@ func Test_newsService_UpdateNews(t *testing.T) {
 			// then
 			ts.newsRepository.AssertExpectations(t)
 			ts.schoolRepository.AssertExpectations(t)
-			if err != nil {
-				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
-			}
+			assert.Equal(t, tt.wantErr, err != nil)
 		})
 	}
 }
@@ -336,7 +985,7 @@ func Test_newsService_DeleteNews(t *testing.T) {
 		wantErr bool
 	}{
 		{
-			name: "PASS - 정상 삭제",
+			name: "PASS - OWNER 멤버의 소식 삭제",
 			args: args{
 				ctx: context.Background(),
 				req: domain.DeleteNewsRequest{
@@ -353,7 +1002,26 @@ func Test_newsService_DeleteNews(t *testing.T) {
 					UserID:   1,
 					Title:    "삭제할 소식",
 				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 2,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
 				ts.newsRepository.EXPECT().DeleteNews(mock.Anything, 1).Return(nil).Once()
+				ts.timelineService.EXPECT().HideNews(mock.Anything, 1).Return(nil).Once()
+				ts.searchIndex.EXPECT().RemoveNews(mock.Anything, 1).Return(nil).Once()
+				ts.attachmentService.EXPECT().DeleteNewsAttachments(mock.Anything, 1).Return(nil).Once()
 			},
 			wantErr: false,
 		},
@@ -372,7 +1040,29 @@ func Test_newsService_DeleteNews(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "FAIL - 요청 된 소식의 작성자가 아님",
+			name: "FAIL - 이미 삭제된 소식 다시 삭제",
+			args: args{
+				ctx: context.Background(),
+				req: domain.DeleteNewsRequest{
+					UserID: 1,
+					ID:     1,
+				},
+			},
+			mock: func(ts newsServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 1).Return(&domain.News{
+					Base: domain.Base{
+						ID:         1,
+						DeleteDate: sql.NullTime{Time: testNow, Valid: true},
+					},
+					SchoolID: 1,
+					UserID:   1,
+					Title:    "삭제된 소식",
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 학교 멤버가 아닌 유저의 소식 삭제",
 			args: args{
 				ctx: context.Background(),
 				req: domain.DeleteNewsRequest{
@@ -389,6 +1079,18 @@ func Test_newsService_DeleteNews(t *testing.T) {
 					UserID:   7777,
 					Title:    "소식의 작성자가 달라요",
 				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 2,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(nil, nil).Once()
 			},
 			wantErr: true,
 		},
@@ -406,9 +1108,401 @@ func Test_newsService_DeleteNews(t *testing.T) {
 			// then
 			ts.newsRepository.AssertExpectations(t)
 			ts.schoolRepository.AssertExpectations(t)
-			if err != nil {
-				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			ts.timelineService.AssertExpectations(t)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_newsService_ListNewsRevisions(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     domain.ListNewsRevisionsRequest
+		mock    func(ts newsServiceTestSuite)
+		want    domain.ListNewsRevisionsResponse
+		wantErr bool
+	}{
+		{
+			name: "PASS - VIEWER 멤버의 수정 이력 조회",
+			req:  domain.ListNewsRevisionsRequest{UserID: 1, NewsID: 1},
+			mock: func(ts newsServiceTestSuite) {
+				expectNewsSchoolMember(ts, domain.NewsStatusPublished, domain.SchoolRoleViewer)
+				ts.newsRepository.EXPECT().ListNewsRevisions(mock.Anything, 1).Return([]domain.NewsRevision{
+					{ID: 1, NewsID: 1, Revision: 1, EditorID: 2, Title: "타이틀 처음", CreateDate: testNow},
+				}, nil).Once()
+			},
+			want: domain.ListNewsRevisionsResponse{
+				Revisions: []domain.NewsRevisionDTO{
+					{Revision: 1, NewsID: 1, EditorID: 2, Title: "타이틀 처음", EditDate: testNow},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 삭제된 소식의 수정 이력 조회",
+			req:  domain.ListNewsRevisionsRequest{UserID: 1, NewsID: 1},
+			mock: func(ts newsServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 1).Return(&domain.News{
+					Base: domain.Base{
+						ID:         1,
+						DeleteDate: sql.NullTime{Time: testNow, Valid: true},
+					},
+					SchoolID: 1,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupNewsServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.service.ListNewsRevisions(context.Background(), tt.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_newsService_DiffNewsRevisions(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      domain.DiffNewsRevisionsRequest
+		mock     func(ts newsServiceTestSuite)
+		wantBody []domain.NewsDiffLineDTO
+		wantErr  bool
+	}{
+		{
+			name: "PASS - 리비전과 현재 소식 비교",
+			req:  domain.DiffNewsRevisionsRequest{UserID: 1, NewsID: 1, From: 1},
+			mock: func(ts newsServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 1).Return(&domain.News{
+					Base: domain.Base{
+						ID: 1,
+					},
+					SchoolID: 1,
+					Title:    "타이틀",
+					Body:     "첫째 줄\n바뀐 둘째 줄",
+					Status:   domain.NewsStatusPublished,
+				}, nil).Once()
+				expectSchoolMember(ts, domain.SchoolRoleViewer)
+				ts.newsRepository.EXPECT().FindNewsRevision(mock.Anything, domain.FindNewsRevisionParams{NewsID: 1, Revision: 1}).Return(&domain.NewsRevision{
+					NewsID:   1,
+					Revision: 1,
+					Title:    "타이틀",
+					Body:     "첫째 줄\n둘째 줄",
+				}, nil).Once()
+			},
+			wantBody: []domain.NewsDiffLineDTO{
+				{Op: "equal", Text: "첫째 줄"},
+				{Op: "delete", Text: "둘째 줄"},
+				{Op: "insert", Text: "바뀐 둘째 줄"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 없는 리비전과 비교",
+			req:  domain.DiffNewsRevisionsRequest{UserID: 1, NewsID: 1, From: 1, To: 9},
+			mock: func(ts newsServiceTestSuite) {
+				expectNewsSchoolMember(ts, domain.NewsStatusPublished, domain.SchoolRoleViewer)
+				ts.newsRepository.EXPECT().FindNewsRevision(mock.Anything, domain.FindNewsRevisionParams{NewsID: 1, Revision: 1}).Return(&domain.NewsRevision{
+					NewsID:   1,
+					Revision: 1,
+				}, nil).Once()
+				ts.newsRepository.EXPECT().FindNewsRevision(mock.Anything, domain.FindNewsRevisionParams{NewsID: 1, Revision: 9}).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupNewsServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.service.DiffNewsRevisions(context.Background(), tt.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(t, []domain.NewsDiffLineDTO{{Op: "equal", Text: "타이틀"}}, got.Title)
+				assert.Equal(t, tt.wantBody, got.Body)
 			}
 		})
 	}
 }
+
+func Test_newsService_RollbackNews(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     domain.RollbackNewsRequest
+		mock    func(ts newsServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - OWNER 멤버가 이전 리비전으로 되돌리기",
+			req:  domain.RollbackNewsRequest{UserID: 1, NewsID: 1, Revision: 1},
+			mock: func(ts newsServiceTestSuite) {
+				expectNewsSchoolMember(ts, domain.NewsStatusPublished, domain.SchoolRoleOwner)
+				ts.newsRepository.EXPECT().FindNewsRevision(mock.Anything, domain.FindNewsRevisionParams{NewsID: 1, Revision: 1}).Return(&domain.NewsRevision{
+					NewsID:        1,
+					Revision:      1,
+					Title:         "타이틀 처음",
+					Body:          "본문 처음",
+					ContentFormat: domain.NewsContentFormatPlain,
+				}, nil).Once()
+				ts.newsRepository.EXPECT().UpdateNews(mock.Anything, mock.MatchedBy(func(params domain.UpdateNewsParams) bool {
+					return params.News.Title == "타이틀 처음" &&
+						params.News.Body == "본문 처음" &&
+						params.News.Status == domain.NewsStatusPublished &&
+						params.PreviousStatus == domain.NewsStatusPublished &&
+						params.EditorID == 1
+				})).Return(nil).Once()
+				ts.searchIndex.EXPECT().IndexNews(mock.Anything, mock.MatchedBy(func(news domain.News) bool {
+					return news.Title == "타이틀 처음"
+				})).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - EDITOR 멤버의 되돌리기",
+			req:  domain.RollbackNewsRequest{UserID: 1, NewsID: 1, Revision: 1},
+			mock: func(ts newsServiceTestSuite) {
+				expectNewsSchoolMember(ts, domain.NewsStatusPublished, domain.SchoolRoleEditor)
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 없는 리비전으로 되돌리기",
+			req:  domain.RollbackNewsRequest{UserID: 1, NewsID: 1, Revision: 9},
+			mock: func(ts newsServiceTestSuite) {
+				expectNewsSchoolMember(ts, domain.NewsStatusPublished, domain.SchoolRoleOwner)
+				ts.newsRepository.EXPECT().FindNewsRevision(mock.Anything, domain.FindNewsRevisionParams{NewsID: 1, Revision: 9}).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupNewsServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.RollbackNews(context.Background(), tt.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_newsService_UpdateNewsPriority(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     domain.UpdateNewsPriorityRequest
+		mock    func(ts newsServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - EDITOR 멤버가 긴급 소식으로 변경",
+			req:  domain.UpdateNewsPriorityRequest{UserID: 1, NewsID: 1, Priority: domain.NewsPriorityUrgent},
+			mock: func(ts newsServiceTestSuite) {
+				expectNewsSchoolMember(ts, domain.NewsStatusPublished, domain.SchoolRoleEditor)
+				ts.newsRepository.EXPECT().UpdateNewsPriority(mock.Anything, domain.UpdateNewsPriorityParams{
+					NewsID:   1,
+					Priority: domain.NewsPriorityUrgent,
+				}).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - VIEWER 멤버의 우선순위 변경",
+			req:  domain.UpdateNewsPriorityRequest{UserID: 1, NewsID: 1, Priority: domain.NewsPriorityUrgent},
+			mock: func(ts newsServiceTestSuite) {
+				expectNewsSchoolMember(ts, domain.NewsStatusPublished, domain.SchoolRoleViewer)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupNewsServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.UpdateNewsPriority(context.Background(), tt.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_newsService_PinNews(t *testing.T) {
+	expireAt := testNow.Add(24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		req     domain.PinNewsRequest
+		mock    func(ts newsServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 만료 시각을 지정해 소식 고정",
+			req:  domain.PinNewsRequest{UserID: 1, NewsID: 1, ExpireAt: &expireAt},
+			mock: func(ts newsServiceTestSuite) {
+				expectNewsSchoolMember(ts, domain.NewsStatusPublished, domain.SchoolRoleEditor)
+				ts.newsRepository.EXPECT().PinNews(mock.Anything, domain.PinNewsParams{
+					NewsID:     1,
+					SchoolID:   1,
+					UserID:     1,
+					ExpireDate: sql.NullTime{Time: expireAt, Valid: true},
+					MaxPins:    defaultMaxPins,
+					Now:        testNow,
+				}).Return(true, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 최대 고정 개수 초과",
+			req:  domain.PinNewsRequest{UserID: 1, NewsID: 1},
+			mock: func(ts newsServiceTestSuite) {
+				expectNewsSchoolMember(ts, domain.NewsStatusPublished, domain.SchoolRoleEditor)
+				ts.newsRepository.EXPECT().PinNews(mock.Anything, domain.PinNewsParams{
+					NewsID:   1,
+					SchoolID: 1,
+					UserID:   1,
+					MaxPins:  defaultMaxPins,
+					Now:      testNow,
+				}).Return(false, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 발행되지 않은 소식 고정",
+			req:  domain.PinNewsRequest{UserID: 1, NewsID: 1},
+			mock: func(ts newsServiceTestSuite) {
+				expectNewsSchoolMember(ts, domain.NewsStatusDraft, domain.SchoolRoleEditor)
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 삭제된 학교의 소식 고정",
+			req:  domain.PinNewsRequest{UserID: 1, NewsID: 1},
+			mock: func(ts newsServiceTestSuite) {
+				expectDeletedSchoolNews(ts, domain.SchoolRoleEditor)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupNewsServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.PinNews(context.Background(), tt.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_newsService_UnpinNews(t *testing.T) {
+	// given
+	ts := setupNewsServiceTestSuite(t)
+	expectNewsSchoolMember(ts, domain.NewsStatusPublished, domain.SchoolRoleEditor)
+	ts.newsRepository.EXPECT().UnpinNews(mock.Anything, 1).Return(nil).Once()
+
+	// when
+	err := ts.service.UnpinNews(context.Background(), domain.UnpinNewsRequest{UserID: 1, NewsID: 1})
+
+	// then
+	assert.NoError(t, err)
+}
+
+func expectSchoolMember(ts newsServiceTestSuite, role domain.SchoolRole) {
+	ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+		Base: domain.Base{
+			ID: 1,
+		},
+		UserID: 2,
+		Name:   "클래스팅",
+		Region: "서울",
+	}, nil).Once()
+	ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+		SchoolID: 1,
+		UserID:   1,
+	}).Return(&domain.SchoolMember{
+		SchoolID: 1,
+		UserID:   1,
+		Role:     role,
+	}, nil).Once()
+}
+
+func expectNewsSchoolMember(ts newsServiceTestSuite, status domain.NewsStatus, role domain.SchoolRole) {
+	ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 1).Return(&domain.News{
+		Base: domain.Base{
+			ID: 1,
+		},
+		SchoolID: 1,
+		UserID:   1,
+		Title:    "타이틀 원본",
+		Status:   status,
+		PublishDate: sql.NullTime{
+			Time:  testNow.Add(-time.Minute),
+			Valid: status != domain.NewsStatusDraft,
+		},
+	}, nil).Once()
+	expectSchoolMember(ts, role)
+}
+
+// expectDeletedSchoolNews 삭제된 학교의 발행된 소식을 같은 학교 멤버가 다룰 때의 조회를 기대한다.
+func expectDeletedSchoolNews(ts newsServiceTestSuite, role domain.SchoolRole) {
+	ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 1).Return(&domain.News{
+		Base: domain.Base{
+			ID: 1,
+		},
+		SchoolID:    1,
+		UserID:      1,
+		Title:       "타이틀 원본",
+		Status:      domain.NewsStatusPublished,
+		PublishDate: sql.NullTime{Time: testNow.Add(-time.Minute), Valid: true},
+	}, nil).Once()
+	ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+		Base: domain.Base{
+			ID:         1,
+			DeleteDate: sql.NullTime{Time: testNow.Add(-time.Hour), Valid: true},
+		},
+		UserID: 2,
+		Name:   "클래스팅",
+		Region: "서울",
+	}, nil).Once()
+	ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+		SchoolID: 1,
+		UserID:   1,
+	}).Return(&domain.SchoolMember{
+		SchoolID: 1,
+		UserID:   1,
+		Role:     role,
+	}, nil).Once()
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
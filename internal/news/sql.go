@@ -1,9 +1,50 @@
 package news
 
-const createNewsQuery = `INSERT INTO news (school_id, user_id, title) VALUES (?, ?, ?)`
+const createNewsQuery = `INSERT INTO news (school_id, user_id, title, summary, body, content_format, status, publish_date, priority) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
-const listNewsQuery = `SELECT id, create_date, update_date, delete_date, school_id, user_id, title FROM news WHERE delete_date IS NULL %s %s %s ORDER BY id DESC LIMIT 10`
+// listNewsQuery 조건, 정렬, 조회 개수는 db.Query로 붙인다.
+const listNewsQuery = `SELECT id, create_date, update_date, delete_date, school_id, user_id, title, summary, body, content_format, status, publish_date, edit_date, priority FROM news`
 
-const findNewsByIDQuery = `SELECT id, create_date, update_date, delete_date, school_id, user_id, title FROM news WHERE id = ?`
+const findNewsByIDQuery = `SELECT id, create_date, update_date, delete_date, school_id, user_id, title, summary, body, content_format, status, publish_date, edit_date, priority FROM news WHERE id = ?`
+
+// findNewsStatusForUpdateQuery 상태를 바꾸는 동안 예약 발행 스케줄러가 같은 소식을 발행하지 못하도록 잠근다.
+const findNewsStatusForUpdateQuery = `SELECT status FROM news WHERE id = ? FOR UPDATE`
+
+// findNewsContentForUpdateQuery 수정 전 내용을 리비전으로 남기는 동안 다른 수정이 끼어들지 못하도록 잠근다.
+const findNewsContentForUpdateQuery = `SELECT status, title, summary, body, content_format FROM news WHERE id = ? FOR UPDATE`
+
+const updateNewsQuery = `UPDATE news SET title = ?, summary = ?, body = ?, content_format = ?, status = ?, publish_date = ?, edit_date = COALESCE(?, edit_date) WHERE id = ?`
 
 const deleteNewsQuery = `UPDATE news SET delete_date = ? WHERE id = ?`
+
+// listDueScheduledNewsQuery 삭제된 학교의 예약 소식은 발행하지 않고 학교를 복구하면 다음 폴링에서 발행한다.
+const listDueScheduledNewsQuery = `SELECT id, create_date, update_date, delete_date, school_id, user_id, title, summary, body, content_format, status, publish_date, edit_date, priority FROM news
+WHERE status = 'SCHEDULED' AND publish_date <= ? AND delete_date IS NULL AND school_id IN (SELECT id FROM schools WHERE delete_date IS NULL)
+ORDER BY publish_date, id
+LIMIT ?`
+
+// publishScheduledNewsQuery 여러 인스턴스가 같은 소식을 동시에 발행하려 해도 상태 조건 때문에 한 곳에서만 변경된다.
+// 조회한 뒤 학교가 삭제되었으면 발행하지 않는다.
+const publishScheduledNewsQuery = `UPDATE news SET status = 'PUBLISHED'
+WHERE id = ? AND status = 'SCHEDULED' AND publish_date <= ? AND delete_date IS NULL AND school_id IN (SELECT id FROM schools WHERE delete_date IS NULL)`
+
+// createNewsRevisionQuery 소식 행을 잠근 트랜잭션 안에서 실행하므로 같은 리비전 번호가 두 번 매겨지지 않는다.
+const createNewsRevisionQuery = `INSERT INTO news_revisions (news_id, revision, editor_id, title, summary, body, content_format) SELECT ?, COALESCE(MAX(revision), 0) + 1, ?, ?, ?, ?, ? FROM news_revisions WHERE news_id = ?`
+
+const listNewsRevisionsQuery = `SELECT id, news_id, revision, editor_id, title, summary, body, content_format, create_date FROM news_revisions WHERE news_id = ? ORDER BY revision DESC`
+
+const findNewsRevisionQuery = `SELECT id, news_id, revision, editor_id, title, summary, body, content_format, create_date FROM news_revisions WHERE news_id = ? AND revision = ?`
+
+const updateNewsPriorityQuery = `UPDATE news SET priority = ? WHERE id = ?`
+
+// lockSchoolForPinQuery 학교 행을 잠가 같은 학교에 동시에 고정해도 최대 고정 개수를 넘지 않도록 한다.
+const lockSchoolForPinQuery = `SELECT id FROM schools WHERE id = ? FOR UPDATE`
+
+const countActivePinsQuery = `SELECT COUNT(*) FROM news_pins WHERE school_id = ? AND news_id <> ? AND (expire_date IS NULL OR expire_date > ?)`
+
+const upsertNewsPinQuery = `INSERT INTO news_pins (news_id, school_id, user_id, expire_date) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE user_id = VALUES(user_id), expire_date = VALUES(expire_date)`
+
+const deleteNewsPinQuery = `DELETE FROM news_pins WHERE news_id = ?`
+
+// listPinnedNewsQuery 만료된 고정이나 발행 상태가 아닌 소식은 고정되지 않은 것으로 본다.
+const listPinnedNewsQuery = `SELECT news.id, news.create_date, news.update_date, news.delete_date, news.school_id, news.user_id, news.title, news.summary, news.body, news.content_format, news.status, news.publish_date, news.edit_date, news.priority FROM news_pins JOIN news ON news.id = news_pins.news_id WHERE news_pins.school_id = ? AND (news_pins.expire_date IS NULL OR news_pins.expire_date > ?) AND news.status = 'PUBLISHED' AND news.delete_date IS NULL ORDER BY news_pins.create_date DESC, news.id DESC`
@@ -0,0 +1,14 @@
+package outbox
+
+import (
+	"classting/domain"
+	"context"
+	"database/sql"
+)
+
+// Append 도메인 변경과 같은 트랜잭션에서 아웃박스 이벤트를 기록한다.
+func Append(ctx context.Context, tx *sql.Tx, event domain.OutboxEvent) error {
+	_, err := tx.ExecContext(ctx, appendOutboxEventQuery, event.AggregateType, event.AggregateID, event.EventType, event.Payload)
+
+	return err
+}
@@ -0,0 +1,156 @@
+package outbox
+
+import (
+	"classting/config"
+	"classting/domain"
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	// gapTimeout 아이디는 먼저 받았지만 늦게 커밋되어 건너뛴 이벤트를 기다리는 시간
+	gapTimeout = 10 * time.Second
+	// maxGaps 롤백이나 auto_increment 간격으로 크게 비는 아이디까지 기다리지 않도록 한 번에 기다리는 아이디 수를 제한한다.
+	maxGaps = 1000
+)
+
+// outboxBroadcaster SSE/웹소켓 허브는 인스턴스마다 메모리에 있으므로 인스턴스마다 아웃박스를 아이디 순으로 따라 읽어
+// 자기 허브에 전달한다. 전달 여부를 기록하지 않고 실패해도 다시 전달하지 않으며, 놓친 이벤트는 클라이언트가 재연결로 따라잡는다.
+type outboxBroadcaster struct {
+	outboxRepository domain.OutboxRepository
+	sinks            []domain.OutboxSink
+	pollInterval     time.Duration
+	batchSize        int
+
+	// cursor 전달한 가장 큰 이벤트 아이디, 시작 전에는 -1
+	cursor int
+	// gaps cursor보다 작지만 아직 읽지 못한 아이디와 기다릴 기한
+	gaps map[int]time.Time
+
+	done     chan struct{}
+	doneOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+func NewOutboxBroadcaster(
+	outboxRepository domain.OutboxRepository,
+	cfg *config.Config,
+	sinks ...domain.OutboxSink,
+) *outboxBroadcaster {
+	pollInterval := defaultPollInterval
+	if cfg.Outbox.PollIntervalMillis > 0 {
+		pollInterval = time.Duration(cfg.Outbox.PollIntervalMillis) * time.Millisecond
+	}
+	batchSize := defaultBatchSize
+	if cfg.Outbox.BatchSize > 0 {
+		batchSize = cfg.Outbox.BatchSize
+	}
+
+	return &outboxBroadcaster{
+		outboxRepository: outboxRepository,
+		sinks:            sinks,
+		pollInterval:     pollInterval,
+		batchSize:        batchSize,
+		cursor:           -1,
+		gaps:             make(map[int]time.Time),
+		done:             make(chan struct{}),
+	}
+}
+
+// Run 시작 시점 이후에 기록된 이벤트부터 전달한다.
+func (b *outboxBroadcaster) Run() {
+	b.wg.Add(1)
+	go b.broadcast()
+}
+
+// Shutdown 전달을 멈추고 진행 중인 전달이 끝날 때까지 기다린다.
+func (b *outboxBroadcaster) Shutdown(ctx context.Context) error {
+	b.doneOnce.Do(func() {
+		close(b.done)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		b.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *outboxBroadcaster) broadcast() {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(b.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.done:
+			return
+		case <-ticker.C:
+			if err := b.poll(context.Background()); err != nil {
+				log.Printf("outbox: broadcast events: %v", err)
+			}
+		}
+	}
+}
+
+func (b *outboxBroadcaster) poll(ctx context.Context) error {
+	if b.cursor < 0 {
+		cursor, err := b.outboxRepository.FindLastOutboxEventID(ctx)
+		if err != nil {
+			return err
+		}
+		b.cursor = cursor
+	}
+
+	gaps := make([]int, 0, len(b.gaps))
+	for id := range b.gaps {
+		gaps = append(gaps, id)
+	}
+	events, err := b.outboxRepository.ListOutboxEvents(ctx, domain.ListOutboxEventsParams{
+		After: b.cursor,
+		IDs:   gaps,
+		Limit: b.batchSize + len(gaps),
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, event := range events {
+		if _, ok := b.gaps[event.ID]; ok {
+			delete(b.gaps, event.ID)
+		} else if event.ID > b.cursor {
+			// 아이디 순서와 커밋 순서가 다를 수 있어 건너뛴 아이디는 잠시 더 읽어 본다.
+			if event.ID-b.cursor-1 <= maxGaps-len(b.gaps) {
+				for id := b.cursor + 1; id < event.ID; id++ {
+					b.gaps[id] = now.Add(gapTimeout)
+				}
+			}
+			b.cursor = event.ID
+		}
+
+		for _, sink := range b.sinks {
+			if err := sink.HandleOutboxEvent(ctx, event); err != nil {
+				log.Printf("outbox: broadcast event %d %s: %v", event.ID, event.EventType, err)
+			}
+		}
+	}
+
+	for id, deadline := range b.gaps {
+		if now.After(deadline) {
+			delete(b.gaps, id)
+		}
+	}
+
+	return nil
+}
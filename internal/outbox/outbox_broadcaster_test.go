@@ -0,0 +1,155 @@
+package outbox
+
+import (
+	"classting/config"
+	"classting/domain"
+	"classting/mocks"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+type outboxBroadcasterTestSuite struct {
+	outboxRepository *mocks.OutboxRepository
+	sink             *mocks.OutboxSink
+	broadcaster      *outboxBroadcaster
+}
+
+func setupOutboxBroadcasterTestSuite(t *testing.T) outboxBroadcasterTestSuite {
+	var us outboxBroadcasterTestSuite
+
+	us.outboxRepository = mocks.NewOutboxRepository(t)
+	us.sink = mocks.NewOutboxSink(t)
+	us.broadcaster = NewOutboxBroadcaster(us.outboxRepository, &config.Config{
+		Outbox: config.Outbox{
+			BatchSize: 10,
+		},
+	}, us.sink)
+
+	return us
+}
+
+func Test_outboxBroadcaster_poll(t *testing.T) {
+	event := func(id int) domain.OutboxEvent {
+		return domain.OutboxEvent{ID: id, AggregateType: domain.OutboxAggregateTypeNews, EventType: string(domain.NewsEventTypeCreated)}
+	}
+
+	tests := []struct {
+		name       string
+		cursor     int
+		gaps       map[int]time.Time
+		mock       func(ts outboxBroadcasterTestSuite)
+		wantCursor int
+		wantGaps   []int
+		wantErr    bool
+	}{
+		{
+			name: "PASS - 시작 시점의 마지막 이벤트 다음부터 전달",
+			mock: func(ts outboxBroadcasterTestSuite) {
+				ts.outboxRepository.EXPECT().FindLastOutboxEventID(mock.Anything).Return(5, nil).Once()
+				ts.outboxRepository.EXPECT().ListOutboxEvents(mock.Anything, domain.ListOutboxEventsParams{
+					After: 5,
+					IDs:   []int{},
+					Limit: 10,
+				}).Return([]domain.OutboxEvent{event(6)}, nil).Once()
+				ts.sink.EXPECT().HandleOutboxEvent(mock.Anything, event(6)).Return(nil).Once()
+			},
+			cursor:     -1,
+			wantCursor: 6,
+			wantGaps:   []int{},
+		},
+		{
+			name:   "PASS - 건너뛴 아이디는 기다렸다가 커밋되면 전달",
+			cursor: 6,
+			mock: func(ts outboxBroadcasterTestSuite) {
+				ts.outboxRepository.EXPECT().ListOutboxEvents(mock.Anything, domain.ListOutboxEventsParams{
+					After: 6,
+					IDs:   []int{},
+					Limit: 10,
+				}).Return([]domain.OutboxEvent{event(8)}, nil).Once()
+				ts.sink.EXPECT().HandleOutboxEvent(mock.Anything, event(8)).Return(nil).Once()
+			},
+			wantCursor: 8,
+			wantGaps:   []int{7},
+		},
+		{
+			name:   "PASS - 늦게 커밋된 이벤트를 전달하고 기다리던 아이디에서 제거",
+			cursor: 8,
+			gaps:   map[int]time.Time{7: time.Now().Add(gapTimeout)},
+			mock: func(ts outboxBroadcasterTestSuite) {
+				ts.outboxRepository.EXPECT().ListOutboxEvents(mock.Anything, domain.ListOutboxEventsParams{
+					After: 8,
+					IDs:   []int{7},
+					Limit: 11,
+				}).Return([]domain.OutboxEvent{event(7)}, nil).Once()
+				ts.sink.EXPECT().HandleOutboxEvent(mock.Anything, event(7)).Return(nil).Once()
+			},
+			wantCursor: 8,
+			wantGaps:   []int{},
+		},
+		{
+			name:   "PASS - 기한이 지난 아이디는 더 기다리지 않고 싱크 실패는 다시 전달하지 않음",
+			cursor: 8,
+			gaps:   map[int]time.Time{7: time.Now().Add(-time.Second)},
+			mock: func(ts outboxBroadcasterTestSuite) {
+				ts.outboxRepository.EXPECT().ListOutboxEvents(mock.Anything, mock.Anything).Return([]domain.OutboxEvent{event(9)}, nil).Once()
+				ts.sink.EXPECT().HandleOutboxEvent(mock.Anything, event(9)).Return(errors.New("sink error")).Once()
+			},
+			wantCursor: 9,
+			wantGaps:   []int{},
+		},
+		{
+			name:   "FAIL - 마지막 이벤트 아이디 조회 실패",
+			cursor: -1,
+			mock: func(ts outboxBroadcasterTestSuite) {
+				ts.outboxRepository.EXPECT().FindLastOutboxEventID(mock.Anything).Return(0, errors.New("db error")).Once()
+			},
+			wantCursor: -1,
+			wantGaps:   []int{},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupOutboxBroadcasterTestSuite(t)
+			ts.broadcaster.cursor = tt.cursor
+			for id, deadline := range tt.gaps {
+				ts.broadcaster.gaps[id] = deadline
+			}
+			tt.mock(ts)
+
+			// when
+			err := ts.broadcaster.poll(context.Background())
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantCursor, ts.broadcaster.cursor)
+			gaps := []int{}
+			for id := range ts.broadcaster.gaps {
+				gaps = append(gaps, id)
+			}
+			assert.Equal(t, tt.wantGaps, gaps)
+		})
+	}
+}
+
+func Test_outboxBroadcaster_Shutdown(t *testing.T) {
+	// given
+	ts := setupOutboxBroadcasterTestSuite(t)
+	ts.broadcaster.Run()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// when
+	err := ts.broadcaster.Shutdown(ctx)
+
+	// then
+	assert.NoError(t, err)
+	assert.NoError(t, ts.broadcaster.Shutdown(ctx))
+}
@@ -0,0 +1,182 @@
+package outbox
+
+import (
+	"classting/config"
+	"classting/domain"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	defaultPollInterval = 200 * time.Millisecond
+	defaultBatchSize    = 100
+	defaultMaxAttempts  = 10
+	dispatchTimeout     = 30 * time.Second
+	// claimLease 가져간 이벤트를 다른 인스턴스가 가져가지 못하는 시간, 전달 중에 종료된 인스턴스의 이벤트는 리스가 끝나면 다시 전달된다.
+	claimLease = time.Minute
+)
+
+// Sink 릴레이가 싱크별 전달 성공을 기록할 수 있도록 싱크에 이름을 붙인다. 이름은 배포가 바뀌어도 같아야 한다.
+type Sink struct {
+	Name string
+	domain.OutboxSink
+}
+
+// outboxRelay 아웃박스에 기록된 이벤트를 가져가(claim) 순서대로 모든 싱크에 전달한다.
+// 실패한 이벤트는 실패한 싱크에만 다시 전달하지만 기록 전에 종료되면 같은 이벤트를 여러 번 받을 수 있다.
+// 이벤트마다 한 인스턴스에서만 전달하므로 인스턴스마다 있는 실시간 허브는 outboxBroadcaster로 전달한다.
+type outboxRelay struct {
+	outboxRepository domain.OutboxRepository
+	sinks            []Sink
+	pollInterval     time.Duration
+	batchSize        int
+	maxAttempts      int
+
+	done     chan struct{}
+	doneOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+func NewOutboxRelay(
+	outboxRepository domain.OutboxRepository,
+	cfg *config.Config,
+	sinks ...Sink,
+) *outboxRelay {
+	pollInterval := defaultPollInterval
+	if cfg.Outbox.PollIntervalMillis > 0 {
+		pollInterval = time.Duration(cfg.Outbox.PollIntervalMillis) * time.Millisecond
+	}
+	batchSize := defaultBatchSize
+	if cfg.Outbox.BatchSize > 0 {
+		batchSize = cfg.Outbox.BatchSize
+	}
+	maxAttempts := defaultMaxAttempts
+	if cfg.Outbox.MaxAttempts > 0 {
+		maxAttempts = cfg.Outbox.MaxAttempts
+	}
+
+	return &outboxRelay{
+		outboxRepository: outboxRepository,
+		sinks:            sinks,
+		pollInterval:     pollInterval,
+		batchSize:        batchSize,
+		maxAttempts:      maxAttempts,
+		done:             make(chan struct{}),
+	}
+}
+
+// Run 아웃박스를 주기적으로 읽어 전달하는 릴레이를 실행한다.
+func (r *outboxRelay) Run() {
+	r.wg.Add(1)
+	go r.relay()
+}
+
+// Shutdown 릴레이를 멈추고 진행 중인 전달이 끝날 때까지 기다린다.
+func (r *outboxRelay) Shutdown(ctx context.Context) error {
+	r.doneOnce.Do(func() {
+		close(r.done)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *outboxRelay) relay() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+			if err := r.dispatchPending(context.Background()); err != nil {
+				log.Printf("outbox: dispatch pending events: %v", err)
+			}
+		}
+	}
+}
+
+func (r *outboxRelay) dispatchPending(ctx context.Context) error {
+	claimDate := time.Now()
+	events, err := r.outboxRepository.ClaimOutboxEvents(ctx, domain.ClaimOutboxEventsParams{
+		Limit:       r.batchSize,
+		MaxAttempts: r.maxAttempts,
+		Lease:       claimLease,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		select {
+		case <-r.done:
+			return nil
+		default:
+		}
+		// 리스가 끝나 가면 남은 이벤트는 다른 인스턴스가 가져갈 수 있으므로 다음 폴링에서 다시 가져간다.
+		if time.Since(claimDate) > claimLease/2 {
+			return nil
+		}
+
+		dispatchedSinks, err := r.dispatch(ctx, event)
+		if err != nil {
+			if event.Attempts+1 >= r.maxAttempts {
+				log.Printf("outbox: event %d %s gave up after %d attempts: %v", event.ID, event.EventType, event.Attempts+1, err)
+			}
+			err = r.outboxRepository.MarkOutboxEventFailed(ctx, domain.MarkOutboxEventFailedParams{
+				ID:              event.ID,
+				LastError:       err.Error(),
+				DispatchedSinks: dispatchedSinks,
+			})
+			if err != nil {
+				log.Printf("outbox: mark event %d failed: %v", event.ID, err)
+			}
+			continue
+		}
+
+		if err := r.outboxRepository.MarkOutboxEventDispatched(ctx, event.ID); err != nil {
+			log.Printf("outbox: mark event %d dispatched: %v", event.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// dispatch 이전 시도에서 성공한 싱크는 건너뛰고, 이번까지 전달에 성공한 싱크 이름을 반환한다.
+func (r *outboxRelay) dispatch(ctx context.Context, event domain.OutboxEvent) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, dispatchTimeout)
+	defer cancel()
+
+	dispatchedSinks := slices.Clone(event.DispatchedSinks)
+	var errs []error
+	for _, sink := range r.sinks {
+		if slices.Contains(dispatchedSinks, sink.Name) {
+			continue
+		}
+		if err := sink.HandleOutboxEvent(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sink.Name, err))
+			continue
+		}
+		dispatchedSinks = append(dispatchedSinks, sink.Name)
+	}
+
+	return dispatchedSinks, errors.Join(errs...)
+}
@@ -0,0 +1,171 @@
+package outbox
+
+import (
+	"classting/config"
+	"classting/domain"
+	"classting/mocks"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+type outboxRelayTestSuite struct {
+	outboxRepository *mocks.OutboxRepository
+	sinks            []*mocks.OutboxSink
+	relay            *outboxRelay
+}
+
+func setupOutboxRelayTestSuite(t *testing.T) outboxRelayTestSuite {
+	var us outboxRelayTestSuite
+
+	us.outboxRepository = mocks.NewOutboxRepository(t)
+	us.sinks = []*mocks.OutboxSink{mocks.NewOutboxSink(t), mocks.NewOutboxSink(t)}
+	us.relay = NewOutboxRelay(us.outboxRepository, &config.Config{
+		Outbox: config.Outbox{
+			MaxAttempts: 3,
+		},
+	}, Sink{Name: "first", OutboxSink: us.sinks[0]}, Sink{Name: "second", OutboxSink: us.sinks[1]})
+
+	return us
+}
+
+func Test_outboxRelay_dispatchPending(t *testing.T) {
+	event := domain.OutboxEvent{
+		ID:            1,
+		AggregateType: domain.OutboxAggregateTypeNews,
+		AggregateID:   1,
+		EventType:     string(domain.NewsEventTypeCreated),
+		Payload:       []byte(`{"ID":1,"SchoolID":1,"Title":"새 소식"}`),
+	}
+
+	tests := []struct {
+		name string
+		mock func(ts outboxRelayTestSuite)
+	}{
+		{
+			name: "PASS - 모든 싱크에 전달 후 전달 완료 처리",
+			mock: func(ts outboxRelayTestSuite) {
+				ts.outboxRepository.EXPECT().ClaimOutboxEvents(mock.Anything, domain.ClaimOutboxEventsParams{
+					Limit:       defaultBatchSize,
+					MaxAttempts: 3,
+					Lease:       claimLease,
+				}).Return([]domain.OutboxEvent{event}, nil).Once()
+				ts.sinks[0].EXPECT().HandleOutboxEvent(mock.Anything, event).Return(nil).Once()
+				ts.sinks[1].EXPECT().HandleOutboxEvent(mock.Anything, event).Return(nil).Once()
+				ts.outboxRepository.EXPECT().MarkOutboxEventDispatched(mock.Anything, 1).Return(nil).Once()
+			},
+		},
+		{
+			name: "PASS - 싱크 하나가 실패하면 성공한 싱크를 함께 실패로 기록",
+			mock: func(ts outboxRelayTestSuite) {
+				ts.outboxRepository.EXPECT().ClaimOutboxEvents(mock.Anything, mock.Anything).Return([]domain.OutboxEvent{event}, nil).Once()
+				ts.sinks[0].EXPECT().HandleOutboxEvent(mock.Anything, event).Return(errors.New("sink error")).Once()
+				ts.sinks[1].EXPECT().HandleOutboxEvent(mock.Anything, event).Return(nil).Once()
+				ts.outboxRepository.EXPECT().MarkOutboxEventFailed(mock.Anything, domain.MarkOutboxEventFailedParams{
+					ID:              1,
+					LastError:       "first: sink error",
+					DispatchedSinks: []string{"second"},
+				}).Return(nil).Once()
+			},
+		},
+		{
+			name: "PASS - 재전달 시 이미 성공한 싱크는 건너뜀",
+			mock: func(ts outboxRelayTestSuite) {
+				retried := event
+				retried.Attempts = 1
+				retried.DispatchedSinks = []string{"second"}
+				ts.outboxRepository.EXPECT().ClaimOutboxEvents(mock.Anything, mock.Anything).Return([]domain.OutboxEvent{retried}, nil).Once()
+				ts.sinks[0].EXPECT().HandleOutboxEvent(mock.Anything, retried).Return(nil).Once()
+				ts.outboxRepository.EXPECT().MarkOutboxEventDispatched(mock.Anything, 1).Return(nil).Once()
+			},
+		},
+		{
+			name: "PASS - 가져간 이벤트가 없으면 전달하지 않음",
+			mock: func(ts outboxRelayTestSuite) {
+				ts.outboxRepository.EXPECT().ClaimOutboxEvents(mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupOutboxRelayTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.relay.dispatchPending(context.Background())
+
+			// then
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_outboxRelay_Shutdown(t *testing.T) {
+	// given
+	ts := setupOutboxRelayTestSuite(t)
+	ts.relay.Run()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// when
+	err := ts.relay.Shutdown(ctx)
+
+	// then
+	assert.NoError(t, err)
+	assert.NoError(t, ts.relay.Shutdown(ctx))
+}
+
+func Test_newsEventSink_HandleOutboxEvent(t *testing.T) {
+	tests := []struct {
+		name  string
+		event domain.OutboxEvent
+		mock  func(publisher *mocks.NewsEventPublisher)
+	}{
+		{
+			name: "PASS - 소식 이벤트 전달",
+			event: domain.OutboxEvent{
+				ID:            7,
+				AggregateType: domain.OutboxAggregateTypeNews,
+				AggregateID:   1,
+				EventType:     string(domain.NewsEventTypeUpdated),
+				Payload:       []byte(`{"ID":1,"SchoolID":2,"Title":"수정된 소식"}`),
+			},
+			mock: func(publisher *mocks.NewsEventPublisher) {
+				publisher.EXPECT().PublishNewsEvent(mock.Anything, domain.NewsEvent{
+					ID:   7,
+					Type: domain.NewsEventTypeUpdated,
+					News: domain.News{Base: domain.Base{ID: 1}, SchoolID: 2, Title: "수정된 소식"},
+				}).Return(nil).Once()
+			},
+		},
+		{
+			name: "PASS - 구독 이벤트는 무시",
+			event: domain.OutboxEvent{
+				ID:            8,
+				AggregateType: domain.OutboxAggregateTypeSubscription,
+				EventType:     string(domain.SubscriptionEventTypeCreated),
+			},
+			mock: func(publisher *mocks.NewsEventPublisher) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			publisher := mocks.NewNewsEventPublisher(t)
+			tt.mock(publisher)
+
+			// when
+			err := NewNewsEventSink(publisher).HandleOutboxEvent(context.Background(), tt.event)
+
+			// then
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package outbox
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"classting/pkg/content"
+	"classting/pkg/db"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+const maxLastErrorLength = 255
+
+type outboxRepository struct {
+	sqlDB *sql.DB
+}
+
+func NewOutboxRepository(sqlDB *sql.DB) *outboxRepository {
+	return &outboxRepository{
+		sqlDB: sqlDB,
+	}
+}
+
+var _ domain.OutboxRepository = (*outboxRepository)(nil)
+
+func (o outboxRepository) ClaimOutboxEvents(ctx context.Context, params domain.ClaimOutboxEventsParams) ([]domain.OutboxEvent, error) {
+	const op cerrors.Op = "outbox/outboxRepository/ClaimOutboxEvents"
+
+	claimToken, err := newClaimToken()
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	now := time.Now().UTC()
+	result, err := o.sqlDB.ExecContext(ctx, claimOutboxEventsQuery, claimToken, now.Add(params.Lease), params.MaxAttempts, now, params.Limit)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	if claimed, err := result.RowsAffected(); err == nil && claimed == 0 {
+		return nil, nil
+	}
+
+	events, err := o.queryOutboxEvents(ctx, listClaimedOutboxEventsQuery, claimToken)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return events, nil
+}
+
+func (o outboxRepository) MarkOutboxEventDispatched(ctx context.Context, eventID int) error {
+	const op cerrors.Op = "outbox/outboxRepository/MarkOutboxEventDispatched"
+
+	_, err := o.sqlDB.ExecContext(ctx, markOutboxEventDispatchedQuery, time.Now().UTC(), eventID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (o outboxRepository) MarkOutboxEventFailed(ctx context.Context, params domain.MarkOutboxEventFailedParams) error {
+	const op cerrors.Op = "outbox/outboxRepository/MarkOutboxEventFailed"
+
+	lastError := content.Truncate(params.LastError, maxLastErrorLength)
+
+	_, err := o.sqlDB.ExecContext(ctx, markOutboxEventFailedQuery, lastError, strings.Join(params.DispatchedSinks, ","), params.ID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (o outboxRepository) ListOutboxEvents(ctx context.Context, params domain.ListOutboxEventsParams) ([]domain.OutboxEvent, error) {
+	const op cerrors.Op = "outbox/outboxRepository/ListOutboxEvents"
+
+	query, args := db.Select(listOutboxEventsQuery).
+		Where(db.Or(db.Expr("id > ?", params.After), db.In("id", params.IDs))).
+		OrderBy("id", false).
+		Limit(params.Limit).
+		Build()
+
+	events, err := o.queryOutboxEvents(ctx, query, args...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return events, nil
+}
+
+func (o outboxRepository) FindLastOutboxEventID(ctx context.Context) (int, error) {
+	const op cerrors.Op = "outbox/outboxRepository/FindLastOutboxEventID"
+
+	var id int
+	if err := o.sqlDB.QueryRowContext(ctx, findLastOutboxEventIDQuery).Scan(&id); err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return id, nil
+}
+
+func (o outboxRepository) queryOutboxEvents(ctx context.Context, query string, args ...any) ([]domain.OutboxEvent, error) {
+	var events []domain.OutboxEvent
+
+	rows, err := o.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			event           domain.OutboxEvent
+			dispatchedSinks string
+		)
+		err := rows.Scan(
+			&event.ID,
+			&event.CreateDate,
+			&event.AggregateType,
+			&event.AggregateID,
+			&event.EventType,
+			&event.Payload,
+			&event.Attempts,
+			&dispatchedSinks,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if dispatchedSinks != "" {
+			event.DispatchedSinks = strings.Split(dispatchedSinks, ",")
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+func newClaimToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
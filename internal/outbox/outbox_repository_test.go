@@ -0,0 +1,140 @@
+package outbox
+
+import (
+	"classting/domain"
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+type outboxRepositoryTestSuite struct {
+	sqlDB            *sql.DB
+	sqlMock          sqlmock.Sqlmock
+	outboxRepository domain.OutboxRepository
+}
+
+func setupOutboxRepositoryTestSuite() outboxRepositoryTestSuite {
+	var us outboxRepositoryTestSuite
+
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	us.sqlDB = mockDB
+	us.sqlMock = mock
+	us.outboxRepository = NewOutboxRepository(mockDB)
+
+	return us
+}
+
+func Test_outboxRepository_ClaimOutboxEvents(t *testing.T) {
+	createDate := time.Now()
+	columns := []string{"id", "create_date", "aggregate_type", "aggregate_id", "event_type", "payload", "attempts", "dispatched_sinks"}
+
+	tests := []struct {
+		name string
+		mock func(ts outboxRepositoryTestSuite)
+		want []domain.OutboxEvent
+	}{
+		{
+			name: "PASS - 토큰을 남긴 이벤트만 조회",
+			mock: func(ts outboxRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`UPDATE outbox SET claim_token = \?, lease_date = \? WHERE dispatch_date IS NULL AND attempts < \? AND \(lease_date IS NULL OR lease_date <= \?\) ORDER BY id LIMIT \?`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 10, sqlmock.AnyArg(), 100).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				rows := sqlmock.NewRows(columns).AddRow(1, createDate, "news", 3, "news.created", []byte(`{"ID":3}`), 1, "webhook,log")
+				ts.sqlMock.ExpectQuery(`SELECT (.+) FROM outbox WHERE claim_token = \? AND dispatch_date IS NULL ORDER BY id`).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(rows)
+			},
+			want: []domain.OutboxEvent{
+				{
+					ID:              1,
+					CreateDate:      createDate,
+					AggregateType:   domain.OutboxAggregateTypeNews,
+					AggregateID:     3,
+					EventType:       "news.created",
+					Payload:         []byte(`{"ID":3}`),
+					Attempts:        1,
+					DispatchedSinks: []string{"webhook", "log"},
+				},
+			},
+		},
+		{
+			name: "PASS - 가져간 이벤트가 없으면 조회하지 않음",
+			mock: func(ts outboxRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`UPDATE outbox SET claim_token = \?`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupOutboxRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.outboxRepository.ClaimOutboxEvents(context.Background(), domain.ClaimOutboxEventsParams{
+				Limit:       100,
+				MaxAttempts: 10,
+				Lease:       time.Minute,
+			})
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_outboxRepository_ListOutboxEvents(t *testing.T) {
+	// given
+	ts := setupOutboxRepositoryTestSuite()
+	createDate := time.Now()
+	columns := []string{"id", "create_date", "aggregate_type", "aggregate_id", "event_type", "payload", "attempts", "dispatched_sinks"}
+	rows := sqlmock.NewRows(columns).
+		AddRow(4, createDate, "subscription", 2, "subscription.created", []byte(`{"ID":2}`), 0, "").
+		AddRow(9, createDate, "news", 3, "news.created", []byte(`{"ID":3}`), 0, "")
+	ts.sqlMock.ExpectQuery(`SELECT (.+) FROM outbox WHERE \(id > \? OR id IN \(\?, \?\)\) ORDER BY id ASC LIMIT \?`).
+		WithArgs(8, 4, 5, 102).
+		WillReturnRows(rows)
+
+	// when
+	got, err := ts.outboxRepository.ListOutboxEvents(context.Background(), domain.ListOutboxEventsParams{
+		After: 8,
+		IDs:   []int{4, 5},
+		Limit: 102,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []int{4, 9}, []int{got[0].ID, got[1].ID})
+	assert.Nil(t, got[0].DispatchedSinks)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_outboxRepository_MarkOutboxEventFailed(t *testing.T) {
+	// given
+	ts := setupOutboxRepositoryTestSuite()
+	ts.sqlMock.ExpectExec(`UPDATE outbox SET attempts = attempts \+ 1`).
+		WithArgs(strings.Repeat("e", maxLastErrorLength), "webhook", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// when
+	err := ts.outboxRepository.MarkOutboxEventFailed(context.Background(), domain.MarkOutboxEventFailedParams{
+		ID:              1,
+		LastError:       strings.Repeat("e", maxLastErrorLength+10),
+		DispatchedSinks: []string{"webhook"},
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
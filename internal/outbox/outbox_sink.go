@@ -0,0 +1,74 @@
+package outbox
+
+import (
+	"classting/domain"
+	"context"
+	"log"
+)
+
+type logSink struct{}
+
+// NewLogSink 아웃박스 이벤트를 로그로 남기는 싱크
+func NewLogSink() *logSink {
+	return &logSink{}
+}
+
+var _ domain.OutboxSink = (*logSink)(nil)
+
+func (s logSink) HandleOutboxEvent(ctx context.Context, event domain.OutboxEvent) error {
+	log.Printf("outbox: event %d %s %s %d", event.ID, event.EventType, event.AggregateType, event.AggregateID)
+
+	return nil
+}
+
+type newsEventSink struct {
+	publisher domain.NewsEventPublisher
+}
+
+// NewNewsEventSink 소식 이벤트를 NewsEventPublisher로 전달하는 싱크, 다른 이벤트는 무시한다.
+func NewNewsEventSink(publisher domain.NewsEventPublisher) *newsEventSink {
+	return &newsEventSink{
+		publisher: publisher,
+	}
+}
+
+var _ domain.OutboxSink = (*newsEventSink)(nil)
+
+func (s newsEventSink) HandleOutboxEvent(ctx context.Context, event domain.OutboxEvent) error {
+	if event.AggregateType != domain.OutboxAggregateTypeNews {
+		return nil
+	}
+
+	newsEvent, err := event.NewsEvent()
+	if err != nil {
+		return err
+	}
+
+	return s.publisher.PublishNewsEvent(ctx, newsEvent)
+}
+
+type subscriptionEventSink struct {
+	publisher domain.SubscriptionEventPublisher
+}
+
+// NewSubscriptionEventSink 구독 이벤트를 SubscriptionEventPublisher로 전달하는 싱크, 다른 이벤트는 무시한다.
+func NewSubscriptionEventSink(publisher domain.SubscriptionEventPublisher) *subscriptionEventSink {
+	return &subscriptionEventSink{
+		publisher: publisher,
+	}
+}
+
+var _ domain.OutboxSink = (*subscriptionEventSink)(nil)
+
+func (s subscriptionEventSink) HandleOutboxEvent(ctx context.Context, event domain.OutboxEvent) error {
+	if event.AggregateType != domain.OutboxAggregateTypeSubscription {
+		return nil
+	}
+
+	subscriptionEvent, err := event.SubscriptionEvent()
+	if err != nil {
+		return err
+	}
+
+	return s.publisher.PublishSubscriptionEvent(ctx, subscriptionEvent)
+}
@@ -0,0 +1,20 @@
+package outbox
+
+const appendOutboxEventQuery = `INSERT INTO outbox (aggregate_type, aggregate_id, event_type, payload) VALUES (?, ?, ?, ?)`
+
+// claimOutboxEventsQuery MySQL 5.7에는 SKIP LOCKED가 없어 전달할 이벤트에 토큰과 리스 만료 시각을 남긴다.
+// 여러 인스턴스가 동시에 실행해도 한 이벤트는 한 인스턴스만 가져가고, 전달 중에 종료되면 리스가 끝난 뒤 다시 전달된다.
+const claimOutboxEventsQuery = `UPDATE outbox SET claim_token = ?, lease_date = ?
+WHERE dispatch_date IS NULL AND attempts < ? AND (lease_date IS NULL OR lease_date <= ?)
+ORDER BY id
+LIMIT ?`
+
+const listClaimedOutboxEventsQuery = `SELECT id, create_date, aggregate_type, aggregate_id, event_type, payload, attempts, dispatched_sinks FROM outbox WHERE claim_token = ? AND dispatch_date IS NULL ORDER BY id`
+
+const markOutboxEventDispatchedQuery = `UPDATE outbox SET dispatch_date = ?, last_error = NULL, claim_token = NULL, lease_date = NULL WHERE id = ?`
+
+const markOutboxEventFailedQuery = `UPDATE outbox SET attempts = attempts + 1, last_error = ?, dispatched_sinks = ?, claim_token = NULL, lease_date = NULL WHERE id = ?`
+
+const findLastOutboxEventIDQuery = `SELECT COALESCE(MAX(id), 0) FROM outbox`
+
+const listOutboxEventsQuery = `SELECT id, create_date, aggregate_type, aggregate_id, event_type, payload, attempts, dispatched_sinks FROM outbox`
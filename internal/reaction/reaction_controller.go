@@ -0,0 +1,114 @@
+package reaction
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"classting/pkg/router"
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+func RegisterRoutes(e *gin.Engine, controller domain.ReactionController, auth *router.JWTAuth) {
+	api := e.Group("/news")
+	{
+		api.PUT("/:newsID/reactions/:emoji", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeStudent}), controller.AddReaction)
+		api.DELETE("/:newsID/reactions/:emoji", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeStudent}), controller.RemoveReaction)
+	}
+}
+
+type reactionController struct {
+	service domain.ReactionService
+}
+
+func NewReactionController(service domain.ReactionService) *reactionController {
+	return &reactionController{
+		service: service,
+	}
+}
+
+var _ domain.ReactionController = (*reactionController)(nil)
+
+// AddReaction
+// @Tags Reaction
+// @Summary 소식 반응 남기기 [추가 구현] 권한 - 학생
+// @Description 구독 중인 학교의 발행된 소식에 이모지 반응을 남깁니다. 이모지마다 한 번만 남길 수 있고 이미 남긴 반응이면 그대로 둡니다.
+// @Description 이모지 - thumbs_up, heart, clap, laugh, surprised
+// @Produce json
+// @Security BearerAuth
+// @Param newsID path int true "소식 ID"
+// @Param emoji path string true "이모지" Enums(thumbs_up, heart, clap, laugh, surprised)
+// @Success 204
+// @Router /news/{newsID}/reactions/{emoji} [put]
+func (n reactionController) AddReaction(c *gin.Context) {
+	var req domain.AddReactionRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := n.service.AddReaction(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RemoveReaction
+// @Tags Reaction
+// @Summary 소식 반응 취소 [추가 구현] 권한 - 학생
+// @Description 소식에 남긴 이모지 반응을 취소합니다. 남기지 않은 반응이어도 성공으로 응답합니다.
+// @Produce json
+// @Security BearerAuth
+// @Param newsID path int true "소식 ID"
+// @Param emoji path string true "이모지" Enums(thumbs_up, heart, clap, laugh, surprised)
+// @Success 204
+// @Router /news/{newsID}/reactions/{emoji} [delete]
+func (n reactionController) RemoveReaction(c *gin.Context) {
+	var req domain.RemoveReactionRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := n.service.RemoveReaction(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,157 @@
+package reaction
+
+import (
+	"classting/config"
+	"classting/domain"
+	"classting/internal/user"
+	"classting/mocks"
+	"classting/pkg/router"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type reactionControllerTestSuite struct {
+	router             *gin.Engine
+	cfg                *config.Config
+	reactionService    *mocks.ReactionService
+	reactionController domain.ReactionController
+}
+
+func setupReactionControllerTestSuite(t *testing.T) reactionControllerTestSuite {
+	var us reactionControllerTestSuite
+
+	gin.SetMode(gin.TestMode)
+	us.router = gin.Default()
+	us.reactionService = mocks.NewReactionService(t)
+	us.cfg = &config.Config{
+		Auth: config.Auth{
+			Secret: "classting_test_secret",
+		},
+	}
+
+	us.reactionController = NewReactionController(us.reactionService)
+	RegisterRoutes(
+		us.router, us.reactionController,
+		router.NewJWTAuth(us.cfg.Auth.Secret, nil, nil),
+	)
+
+	return us
+}
+
+func userToken(ts reactionControllerTestSuite, userType domain.UserType) string {
+	token, _ := user.CreateAccessToken(domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+		Type: userType,
+	}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+
+	return token
+}
+
+func Test_reactionController_AddReaction(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		userType domain.UserType
+		mock     func(ts reactionControllerTestSuite)
+		code     int
+	}{
+		{
+			name:     "PASS - 반응 남기기",
+			path:     "/news/3/reactions/thumbs_up",
+			userType: domain.UserUseTypeStudent,
+			mock: func(ts reactionControllerTestSuite) {
+				ts.reactionService.EXPECT().AddReaction(mock.Anything, domain.AddReactionRequest{
+					UserID: 1,
+					NewsID: 3,
+					Emoji:  domain.ReactionEmojiThumbsUp,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name:     "FAIL - 지원하지 않는 이모지",
+			path:     "/news/3/reactions/angry",
+			userType: domain.UserUseTypeStudent,
+			mock:     func(ts reactionControllerTestSuite) {},
+			code:     http.StatusBadRequest,
+		},
+		{
+			name:     "FAIL - 관리자 권한",
+			path:     "/news/3/reactions/thumbs_up",
+			userType: domain.UserUseTypeAdmin,
+			mock:     func(ts reactionControllerTestSuite) {},
+			code:     http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupReactionControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodPut, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer "+userToken(ts, tt.userType))
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.reactionService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_reactionController_RemoveReaction(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		mock func(ts reactionControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 반응 취소",
+			path: "/news/3/reactions/heart",
+			mock: func(ts reactionControllerTestSuite) {
+				ts.reactionService.EXPECT().RemoveReaction(mock.Anything, domain.RemoveReactionRequest{
+					UserID: 1,
+					NewsID: 3,
+					Emoji:  domain.ReactionEmojiHeart,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "FAIL - 잘못된 소식 ID",
+			path: "/news/0/reactions/heart",
+			mock: func(ts reactionControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupReactionControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodDelete, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer "+userToken(ts, domain.UserUseTypeStudent))
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.reactionService.AssertExpectations(t)
+		})
+	}
+}
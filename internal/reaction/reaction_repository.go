@@ -0,0 +1,78 @@
+package reaction
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"classting/pkg/db"
+	"context"
+	"database/sql"
+)
+
+type reactionRepository struct {
+	sqlDB *sql.DB
+}
+
+func NewReactionRepository(sqlDB *sql.DB) *reactionRepository {
+	return &reactionRepository{
+		sqlDB: sqlDB,
+	}
+}
+
+var _ domain.ReactionRepository = (*reactionRepository)(nil)
+
+// CreateReaction 이미 남긴 반응이면 아무것도 하지 않는다.
+func (r reactionRepository) CreateReaction(ctx context.Context, reaction domain.Reaction) error {
+	const op cerrors.Op = "reaction/reactionRepository/CreateReaction"
+
+	if _, err := r.sqlDB.ExecContext(ctx, createReactionQuery, reaction.NewsID, reaction.UserID, reaction.Emoji); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (r reactionRepository) DeleteReaction(ctx context.Context, params domain.DeleteReactionParams) error {
+	const op cerrors.Op = "reaction/reactionRepository/DeleteReaction"
+
+	if _, err := r.sqlDB.ExecContext(ctx, deleteReactionQuery, params.NewsID, params.UserID, params.Emoji); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// ListReactionCounts 여러 소식의 이모지별 반응 수를 한 번의 쿼리로 집계한다.
+func (r reactionRepository) ListReactionCounts(ctx context.Context, params domain.ListReactionCountsParams) ([]domain.ReactionCount, error) {
+	const op cerrors.Op = "reaction/reactionRepository/ListReactionCounts"
+
+	if len(params.NewsIDs) == 0 {
+		return nil, nil
+	}
+
+	query, args := db.Select(listReactionCountsQuery, params.UserID).
+		Where(db.In("news_id", params.NewsIDs)).
+		GroupBy("news_id", "emoji").
+		OrderBy("news_id", false).
+		OrderBy("emoji", false).
+		Build()
+
+	rows, err := r.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var counts []domain.ReactionCount
+	for rows.Next() {
+		var count domain.ReactionCount
+		if err := rows.Scan(&count.NewsID, &count.Emoji, &count.Count, &count.ReactedByMe); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		counts = append(counts, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,206 @@
+package reaction
+
+import (
+	"classting/domain"
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type reactionRepositoryTestSuite struct {
+	sqlDB              *sql.DB
+	sqlMock            sqlmock.Sqlmock
+	reactionRepository domain.ReactionRepository
+}
+
+func setupReactionRepositoryTestSuite() reactionRepositoryTestSuite {
+	var us reactionRepositoryTestSuite
+
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	us.sqlDB = mockDB
+	us.sqlMock = mock
+	us.reactionRepository = NewReactionRepository(mockDB)
+
+	return us
+}
+
+func Test_reactionRepository_CreateReaction(t *testing.T) {
+	tests := []struct {
+		name     string
+		reaction domain.Reaction
+		mock     func(ts reactionRepositoryTestSuite)
+		wantErr  bool
+	}{
+		{
+			name: "PASS - 반응 남기기",
+			reaction: domain.Reaction{
+				NewsID: 1,
+				UserID: 2,
+				Emoji:  domain.ReactionEmojiHeart,
+			},
+			mock: func(ts reactionRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`INSERT IGNORE INTO news_reactions \(news_id, user_id, emoji\) VALUES \(\?, \?, \?\)`).
+					WithArgs(1, 2, domain.ReactionEmojiHeart).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 서버 에러",
+			reaction: domain.Reaction{
+				NewsID: 1,
+				UserID: 2,
+				Emoji:  domain.ReactionEmojiHeart,
+			},
+			mock: func(ts reactionRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`INSERT IGNORE INTO news_reactions`).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupReactionRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			err := ts.reactionRepository.CreateReaction(context.Background(), tt.reaction)
+
+			// then
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_reactionRepository_DeleteReaction(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  domain.DeleteReactionParams
+		mock    func(ts reactionRepositoryTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 반응 취소",
+			params: domain.DeleteReactionParams{
+				NewsID: 1,
+				UserID: 2,
+				Emoji:  domain.ReactionEmojiHeart,
+			},
+			mock: func(ts reactionRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`DELETE FROM news_reactions WHERE news_id = \? AND user_id = \? AND emoji = \?`).
+					WithArgs(1, 2, domain.ReactionEmojiHeart).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 서버 에러",
+			params: domain.DeleteReactionParams{
+				NewsID: 1,
+				UserID: 2,
+				Emoji:  domain.ReactionEmojiHeart,
+			},
+			mock: func(ts reactionRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`DELETE FROM news_reactions`).WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupReactionRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			err := ts.reactionRepository.DeleteReaction(context.Background(), tt.params)
+
+			// then
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_reactionRepository_ListReactionCounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  domain.ListReactionCountsParams
+		mock    func(ts reactionRepositoryTestSuite)
+		want    []domain.ReactionCount
+		wantErr bool
+	}{
+		{
+			name: "PASS - 여러 소식의 반응 수를 한 번에 조회",
+			params: domain.ListReactionCountsParams{
+				UserID:  2,
+				NewsIDs: []int{3, 1},
+			},
+			mock: func(ts reactionRepositoryTestSuite) {
+				rows := sqlmock.NewRows([]string{"news_id", "emoji", "count", "reacted_by_me"}).
+					AddRow(1, "heart", 2, 0).
+					AddRow(3, "thumbs_up", 5, 1)
+				ts.sqlMock.ExpectQuery(`SELECT news_id, emoji, COUNT\(\*\), MAX\(user_id = \?\) FROM news_reactions WHERE news_id IN \(\?, \?\) GROUP BY news_id, emoji`).
+					WithArgs(2, 3, 1).
+					WillReturnRows(rows)
+			},
+			want: []domain.ReactionCount{
+				{NewsID: 1, Emoji: domain.ReactionEmojiHeart, Count: 2, ReactedByMe: false},
+				{NewsID: 3, Emoji: domain.ReactionEmojiThumbsUp, Count: 5, ReactedByMe: true},
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 조회할 소식이 없는 경우",
+			params: domain.ListReactionCountsParams{
+				UserID: 2,
+			},
+			mock:    func(ts reactionRepositoryTestSuite) {},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 서버 에러",
+			params: domain.ListReactionCountsParams{
+				UserID:  2,
+				NewsIDs: []int{1},
+			},
+			mock: func(ts reactionRepositoryTestSuite) {
+				ts.sqlMock.ExpectQuery(`SELECT news_id, emoji`).WillReturnError(sql.ErrConnDone)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupReactionRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.reactionRepository.ListReactionCounts(context.Background(), tt.params)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package reaction
+
+import (
+	"classting/domain"
+	"classting/internal/school"
+	"classting/pkg/cerrors"
+	"context"
+)
+
+type reactionService struct {
+	reactionRepository     domain.ReactionRepository
+	newsRepository         domain.NewsRepository
+	schoolRepository       domain.SchoolRepository
+	subscriptionRepository domain.SubscriptionRepository
+}
+
+func NewReactionService(
+	reactionRepository domain.ReactionRepository,
+	newsRepository domain.NewsRepository,
+	schoolRepository domain.SchoolRepository,
+	subscriptionRepository domain.SubscriptionRepository,
+) *reactionService {
+	return &reactionService{
+		reactionRepository:     reactionRepository,
+		newsRepository:         newsRepository,
+		schoolRepository:       schoolRepository,
+		subscriptionRepository: subscriptionRepository,
+	}
+}
+
+var _ domain.ReactionService = (*reactionService)(nil)
+
+func (s reactionService) AddReaction(ctx context.Context, req domain.AddReactionRequest) error {
+	const op cerrors.Op = "reaction/service/AddReaction"
+
+	news, err := s.findNews(ctx, op, req.NewsID)
+	if err != nil {
+		return err
+	}
+	if news.Status != domain.NewsStatusPublished {
+		return cerrors.E(op, cerrors.NotExist, "소식을 찾을 수 없습니다.")
+	}
+
+	subscription, err := s.subscriptionRepository.FindSubscriptionByUserIDAndSchoolID(ctx, domain.FindSubscriptionByUserIDAndSchoolIDParams{
+		UserID:   req.UserID,
+		SchoolID: news.SchoolID,
+	})
+	if err != nil {
+		return err
+	}
+	if subscription == nil {
+		return cerrors.E(op, cerrors.Permission, "구독한 학교의 소식에만 반응할 수 있습니다.")
+	}
+	if _, err := school.FindActiveSchool(ctx, s.schoolRepository, op, news.SchoolID); err != nil {
+		return err
+	}
+
+	return s.reactionRepository.CreateReaction(ctx, domain.Reaction{
+		NewsID: news.ID,
+		UserID: req.UserID,
+		Emoji:  req.Emoji,
+	})
+}
+
+// RemoveReaction 자신이 남긴 반응은 구독을 취소한 뒤에도 지울 수 있다.
+func (s reactionService) RemoveReaction(ctx context.Context, req domain.RemoveReactionRequest) error {
+	const op cerrors.Op = "reaction/service/RemoveReaction"
+
+	news, err := s.findNews(ctx, op, req.NewsID)
+	if err != nil {
+		return err
+	}
+
+	return s.reactionRepository.DeleteReaction(ctx, domain.DeleteReactionParams{
+		NewsID: news.ID,
+		UserID: req.UserID,
+		Emoji:  req.Emoji,
+	})
+}
+
+// ListNewsReactions 소식 ID별 이모지 반응 수와 조회한 유저의 반응 여부를 반환한다.
+func (s reactionService) ListNewsReactions(ctx context.Context, params domain.ListReactionCountsParams) (map[int][]domain.ReactionDTO, error) {
+	counts, err := s.reactionRepository.ListReactionCounts(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	reactionDTOs := make(map[int][]domain.ReactionDTO)
+	for _, count := range counts {
+		reactionDTOs[count.NewsID] = append(reactionDTOs[count.NewsID], domain.ReactionDTOFrom(count))
+	}
+
+	return reactionDTOs, nil
+}
+
+func (s reactionService) findNews(ctx context.Context, op cerrors.Op, newsID int) (*domain.News, error) {
+	news, err := s.newsRepository.FindNewsByID(ctx, newsID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	if news == nil || news.DeleteDate.Valid {
+		return nil, cerrors.E(op, cerrors.NotExist, "소식을 찾을 수 없습니다.")
+	}
+
+	return news, nil
+}
@@ -0,0 +1,202 @@
+package reaction
+
+import (
+	"classting/domain"
+	"classting/mocks"
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+type reactionServiceTestSuite struct {
+	reactionRepository     *mocks.ReactionRepository
+	newsRepository         *mocks.NewsRepository
+	schoolRepository       *mocks.SchoolRepository
+	subscriptionRepository *mocks.SubscriptionRepository
+	service                domain.ReactionService
+}
+
+func setupReactionServiceTestSuite(t *testing.T) reactionServiceTestSuite {
+	var us reactionServiceTestSuite
+
+	us.reactionRepository = mocks.NewReactionRepository(t)
+	us.newsRepository = mocks.NewNewsRepository(t)
+	us.schoolRepository = mocks.NewSchoolRepository(t)
+	us.subscriptionRepository = mocks.NewSubscriptionRepository(t)
+	us.service = NewReactionService(us.reactionRepository, us.newsRepository, us.schoolRepository, us.subscriptionRepository)
+
+	return us
+}
+
+func testNews(status domain.NewsStatus) *domain.News {
+	return &domain.News{
+		Base:     domain.Base{ID: 3},
+		SchoolID: 1,
+		Status:   status,
+	}
+}
+
+func Test_reactionService_AddReaction(t *testing.T) {
+	req := domain.AddReactionRequest{
+		UserID: 1,
+		NewsID: 3,
+		Emoji:  domain.ReactionEmojiThumbsUp,
+	}
+
+	tests := []struct {
+		name    string
+		mock    func(ts reactionServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 구독한 학교의 소식에 반응 남기기",
+			mock: func(ts reactionServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusPublished), nil).Once()
+				ts.subscriptionRepository.EXPECT().FindSubscriptionByUserIDAndSchoolID(mock.Anything, domain.FindSubscriptionByUserIDAndSchoolIDParams{
+					UserID:   1,
+					SchoolID: 1,
+				}).Return(&domain.Subscription{Base: domain.Base{ID: 1}, UserID: 1, SchoolID: 1}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{Base: domain.Base{ID: 1}}, nil).Once()
+				ts.reactionRepository.EXPECT().CreateReaction(mock.Anything, domain.Reaction{
+					NewsID: 3,
+					UserID: 1,
+					Emoji:  domain.ReactionEmojiThumbsUp,
+				}).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 구독하지 않은 학교의 소식",
+			mock: func(ts reactionServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusPublished), nil).Once()
+				ts.subscriptionRepository.EXPECT().FindSubscriptionByUserIDAndSchoolID(mock.Anything, domain.FindSubscriptionByUserIDAndSchoolIDParams{
+					UserID:   1,
+					SchoolID: 1,
+				}).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 삭제된 학교의 소식",
+			mock: func(ts reactionServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusPublished), nil).Once()
+				ts.subscriptionRepository.EXPECT().FindSubscriptionByUserIDAndSchoolID(mock.Anything, mock.Anything).
+					Return(&domain.Subscription{Base: domain.Base{ID: 1}, UserID: 1, SchoolID: 1}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{ID: 1, DeleteDate: sql.NullTime{Time: time.Now(), Valid: true}},
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 발행되지 않은 소식",
+			mock: func(ts reactionServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusScheduled), nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 삭제된 소식",
+			mock: func(ts reactionServiceTestSuite) {
+				news := testNews(domain.NewsStatusPublished)
+				news.DeleteDate = sql.NullTime{Time: time.Now(), Valid: true}
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(news, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupReactionServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.AddReaction(context.Background(), req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_reactionService_RemoveReaction(t *testing.T) {
+	req := domain.RemoveReactionRequest{
+		UserID: 1,
+		NewsID: 3,
+		Emoji:  domain.ReactionEmojiHeart,
+	}
+
+	tests := []struct {
+		name    string
+		mock    func(ts reactionServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 반응 취소",
+			mock: func(ts reactionServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(testNews(domain.NewsStatusArchived), nil).Once()
+				ts.reactionRepository.EXPECT().DeleteReaction(mock.Anything, domain.DeleteReactionParams{
+					NewsID: 3,
+					UserID: 1,
+					Emoji:  domain.ReactionEmojiHeart,
+				}).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 존재하지 않는 소식",
+			mock: func(ts reactionServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupReactionServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.RemoveReaction(context.Background(), req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_reactionService_ListNewsReactions(t *testing.T) {
+	// given
+	ts := setupReactionServiceTestSuite(t)
+	params := domain.ListReactionCountsParams{
+		UserID:  1,
+		NewsIDs: []int{3, 1},
+	}
+	ts.reactionRepository.EXPECT().ListReactionCounts(mock.Anything, params).Return([]domain.ReactionCount{
+		{NewsID: 1, Emoji: domain.ReactionEmojiHeart, Count: 2},
+		{NewsID: 3, Emoji: domain.ReactionEmojiClap, Count: 1},
+		{NewsID: 3, Emoji: domain.ReactionEmojiThumbsUp, Count: 5, ReactedByMe: true},
+	}, nil).Once()
+
+	// when
+	got, err := ts.service.ListNewsReactions(context.Background(), params)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, map[int][]domain.ReactionDTO{
+		1: {
+			{Emoji: domain.ReactionEmojiHeart, Count: 2},
+		},
+		3: {
+			{Emoji: domain.ReactionEmojiClap, Count: 1},
+			{Emoji: domain.ReactionEmojiThumbsUp, Count: 5, ReactedByMe: true},
+		},
+	}, got)
+}
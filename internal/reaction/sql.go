@@ -0,0 +1,8 @@
+package reaction
+
+const createReactionQuery = `INSERT IGNORE INTO news_reactions (news_id, user_id, emoji) VALUES (?, ?, ?)`
+
+const deleteReactionQuery = `DELETE FROM news_reactions WHERE news_id = ? AND user_id = ? AND emoji = ?`
+
+// listReactionCountsQuery 플레이스홀더는 반응 여부를 확인할 유저 ID, 소식 ID 조건과 그룹, 정렬은 db.Query로 붙인다.
+const listReactionCountsQuery = `SELECT news_id, emoji, COUNT(*), MAX(user_id = ?) FROM news_reactions`
@@ -11,11 +11,19 @@ import (
 	"time"
 )
 
-func RegisterRoutes(e *gin.Engine, controller domain.SchoolController, cfg *config.Config) {
+func RegisterRoutes(e *gin.Engine, controller domain.SchoolController, auth *router.JWTAuth) {
 	api := e.Group("/schools")
 	{
-		api.POST("", router.JWTMiddleware(cfg.Auth.Secret, []domain.UserType{domain.UserUseTypeAdmin}), controller.CreateSchool)
-		api.GET("", router.JWTMiddleware(cfg.Auth.Secret, []domain.UserType{domain.UserUseTypeAdmin, domain.UserUseTypeStudent}), controller.ListSchools)
+		api.POST("", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.CreateSchool)
+		api.GET("", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin, domain.UserUseTypeStudent}), controller.ListSchools)
+		api.GET("/regions", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin, domain.UserUseTypeStudent}), controller.ListRegions)
+		api.PUT("/:schoolID", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.UpdateSchool)
+		api.DELETE("/:schoolID", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.DeleteSchool)
+		api.POST("/:schoolID/restore", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.RestoreSchool)
+		api.GET("/:schoolID/members", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.ListSchoolMembers)
+		api.POST("/:schoolID/members", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.InviteSchoolMember)
+		api.DELETE("/:schoolID/members/:userID", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.RemoveSchoolMember)
+		api.PUT("/:schoolID/owner", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.TransferSchoolOwnership)
 	}
 }
 
@@ -74,13 +82,18 @@ func (u schoolController) CreateSchool(c *gin.Context) {
 
 // ListSchools
 // @Summary 학교 목록 조회 [테스트 추가 API] 권한 - 관리자, 학생
-// @Description 학교의 목록을 조회 합니다 커서기반 페이징 id을 기반으로 최신순 정렬
+// @Description 학교의 목록을 조회 합니다 커서기반 페이징, 기본은 id을 기반으로 최신순 정렬
 // @Description 유저 아이디를 통해 해당 유저가 소유한 학교인지 확인합니다.
+// @Description 지역(시/도)으로 거르고 학교명(name), 구독자 수(subscribers) 순으로 정렬할 수 있으며, 학교마다 구독자 수와 조회한 유저의 구독 여부를 함께 반환합니다.
+// @Description 커서는 응답으로 받은 값을 그대로 사용하며 같은 정렬 기준으로만 사용할 수 있습니다.
 // @Tags Schools
 // @Produce json
 // @Security BearerAuth
-// @Param cursor query int false "커서"
+// @Param cursor query string false "이전 응답의 cursor 또는 prevCursor"
+// @Param size query int false "페이지 크기"
 // @Param userID query int false "유저 아이디"
+// @Param region query string false "지역 (시/도 약칭 또는 정식 명칭)"
+// @Param sort query string false "정렬 기준" Enums(latest, name, subscribers)
 // @Success 200 {object} domain.ListSchoolsResponse "학교 목록"
 // @Router /schools [get]
 func (u schoolController) ListSchools(c *gin.Context) {
@@ -91,6 +104,13 @@ func (u schoolController) ListSchools(c *gin.Context) {
 		return
 	}
 
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.RequestUserID = userID
+
 	if err := req.Validate(); err != nil {
 		c.JSON(cerrors.ToSentinelAPIError(err))
 		return
@@ -107,3 +127,329 @@ func (u schoolController) ListSchools(c *gin.Context) {
 
 	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
 }
+
+// ListRegions
+// @Summary 지역 목록 조회 [추가 구현] 권한 - 관리자, 학생
+// @Description 학교 생성, 수정과 목록 조회에 사용할 수 있는 시/도 목록을 조회합니다.
+// @Tags Schools
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} domain.ListRegionsResponse "지역 목록"
+// @Router /schools/regions [get]
+func (u schoolController) ListRegions(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := u.service.ListRegions(ctx)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
+}
+
+// UpdateSchool
+// @Tags Schools
+// @Summary 학교 수정 [추가 구현] 권한 - 관리자
+// @Description 학교 OWNER가 학교명과 지역을 수정합니다. 다른 학교가 사용 중인 지역, 학교명으로는 수정할 수 없습니다.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param schoolID path int true "학교 ID"
+// @Param UpdateSchoolRequest body domain.UpdateSchoolRequest true "학교 수정 요청"
+// @Success 204
+// @Router /schools/{schoolID} [put]
+func (u schoolController) UpdateSchool(c *gin.Context) {
+	var req domain.UpdateSchoolRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := u.service.UpdateSchool(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// DeleteSchool
+// @Tags Schools
+// @Summary 학교 삭제 [추가 구현] 권한 - 관리자
+// @Description 학교 OWNER가 학교를 삭제합니다. 삭제된 학교는 학교 목록에서 제외되고 새로 구독하거나 소식을 발행할 수 없습니다.
+// @Description 기존 구독자의 구독 목록에는 schoolDeleted가 true로 표시되며 이미 발행된 소식은 계속 조회할 수 있습니다.
+// @Produce json
+// @Security BearerAuth
+// @Param schoolID path int true "학교 ID"
+// @Success 204
+// @Router /schools/{schoolID} [delete]
+func (u schoolController) DeleteSchool(c *gin.Context) {
+	var req domain.DeleteSchoolRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := u.service.DeleteSchool(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RestoreSchool
+// @Tags Schools
+// @Summary 학교 복구 [추가 구현] 권한 - 관리자
+// @Description 학교 OWNER가 삭제된 학교를 복구합니다. 삭제 후 같은 지역, 학교명의 학교가 만들어졌다면 복구할 수 없습니다.
+// @Produce json
+// @Security BearerAuth
+// @Param schoolID path int true "학교 ID"
+// @Success 204
+// @Router /schools/{schoolID}/restore [post]
+func (u schoolController) RestoreSchool(c *gin.Context) {
+	var req domain.RestoreSchoolRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := u.service.RestoreSchool(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListSchoolMembers
+// @Tags Schools
+// @Summary 학교 멤버 목록 조회 [추가 구현] 권한 - 관리자
+// @Description 학교를 함께 운영하는 멤버와 역할을 조회합니다.
+// @Description OWNER는 멤버 초대, 제외, 소유권 이전과 웹훅 관리를, EDITOR는 소식 발행, 수정, 삭제를, VIEWER는 소식 조회를 할 수 있습니다.
+// @Produce json
+// @Security BearerAuth
+// @Param schoolID path int true "학교 ID"
+// @Success 200 {object} domain.ListSchoolMembersResponse "학교 멤버 목록"
+// @Router /schools/{schoolID}/members [get]
+func (u schoolController) ListSchoolMembers(c *gin.Context) {
+	var req domain.ListSchoolMembersRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := u.service.ListSchoolMembers(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
+}
+
+// InviteSchoolMember
+// @Tags Schools
+// @Summary 학교 멤버 초대 [추가 구현] 권한 - 관리자
+// @Description 관리자 유저를 EDITOR 또는 VIEWER 역할로 학교 멤버에 추가합니다.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param schoolID path int true "학교 ID"
+// @Param InviteSchoolMemberRequest body domain.InviteSchoolMemberRequest true "학교 멤버 초대 요청"
+// @Success 204
+// @Router /schools/{schoolID}/members [post]
+func (u schoolController) InviteSchoolMember(c *gin.Context) {
+	var req domain.InviteSchoolMemberRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := u.service.InviteSchoolMember(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RemoveSchoolMember
+// @Tags Schools
+// @Summary 학교 멤버 제외 [추가 구현] 권한 - 관리자
+// @Description OWNER는 다른 멤버를 제외할 수 있고 멤버는 자신의 유저 ID로 학교에서 나갈 수 있습니다. OWNER는 소유권을 이전한 후에 제외할 수 있습니다.
+// @Produce json
+// @Security BearerAuth
+// @Param schoolID path int true "학교 ID"
+// @Param userID path int true "제외할 유저 ID"
+// @Success 204
+// @Router /schools/{schoolID}/members/{userID} [delete]
+func (u schoolController) RemoveSchoolMember(c *gin.Context) {
+	var req domain.RemoveSchoolMemberRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := u.service.RemoveSchoolMember(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// TransferSchoolOwnership
+// @Tags Schools
+// @Summary 학교 소유권 이전 [추가 구현] 권한 - 관리자
+// @Description 학교 멤버에게 소유권을 이전합니다. 이전 OWNER는 EDITOR가 됩니다.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param schoolID path int true "학교 ID"
+// @Param TransferSchoolOwnershipRequest body domain.TransferSchoolOwnershipRequest true "소유권 이전 요청"
+// @Success 204
+// @Router /schools/{schoolID}/owner [put]
+func (u schoolController) TransferSchoolOwnership(c *gin.Context) {
+	var req domain.TransferSchoolOwnershipRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := u.service.TransferSchoolOwnership(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -6,6 +6,7 @@ import (
 	"classting/domain"
 	"classting/internal/user"
 	"classting/mocks"
+	"classting/pkg/router"
 	"encoding/json"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -40,7 +41,7 @@ func setupSchoolControllerTestSuite(t *testing.T) schoolControllerTestSuite {
 	us.schoolController = NewSchoolController(us.schoolService, us.cfg)
 	RegisterRoutes(
 		us.router, us.schoolController,
-		us.cfg,
+		router.NewJWTAuth(us.cfg.Auth.Secret, nil, nil),
 	)
 
 	return us
@@ -101,6 +102,20 @@ func Test_schoolController_CreateSchool(t *testing.T) {
 			mock: func(ts schoolControllerTestSuite) {},
 			code: http.StatusBadRequest,
 		},
+		{
+			name: "FAIL - 지역 분류에 없는 지역",
+			body: func() *bytes.Reader {
+				req := domain.CreateSchoolRequest{
+					Name:   "클래스팅",
+					Region: "서울 강남구",
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts schoolControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -144,7 +159,8 @@ func Test_schoolController_ListSchools(t *testing.T) {
 			},
 			mock: func(ts schoolControllerTestSuite) {
 				ts.schoolService.EXPECT().ListSchools(mock.Anything, domain.ListSchoolsRequest{
-					UserID: nil,
+					RequestUserID: 1,
+					UserID:        nil,
 				}).Return(domain.ListSchoolsResponse{}, nil).Once()
 			},
 			code: http.StatusOK,
@@ -158,45 +174,73 @@ func Test_schoolController_ListSchools(t *testing.T) {
 			},
 			mock: func(ts schoolControllerTestSuite) {
 				ts.schoolService.EXPECT().ListSchools(mock.Anything, domain.ListSchoolsRequest{
-					UserID: pointer.Int(1),
+					RequestUserID: 1,
+					UserID:        pointer.Int(1),
 				}).Return(domain.ListSchoolsResponse{}, nil).Once()
 			},
 			code: http.StatusOK,
 		},
 		{
-			name: "PASS - 일부 조회 (커서 입력)",
+			name: "PASS - 지역, 정렬 기준 입력",
 			query: func() string {
 				params := url.Values{}
-				params.Add("cursor", "1")
+				params.Add("region", "부산광역시")
+				params.Add("sort", "subscribers")
 				return params.Encode()
 			},
 			mock: func(ts schoolControllerTestSuite) {
 				ts.schoolService.EXPECT().ListSchools(mock.Anything, domain.ListSchoolsRequest{
-					UserID: nil,
-					Cursor: pointer.Int(1),
+					RequestUserID: 1,
+					Region:        "부산광역시",
+					Sort:          domain.SchoolSortSubscribers,
 				}).Return(domain.ListSchoolsResponse{}, nil).Once()
 			},
 			code: http.StatusOK,
 		},
 		{
-			name: "PASS - 전체 조회 (커서 미 입력)",
+			name: "PASS - 일부 조회 (커서 입력)",
 			query: func() string {
 				params := url.Values{}
+				params.Add("sort", "name")
+				params.Add("cursor", "eyJpIjozLCJkIjoibmV4dCJ9.c2lnbmF0dXJl")
+				params.Add("size", "20")
 				return params.Encode()
 			},
 			mock: func(ts schoolControllerTestSuite) {
 				ts.schoolService.EXPECT().ListSchools(mock.Anything, domain.ListSchoolsRequest{
-					UserID: nil,
-					Cursor: nil,
+					RequestUserID: 1,
+					Sort:          domain.SchoolSortName,
+					Cursor:        "eyJpIjozLCJkIjoibmV4dCJ9.c2lnbmF0dXJl",
+					Size:          20,
 				}).Return(domain.ListSchoolsResponse{}, nil).Once()
 			},
 			code: http.StatusOK,
 		},
 		{
-			name: "FAIL - 전체 조회 (커서 제로 값 입력)",
+			name: "FAIL - 음수 페이지 크기",
+			query: func() string {
+				params := url.Values{}
+				params.Add("size", "-1")
+				return params.Encode()
+			},
+			mock: func(ts schoolControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 지원하지 않는 지역",
 			query: func() string {
 				params := url.Values{}
-				params.Add("cursor", "0")
+				params.Add("region", "도쿄")
+				return params.Encode()
+			},
+			mock: func(ts schoolControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 지원하지 않는 정렬 기준",
+			query: func() string {
+				params := url.Values{}
+				params.Add("sort", "popular")
 				return params.Encode()
 			},
 			mock: func(ts schoolControllerTestSuite) {},
@@ -240,3 +284,215 @@ func Test_schoolController_ListSchools(t *testing.T) {
 		})
 	}
 }
+
+func Test_schoolController_ListRegions(t *testing.T) {
+	// given
+	ts := setupSchoolControllerTestSuite(t)
+	ts.schoolService.EXPECT().ListRegions(mock.Anything).Return(domain.ListRegionsResponse{
+		Regions: []domain.RegionDTO{
+			{Code: "서울", Name: "서울특별시"},
+		},
+	}, nil).Once()
+	req, _ := http.NewRequest(http.MethodGet, "/schools/regions", nil)
+	token, _ := user.CreateAccessToken(domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+		Type: domain.UserUseTypeStudent,
+	}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	// when
+	rec := httptest.NewRecorder()
+	ts.router.ServeHTTP(rec, req)
+
+	// then
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func Test_schoolController_InviteSchoolMember(t *testing.T) {
+	tests := []struct {
+		name string
+		body func() *bytes.Reader
+		mock func(ts schoolControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - EDITOR로 초대",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.InviteSchoolMemberRequest{
+					UserName: "classting_admin_2",
+					Role:     domain.SchoolRoleEditor,
+				})
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts schoolControllerTestSuite) {
+				ts.schoolService.EXPECT().InviteSchoolMember(mock.Anything, domain.InviteSchoolMemberRequest{
+					UserID:   1,
+					SchoolID: 1,
+					UserName: "classting_admin_2",
+					Role:     domain.SchoolRoleEditor,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "FAIL - OWNER 역할로 초대",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.InviteSchoolMemberRequest{
+					UserName: "classting_admin_2",
+					Role:     domain.SchoolRoleOwner,
+				})
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts schoolControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSchoolControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodPost, "/schools/1/members", tt.body())
+			req.Header.Set("Content-Type", "application/json")
+			token, _ := user.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+				Type: domain.UserUseTypeAdmin,
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.schoolService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_schoolController_RemoveSchoolMember(t *testing.T) {
+	// given
+	ts := setupSchoolControllerTestSuite(t)
+	ts.schoolService.EXPECT().RemoveSchoolMember(mock.Anything, domain.RemoveSchoolMemberRequest{
+		UserID:       1,
+		SchoolID:     1,
+		MemberUserID: 2,
+	}).Return(nil).Once()
+	req, _ := http.NewRequest(http.MethodDelete, "/schools/1/members/2", nil)
+	token, _ := user.CreateAccessToken(domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+		Type: domain.UserUseTypeAdmin,
+	}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	// when
+	rec := httptest.NewRecorder()
+	ts.router.ServeHTTP(rec, req)
+
+	// then
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func Test_schoolController_TransferSchoolOwnership(t *testing.T) {
+	// given
+	ts := setupSchoolControllerTestSuite(t)
+	ts.schoolService.EXPECT().TransferSchoolOwnership(mock.Anything, domain.TransferSchoolOwnershipRequest{
+		UserID:   1,
+		SchoolID: 1,
+		ToUserID: 2,
+	}).Return(nil).Once()
+	req, _ := http.NewRequest(http.MethodPut, "/schools/1/owner", bytes.NewReader([]byte(`{"userID":2}`)))
+	req.Header.Set("Content-Type", "application/json")
+	token, _ := user.CreateAccessToken(domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+		Type: domain.UserUseTypeAdmin,
+	}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	// when
+	rec := httptest.NewRecorder()
+	ts.router.ServeHTTP(rec, req)
+
+	// then
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func Test_schoolController_UpdateSchool(t *testing.T) {
+	tests := []struct {
+		name string
+		body func() *bytes.Reader
+		mock func(ts schoolControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 학교 수정",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.UpdateSchoolRequest{
+					Name:   "클래스팅",
+					Region: "부산",
+				})
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts schoolControllerTestSuite) {
+				ts.schoolService.EXPECT().UpdateSchool(mock.Anything, domain.UpdateSchoolRequest{
+					UserID:   1,
+					SchoolID: 1,
+					Name:     "클래스팅",
+					Region:   "부산",
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "FAIL - 빈 문자열 지역",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.UpdateSchoolRequest{
+					Name:   "클래스팅",
+					Region: "",
+				})
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts schoolControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSchoolControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodPut, "/schools/1", tt.body())
+			req.Header.Set("Content-Type", "application/json")
+			token, _ := user.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+				Type: domain.UserUseTypeAdmin,
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.schoolService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package school
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"context"
+)
+
+// AuthorizeMember 학교가 있고 userID가 role 이상의 권한을 가진 멤버인지 확인하고 학교를 반환한다.
+// 학교 권한을 확인하는 서비스는 모두 이 함수를 사용하여 없는 학교는 NotExist, 권한이 없으면 Permission으로 응답한다.
+func AuthorizeMember(ctx context.Context, schoolRepository domain.SchoolRepository, op cerrors.Op, schoolID, userID int, role domain.SchoolRole) (*domain.School, error) {
+	school, err := schoolRepository.FindSchoolByID(ctx, schoolID)
+	if err != nil {
+		return nil, err
+	}
+	if school == nil {
+		return nil, cerrors.E(op, cerrors.NotExist, "해당 학교가 존재하지 않습니다.")
+	}
+
+	member, err := schoolRepository.FindSchoolMember(ctx, domain.FindSchoolMemberParams{
+		SchoolID: schoolID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if member == nil || !member.Role.Includes(role) {
+		return nil, cerrors.E(op, cerrors.Permission, "해당 학교에 대한 권한이 없습니다.")
+	}
+
+	return school, nil
+}
+
+// AuthorizeActiveMember AuthorizeMember에 더해 삭제된 학교를 거부한다.
+// 삭제된 학교는 복구하기 전까지 소식, 첨부 파일, 댓글, 웹훅을 추가하거나 바꿀 수 없다.
+func AuthorizeActiveMember(ctx context.Context, schoolRepository domain.SchoolRepository, op cerrors.Op, schoolID, userID int, role domain.SchoolRole) (*domain.School, error) {
+	school, err := AuthorizeMember(ctx, schoolRepository, op, schoolID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+	if school.Deleted() {
+		return nil, errDeletedSchool(op)
+	}
+
+	return school, nil
+}
+
+// FindActiveSchool 멤버가 아닌 구독자가 학교의 소식에 댓글, 반응을 남길 때 학교가 삭제되지 않았는지 확인한다.
+func FindActiveSchool(ctx context.Context, schoolRepository domain.SchoolRepository, op cerrors.Op, schoolID int) (*domain.School, error) {
+	school, err := schoolRepository.FindSchoolByID(ctx, schoolID)
+	if err != nil {
+		return nil, err
+	}
+	if school == nil {
+		return nil, cerrors.E(op, cerrors.NotExist, "해당 학교가 존재하지 않습니다.")
+	}
+	if school.Deleted() {
+		return nil, errDeletedSchool(op)
+	}
+
+	return school, nil
+}
+
+func errDeletedSchool(op cerrors.Op) error {
+	return cerrors.E(op, cerrors.Invalid, "삭제된 학교입니다. 학교를 복구한 후 이용해주세요.")
+}
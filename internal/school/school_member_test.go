@@ -0,0 +1,133 @@
+package school
+
+import (
+	"classting/domain"
+	"classting/mocks"
+	"classting/pkg/cerrors"
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestAuthorizeMember(t *testing.T) {
+	const op cerrors.Op = "school/test/AuthorizeMember"
+
+	tests := []struct {
+		name     string
+		role     domain.SchoolRole
+		mock     func(schoolRepository *mocks.SchoolRepository)
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 요구 권한 이상의 멤버",
+			role: domain.SchoolRoleEditor,
+			mock: func(schoolRepository *mocks.SchoolRepository) {
+				schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{Base: domain.Base{ID: 1}}, nil).Once()
+				schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{SchoolID: 1, UserID: 2}).
+					Return(&domain.SchoolMember{SchoolID: 1, UserID: 2, Role: domain.SchoolRoleOwner}, nil).Once()
+			},
+		},
+		{
+			name: "FAIL - 존재하지 않는 학교",
+			role: domain.SchoolRoleViewer,
+			mock: func(schoolRepository *mocks.SchoolRepository) {
+				schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(nil, nil).Once()
+			},
+			wantKind: cerrors.NotExist,
+		},
+		{
+			name: "FAIL - 학교 멤버가 아님",
+			role: domain.SchoolRoleViewer,
+			mock: func(schoolRepository *mocks.SchoolRepository) {
+				schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{Base: domain.Base{ID: 1}}, nil).Once()
+				schoolRepository.EXPECT().FindSchoolMember(mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			wantKind: cerrors.Permission,
+		},
+		{
+			name: "FAIL - 요구 권한보다 낮은 멤버",
+			role: domain.SchoolRoleOwner,
+			mock: func(schoolRepository *mocks.SchoolRepository) {
+				schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{Base: domain.Base{ID: 1}}, nil).Once()
+				schoolRepository.EXPECT().FindSchoolMember(mock.Anything, mock.Anything).
+					Return(&domain.SchoolMember{SchoolID: 1, UserID: 2, Role: domain.SchoolRoleEditor}, nil).Once()
+			},
+			wantKind: cerrors.Permission,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			schoolRepository := mocks.NewSchoolRepository(t)
+			tt.mock(schoolRepository)
+
+			// when
+			got, err := AuthorizeMember(context.Background(), schoolRepository, op, 1, 2, tt.role)
+
+			// then
+			if tt.wantKind == 0 {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, got.ID)
+				return
+			}
+			var cerr *cerrors.Error
+			if assert.True(t, errors.As(err, &cerr)) {
+				assert.Equal(t, tt.wantKind, cerr.Kind)
+			}
+			assert.Nil(t, got)
+		})
+	}
+}
+
+func TestAuthorizeActiveMember(t *testing.T) {
+	const op cerrors.Op = "school/test/AuthorizeActiveMember"
+
+	tests := []struct {
+		name     string
+		school   *domain.School
+		wantKind cerrors.Kind
+	}{
+		{
+			name:   "PASS - 삭제되지 않은 학교",
+			school: &domain.School{Base: domain.Base{ID: 1}},
+		},
+		{
+			name: "FAIL - 삭제된 학교",
+			school: &domain.School{Base: domain.Base{
+				ID:         1,
+				DeleteDate: sql.NullTime{Time: time.Now(), Valid: true},
+			}},
+			wantKind: cerrors.Invalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			schoolRepository := mocks.NewSchoolRepository(t)
+			schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(tt.school, nil).Once()
+			schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{SchoolID: 1, UserID: 2}).
+				Return(&domain.SchoolMember{SchoolID: 1, UserID: 2, Role: domain.SchoolRoleOwner}, nil).Once()
+
+			// when
+			got, err := AuthorizeActiveMember(context.Background(), schoolRepository, op, 1, 2, domain.SchoolRoleEditor)
+
+			// then
+			if tt.wantKind == 0 {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, got.ID)
+				return
+			}
+			var cerr *cerrors.Error
+			if assert.True(t, errors.As(err, &cerr)) {
+				assert.Equal(t, tt.wantKind, cerr.Kind)
+			}
+			assert.Nil(t, got)
+		})
+	}
+}
@@ -3,10 +3,11 @@ package school
 import (
 	"classting/domain"
 	"classting/pkg/cerrors"
+	"classting/pkg/db"
 	"context"
 	"database/sql"
 	"errors"
-	"fmt"
+	"github.com/go-sql-driver/mysql"
 )
 
 type schoolRepository struct {
@@ -21,45 +22,70 @@ func NewSchoolRepository(sqlDB *sql.DB) *schoolRepository {
 
 var _ domain.SchoolRepository = (*schoolRepository)(nil)
 
+// CreateSchool 학교를 만든 유저를 OWNER 멤버로 함께 등록한다.
 func (s schoolRepository) CreateSchool(ctx context.Context, school domain.School) (int, error) {
 	const op cerrors.Op = "school/schoolRepository/CreateSchool"
 
-	result, err := s.sqlDB.ExecContext(ctx, createSchoolQuery, school.UserID, school.Name, school.Region)
-	if err != nil {
-		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
-	}
+	var schoolID int64
+	err := db.WithTx(ctx, s.sqlDB, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, createSchoolQuery, school.UserID, school.Name, school.Region)
+		if err != nil {
+			return err
+		}
+
+		schoolID, err = result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, createSchoolMemberQuery, schoolID, school.UserID, domain.SchoolRoleOwner)
 
-	userID, err := result.LastInsertId()
+		return err
+	})
 	if err != nil {
 		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 	}
 
-	return int(userID), nil
+	return int(schoolID), nil
 }
 
-func (s schoolRepository) ListSchools(ctx context.Context, params domain.ListSchoolsParams) ([]domain.School, error) {
+func (s schoolRepository) ListSchools(ctx context.Context, params domain.ListSchoolsParams) ([]domain.DirectorySchool, error) {
 	const op cerrors.Op = "school/schoolRepository/ListSchools"
 
-	var schools []domain.School
+	var schools []domain.DirectorySchool
 
-	query := fmt.Sprintf(listSchoolQuery,
-		params.AndUserID(),
-		params.AfterCursor(),
-	)
+	q := db.Select(listSchoolQuery, params.RequestUserID).Where(db.Expr("delete_date IS NULL"))
+	if params.UserID != nil {
+		q.Where(db.Eq("user_id", *params.UserID))
+	}
+	if params.Region != "" {
+		q.Where(db.Eq("region", params.Region))
+	}
+	switch params.Sort {
+	case domain.SchoolSortName:
+		q.SeekKey(params.Cursor, false, db.Key{Column: "name"}, "id")
+	case domain.SchoolSortSubscribers:
+		q.SeekKey(params.Cursor, true, db.Key{Column: "subscriber_count", Int: true}, "id")
+	default:
+		q.Seek(params.Cursor, true, "id")
+	}
+	query, args := q.Limit(params.Limit).Build()
 
-	rows, err := s.sqlDB.QueryContext(ctx, query)
+	rows, err := s.sqlDB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		var school domain.School
+		var school domain.DirectorySchool
 		err := rows.Scan(
 			&school.ID,
 			&school.UserID,
 			&school.Name,
 			&school.Region,
+			&school.SubscriberCount,
+			&school.Subscribed,
 		)
 		if err != nil {
 			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
@@ -74,8 +100,7 @@ func (s schoolRepository) FindSchoolByNameAndRegion(ctx context.Context, params
 	const op cerrors.Op = "school/schollRepository/FindSchoolByNameAndRegion"
 	var school domain.School
 
-	findSchoolByNameAndRegion := `SELECT id, user_id, name, region FROM schools WHERE name = ? AND region = ?`
-	err := s.sqlDB.QueryRowContext(ctx, findSchoolByNameAndRegion, params.Name, params.Region).
+	err := s.sqlDB.QueryRowContext(ctx, findSchoolByNameAndRegionQuery, params.Name, params.Region).
 		Scan(&school.ID, &school.UserID, &school.Name, &school.Region)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
@@ -92,7 +117,7 @@ func (s schoolRepository) FindSchoolByID(ctx context.Context, schoolID int) (*do
 	var school domain.School
 
 	err := s.sqlDB.QueryRowContext(ctx, findSchoolByID, schoolID).
-		Scan(&school.ID, &school.UserID, &school.Name, &school.Region)
+		Scan(&school.ID, &school.UserID, &school.Name, &school.Region, &school.DeleteDate)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
@@ -102,3 +127,153 @@ func (s schoolRepository) FindSchoolByID(ctx context.Context, schoolID int) (*do
 
 	return &school, nil
 }
+
+func (s schoolRepository) UpdateSchool(ctx context.Context, school domain.School) error {
+	const op cerrors.Op = "school/schoolRepository/UpdateSchool"
+
+	_, err := s.sqlDB.ExecContext(ctx, updateSchoolQuery, school.Name, school.Region, school.ID)
+	if isDuplicateEntry(err) {
+		return cerrors.E(op, cerrors.Exist, err, "이미 사용중인 지역, 학교명입니다.")
+	}
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// DeleteSchool delete_date를 기록하는 소프트 딜리트, 멤버와 구독, 소식은 복구를 위해 남겨둔다.
+func (s schoolRepository) DeleteSchool(ctx context.Context, schoolID int) error {
+	const op cerrors.Op = "school/schoolRepository/DeleteSchool"
+
+	_, err := s.sqlDB.ExecContext(ctx, deleteSchoolQuery, schoolID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// RestoreSchool 삭제 후 같은 지역, 학교명으로 새 학교가 만들어졌다면 유니크 키에 걸려 복구할 수 없다.
+func (s schoolRepository) RestoreSchool(ctx context.Context, schoolID int) error {
+	const op cerrors.Op = "school/schoolRepository/RestoreSchool"
+
+	_, err := s.sqlDB.ExecContext(ctx, restoreSchoolQuery, schoolID)
+	if isDuplicateEntry(err) {
+		return cerrors.E(op, cerrors.Exist, err, "이미 사용중인 지역, 학교명입니다.")
+	}
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (s schoolRepository) FindSchoolMember(ctx context.Context, params domain.FindSchoolMemberParams) (*domain.SchoolMember, error) {
+	const op cerrors.Op = "school/schoolRepository/FindSchoolMember"
+	var member domain.SchoolMember
+
+	err := s.sqlDB.QueryRowContext(ctx, findSchoolMemberQuery, params.SchoolID, params.UserID).Scan(
+		&member.ID,
+		&member.CreateDate,
+		&member.UpdateDate,
+		&member.SchoolID,
+		&member.UserID,
+		&member.UserName,
+		&member.Role,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return &member, nil
+}
+
+func (s schoolRepository) ListSchoolMembers(ctx context.Context, schoolID int) ([]domain.SchoolMember, error) {
+	const op cerrors.Op = "school/schoolRepository/ListSchoolMembers"
+
+	var members []domain.SchoolMember
+
+	rows, err := s.sqlDB.QueryContext(ctx, listSchoolMembersQuery, schoolID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var member domain.SchoolMember
+		err := rows.Scan(
+			&member.ID,
+			&member.CreateDate,
+			&member.UpdateDate,
+			&member.SchoolID,
+			&member.UserID,
+			&member.UserName,
+			&member.Role,
+		)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+func (s schoolRepository) CreateSchoolMember(ctx context.Context, member domain.SchoolMember) (int, error) {
+	const op cerrors.Op = "school/schoolRepository/CreateSchoolMember"
+
+	result, err := s.sqlDB.ExecContext(ctx, createSchoolMemberQuery, member.SchoolID, member.UserID, member.Role)
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	memberID, err := result.LastInsertId()
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return int(memberID), nil
+}
+
+func (s schoolRepository) DeleteSchoolMember(ctx context.Context, memberID int) error {
+	const op cerrors.Op = "school/schoolRepository/DeleteSchoolMember"
+
+	_, err := s.sqlDB.ExecContext(ctx, deleteSchoolMemberQuery, memberID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// TransferSchoolOwnership 이전 OWNER는 EDITOR가 되고 schools.user_id도 새 OWNER로 바꾼다.
+func (s schoolRepository) TransferSchoolOwnership(ctx context.Context, params domain.TransferSchoolOwnershipParams) error {
+	const op cerrors.Op = "school/schoolRepository/TransferSchoolOwnership"
+
+	err := db.WithTx(ctx, s.sqlDB, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, updateSchoolMemberRoleQuery, domain.SchoolRoleEditor, params.SchoolID, params.FromUserID); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, updateSchoolMemberRoleQuery, domain.SchoolRoleOwner, params.SchoolID, params.ToUserID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, updateSchoolOwnerQuery, params.ToUserID, params.SchoolID)
+
+		return err
+	})
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func isDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
@@ -2,6 +2,7 @@ package school
 
 import (
 	"classting/domain"
+	"classting/pkg/pagination"
 	"context"
 	"database/sql"
 	"github.com/DATA-DOG/go-sqlmock"
@@ -9,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"k8s.io/utils/pointer"
 	"testing"
+	"time"
 )
 
 type schoolRepositoryTestSuite struct {
@@ -60,9 +62,14 @@ func Test_schoolRepository_CreateSchool(t *testing.T) {
 				},
 			},
 			mock: func(ts schoolRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
 				ts.sqlMock.ExpectExec("INSERT INTO schools").
 					WithArgs(1, "클래스팅학교", "서울").
 					WillReturnResult(sqlmock.NewResult(1, 1))
+				ts.sqlMock.ExpectExec("INSERT INTO school_members").
+					WithArgs(1, 1, domain.SchoolRoleOwner).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				ts.sqlMock.ExpectCommit()
 			},
 			want:    1,
 			wantErr: false,
@@ -78,9 +85,11 @@ func Test_schoolRepository_CreateSchool(t *testing.T) {
 				},
 			},
 			mock: func(ts schoolRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
 				ts.sqlMock.ExpectExec("INSERT INTO schools").
 					WithArgs(1, "클래스팅학교", "서울").
 					WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+				ts.sqlMock.ExpectRollback()
 			},
 			want:    0,
 			wantErr: true,
@@ -185,86 +194,164 @@ func Test_schoolRepository_ListSchools(t *testing.T) {
 		params domain.ListSchoolsParams
 	}
 
+	columns := []string{"id", "user_id", "name", "region", "subscriber_count", "subscribed"}
+
 	tests := []struct {
 		name    string
 		args    args
 		mock    func(ts schoolRepositoryTestSuite)
-		want    []domain.School
+		want    []domain.DirectorySchool
 		wantErr bool
 	}{
 		{
 			name: "PASS - 전체 조회",
 			args: args{
 				ctx:    context.Background(),
-				params: domain.ListSchoolsParams{},
+				params: domain.ListSchoolsParams{RequestUserID: 4, Limit: 11},
 			},
 			mock: func(ts schoolRepositoryTestSuite) {
-				query := `SELECT id, user_id, name, region FROM schools`
-				columns := []string{"id", "user_id", "name", "region"}
-				rows := sqlmock.NewRows(columns).AddRow(1, 1, "클래스팅", "서울")
-				ts.sqlMock.ExpectQuery(query).WillReturnRows(rows)
+				query := `SELECT id, user_id, name, region, subscriber_count, EXISTS (.+) FROM schools WHERE delete_date IS NULL ORDER BY id DESC LIMIT \?`
+				rows := sqlmock.NewRows(columns).AddRow(1, 1, "클래스팅", "서울", 3, true)
+				ts.sqlMock.ExpectQuery(query).WithArgs(4, 11).WillReturnRows(rows)
 			},
-			want: []domain.School{
+			want: []domain.DirectorySchool{
 				{
-					Base: domain.Base{
-						ID: 1,
+					School: domain.School{
+						Base: domain.Base{
+							ID: 1,
+						},
+						UserID: 1,
+						Name:   "클래스팅",
+						Region: "서울",
 					},
-					UserID: 1,
-					Name:   "클래스팅",
-					Region: "서울",
+					SubscriberCount: 3,
+					Subscribed:      true,
 				},
 			},
 			wantErr: false,
 		},
 		{
-			name: "PASS - 전체 조회 (특정 유저 아이디 입력)",
+			name: "PASS - 전체 조회 (특정 유저 아이디 입력, 커서 입력)",
 			args: args{
 				ctx: context.Background(),
 				params: domain.ListSchoolsParams{
-					UserID: pointer.Int(1),
+					RequestUserID: 1,
+					UserID:        pointer.Int(1),
+					Sort:          domain.SchoolSortLatest,
+					Cursor:        &pagination.Cursor{ID: 3, Direction: pagination.Forward},
+					Limit:         11,
 				},
 			},
 			mock: func(ts schoolRepositoryTestSuite) {
-				query := `SELECT id, user_id, name, region FROM schools`
-				columns := []string{"id", "user_id", "name", "region"}
-				rows := sqlmock.NewRows(columns).AddRow(1, 1, "클래스팅", "서울")
-				ts.sqlMock.ExpectQuery(query).WillReturnRows(rows)
+				query := `AND user_id = \? AND id < \? ORDER BY id DESC LIMIT \?`
+				rows := sqlmock.NewRows(columns).AddRow(2, 1, "클래스팅", "서울", 0, false)
+				ts.sqlMock.ExpectQuery(query).WithArgs(1, 1, 3, 11).WillReturnRows(rows)
 			},
-			want: []domain.School{
+			want: []domain.DirectorySchool{
 				{
-					Base: domain.Base{
-						ID: 1,
+					School: domain.School{
+						Base: domain.Base{
+							ID: 2,
+						},
+						UserID: 1,
+						Name:   "클래스팅",
+						Region: "서울",
 					},
-					UserID: 1,
-					Name:   "클래스팅",
-					Region: "서울",
 				},
 			},
 			wantErr: false,
 		},
 		{
-			name: "PASS - 전체 조회 (특정 유저 아이디 입력, 커서 입력)",
+			name: "PASS - 지역, 학교명 순 조회 (커서 입력)",
 			args: args{
 				ctx: context.Background(),
 				params: domain.ListSchoolsParams{
-					UserID: pointer.Int(1),
-					Cursor: pointer.Int(1),
+					RequestUserID: 4,
+					Region:        "서울",
+					Sort:          domain.SchoolSortName,
+					Cursor:        &pagination.Cursor{Key: "가람초등학교", ID: 3, Direction: pagination.Forward},
+					Limit:         11,
 				},
 			},
 			mock: func(ts schoolRepositoryTestSuite) {
-				query := `SELECT id, user_id, name, region FROM schools`
-				columns := []string{"id", "user_id", "name", "region"}
-				rows := sqlmock.NewRows(columns).AddRow(2, 1, "클래스팅", "서울")
-				ts.sqlMock.ExpectQuery(query).WillReturnRows(rows)
+				query := `AND region = \? AND \(name > \? OR \(name = \? AND id > \?\)\) ORDER BY name ASC, id ASC LIMIT \?`
+				rows := sqlmock.NewRows(columns).AddRow(5, 2, "나래초등학교", "서울", 1, false)
+				ts.sqlMock.ExpectQuery(query).WithArgs(4, "서울", "가람초등학교", "가람초등학교", 3, 11).WillReturnRows(rows)
 			},
-			want: []domain.School{
+			want: []domain.DirectorySchool{
 				{
-					Base: domain.Base{
-						ID: 2,
+					School: domain.School{
+						Base: domain.Base{
+							ID: 5,
+						},
+						UserID: 2,
+						Name:   "나래초등학교",
+						Region: "서울",
 					},
-					UserID: 1,
-					Name:   "클래스팅",
-					Region: "서울",
+					SubscriberCount: 1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 구독자 순 조회 (커서 입력)",
+			args: args{
+				ctx: context.Background(),
+				params: domain.ListSchoolsParams{
+					RequestUserID: 4,
+					Sort:          domain.SchoolSortSubscribers,
+					Cursor:        &pagination.Cursor{Key: "5", ID: 7, Direction: pagination.Forward},
+					Limit:         11,
+				},
+			},
+			mock: func(ts schoolRepositoryTestSuite) {
+				query := `AND \(subscriber_count < \? OR \(subscriber_count = \? AND id < \?\)\) ORDER BY subscriber_count DESC, id DESC LIMIT \?`
+				rows := sqlmock.NewRows(columns).AddRow(6, 2, "해운대초등학교", "부산", 5, true)
+				ts.sqlMock.ExpectQuery(query).WithArgs(4, 5, 5, 7, 11).WillReturnRows(rows)
+			},
+			want: []domain.DirectorySchool{
+				{
+					School: domain.School{
+						Base: domain.Base{
+							ID: 6,
+						},
+						UserID: 2,
+						Name:   "해운대초등학교",
+						Region: "부산",
+					},
+					SubscriberCount: 5,
+					Subscribed:      true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 구독자 순 이전 페이지 조회 (역순 조회)",
+			args: args{
+				ctx: context.Background(),
+				params: domain.ListSchoolsParams{
+					RequestUserID: 4,
+					Sort:          domain.SchoolSortSubscribers,
+					Cursor:        &pagination.Cursor{Key: "5", ID: 6, Direction: pagination.Backward},
+					Limit:         11,
+				},
+			},
+			mock: func(ts schoolRepositoryTestSuite) {
+				query := `AND \(subscriber_count > \? OR \(subscriber_count = \? AND id > \?\)\) ORDER BY subscriber_count ASC, id ASC LIMIT \?`
+				rows := sqlmock.NewRows(columns).AddRow(7, 1, "클래스팅", "서울", 5, false)
+				ts.sqlMock.ExpectQuery(query).WithArgs(4, 5, 5, 6, 11).WillReturnRows(rows)
+			},
+			want: []domain.DirectorySchool{
+				{
+					School: domain.School{
+						Base: domain.Base{
+							ID: 7,
+						},
+						UserID: 1,
+						Name:   "클래스팅",
+						Region: "서울",
+					},
+					SubscriberCount: 5,
 				},
 			},
 			wantErr: false,
@@ -298,6 +385,8 @@ func Test_schoolRepository_FindSchoolByID(t *testing.T) {
 		schoolID int
 	}
 
+	deleteDate := time.Now()
+
 	tests := []struct {
 		name    string
 		args    args
@@ -312,9 +401,9 @@ func Test_schoolRepository_FindSchoolByID(t *testing.T) {
 				schoolID: 1,
 			},
 			mock: func(ts schoolRepositoryTestSuite) {
-				query := "SELECT id, user_id, name, region FROM schools"
-				columns := []string{"id", "user_id", "name", "region"}
-				rows := sqlmock.NewRows(columns).AddRow(1, 1, "클래스팅", "서울")
+				query := "SELECT id, user_id, name, region, delete_date FROM schools"
+				columns := []string{"id", "user_id", "name", "region", "delete_date"}
+				rows := sqlmock.NewRows(columns).AddRow(1, 1, "클래스팅", "서울", nil)
 				ts.sqlMock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
 			},
 			want: &domain.School{
@@ -327,6 +416,29 @@ func Test_schoolRepository_FindSchoolByID(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "PASS - 삭제된 학교 조회",
+			args: args{
+				ctx:      context.Background(),
+				schoolID: 1,
+			},
+			mock: func(ts schoolRepositoryTestSuite) {
+				query := "SELECT id, user_id, name, region, delete_date FROM schools"
+				columns := []string{"id", "user_id", "name", "region", "delete_date"}
+				rows := sqlmock.NewRows(columns).AddRow(1, 1, "클래스팅", "서울", deleteDate)
+				ts.sqlMock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+			},
+			want: &domain.School{
+				Base: domain.Base{
+					ID:         1,
+					DeleteDate: sql.NullTime{Time: deleteDate, Valid: true},
+				},
+				UserID: 1,
+				Name:   "클래스팅",
+				Region: "서울",
+			},
+			wantErr: false,
+		},
 		{
 			name: "PASS - 존재하지 않는 학교 조회",
 			args: args{
@@ -334,7 +446,7 @@ func Test_schoolRepository_FindSchoolByID(t *testing.T) {
 				schoolID: 1,
 			},
 			mock: func(ts schoolRepositoryTestSuite) {
-				query := "SELECT id, user_id, name, region FROM schools"
+				query := "SELECT id, user_id, name, region, delete_date FROM schools"
 				ts.sqlMock.ExpectQuery(query).WithArgs(1).WillReturnError(sql.ErrNoRows)
 			},
 			want:    nil,
@@ -359,3 +471,176 @@ func Test_schoolRepository_FindSchoolByID(t *testing.T) {
 		})
 	}
 }
+
+func Test_schoolRepository_FindSchoolMember(t *testing.T) {
+	createDate := time.Now()
+	updateDate := time.Now()
+
+	tests := []struct {
+		name    string
+		params  domain.FindSchoolMemberParams
+		mock    func(ts schoolRepositoryTestSuite)
+		want    *domain.SchoolMember
+		wantErr bool
+	}{
+		{
+			name: "PASS - 학교 멤버 조회",
+			params: domain.FindSchoolMemberParams{
+				SchoolID: 1,
+				UserID:   2,
+			},
+			mock: func(ts schoolRepositoryTestSuite) {
+				query := `SELECT (.+) FROM school_members JOIN users ON users.id = school_members.user_id WHERE school_members.school_id = \? AND school_members.user_id = \?`
+				columns := []string{"id", "create_date", "update_date", "school_id", "user_id", "user_name", "role"}
+				rows := sqlmock.NewRows(columns).AddRow(1, createDate, updateDate, 1, 2, "classting_editor", "EDITOR")
+				ts.sqlMock.ExpectQuery(query).WithArgs(1, 2).WillReturnRows(rows)
+			},
+			want: &domain.SchoolMember{
+				Base: domain.Base{
+					ID:         1,
+					CreateDate: createDate,
+					UpdateDate: updateDate,
+				},
+				SchoolID: 1,
+				UserID:   2,
+				UserName: "classting_editor",
+				Role:     domain.SchoolRoleEditor,
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 학교 멤버가 아닌 유저 조회",
+			params: domain.FindSchoolMemberParams{
+				SchoolID: 1,
+				UserID:   7777,
+			},
+			mock: func(ts schoolRepositoryTestSuite) {
+				query := `SELECT (.+) FROM school_members`
+				ts.sqlMock.ExpectQuery(query).WithArgs(1, 7777).WillReturnError(sql.ErrNoRows)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSchoolRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.schoolRepository.FindSchoolMember(context.Background(), tt.params)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_schoolRepository_TransferSchoolOwnership(t *testing.T) {
+	// given
+	ts := setupSchoolRepositoryTestSuite()
+	ts.sqlMock.ExpectBegin()
+	ts.sqlMock.ExpectExec(`UPDATE school_members SET role = \?`).
+		WithArgs(domain.SchoolRoleEditor, 1, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	ts.sqlMock.ExpectExec(`UPDATE school_members SET role = \?`).
+		WithArgs(domain.SchoolRoleOwner, 1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	ts.sqlMock.ExpectExec(`UPDATE schools SET user_id = \?`).
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	ts.sqlMock.ExpectCommit()
+
+	// when
+	err := ts.schoolRepository.TransferSchoolOwnership(context.Background(), domain.TransferSchoolOwnershipParams{
+		SchoolID:   1,
+		FromUserID: 1,
+		ToUserID:   2,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_schoolRepository_UpdateSchool(t *testing.T) {
+	tests := []struct {
+		name    string
+		mock    func(ts schoolRepositoryTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 학교 수정",
+			mock: func(ts schoolRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`UPDATE schools SET name = \?, region = \? WHERE id = \? AND delete_date IS NULL`).
+					WithArgs("클래스팅학교", "부산", 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 사용중인 지역, 학교명으로 수정",
+			mock: func(ts schoolRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`UPDATE schools SET name = \?, region = \?`).
+					WithArgs("클래스팅학교", "부산", 1).
+					WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSchoolRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			err := ts.schoolRepository.UpdateSchool(context.Background(), domain.School{
+				Base: domain.Base{
+					ID: 1,
+				},
+				Name:   "클래스팅학교",
+				Region: "부산",
+			})
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_schoolRepository_DeleteSchool(t *testing.T) {
+	// given
+	ts := setupSchoolRepositoryTestSuite()
+	ts.sqlMock.ExpectExec(`UPDATE schools SET delete_date = NOW\(\) WHERE id = \? AND delete_date IS NULL`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// when
+	err := ts.schoolRepository.DeleteSchool(context.Background(), 1)
+
+	// then
+	assert.NoError(t, err)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_schoolRepository_RestoreSchool(t *testing.T) {
+	// given
+	ts := setupSchoolRepositoryTestSuite()
+	ts.sqlMock.ExpectExec(`UPDATE schools SET delete_date = NULL WHERE id = \? AND delete_date IS NOT NULL`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// when
+	err := ts.schoolRepository.RestoreSchool(context.Background(), 1)
+
+	// then
+	assert.NoError(t, err)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
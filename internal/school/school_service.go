@@ -3,21 +3,30 @@ package school
 import (
 	"classting/domain"
 	"classting/pkg/cerrors"
+	"classting/pkg/pagination"
 	"context"
+	"k8s.io/utils/pointer"
+	"log"
 )
 
 type schoolService struct {
 	userRepository   domain.UserRepository
 	schoolRepository domain.SchoolRepository
+	searchIndex      domain.SearchIndex
+	paginator        *pagination.Paginator
 }
 
 func NewSchoolService(
 	schoolRepository domain.SchoolRepository,
 	userRepository domain.UserRepository,
+	searchIndex domain.SearchIndex,
+	paginator *pagination.Paginator,
 ) *schoolService {
 	return &schoolService{
 		userRepository:   userRepository,
 		schoolRepository: schoolRepository,
+		searchIndex:      searchIndex,
+		paginator:        paginator,
 	}
 }
 
@@ -26,9 +35,14 @@ var _ domain.SchoolService = (*schoolService)(nil)
 func (s schoolService) CreateSchool(ctx context.Context, req domain.CreateSchoolRequest) error {
 	const op cerrors.Op = "school/service/createSchool"
 
+	region, ok := domain.NormalizeRegion(req.Region)
+	if !ok {
+		return cerrors.E(op, cerrors.Invalid, "지원하지 않는 지역입니다.")
+	}
+
 	school, err := s.schoolRepository.FindSchoolByNameAndRegion(ctx, domain.FindSchoolByNameAndRegionParams{
 		Name:   req.Name,
-		Region: req.Region,
+		Region: region,
 	})
 	if school != nil {
 		return cerrors.E(op, cerrors.Invalid, "이미 사용중인 지역, 학교명입니다.")
@@ -37,41 +51,294 @@ func (s schoolService) CreateSchool(ctx context.Context, req domain.CreateSchool
 		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 	}
 
-	_, err = s.schoolRepository.CreateSchool(ctx, domain.School{
+	created := domain.School{
 		UserID: req.UserID,
 		Name:   req.Name,
-		Region: req.Region,
-	})
+		Region: region,
+	}
+	created.ID, err = s.schoolRepository.CreateSchool(ctx, created)
 	if err != nil {
 		return err
 	}
 
+	s.indexSchool(ctx, created)
+
 	return nil
 }
 
 func (s schoolService) ListSchools(ctx context.Context, req domain.ListSchoolsRequest) (domain.ListSchoolsResponse, error) {
 	const op cerrors.Op = "school/service/ListSchools"
 
-	schools, err := s.schoolRepository.ListSchools(ctx, domain.ListSchoolsParams{
-		UserID: req.UserID,
-		Cursor: req.Cursor,
-	})
+	params := domain.ListSchoolsParams{
+		RequestUserID: req.RequestUserID,
+		UserID:        req.UserID,
+		Sort:          req.SortOrDefault(),
+	}
+	if req.Region != "" {
+		region, ok := domain.NormalizeRegion(req.Region)
+		if !ok {
+			return domain.ListSchoolsResponse{}, cerrors.E(op, cerrors.Invalid, "지원하지 않는 지역입니다.")
+		}
+		params.Region = region
+	}
+
+	// 정렬 기준이 다른 목록의 커서는 정렬 값의 의미가 달라 사용할 수 없다.
+	filter := pagination.Fingerprint("schools", pointer.IntDeref(params.UserID, 0), params.Region, params.Sort)
+	pageReq, err := s.paginator.Request(req.Cursor, req.Size, filter)
 	if err != nil {
-		return domain.ListSchoolsResponse{}, cerrors.E(op, cerrors.Internal, err, "학교를 조회하는 중에 에러가 발생했습니다.")
+		return domain.ListSchoolsResponse{}, cerrors.E(op, cerrors.Invalid, err, "커서를 확인해주세요.")
 	}
+	params.Cursor = pageReq.Cursor
+	params.Limit = pageReq.Limit()
 
-	var schoolDTOs []domain.SchoolDTO
-	for _, school := range schools {
-		schoolDTOs = append(schoolDTOs, domain.SchoolDTOFrom(school))
+	listed, err := s.schoolRepository.ListSchools(ctx, params)
+	if err != nil {
+		return domain.ListSchoolsResponse{}, cerrors.E(op, cerrors.Internal, err, "학교를 조회하는 중에 에러가 발생했습니다.")
 	}
 
-	var cursor *int
-	if len(schoolDTOs) > 0 {
-		cursor = &schoolDTOs[len(schoolDTOs)-1].ID
+	page := pagination.NewPage(listed, pageReq, func(school domain.DirectorySchool) pagination.Cursor {
+		return pagination.Cursor{Key: params.Sort.SortKey(school), ID: school.ID, Filter: filter}
+	})
+
+	var schoolDTOs []domain.DirectorySchoolDTO
+	for _, school := range page.Items {
+		schoolDTOs = append(schoolDTOs, domain.DirectorySchoolDTOFrom(school))
 	}
 
 	return domain.ListSchoolsResponse{
-		Schools: schoolDTOs,
-		Cursor:  cursor,
+		Schools:    schoolDTOs,
+		Cursor:     s.paginator.Encode(page.Next),
+		PrevCursor: s.paginator.Encode(page.Prev),
+	}, nil
+}
+
+func (s schoolService) ListRegions(ctx context.Context) (domain.ListRegionsResponse, error) {
+	var regionDTOs []domain.RegionDTO
+	for _, region := range domain.Regions() {
+		regionDTOs = append(regionDTOs, domain.RegionDTOFrom(region))
+	}
+
+	return domain.ListRegionsResponse{
+		Regions: regionDTOs,
 	}, nil
 }
+
+func (s schoolService) UpdateSchool(ctx context.Context, req domain.UpdateSchoolRequest) error {
+	const op cerrors.Op = "school/service/UpdateSchool"
+
+	school, err := AuthorizeMember(ctx, s.schoolRepository, op, req.SchoolID, req.UserID, domain.SchoolRoleOwner)
+	if err != nil {
+		return err
+	}
+	if school.Deleted() {
+		return cerrors.E(op, cerrors.Invalid, "삭제된 학교는 수정할 수 없습니다.")
+	}
+
+	region, ok := domain.NormalizeRegion(req.Region)
+	if !ok {
+		return cerrors.E(op, cerrors.Invalid, "지원하지 않는 지역입니다.")
+	}
+
+	duplicate, err := s.schoolRepository.FindSchoolByNameAndRegion(ctx, domain.FindSchoolByNameAndRegionParams{
+		Name:   req.Name,
+		Region: region,
+	})
+	if err != nil {
+		return err
+	}
+	if duplicate != nil && duplicate.ID != school.ID {
+		return cerrors.E(op, cerrors.Invalid, "이미 사용중인 지역, 학교명입니다.")
+	}
+
+	school.Name = req.Name
+	school.Region = region
+
+	if err := s.schoolRepository.UpdateSchool(ctx, *school); err != nil {
+		return err
+	}
+
+	s.indexSchool(ctx, *school)
+
+	return nil
+}
+
+func (s schoolService) DeleteSchool(ctx context.Context, req domain.DeleteSchoolRequest) error {
+	const op cerrors.Op = "school/service/DeleteSchool"
+
+	school, err := AuthorizeMember(ctx, s.schoolRepository, op, req.SchoolID, req.UserID, domain.SchoolRoleOwner)
+	if err != nil {
+		return err
+	}
+	if school.Deleted() {
+		return cerrors.E(op, cerrors.Invalid, "이미 삭제된 학교입니다.")
+	}
+
+	if err := s.schoolRepository.DeleteSchool(ctx, school.ID); err != nil {
+		return err
+	}
+
+	// 학교는 이미 삭제되었으므로 검색 색인 반영 실패는 로그만 남긴다.
+	if err := s.searchIndex.RemoveSchool(ctx, school.ID); err != nil {
+		log.Printf("school: remove school %d from search index: %v", school.ID, err)
+	}
+
+	return nil
+}
+
+func (s schoolService) RestoreSchool(ctx context.Context, req domain.RestoreSchoolRequest) error {
+	const op cerrors.Op = "school/service/RestoreSchool"
+
+	school, err := AuthorizeMember(ctx, s.schoolRepository, op, req.SchoolID, req.UserID, domain.SchoolRoleOwner)
+	if err != nil {
+		return err
+	}
+	if !school.Deleted() {
+		return cerrors.E(op, cerrors.Invalid, "삭제되지 않은 학교입니다.")
+	}
+
+	duplicate, err := s.schoolRepository.FindSchoolByNameAndRegion(ctx, domain.FindSchoolByNameAndRegionParams{
+		Name:   school.Name,
+		Region: school.Region,
+	})
+	if err != nil {
+		return err
+	}
+	if duplicate != nil {
+		return cerrors.E(op, cerrors.Invalid, "같은 지역, 학교명의 학교가 이미 있어 복구할 수 없습니다.")
+	}
+
+	if err := s.schoolRepository.RestoreSchool(ctx, school.ID); err != nil {
+		return err
+	}
+
+	s.indexSchool(ctx, *school)
+
+	return nil
+}
+
+func (s schoolService) ListSchoolMembers(ctx context.Context, req domain.ListSchoolMembersRequest) (domain.ListSchoolMembersResponse, error) {
+	const op cerrors.Op = "school/service/ListSchoolMembers"
+
+	if _, err := AuthorizeMember(ctx, s.schoolRepository, op, req.SchoolID, req.UserID, domain.SchoolRoleViewer); err != nil {
+		return domain.ListSchoolMembersResponse{}, err
+	}
+
+	members, err := s.schoolRepository.ListSchoolMembers(ctx, req.SchoolID)
+	if err != nil {
+		return domain.ListSchoolMembersResponse{}, err
+	}
+
+	memberDTOs := make([]domain.SchoolMemberDTO, 0, len(members))
+	for _, member := range members {
+		memberDTOs = append(memberDTOs, domain.SchoolMemberDTOFrom(member))
+	}
+
+	return domain.ListSchoolMembersResponse{
+		Members: memberDTOs,
+	}, nil
+}
+
+func (s schoolService) InviteSchoolMember(ctx context.Context, req domain.InviteSchoolMemberRequest) error {
+	const op cerrors.Op = "school/service/InviteSchoolMember"
+
+	if _, err := AuthorizeMember(ctx, s.schoolRepository, op, req.SchoolID, req.UserID, domain.SchoolRoleOwner); err != nil {
+		return err
+	}
+
+	user, err := s.userRepository.FindUserByUserName(ctx, req.UserName)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return cerrors.E(op, cerrors.NotExist, "초대할 유저를 찾을 수 없습니다.")
+	}
+	if user.Type != domain.UserUseTypeAdmin {
+		return cerrors.E(op, cerrors.Invalid, "관리자 유저만 학교 멤버로 초대할 수 있습니다.")
+	}
+
+	member, err := s.schoolRepository.FindSchoolMember(ctx, domain.FindSchoolMemberParams{
+		SchoolID: req.SchoolID,
+		UserID:   user.ID,
+	})
+	if err != nil {
+		return err
+	}
+	if member != nil {
+		return cerrors.E(op, cerrors.Exist, "이미 학교 멤버입니다.")
+	}
+
+	_, err = s.schoolRepository.CreateSchoolMember(ctx, domain.SchoolMember{
+		SchoolID: req.SchoolID,
+		UserID:   user.ID,
+		Role:     req.Role,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RemoveSchoolMember OWNER는 다른 멤버를 제외할 수 있고 멤버는 스스로 학교에서 나갈 수 있다.
+func (s schoolService) RemoveSchoolMember(ctx context.Context, req domain.RemoveSchoolMemberRequest) error {
+	const op cerrors.Op = "school/service/RemoveSchoolMember"
+
+	requiredRole := domain.SchoolRoleOwner
+	if req.MemberUserID == req.UserID {
+		requiredRole = domain.SchoolRoleViewer
+	}
+	if _, err := AuthorizeMember(ctx, s.schoolRepository, op, req.SchoolID, req.UserID, requiredRole); err != nil {
+		return err
+	}
+
+	member, err := s.schoolRepository.FindSchoolMember(ctx, domain.FindSchoolMemberParams{
+		SchoolID: req.SchoolID,
+		UserID:   req.MemberUserID,
+	})
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return cerrors.E(op, cerrors.NotExist, "학교 멤버를 찾을 수 없습니다.")
+	}
+	if member.Role == domain.SchoolRoleOwner {
+		return cerrors.E(op, cerrors.Invalid, "소유자는 소유권을 이전한 후에 제외할 수 있습니다.")
+	}
+
+	return s.schoolRepository.DeleteSchoolMember(ctx, member.ID)
+}
+
+func (s schoolService) TransferSchoolOwnership(ctx context.Context, req domain.TransferSchoolOwnershipRequest) error {
+	const op cerrors.Op = "school/service/TransferSchoolOwnership"
+
+	if _, err := AuthorizeMember(ctx, s.schoolRepository, op, req.SchoolID, req.UserID, domain.SchoolRoleOwner); err != nil {
+		return err
+	}
+	if req.ToUserID == req.UserID {
+		return cerrors.E(op, cerrors.Invalid, "이미 학교의 소유자입니다.")
+	}
+
+	member, err := s.schoolRepository.FindSchoolMember(ctx, domain.FindSchoolMemberParams{
+		SchoolID: req.SchoolID,
+		UserID:   req.ToUserID,
+	})
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return cerrors.E(op, cerrors.Invalid, "학교 멤버에게만 소유권을 이전할 수 있습니다.")
+	}
+
+	return s.schoolRepository.TransferSchoolOwnership(ctx, domain.TransferSchoolOwnershipParams{
+		SchoolID:   req.SchoolID,
+		FromUserID: req.UserID,
+		ToUserID:   req.ToUserID,
+	})
+}
+
+// indexSchool 학교는 이미 저장되었으므로 검색 색인 반영 실패는 로그만 남긴다.
+func (s schoolService) indexSchool(ctx context.Context, school domain.School) {
+	if err := s.searchIndex.IndexSchool(ctx, school); err != nil {
+		log.Printf("school: index school %d: %v", school.ID, err)
+	}
+}
@@ -3,16 +3,22 @@ package school
 import (
 	"classting/domain"
 	"classting/mocks"
+	"classting/pkg/pagination"
 	"context"
+	"database/sql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"k8s.io/utils/pointer"
 	"testing"
+	"time"
 )
 
+var testPaginator = pagination.New("classting_test_secret", 2, 5)
+
 type schoolServiceTestSuite struct {
 	schoolRepository *mocks.SchoolRepository
 	userRepository   *mocks.UserRepository
+	searchIndex      *mocks.SearchIndex
 	service          domain.SchoolService
 }
 
@@ -21,7 +27,8 @@ func setupSchoolServiceTestSuite(t *testing.T) schoolServiceTestSuite {
 
 	us.schoolRepository = mocks.NewSchoolRepository(t)
 	us.userRepository = mocks.NewUserRepository(t)
-	us.service = NewSchoolService(us.schoolRepository, us.userRepository)
+	us.searchIndex = mocks.NewSearchIndex(t)
+	us.service = NewSchoolService(us.schoolRepository, us.userRepository, us.searchIndex, testPaginator)
 
 	return us
 }
@@ -58,6 +65,45 @@ func Test_schoolService_CreateSchool(t *testing.T) {
 					Name:   "클래스팅",
 					Region: "서울",
 				}).Return(1, nil).Once()
+				ts.searchIndex.EXPECT().IndexSchool(mock.Anything, domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 정식 명칭의 지역은 약칭으로 저장",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateSchoolRequest{
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울특별시",
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByNameAndRegion(mock.Anything, domain.FindSchoolByNameAndRegionParams{
+					Name:   "클래스팅",
+					Region: "서울",
+				}).Return(nil, nil).Once()
+				ts.schoolRepository.EXPECT().CreateSchool(mock.Anything, domain.School{
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}).Return(1, nil).Once()
+				ts.searchIndex.EXPECT().IndexSchool(mock.Anything, domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}).Return(nil).Once()
 			},
 			wantErr: false,
 		},
@@ -104,6 +150,10 @@ func Test_schoolService_ListSchools(t *testing.T) {
 		req domain.ListSchoolsRequest
 	}
 
+	latestFilter := pagination.Fingerprint("schools", 0, "", domain.SchoolSortLatest)
+	busanFilter := pagination.Fingerprint("schools", 0, "부산", domain.SchoolSortSubscribers)
+	nameFilter := pagination.Fingerprint("schools", 0, "", domain.SchoolSortName)
+
 	var tests = []struct {
 		name    string
 		args    args
@@ -116,35 +166,42 @@ func Test_schoolService_ListSchools(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				req: domain.ListSchoolsRequest{
-					UserID: nil,
-					Cursor: nil,
+					RequestUserID: 4,
 				},
 			},
 			mock: func(ts schoolServiceTestSuite) {
 				ts.schoolRepository.EXPECT().ListSchools(mock.Anything, domain.ListSchoolsParams{
-					UserID: nil,
-					Cursor: nil,
-				}).Return([]domain.School{
+					RequestUserID: 4,
+					Sort:          domain.SchoolSortLatest,
+					Limit:         3,
+				}).Return([]domain.DirectorySchool{
 					{
-						Base: domain.Base{
-							ID: 1,
+						School: domain.School{
+							Base: domain.Base{
+								ID: 1,
+							},
+							UserID: 1,
+							Name:   "클래스팅",
+							Region: "서울",
 						},
-						UserID: 1,
-						Name:   "클래스팅",
-						Region: "서울",
+						SubscriberCount: 3,
+						Subscribed:      true,
 					},
 				}, nil).Once()
 			},
 			want: domain.ListSchoolsResponse{
-				Schools: []domain.SchoolDTO{
+				Schools: []domain.DirectorySchoolDTO{
 					{
-						ID:     1,
-						Name:   "클래스팅",
-						Region: "서울",
-						UserID: 1,
+						SchoolDTO: domain.SchoolDTO{
+							ID:     1,
+							Name:   "클래스팅",
+							Region: "서울",
+							UserID: 1,
+						},
+						SubscriberCount: 3,
+						Subscribed:      true,
 					},
 				},
-				Cursor: pointer.Int(1),
 			},
 			wantErr: false,
 		},
@@ -153,75 +210,155 @@ func Test_schoolService_ListSchools(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				req: domain.ListSchoolsRequest{
-					UserID: pointer.Int(1),
-					Cursor: nil,
+					RequestUserID: 1,
+					UserID:        pointer.Int(1),
+					Size:          10,
 				},
 			},
 			mock: func(ts schoolServiceTestSuite) {
 				ts.schoolRepository.EXPECT().ListSchools(mock.Anything, domain.ListSchoolsParams{
-					UserID: pointer.Int(1),
-					Cursor: nil,
-				}).Return([]domain.School{
+					RequestUserID: 1,
+					UserID:        pointer.Int(1),
+					Sort:          domain.SchoolSortLatest,
+					Limit:         6,
+				}).Return(nil, nil).Once()
+			},
+			want:    domain.ListSchoolsResponse{},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 정식 명칭의 지역으로 구독자 순 다음 페이지 조회",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListSchoolsRequest{
+					RequestUserID: 4,
+					Region:        "부산광역시",
+					Sort:          domain.SchoolSortSubscribers,
+					Cursor:        *testPaginator.Encode(&pagination.Cursor{Key: "5", ID: 7, Direction: pagination.Forward, Filter: busanFilter}),
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().ListSchools(mock.Anything, domain.ListSchoolsParams{
+					RequestUserID: 4,
+					Region:        "부산",
+					Sort:          domain.SchoolSortSubscribers,
+					Cursor:        &pagination.Cursor{Key: "5", ID: 7, Direction: pagination.Forward, Filter: busanFilter},
+					Limit:         3,
+				}).Return([]domain.DirectorySchool{
 					{
-						Base: domain.Base{
-							ID: 1,
+						School: domain.School{
+							Base: domain.Base{
+								ID: 9,
+							},
+							UserID: 2,
+							Name:   "해운대초등학교",
+							Region: "부산",
 						},
-						UserID: 1,
-						Name:   "클래스팅",
-						Region: "서울",
+						SubscriberCount: 5,
 					},
 				}, nil).Once()
 			},
 			want: domain.ListSchoolsResponse{
-				Schools: []domain.SchoolDTO{
+				Schools: []domain.DirectorySchoolDTO{
 					{
-						ID:     1,
-						Name:   "클래스팅",
-						Region: "서울",
-						UserID: 1,
+						SchoolDTO: domain.SchoolDTO{
+							ID:     9,
+							Name:   "해운대초등학교",
+							Region: "부산",
+							UserID: 2,
+						},
+						SubscriberCount: 5,
 					},
 				},
-				Cursor: pointer.Int(1),
+				PrevCursor: testPaginator.Encode(&pagination.Cursor{Key: "5", ID: 9, Direction: pagination.Backward, Filter: busanFilter}),
 			},
 			wantErr: false,
 		},
 		{
-			name: "PASS - 특정 커서 이상의 학교 조회",
+			name: "PASS - 학교명 순 다음 페이지 커서",
 			args: args{
 				ctx: context.Background(),
 				req: domain.ListSchoolsRequest{
-					UserID: nil,
-					Cursor: pointer.Int(1),
+					RequestUserID: 4,
+					Sort:          domain.SchoolSortName,
 				},
 			},
 			mock: func(ts schoolServiceTestSuite) {
 				ts.schoolRepository.EXPECT().ListSchools(mock.Anything, domain.ListSchoolsParams{
-					UserID: nil,
-					Cursor: pointer.Int(1),
-				}).Return([]domain.School{
-					{
-						Base: domain.Base{
-							ID: 2,
-						},
-						UserID: 1,
-						Name:   "클래스팅",
-						Region: "서울",
-					},
+					RequestUserID: 4,
+					Sort:          domain.SchoolSortName,
+					Limit:         3,
+				}).Return([]domain.DirectorySchool{
+					{School: domain.School{Base: domain.Base{ID: 2}, UserID: 1, Name: "가람초등학교", Region: "서울"}},
+					{School: domain.School{Base: domain.Base{ID: 5}, UserID: 1, Name: "나래초등학교", Region: "서울"}},
+					{School: domain.School{Base: domain.Base{ID: 3}, UserID: 1, Name: "다솜초등학교", Region: "서울"}},
 				}, nil).Once()
 			},
 			want: domain.ListSchoolsResponse{
-				Schools: []domain.SchoolDTO{
-					{
-						ID:     2,
-						Name:   "클래스팅",
-						Region: "서울",
-						UserID: 1,
-					},
+				Schools: []domain.DirectorySchoolDTO{
+					{SchoolDTO: domain.SchoolDTO{ID: 2, Name: "가람초등학교", Region: "서울", UserID: 1}},
+					{SchoolDTO: domain.SchoolDTO{ID: 5, Name: "나래초등학교", Region: "서울", UserID: 1}},
+				},
+				Cursor: testPaginator.Encode(&pagination.Cursor{Key: "나래초등학교", ID: 5, Direction: pagination.Forward, Filter: nameFilter}),
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 학교명 순 이전 페이지 조회",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListSchoolsRequest{
+					RequestUserID: 4,
+					Sort:          domain.SchoolSortName,
+					Cursor:        *testPaginator.Encode(&pagination.Cursor{Key: "다솜초등학교", ID: 3, Direction: pagination.Backward, Filter: nameFilter}),
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().ListSchools(mock.Anything, domain.ListSchoolsParams{
+					RequestUserID: 4,
+					Sort:          domain.SchoolSortName,
+					Cursor:        &pagination.Cursor{Key: "다솜초등학교", ID: 3, Direction: pagination.Backward, Filter: nameFilter},
+					Limit:         3,
+				}).Return([]domain.DirectorySchool{
+					{School: domain.School{Base: domain.Base{ID: 5}, UserID: 1, Name: "나래초등학교", Region: "서울"}},
+					{School: domain.School{Base: domain.Base{ID: 2}, UserID: 1, Name: "가람초등학교", Region: "서울"}},
+				}, nil).Once()
+			},
+			want: domain.ListSchoolsResponse{
+				Schools: []domain.DirectorySchoolDTO{
+					{SchoolDTO: domain.SchoolDTO{ID: 2, Name: "가람초등학교", Region: "서울", UserID: 1}},
+					{SchoolDTO: domain.SchoolDTO{ID: 5, Name: "나래초등학교", Region: "서울", UserID: 1}},
 				},
-				Cursor: pointer.Int(2),
+				Cursor: testPaginator.Encode(&pagination.Cursor{Key: "나래초등학교", ID: 5, Direction: pagination.Forward, Filter: nameFilter}),
 			},
 			wantErr: false,
 		},
+		{
+			name: "FAIL - 정렬 기준과 다른 커서",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListSchoolsRequest{
+					RequestUserID: 4,
+					Cursor:        *testPaginator.Encode(&pagination.Cursor{Key: "가람초등학교", ID: 2, Direction: pagination.Forward, Filter: nameFilter}),
+				},
+			},
+			mock:    func(ts schoolServiceTestSuite) {},
+			want:    domain.ListSchoolsResponse{},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 변조된 커서",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListSchoolsRequest{
+					RequestUserID: 4,
+					Cursor:        *pagination.New("classting_other_secret", 0, 0).Encode(&pagination.Cursor{ID: 2, Direction: pagination.Forward, Filter: latestFilter}),
+				},
+			},
+			mock:    func(ts schoolServiceTestSuite) {},
+			want:    domain.ListSchoolsResponse{},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -236,9 +373,965 @@ func Test_schoolService_ListSchools(t *testing.T) {
 			// then
 			ts.schoolRepository.AssertExpectations(t)
 			assert.Equal(t, tt.want, got)
-			if err != nil {
-				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
-			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_schoolService_InviteSchoolMember(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.InviteSchoolMemberRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts schoolServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - OWNER가 관리자를 EDITOR로 초대",
+			args: args{
+				ctx: context.Background(),
+				req: domain.InviteSchoolMemberRequest{
+					UserID:   1,
+					SchoolID: 1,
+					UserName: "classting_editor",
+					Role:     domain.SchoolRoleEditor,
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					Base: domain.Base{
+						ID: 1,
+					},
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+				ts.userRepository.EXPECT().FindUserByUserName(mock.Anything, "classting_editor").Return(&domain.User{
+					Base: domain.Base{
+						ID: 2,
+					},
+					UserName: "classting_editor",
+					Type:     domain.UserUseTypeAdmin,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   2,
+				}).Return(nil, nil).Once()
+				ts.schoolRepository.EXPECT().CreateSchoolMember(mock.Anything, domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   2,
+					Role:     domain.SchoolRoleEditor,
+				}).Return(1, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - EDITOR는 멤버를 초대할 수 없음",
+			args: args{
+				ctx: context.Background(),
+				req: domain.InviteSchoolMemberRequest{
+					UserID:   1,
+					SchoolID: 1,
+					UserName: "classting_editor",
+					Role:     domain.SchoolRoleEditor,
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					Base: domain.Base{
+						ID: 1,
+					},
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleEditor,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 학생 유저 초대",
+			args: args{
+				ctx: context.Background(),
+				req: domain.InviteSchoolMemberRequest{
+					UserID:   1,
+					SchoolID: 1,
+					UserName: "classting_editor",
+					Role:     domain.SchoolRoleEditor,
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					Base: domain.Base{
+						ID: 1,
+					},
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+				ts.userRepository.EXPECT().FindUserByUserName(mock.Anything, "classting_editor").Return(&domain.User{
+					Base: domain.Base{
+						ID: 2,
+					},
+					UserName: "classting_editor",
+					Type:     domain.UserUseTypeStudent,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 이미 학교 멤버인 유저 초대",
+			args: args{
+				ctx: context.Background(),
+				req: domain.InviteSchoolMemberRequest{
+					UserID:   1,
+					SchoolID: 1,
+					UserName: "classting_editor",
+					Role:     domain.SchoolRoleEditor,
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					Base: domain.Base{
+						ID: 1,
+					},
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+				ts.userRepository.EXPECT().FindUserByUserName(mock.Anything, "classting_editor").Return(&domain.User{
+					Base: domain.Base{
+						ID: 2,
+					},
+					UserName: "classting_editor",
+					Type:     domain.UserUseTypeAdmin,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   2,
+				}).Return(&domain.SchoolMember{
+					Base: domain.Base{
+						ID: 2,
+					},
+					SchoolID: 1,
+					UserID:   2,
+					Role:     domain.SchoolRoleViewer,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSchoolServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.InviteSchoolMember(tt.args.ctx, tt.args.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_schoolService_RemoveSchoolMember(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.RemoveSchoolMemberRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts schoolServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - OWNER가 멤버 제외",
+			args: args{
+				ctx: context.Background(),
+				req: domain.RemoveSchoolMemberRequest{
+					UserID:       1,
+					SchoolID:     1,
+					MemberUserID: 2,
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					Base: domain.Base{
+						ID: 1,
+					},
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   2,
+				}).Return(&domain.SchoolMember{
+					Base: domain.Base{
+						ID: 5,
+					},
+					SchoolID: 1,
+					UserID:   2,
+					Role:     domain.SchoolRoleEditor,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().DeleteSchoolMember(mock.Anything, 5).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - VIEWER가 스스로 학교에서 나가기",
+			args: args{
+				ctx: context.Background(),
+				req: domain.RemoveSchoolMemberRequest{
+					UserID:       2,
+					SchoolID:     1,
+					MemberUserID: 2,
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   2,
+				}).Return(&domain.SchoolMember{
+					Base: domain.Base{
+						ID: 5,
+					},
+					SchoolID: 1,
+					UserID:   2,
+					Role:     domain.SchoolRoleViewer,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   2,
+				}).Return(&domain.SchoolMember{
+					Base: domain.Base{
+						ID: 5,
+					},
+					SchoolID: 1,
+					UserID:   2,
+					Role:     domain.SchoolRoleViewer,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().DeleteSchoolMember(mock.Anything, 5).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - EDITOR가 다른 멤버 제외",
+			args: args{
+				ctx: context.Background(),
+				req: domain.RemoveSchoolMemberRequest{
+					UserID:       2,
+					SchoolID:     1,
+					MemberUserID: 3,
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   2,
+				}).Return(&domain.SchoolMember{
+					Base: domain.Base{
+						ID: 2,
+					},
+					SchoolID: 1,
+					UserID:   2,
+					Role:     domain.SchoolRoleEditor,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - OWNER는 제외할 수 없음",
+			args: args{
+				ctx: context.Background(),
+				req: domain.RemoveSchoolMemberRequest{
+					UserID:       1,
+					SchoolID:     1,
+					MemberUserID: 1,
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					Base: domain.Base{
+						ID: 1,
+					},
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					Base: domain.Base{
+						ID: 1,
+					},
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSchoolServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.RemoveSchoolMember(tt.args.ctx, tt.args.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_schoolService_TransferSchoolOwnership(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.TransferSchoolOwnershipRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts schoolServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - EDITOR 멤버에게 소유권 이전",
+			args: args{
+				ctx: context.Background(),
+				req: domain.TransferSchoolOwnershipRequest{
+					UserID:   1,
+					SchoolID: 1,
+					ToUserID: 2,
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					Base: domain.Base{
+						ID: 1,
+					},
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   2,
+				}).Return(&domain.SchoolMember{
+					Base: domain.Base{
+						ID: 2,
+					},
+					SchoolID: 1,
+					UserID:   2,
+					Role:     domain.SchoolRoleEditor,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().TransferSchoolOwnership(mock.Anything, domain.TransferSchoolOwnershipParams{
+					SchoolID:   1,
+					FromUserID: 1,
+					ToUserID:   2,
+				}).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 학교 멤버가 아닌 유저에게 소유권 이전",
+			args: args{
+				ctx: context.Background(),
+				req: domain.TransferSchoolOwnershipRequest{
+					UserID:   1,
+					SchoolID: 1,
+					ToUserID: 7777,
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					Base: domain.Base{
+						ID: 1,
+					},
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   7777,
+				}).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 존재하지 않는 학교",
+			args: args{
+				ctx: context.Background(),
+				req: domain.TransferSchoolOwnershipRequest{
+					UserID:   1,
+					SchoolID: 1,
+					ToUserID: 2,
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSchoolServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.TransferSchoolOwnership(tt.args.ctx, tt.args.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_schoolService_UpdateSchool(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.UpdateSchoolRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts schoolServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - OWNER가 지역 수정",
+			args: args{
+				ctx: context.Background(),
+				req: domain.UpdateSchoolRequest{
+					UserID:   1,
+					SchoolID: 1,
+					Name:     "클래스팅",
+					Region:   "부산",
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolByNameAndRegion(mock.Anything, domain.FindSchoolByNameAndRegionParams{
+					Name:   "클래스팅",
+					Region: "부산",
+				}).Return(nil, nil).Once()
+				ts.schoolRepository.EXPECT().UpdateSchool(mock.Anything, domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "부산",
+				}).Return(nil).Once()
+				ts.searchIndex.EXPECT().IndexSchool(mock.Anything, domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "부산",
+				}).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - EDITOR의 학교 수정",
+			args: args{
+				ctx: context.Background(),
+				req: domain.UpdateSchoolRequest{
+					UserID:   1,
+					SchoolID: 1,
+					Name:     "클래스팅",
+					Region:   "부산",
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleEditor,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 다른 학교가 사용중인 지역, 학교명",
+			args: args{
+				ctx: context.Background(),
+				req: domain.UpdateSchoolRequest{
+					UserID:   1,
+					SchoolID: 1,
+					Name:     "클래스팅",
+					Region:   "부산",
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolByNameAndRegion(mock.Anything, domain.FindSchoolByNameAndRegionParams{
+					Name:   "클래스팅",
+					Region: "부산",
+				}).Return(&domain.School{
+					Base: domain.Base{
+						ID: 2,
+					},
+					UserID: 2,
+					Name:   "클래스팅",
+					Region: "부산",
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 삭제된 학교 수정",
+			args: args{
+				ctx: context.Background(),
+				req: domain.UpdateSchoolRequest{
+					UserID:   1,
+					SchoolID: 1,
+					Name:     "클래스팅",
+					Region:   "부산",
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID:         1,
+						DeleteDate: sql.NullTime{Time: time.Now(), Valid: true},
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSchoolServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.UpdateSchool(tt.args.ctx, tt.args.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_schoolService_DeleteSchool(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.DeleteSchoolRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts schoolServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - OWNER가 학교 삭제",
+			args: args{
+				ctx: context.Background(),
+				req: domain.DeleteSchoolRequest{
+					UserID:   1,
+					SchoolID: 1,
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().DeleteSchool(mock.Anything, 1).Return(nil).Once()
+				ts.searchIndex.EXPECT().RemoveSchool(mock.Anything, 1).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 이미 삭제된 학교",
+			args: args{
+				ctx: context.Background(),
+				req: domain.DeleteSchoolRequest{
+					UserID:   1,
+					SchoolID: 1,
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID:         1,
+						DeleteDate: sql.NullTime{Time: time.Now(), Valid: true},
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 존재하지 않는 학교",
+			args: args{
+				ctx: context.Background(),
+				req: domain.DeleteSchoolRequest{
+					UserID:   1,
+					SchoolID: 1,
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSchoolServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.DeleteSchool(tt.args.ctx, tt.args.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_schoolService_RestoreSchool(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.RestoreSchoolRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts schoolServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 삭제된 학교 복구",
+			args: args{
+				ctx: context.Background(),
+				req: domain.RestoreSchoolRequest{
+					UserID:   1,
+					SchoolID: 1,
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID:         1,
+						DeleteDate: sql.NullTime{Time: time.Now(), Valid: true},
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolByNameAndRegion(mock.Anything, domain.FindSchoolByNameAndRegionParams{
+					Name:   "클래스팅",
+					Region: "서울",
+				}).Return(nil, nil).Once()
+				ts.schoolRepository.EXPECT().RestoreSchool(mock.Anything, 1).Return(nil).Once()
+				ts.searchIndex.EXPECT().IndexSchool(mock.Anything, mock.MatchedBy(func(school domain.School) bool {
+					return school.ID == 1
+				})).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 삭제되지 않은 학교 복구",
+			args: args{
+				ctx: context.Background(),
+				req: domain.RestoreSchoolRequest{
+					UserID:   1,
+					SchoolID: 1,
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 같은 지역, 학교명의 학교가 새로 생성됨",
+			args: args{
+				ctx: context.Background(),
+				req: domain.RestoreSchoolRequest{
+					UserID:   1,
+					SchoolID: 1,
+				},
+			},
+			mock: func(ts schoolServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID:         1,
+						DeleteDate: sql.NullTime{Time: time.Now(), Valid: true},
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolByNameAndRegion(mock.Anything, domain.FindSchoolByNameAndRegionParams{
+					Name:   "클래스팅",
+					Region: "서울",
+				}).Return(&domain.School{
+					Base: domain.Base{
+						ID: 2,
+					},
+					UserID: 2,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSchoolServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.RestoreSchool(tt.args.ctx, tt.args.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
 		})
 	}
 }
@@ -2,6 +2,27 @@ package school
 
 const createSchoolQuery = `INSERT INTO schools (user_id, name, region) values (?, ?, ?)`
 
-const listSchoolQuery = `SELECT id, user_id, name, region FROM schools WHERE TRUE %s %s ORDER BY id DESC LIMIT 10`
+// listSchoolQuery 플레이스홀더는 구독 여부를 확인할 유저 ID, 조건, 정렬, 조회 개수는 db.Query로 붙인다.
+const listSchoolQuery = `SELECT id, user_id, name, region, subscriber_count, EXISTS (SELECT 1 FROM subscriptions WHERE subscriptions.school_id = schools.id AND subscriptions.user_id = ? AND subscriptions.delete_date IS NULL) FROM schools`
 
-const findSchoolByID = `SELECT id, user_id, name, region FROM schools WHERE id = ?`
+const findSchoolByID = `SELECT id, user_id, name, region, delete_date FROM schools WHERE id = ?`
+
+const findSchoolByNameAndRegionQuery = `SELECT id, user_id, name, region FROM schools WHERE name = ? AND region = ? AND delete_date IS NULL`
+
+const updateSchoolQuery = `UPDATE schools SET name = ?, region = ? WHERE id = ? AND delete_date IS NULL`
+
+const deleteSchoolQuery = `UPDATE schools SET delete_date = NOW() WHERE id = ? AND delete_date IS NULL`
+
+const restoreSchoolQuery = `UPDATE schools SET delete_date = NULL WHERE id = ? AND delete_date IS NOT NULL`
+
+const createSchoolMemberQuery = `INSERT INTO school_members (school_id, user_id, role) VALUES (?, ?, ?)`
+
+const findSchoolMemberQuery = `SELECT school_members.id, school_members.create_date, school_members.update_date, school_members.school_id, school_members.user_id, users.user_name, school_members.role FROM school_members JOIN users ON users.id = school_members.user_id WHERE school_members.school_id = ? AND school_members.user_id = ?`
+
+const listSchoolMembersQuery = `SELECT school_members.id, school_members.create_date, school_members.update_date, school_members.school_id, school_members.user_id, users.user_name, school_members.role FROM school_members JOIN users ON users.id = school_members.user_id WHERE school_members.school_id = ? ORDER BY school_members.id`
+
+const deleteSchoolMemberQuery = `DELETE FROM school_members WHERE id = ?`
+
+const updateSchoolMemberRoleQuery = `UPDATE school_members SET role = ? WHERE school_id = ? AND user_id = ?`
+
+const updateSchoolOwnerQuery = `UPDATE schools SET user_id = ? WHERE id = ?`
@@ -0,0 +1,121 @@
+package search
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"classting/pkg/router"
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+func RegisterRoutes(e *gin.Engine, controller domain.SearchController, auth *router.JWTAuth) {
+	schools := e.Group("/schools")
+	{
+		schools.GET("/search", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin, domain.UserUseTypeStudent}), controller.SearchSchools)
+	}
+	news := e.Group("/news")
+	{
+		news.GET("/search", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin, domain.UserUseTypeStudent}), controller.SearchNews)
+	}
+}
+
+type searchController struct {
+	service domain.SearchService
+}
+
+func NewSearchController(service domain.SearchService) *searchController {
+	return &searchController{
+		service: service,
+	}
+}
+
+var _ domain.SearchController = (*searchController)(nil)
+
+// SearchSchools
+// @Tags Search
+// @Summary 학교 검색 [추가 구현] 권한 - 학생/관리자
+// @Description 학교명과 지역으로 학교를 검색합니다. 검색어를 두 글자 단위로 나누어 띄어쓰기 없는 한글도 검색됩니다.
+// @Description 검색 결과는 10개씩 아이디 기반으로 최신 순 정렬합니다 (커서로 페이징 가능)
+// @Produce json
+// @Security BearerAuth
+// @Param q query string true "검색어 (최대 100자)"
+// @Param cursor query int false "커서"
+// @Success 200 {object} domain.SearchSchoolsResponse "학교 검색 결과"
+// @Router /schools/search [get]
+func (s searchController) SearchSchools(c *gin.Context) {
+	var req domain.SearchSchoolsRequest
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := s.service.SearchSchools(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
+}
+
+// SearchNews
+// @Tags Search
+// @Summary 소식 검색 [추가 구현] 권한 - 학생/관리자
+// @Description 소식의 제목, 요약, 본문으로 소식을 검색합니다.
+// @Description 학생은 구독 중인 학교의 발행된 소식을, 관리자는 멤버로 속한 학교의 모든 상태의 소식을 검색합니다.
+// @Description 검색 결과는 10개씩 아이디 기반으로 최신 순 정렬합니다 (커서로 페이징 가능)
+// @Produce json
+// @Security BearerAuth
+// @Param q query string true "검색어 (최대 100자)"
+// @Param cursor query int false "커서"
+// @Success 200 {object} domain.SearchNewsResponse "소식 검색 결과"
+// @Router /news/search [get]
+func (s searchController) SearchNews(c *gin.Context) {
+	var req domain.SearchNewsRequest
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	userType, err := router.GetUserTypeFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserType = userType
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := s.service.SearchNews(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
+}
@@ -0,0 +1,119 @@
+package search
+
+import (
+	"classting/config"
+	"classting/domain"
+	"classting/internal/user"
+	"classting/mocks"
+	"classting/pkg/router"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type searchControllerTestSuite struct {
+	router           *gin.Engine
+	cfg              *config.Config
+	searchService    *mocks.SearchService
+	searchController domain.SearchController
+}
+
+func setupSearchControllerTestSuite(t *testing.T) searchControllerTestSuite {
+	var us searchControllerTestSuite
+
+	gin.SetMode(gin.TestMode)
+	us.router = gin.Default()
+	us.searchService = mocks.NewSearchService(t)
+	us.cfg = &config.Config{
+		Auth: config.Auth{
+			Secret: "classting_test_secret",
+		},
+	}
+
+	us.searchController = NewSearchController(us.searchService)
+	RegisterRoutes(
+		us.router, us.searchController,
+		router.NewJWTAuth(us.cfg.Auth.Secret, nil, nil),
+	)
+
+	return us
+}
+
+func Test_searchController_Search(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		userType domain.UserType
+		mock     func(ts searchControllerTestSuite)
+		code     int
+	}{
+		{
+			name:     "PASS - 학교 검색",
+			path:     "/schools/search?q=%EC%B4%88%EB%93%B1%ED%95%99%EA%B5%90",
+			userType: domain.UserUseTypeStudent,
+			mock: func(ts searchControllerTestSuite) {
+				ts.searchService.EXPECT().SearchSchools(mock.Anything, domain.SearchSchoolsRequest{
+					Query: "초등학교",
+				}).Return(domain.SearchSchoolsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name:     "FAIL - 검색어 없이 학교 검색",
+			path:     "/schools/search",
+			userType: domain.UserUseTypeStudent,
+			mock:     func(ts searchControllerTestSuite) {},
+			code:     http.StatusBadRequest,
+		},
+		{
+			name:     "PASS - 관리자의 소식 검색",
+			path:     "/news/search?q=%EC%83%81%EB%8B%B4&cursor=10",
+			userType: domain.UserUseTypeAdmin,
+			mock: func(ts searchControllerTestSuite) {
+				cursor := 10
+				ts.searchService.EXPECT().SearchNews(mock.Anything, domain.SearchNewsRequest{
+					UserID:   1,
+					UserType: domain.UserUseTypeAdmin,
+					Query:    "상담",
+					Cursor:   &cursor,
+				}).Return(domain.SearchNewsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name:     "FAIL - 잘못된 커서로 소식 검색",
+			path:     "/news/search?q=%EC%83%81%EB%8B%B4&cursor=0",
+			userType: domain.UserUseTypeStudent,
+			mock:     func(ts searchControllerTestSuite) {},
+			code:     http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSearchControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			token, _ := user.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+				Type: tt.userType,
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.searchService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package search
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"classting/pkg/db"
+	"context"
+	"database/sql"
+)
+
+// fulltextIndex MySQL FULLTEXT 색인으로 검색한다. 색인은 MySQL이 schools, news 테이블을 저장할 때 함께 갱신하므로
+// 여러 인스턴스가 같은 색인을 공유하고 Index, Remove는 할 일이 없다.
+type fulltextIndex struct {
+	sqlDB *sql.DB
+}
+
+func NewFulltextIndex(sqlDB *sql.DB) *fulltextIndex {
+	return &fulltextIndex{
+		sqlDB: sqlDB,
+	}
+}
+
+var _ domain.SearchIndex = (*fulltextIndex)(nil)
+
+func (f fulltextIndex) IndexSchool(ctx context.Context, school domain.School) error {
+	return nil
+}
+
+func (f fulltextIndex) RemoveSchool(ctx context.Context, schoolID int) error {
+	return nil
+}
+
+func (f fulltextIndex) IndexNews(ctx context.Context, news domain.News) error {
+	return nil
+}
+
+func (f fulltextIndex) RemoveNews(ctx context.Context, newsID int) error {
+	return nil
+}
+
+func (f fulltextIndex) SearchSchools(ctx context.Context, params domain.SearchSchoolsParams) ([]int, error) {
+	const op cerrors.Op = "search/fulltextIndex/SearchSchools"
+
+	query := booleanQuery(params.Query)
+	if query == "" {
+		return nil, nil
+	}
+
+	q := db.Select(searchSchoolsQuery).Where(
+		db.Expr("MATCH (name, region) AGAINST (? IN BOOLEAN MODE)", query),
+		db.Expr("delete_date IS NULL"),
+	)
+	if params.Cursor != nil {
+		q.Where(db.Expr("id < ?", *params.Cursor))
+	}
+
+	ids, err := f.queryIDs(ctx, q.OrderBy("id", true).Limit(searchPageSize))
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return ids, nil
+}
+
+func (f fulltextIndex) SearchNews(ctx context.Context, params domain.SearchNewsParams) ([]int, error) {
+	const op cerrors.Op = "search/fulltextIndex/SearchNews"
+
+	query := booleanQuery(params.Query)
+	if query == "" || len(params.SchoolIDs) == 0 {
+		return nil, nil
+	}
+
+	q := db.Select(searchNewsQuery).Where(
+		db.Expr("MATCH (title, summary, body) AGAINST (? IN BOOLEAN MODE)", query),
+		db.Expr("delete_date IS NULL"),
+		db.In("school_id", params.SchoolIDs),
+	)
+	if params.Status != "" {
+		q.Where(db.Eq("status", params.Status))
+	}
+	if params.Cursor != nil {
+		q.Where(db.Expr("id < ?", *params.Cursor))
+	}
+
+	ids, err := f.queryIDs(ctx, q.OrderBy("id", true).Limit(searchPageSize))
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return ids, nil
+}
+
+func (f fulltextIndex) queryIDs(ctx context.Context, q *db.Query) ([]int, error) {
+	query, args := q.Build()
+	rows, err := f.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
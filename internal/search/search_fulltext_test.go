@@ -0,0 +1,131 @@
+package search
+
+import (
+	"classting/domain"
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+	"testing"
+)
+
+type fulltextIndexTestSuite struct {
+	sqlDB         *sql.DB
+	sqlMock       sqlmock.Sqlmock
+	fulltextIndex domain.SearchIndex
+}
+
+func setupFulltextIndexTestSuite() fulltextIndexTestSuite {
+	var us fulltextIndexTestSuite
+
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	us.sqlDB = mockDB
+	us.sqlMock = mock
+	us.fulltextIndex = NewFulltextIndex(mockDB)
+
+	return us
+}
+
+func Test_fulltextIndex_SearchSchools(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  domain.SearchSchoolsParams
+		mock    func(ts fulltextIndexTestSuite)
+		want    []int
+		wantErr bool
+	}{
+		{
+			name:   "PASS - 모든 단어를 포함하도록 검색",
+			params: domain.SearchSchoolsParams{Query: "서울 초등학교", Cursor: pointer.Int(10)},
+			mock: func(ts fulltextIndexTestSuite) {
+				ts.sqlMock.ExpectQuery(`SELECT id FROM schools WHERE MATCH \(name, region\) AGAINST \(\? IN BOOLEAN MODE\) AND delete_date IS NULL AND id < \? ORDER BY id DESC LIMIT \?`).
+					WithArgs("+서울 +초등학교", 10, 10).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(1))
+			},
+			want:    []int{3, 1},
+			wantErr: false,
+		},
+		{
+			name:    "PASS - 연산자만 있는 검색어는 조회하지 않음",
+			params:  domain.SearchSchoolsParams{Query: "+-*"},
+			mock:    func(ts fulltextIndexTestSuite) {},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:   "FAIL - 서버 에러",
+			params: domain.SearchSchoolsParams{Query: "서울"},
+			mock: func(ts fulltextIndexTestSuite) {
+				ts.sqlMock.ExpectQuery(`SELECT id FROM schools`).WithArgs("+서울", 10).WillReturnError(sql.ErrConnDone)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupFulltextIndexTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.fulltextIndex.SearchSchools(context.Background(), tt.params)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_fulltextIndex_SearchNews(t *testing.T) {
+	tests := []struct {
+		name   string
+		params domain.SearchNewsParams
+		mock   func(ts fulltextIndexTestSuite)
+		want   []int
+	}{
+		{
+			name:   "PASS - 구독한 학교의 발행된 소식 검색",
+			params: domain.SearchNewsParams{Query: "학부모 상담", SchoolIDs: []int{1, 2}, Status: domain.NewsStatusPublished},
+			mock: func(ts fulltextIndexTestSuite) {
+				ts.sqlMock.ExpectQuery(`SELECT id FROM news WHERE MATCH \(title, summary, body\) AGAINST \(\? IN BOOLEAN MODE\) AND delete_date IS NULL AND school_id IN \(\?, \?\) AND status = \? ORDER BY id DESC LIMIT \?`).
+					WithArgs("+학부모 +상담", 1, 2, domain.NewsStatusPublished, 10).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+			},
+			want: []int{5},
+		},
+		{
+			name:   "PASS - 검색할 학교가 없으면 조회하지 않음",
+			params: domain.SearchNewsParams{Query: "상담"},
+			mock:   func(ts fulltextIndexTestSuite) {},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupFulltextIndexTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.fulltextIndex.SearchNews(context.Background(), tt.params)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+		})
+	}
+}
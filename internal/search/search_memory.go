@@ -0,0 +1,225 @@
+package search
+
+import (
+	"classting/domain"
+	"context"
+	"sort"
+	"sync"
+)
+
+const (
+	// searchPageSize 다른 목록 조회와 같이 10개씩 조회한다.
+	searchPageSize = 10
+	// loadBatchSize 메모리 색인을 만들 때 한 번에 읽는 문서 수
+	loadBatchSize = 500
+)
+
+type newsDocument struct {
+	schoolID int
+	status   domain.NewsStatus
+}
+
+// invertedIndex 색인어마다 색인어를 포함한 문서 아이디를 저장한다.
+type invertedIndex struct {
+	postings map[string]map[int]struct{}
+	terms    map[int][]string
+}
+
+func newInvertedIndex() *invertedIndex {
+	return &invertedIndex{
+		postings: make(map[string]map[int]struct{}),
+		terms:    make(map[int][]string),
+	}
+}
+
+func (ix *invertedIndex) add(id int, texts ...string) {
+	ix.remove(id)
+
+	terms := searchTerms(texts...)
+	for _, term := range terms {
+		if ix.postings[term] == nil {
+			ix.postings[term] = make(map[int]struct{})
+		}
+		ix.postings[term][id] = struct{}{}
+	}
+	ix.terms[id] = terms
+}
+
+func (ix *invertedIndex) remove(id int) {
+	for _, term := range ix.terms[id] {
+		delete(ix.postings[term], id)
+		if len(ix.postings[term]) == 0 {
+			delete(ix.postings, term)
+		}
+	}
+	delete(ix.terms, id)
+}
+
+// match 모든 색인어를 포함한 문서 아이디를 최신 순으로 반환한다.
+func (ix *invertedIndex) match(terms []string, keep func(id int) bool) []int {
+	if len(terms) == 0 {
+		return nil
+	}
+
+	// 문서가 가장 적은 색인어부터 교집합을 구한다.
+	sort.Slice(terms, func(i, j int) bool {
+		return len(ix.postings[terms[i]]) < len(ix.postings[terms[j]])
+	})
+
+	var ids []int
+	for id := range ix.postings[terms[0]] {
+		if !keep(id) {
+			continue
+		}
+		matched := true
+		for _, term := range terms[1:] {
+			if _, ok := ix.postings[term][id]; !ok {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			ids = append(ids, id)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(ids)))
+
+	return ids
+}
+
+// memoryIndex 프로세스 메모리의 역색인. 여러 인스턴스로 실행하면 인스턴스 간에 공유되지 않는다.
+type memoryIndex struct {
+	mu       sync.RWMutex
+	schools  *invertedIndex
+	news     *invertedIndex
+	newsDocs map[int]newsDocument
+}
+
+func NewMemoryIndex() *memoryIndex {
+	return &memoryIndex{
+		schools:  newInvertedIndex(),
+		news:     newInvertedIndex(),
+		newsDocs: make(map[int]newsDocument),
+	}
+}
+
+var _ domain.SearchIndex = (*memoryIndex)(nil)
+
+// Load 삭제되지 않은 학교와 소식을 모두 읽어 색인한다. 서버를 시작할 때 한 번 실행한다.
+func (m *memoryIndex) Load(ctx context.Context, repository domain.SearchRepository) error {
+	params := domain.ListIndexDocumentsParams{Limit: loadBatchSize}
+	for {
+		schools, err := repository.ListIndexSchools(ctx, params)
+		if err != nil {
+			return err
+		}
+		for _, school := range schools {
+			_ = m.IndexSchool(ctx, school)
+		}
+		if len(schools) < params.Limit {
+			break
+		}
+		params.AfterID = schools[len(schools)-1].ID
+	}
+
+	params = domain.ListIndexDocumentsParams{Limit: loadBatchSize}
+	for {
+		news, err := repository.ListIndexNews(ctx, params)
+		if err != nil {
+			return err
+		}
+		for _, n := range news {
+			_ = m.IndexNews(ctx, n)
+		}
+		if len(news) < params.Limit {
+			break
+		}
+		params.AfterID = news[len(news)-1].ID
+	}
+
+	return nil
+}
+
+func (m *memoryIndex) IndexSchool(ctx context.Context, school domain.School) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.schools.add(school.ID, school.Name, school.Region)
+
+	return nil
+}
+
+func (m *memoryIndex) RemoveSchool(ctx context.Context, schoolID int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.schools.remove(schoolID)
+
+	return nil
+}
+
+func (m *memoryIndex) IndexNews(ctx context.Context, news domain.News) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.news.add(news.ID, news.Title, news.Summary, news.Body)
+	m.newsDocs[news.ID] = newsDocument{
+		schoolID: news.SchoolID,
+		status:   news.Status,
+	}
+
+	return nil
+}
+
+func (m *memoryIndex) RemoveNews(ctx context.Context, newsID int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.news.remove(newsID)
+	delete(m.newsDocs, newsID)
+
+	return nil
+}
+
+func (m *memoryIndex) SearchSchools(ctx context.Context, params domain.SearchSchoolsParams) ([]int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ids := m.schools.match(searchTerms(params.Query), func(id int) bool {
+		return params.Cursor == nil || id < *params.Cursor
+	})
+
+	return firstPage(ids), nil
+}
+
+func (m *memoryIndex) SearchNews(ctx context.Context, params domain.SearchNewsParams) ([]int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	schoolIDs := make(map[int]struct{}, len(params.SchoolIDs))
+	for _, schoolID := range params.SchoolIDs {
+		schoolIDs[schoolID] = struct{}{}
+	}
+
+	ids := m.news.match(searchTerms(params.Query), func(id int) bool {
+		if params.Cursor != nil && id >= *params.Cursor {
+			return false
+		}
+		doc := m.newsDocs[id]
+		if _, ok := schoolIDs[doc.schoolID]; !ok {
+			return false
+		}
+
+		return params.Status == "" || doc.status == params.Status
+	})
+
+	return firstPage(ids), nil
+}
+
+func firstPage(ids []int) []int {
+	if len(ids) > searchPageSize {
+		return ids[:searchPageSize]
+	}
+
+	return ids
+}
@@ -0,0 +1,177 @@
+package search
+
+import (
+	"classting/domain"
+	"classting/mocks"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/utils/pointer"
+	"testing"
+)
+
+func Test_searchTerms(t *testing.T) {
+	tests := []struct {
+		name  string
+		texts []string
+		want  []string
+	}{
+		{
+			name:  "PASS - 한글은 두 글자씩 겹쳐 나눔",
+			texts: []string{"서울초등학교"},
+			want:  []string{"서울", "울초", "초등", "등학", "학교"},
+		},
+		{
+			name:  "PASS - 특수문자로 단어를 나누고 대소문자는 구분하지 않음",
+			texts: []string{"Class-Ting!"},
+			want:  []string{"cl", "la", "as", "ss", "ti", "in", "ng"},
+		},
+		{
+			name:  "PASS - 한 글자 단어와 중복된 색인어는 제외",
+			texts: []string{"새 학교", "학교"},
+			want:  []string{"학교"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			got := searchTerms(tt.texts...)
+
+			// then
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_memoryIndex_SearchSchools(t *testing.T) {
+	tests := []struct {
+		name   string
+		params domain.SearchSchoolsParams
+		want   []int
+	}{
+		{
+			name:   "PASS - 띄어쓰기 없는 학교명에서 검색",
+			params: domain.SearchSchoolsParams{Query: "초등학교"},
+			want:   []int{2, 1},
+		},
+		{
+			name:   "PASS - 모든 단어를 포함한 학교만 검색",
+			params: domain.SearchSchoolsParams{Query: "초등학교 부산"},
+			want:   []int{2},
+		},
+		{
+			name:   "PASS - 커서 이전 학교만 검색",
+			params: domain.SearchSchoolsParams{Query: "초등학교", Cursor: pointer.Int(2)},
+			want:   []int{1},
+		},
+		{
+			name:   "PASS - 삭제된 학교는 제외",
+			params: domain.SearchSchoolsParams{Query: "중학교"},
+			want:   nil,
+		},
+		{
+			name:   "PASS - 색인어가 없는 검색어",
+			params: domain.SearchSchoolsParams{Query: "!"},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ctx := context.Background()
+			index := NewMemoryIndex()
+			_ = index.IndexSchool(ctx, domain.School{Base: domain.Base{ID: 1}, Name: "서울초등학교", Region: "서울"})
+			_ = index.IndexSchool(ctx, domain.School{Base: domain.Base{ID: 2}, Name: "해운대초등학교", Region: "부산"})
+			_ = index.IndexSchool(ctx, domain.School{Base: domain.Base{ID: 3}, Name: "클래스팅중학교", Region: "서울"})
+			_ = index.RemoveSchool(ctx, 3)
+
+			// when
+			got, err := index.SearchSchools(ctx, tt.params)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_memoryIndex_SearchNews(t *testing.T) {
+	tests := []struct {
+		name   string
+		params domain.SearchNewsParams
+		want   []int
+	}{
+		{
+			name:   "PASS - 제목, 요약, 본문에서 검색",
+			params: domain.SearchNewsParams{Query: "상담", SchoolIDs: []int{1, 2}},
+			want:   []int{3, 2, 1},
+		},
+		{
+			name:   "PASS - 학교 범위 밖의 소식은 제외",
+			params: domain.SearchNewsParams{Query: "상담", SchoolIDs: []int{1}},
+			want:   []int{2, 1},
+		},
+		{
+			name:   "PASS - 발행된 소식만 검색",
+			params: domain.SearchNewsParams{Query: "상담", SchoolIDs: []int{1, 2}, Status: domain.NewsStatusPublished},
+			want:   []int{3, 1},
+		},
+		{
+			name:   "PASS - 수정된 내용으로 검색",
+			params: domain.SearchNewsParams{Query: "방학", SchoolIDs: []int{1, 2}},
+			want:   []int{4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ctx := context.Background()
+			index := NewMemoryIndex()
+			_ = index.IndexNews(ctx, domain.News{Base: domain.Base{ID: 1}, SchoolID: 1, Title: "학부모 상담 안내", Status: domain.NewsStatusPublished})
+			_ = index.IndexNews(ctx, domain.News{Base: domain.Base{ID: 2}, SchoolID: 1, Summary: "상담 일정", Status: domain.NewsStatusDraft})
+			_ = index.IndexNews(ctx, domain.News{Base: domain.Base{ID: 3}, SchoolID: 2, Body: "진로상담 신청", Status: domain.NewsStatusPublished})
+			_ = index.IndexNews(ctx, domain.News{Base: domain.Base{ID: 4}, SchoolID: 2, Title: "상담 주간", Status: domain.NewsStatusPublished})
+			_ = index.IndexNews(ctx, domain.News{Base: domain.Base{ID: 4}, SchoolID: 2, Title: "여름 방학 안내", Status: domain.NewsStatusPublished})
+			_ = index.IndexNews(ctx, domain.News{Base: domain.Base{ID: 5}, SchoolID: 2, Title: "상담 취소", Status: domain.NewsStatusPublished})
+			_ = index.RemoveNews(ctx, 5)
+
+			// when
+			got, err := index.SearchNews(ctx, tt.params)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_memoryIndex_Load(t *testing.T) {
+	// given
+	ctx := context.Background()
+	repository := mocks.NewSearchRepository(t)
+	schools := make([]domain.School, loadBatchSize)
+	for i := range schools {
+		schools[i] = domain.School{Base: domain.Base{ID: i + 1}, Name: "클래스팅학교", Region: "서울"}
+	}
+	repository.EXPECT().ListIndexSchools(mock.Anything, domain.ListIndexDocumentsParams{Limit: loadBatchSize}).Return(schools, nil).Once()
+	repository.EXPECT().ListIndexSchools(mock.Anything, domain.ListIndexDocumentsParams{AfterID: loadBatchSize, Limit: loadBatchSize}).Return([]domain.School{
+		{Base: domain.Base{ID: loadBatchSize + 1}, Name: "마지막학교", Region: "부산"},
+	}, nil).Once()
+	repository.EXPECT().ListIndexNews(mock.Anything, domain.ListIndexDocumentsParams{Limit: loadBatchSize}).Return([]domain.News{
+		{Base: domain.Base{ID: 1}, SchoolID: 1, Title: "개학 안내", Status: domain.NewsStatusPublished},
+	}, nil).Once()
+	index := NewMemoryIndex()
+
+	// when
+	err := index.Load(ctx, repository)
+
+	// then
+	assert.NoError(t, err)
+	schoolIDs, _ := index.SearchSchools(ctx, domain.SearchSchoolsParams{Query: "마지막"})
+	assert.Equal(t, []int{loadBatchSize + 1}, schoolIDs)
+	newsIDs, _ := index.SearchNews(ctx, domain.SearchNewsParams{Query: "개학", SchoolIDs: []int{1}})
+	assert.Equal(t, []int{1}, newsIDs)
+}
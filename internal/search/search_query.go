@@ -0,0 +1,58 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ngramSize MySQL ngram 파서의 기본 토큰 크기와 같게 두 글자 단위로 색인한다.
+// 조사가 붙어 띄어쓰기로 나눌 수 없는 한글도 "서울초등학교"를 "초등학교"로 찾을 수 있다.
+const ngramSize = 2
+
+// searchWords 문자, 숫자가 아닌 글자로 단어를 나누고 ngramSize보다 짧은 단어는 버린다.
+func searchWords(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	filtered := words[:0]
+	for _, word := range words {
+		if utf8.RuneCountInString(word) >= ngramSize {
+			filtered = append(filtered, word)
+		}
+	}
+
+	return filtered
+}
+
+// searchTerms 단어를 두 글자씩 겹쳐 나눈 색인어, 중복된 색인어는 한 번만 담는다.
+func searchTerms(texts ...string) []string {
+	seen := make(map[string]struct{})
+	var terms []string
+	for _, text := range texts {
+		for _, word := range searchWords(text) {
+			runes := []rune(word)
+			for i := 0; i+ngramSize <= len(runes); i++ {
+				term := string(runes[i : i+ngramSize])
+				if _, ok := seen[term]; ok {
+					continue
+				}
+				seen[term] = struct{}{}
+				terms = append(terms, term)
+			}
+		}
+	}
+
+	return terms
+}
+
+// booleanQuery 모든 단어를 포함하도록 단어마다 +를 붙인 BOOLEAN MODE 검색어, 연산자로 쓰이는 특수문자는 searchWords에서 제거된다.
+func booleanQuery(query string) string {
+	words := searchWords(query)
+	for i, word := range words {
+		words[i] = "+" + word
+	}
+
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,165 @@
+package search
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"classting/pkg/db"
+	"context"
+	"database/sql"
+)
+
+type searchRepository struct {
+	sqlDB *sql.DB
+}
+
+func NewSearchRepository(sqlDB *sql.DB) *searchRepository {
+	return &searchRepository{
+		sqlDB: sqlDB,
+	}
+}
+
+var _ domain.SearchRepository = (*searchRepository)(nil)
+
+func (s searchRepository) ListSchoolsByIDs(ctx context.Context, schoolIDs []int) ([]domain.School, error) {
+	const op cerrors.Op = "search/searchRepository/ListSchoolsByIDs"
+
+	if len(schoolIDs) == 0 {
+		return nil, nil
+	}
+
+	query, args := db.Select(listSchoolsByIDsQuery).Where(db.In("id", schoolIDs), db.Expr("delete_date IS NULL")).Build()
+	schools, err := s.querySchools(ctx, query, args...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return schools, nil
+}
+
+func (s searchRepository) ListNewsByIDs(ctx context.Context, newsIDs []int) ([]domain.News, error) {
+	const op cerrors.Op = "search/searchRepository/ListNewsByIDs"
+
+	if len(newsIDs) == 0 {
+		return nil, nil
+	}
+
+	query, args := db.Select(listNewsByIDsQuery).Where(db.In("id", newsIDs), db.Expr("delete_date IS NULL")).Build()
+	news, err := s.queryNews(ctx, query, args...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return news, nil
+}
+
+func (s searchRepository) ListMemberSchoolIDs(ctx context.Context, params domain.ListMemberSchoolIDsParams) ([]int, error) {
+	const op cerrors.Op = "search/searchRepository/ListMemberSchoolIDs"
+
+	query, args := db.Select(listMemberSchoolIDsQuery).
+		Where(
+			db.Eq("school_members.user_id", params.UserID),
+			db.In("school_members.role", params.Role.IncludingRoles()),
+			db.Expr("schools.delete_date IS NULL"),
+		).
+		OrderBy("schools.id", false).
+		Build()
+	rows, err := s.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var schoolIDs []int
+	for rows.Next() {
+		var schoolID int
+		if err := rows.Scan(&schoolID); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		schoolIDs = append(schoolIDs, schoolID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return schoolIDs, nil
+}
+
+func (s searchRepository) ListIndexSchools(ctx context.Context, params domain.ListIndexDocumentsParams) ([]domain.School, error) {
+	const op cerrors.Op = "search/searchRepository/ListIndexSchools"
+
+	schools, err := s.querySchools(ctx, listIndexSchoolsQuery, params.AfterID, params.Limit)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return schools, nil
+}
+
+func (s searchRepository) ListIndexNews(ctx context.Context, params domain.ListIndexDocumentsParams) ([]domain.News, error) {
+	const op cerrors.Op = "search/searchRepository/ListIndexNews"
+
+	news, err := s.queryNews(ctx, listIndexNewsQuery, params.AfterID, params.Limit)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return news, nil
+}
+
+func (s searchRepository) querySchools(ctx context.Context, query string, args ...any) ([]domain.School, error) {
+	rows, err := s.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var schools []domain.School
+	for rows.Next() {
+		var school domain.School
+		if err := rows.Scan(&school.ID, &school.UserID, &school.Name, &school.Region); err != nil {
+			return nil, err
+		}
+		schools = append(schools, school)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return schools, nil
+}
+
+func (s searchRepository) queryNews(ctx context.Context, query string, args ...any) ([]domain.News, error) {
+	rows, err := s.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var news []domain.News
+	for rows.Next() {
+		var item domain.News
+		if err := rows.Scan(
+			&item.ID,
+			&item.CreateDate,
+			&item.UpdateDate,
+			&item.SchoolID,
+			&item.UserID,
+			&item.Title,
+			&item.Summary,
+			&item.Body,
+			&item.ContentFormat,
+			&item.Status,
+			&item.PublishDate,
+			&item.EditDate,
+			&item.Priority,
+		); err != nil {
+			return nil, err
+		}
+		news = append(news, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return news, nil
+}
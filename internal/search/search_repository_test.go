@@ -0,0 +1,146 @@
+package search
+
+import (
+	"classting/domain"
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+type searchRepositoryTestSuite struct {
+	sqlDB            *sql.DB
+	sqlMock          sqlmock.Sqlmock
+	searchRepository domain.SearchRepository
+}
+
+func setupSearchRepositoryTestSuite() searchRepositoryTestSuite {
+	var us searchRepositoryTestSuite
+
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	us.sqlDB = mockDB
+	us.sqlMock = mock
+	us.searchRepository = NewSearchRepository(mockDB)
+
+	return us
+}
+
+func Test_searchRepository_ListSchoolsByIDs(t *testing.T) {
+	tests := []struct {
+		name      string
+		schoolIDs []int
+		mock      func(ts searchRepositoryTestSuite)
+		want      []domain.School
+		wantErr   bool
+	}{
+		{
+			name:      "PASS - 아이디로 학교 조회",
+			schoolIDs: []int{3, 1},
+			mock: func(ts searchRepositoryTestSuite) {
+				ts.sqlMock.ExpectQuery(`SELECT id, user_id, name, region FROM schools WHERE id IN \(\?, \?\) AND delete_date IS NULL`).
+					WithArgs(3, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "name", "region"}).
+						AddRow(1, 1, "서울초등학교", "서울").
+						AddRow(3, 2, "해운대초등학교", "부산"))
+			},
+			want: []domain.School{
+				{Base: domain.Base{ID: 1}, UserID: 1, Name: "서울초등학교", Region: "서울"},
+				{Base: domain.Base{ID: 3}, UserID: 2, Name: "해운대초등학교", Region: "부산"},
+			},
+			wantErr: false,
+		},
+		{
+			name:      "PASS - 아이디가 없으면 조회하지 않음",
+			schoolIDs: nil,
+			mock:      func(ts searchRepositoryTestSuite) {},
+			want:      nil,
+			wantErr:   false,
+		},
+		{
+			name:      "FAIL - 서버 에러",
+			schoolIDs: []int{1},
+			mock: func(ts searchRepositoryTestSuite) {
+				ts.sqlMock.ExpectQuery(`FROM schools`).WithArgs(1).WillReturnError(sql.ErrConnDone)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSearchRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.searchRepository.ListSchoolsByIDs(context.Background(), tt.schoolIDs)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_searchRepository_ListIndexNews(t *testing.T) {
+	// given
+	ts := setupSearchRepositoryTestSuite()
+	now := time.Date(2024, 3, 4, 8, 0, 0, 0, time.UTC)
+	columns := []string{"id", "create_date", "update_date", "school_id", "user_id", "title", "summary", "body", "content_format", "status", "publish_date", "edit_date", "priority"}
+	ts.sqlMock.ExpectQuery(`FROM news WHERE id > \? AND delete_date IS NULL ORDER BY id LIMIT \?`).
+		WithArgs(100, 500).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(101, now, now, 1, 2, "개학 안내", "", "개학 안내 본문", domain.NewsContentFormatPlain, domain.NewsStatusPublished, now, nil, domain.NewsPriorityNormal))
+
+	// when
+	got, err := ts.searchRepository.ListIndexNews(context.Background(), domain.ListIndexDocumentsParams{AfterID: 100, Limit: 500})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.News{
+		{
+			Base:          domain.Base{ID: 101, CreateDate: now, UpdateDate: now},
+			SchoolID:      1,
+			UserID:        2,
+			Title:         "개학 안내",
+			Body:          "개학 안내 본문",
+			ContentFormat: domain.NewsContentFormatPlain,
+			Status:        domain.NewsStatusPublished,
+			PublishDate:   sql.NullTime{Time: now, Valid: true},
+			Priority:      domain.NewsPriorityNormal,
+		},
+	}, got)
+	if ts.sqlMock.ExpectationsWereMet() != nil {
+		t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+	}
+}
+
+func Test_searchRepository_ListMemberSchoolIDs(t *testing.T) {
+	// given
+	ts := setupSearchRepositoryTestSuite()
+	ts.sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT schools.id FROM school_members JOIN schools ON schools.id = school_members.school_id WHERE school_members.user_id = ? AND school_members.role IN (?, ?) AND schools.delete_date IS NULL ORDER BY schools.id ASC")).
+		WithArgs(1, domain.SchoolRoleEditor, domain.SchoolRoleOwner).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(4))
+
+	// when
+	got, err := ts.searchRepository.ListMemberSchoolIDs(context.Background(), domain.ListMemberSchoolIDsParams{
+		UserID: 1,
+		Role:   domain.SchoolRoleEditor,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 4}, got)
+	if ts.sqlMock.ExpectationsWereMet() != nil {
+		t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+	}
+}
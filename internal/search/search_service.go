@@ -0,0 +1,128 @@
+package search
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"context"
+)
+
+type searchService struct {
+	searchIndex            domain.SearchIndex
+	searchRepository       domain.SearchRepository
+	subscriptionRepository domain.SubscriptionRepository
+}
+
+func NewSearchService(
+	searchIndex domain.SearchIndex,
+	searchRepository domain.SearchRepository,
+	subscriptionRepository domain.SubscriptionRepository,
+) *searchService {
+	return &searchService{
+		searchIndex:            searchIndex,
+		searchRepository:       searchRepository,
+		subscriptionRepository: subscriptionRepository,
+	}
+}
+
+var _ domain.SearchService = (*searchService)(nil)
+
+func (s searchService) SearchSchools(ctx context.Context, req domain.SearchSchoolsRequest) (domain.SearchSchoolsResponse, error) {
+	const op cerrors.Op = "search/service/SearchSchools"
+
+	ids, err := s.searchIndex.SearchSchools(ctx, domain.SearchSchoolsParams{
+		Query:  req.Query,
+		Cursor: req.Cursor,
+	})
+	if err != nil {
+		return domain.SearchSchoolsResponse{}, cerrors.E(op, cerrors.Internal, err, "학교를 검색하는 중에 에러가 발생했습니다.")
+	}
+
+	schools, err := s.searchRepository.ListSchoolsByIDs(ctx, ids)
+	if err != nil {
+		return domain.SearchSchoolsResponse{}, err
+	}
+
+	schoolByID := make(map[int]domain.School, len(schools))
+	for _, school := range schools {
+		schoolByID[school.ID] = school
+	}
+
+	// 색인과 테이블 사이에 삭제된 학교는 건너뛰고 색인의 순서를 유지한다.
+	var schoolDTOs []domain.SchoolDTO
+	for _, id := range ids {
+		if school, ok := schoolByID[id]; ok {
+			schoolDTOs = append(schoolDTOs, domain.SchoolDTOFrom(school))
+		}
+	}
+
+	return domain.SearchSchoolsResponse{
+		Schools: schoolDTOs,
+		Cursor:  lastID(ids),
+	}, nil
+}
+
+func (s searchService) SearchNews(ctx context.Context, req domain.SearchNewsRequest) (domain.SearchNewsResponse, error) {
+	const op cerrors.Op = "search/service/SearchNews"
+
+	params := domain.SearchNewsParams{
+		Query:  req.Query,
+		Cursor: req.Cursor,
+	}
+
+	var err error
+	switch req.UserType {
+	case domain.UserUseTypeStudent:
+		params.SchoolIDs, err = s.subscriptionRepository.ListSubscriptionSchoolIDs(ctx, req.UserID)
+		params.Status = domain.NewsStatusPublished
+	case domain.UserUseTypeAdmin:
+		// 학교 멤버는 역할과 관계없이 임시 저장, 예약 소식까지 조회할 수 있다.
+		params.SchoolIDs, err = s.searchRepository.ListMemberSchoolIDs(ctx, domain.ListMemberSchoolIDsParams{
+			UserID: req.UserID,
+			Role:   domain.SchoolRoleViewer,
+		})
+	default:
+		return domain.SearchNewsResponse{}, cerrors.E(op, cerrors.Permission, "소식을 검색할 권한이 없습니다.")
+	}
+	if err != nil {
+		return domain.SearchNewsResponse{}, err
+	}
+	if len(params.SchoolIDs) == 0 {
+		return domain.SearchNewsResponse{}, nil
+	}
+
+	ids, err := s.searchIndex.SearchNews(ctx, params)
+	if err != nil {
+		return domain.SearchNewsResponse{}, cerrors.E(op, cerrors.Internal, err, "소식을 검색하는 중에 에러가 발생했습니다.")
+	}
+
+	news, err := s.searchRepository.ListNewsByIDs(ctx, ids)
+	if err != nil {
+		return domain.SearchNewsResponse{}, err
+	}
+
+	newsByID := make(map[int]domain.News, len(news))
+	for _, n := range news {
+		newsByID[n.ID] = n
+	}
+
+	var newsDTOs []domain.NewsDTO
+	for _, id := range ids {
+		if n, ok := newsByID[id]; ok {
+			newsDTOs = append(newsDTOs, domain.NewsDTOFrom(n))
+		}
+	}
+
+	return domain.SearchNewsResponse{
+		News:   newsDTOs,
+		Cursor: lastID(ids),
+	}, nil
+}
+
+// lastID 색인에서 찾은 마지막 아이디를 커서로 사용해 중간에 삭제된 문서가 있어도 다음 페이지를 이어서 조회한다.
+func lastID(ids []int) *int {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return &ids[len(ids)-1]
+}
@@ -0,0 +1,172 @@
+package search
+
+import (
+	"classting/domain"
+	"classting/mocks"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/utils/pointer"
+	"testing"
+)
+
+type searchServiceTestSuite struct {
+	searchIndex            *mocks.SearchIndex
+	searchRepository       *mocks.SearchRepository
+	subscriptionRepository *mocks.SubscriptionRepository
+	service                domain.SearchService
+}
+
+func setupSearchServiceTestSuite(t *testing.T) searchServiceTestSuite {
+	var us searchServiceTestSuite
+
+	us.searchIndex = mocks.NewSearchIndex(t)
+	us.searchRepository = mocks.NewSearchRepository(t)
+	us.subscriptionRepository = mocks.NewSubscriptionRepository(t)
+	us.service = NewSearchService(us.searchIndex, us.searchRepository, us.subscriptionRepository)
+
+	return us
+}
+
+func Test_searchService_SearchSchools(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     domain.SearchSchoolsRequest
+		mock    func(ts searchServiceTestSuite)
+		want    domain.SearchSchoolsResponse
+		wantErr bool
+	}{
+		{
+			name: "PASS - 색인의 순서대로 학교 조회",
+			req:  domain.SearchSchoolsRequest{Query: "초등학교"},
+			mock: func(ts searchServiceTestSuite) {
+				ts.searchIndex.EXPECT().SearchSchools(mock.Anything, domain.SearchSchoolsParams{Query: "초등학교"}).Return([]int{3, 2, 1}, nil).Once()
+				ts.searchRepository.EXPECT().ListSchoolsByIDs(mock.Anything, []int{3, 2, 1}).Return([]domain.School{
+					{Base: domain.Base{ID: 1}, UserID: 1, Name: "서울초등학교", Region: "서울"},
+					{Base: domain.Base{ID: 3}, UserID: 2, Name: "해운대초등학교", Region: "부산"},
+				}, nil).Once()
+			},
+			want: domain.SearchSchoolsResponse{
+				Schools: []domain.SchoolDTO{
+					{ID: 3, UserID: 2, Name: "해운대초등학교", Region: "부산"},
+					{ID: 1, UserID: 1, Name: "서울초등학교", Region: "서울"},
+				},
+				Cursor: pointer.Int(1),
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 검색 결과 없음",
+			req:  domain.SearchSchoolsRequest{Query: "초등학교", Cursor: pointer.Int(1)},
+			mock: func(ts searchServiceTestSuite) {
+				ts.searchIndex.EXPECT().SearchSchools(mock.Anything, domain.SearchSchoolsParams{Query: "초등학교", Cursor: pointer.Int(1)}).Return(nil, nil).Once()
+				ts.searchRepository.EXPECT().ListSchoolsByIDs(mock.Anything, []int(nil)).Return(nil, nil).Once()
+			},
+			want:    domain.SearchSchoolsResponse{},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSearchServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.service.SearchSchools(context.Background(), tt.req)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_searchService_SearchNews(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     domain.SearchNewsRequest
+		mock    func(ts searchServiceTestSuite)
+		want    domain.SearchNewsResponse
+		wantErr bool
+	}{
+		{
+			name: "PASS - 학생은 구독한 학교의 발행된 소식만 검색",
+			req:  domain.SearchNewsRequest{UserID: 1, UserType: domain.UserUseTypeStudent, Query: "상담"},
+			mock: func(ts searchServiceTestSuite) {
+				ts.subscriptionRepository.EXPECT().ListSubscriptionSchoolIDs(mock.Anything, 1).Return([]int{1, 2}, nil).Once()
+				ts.searchIndex.EXPECT().SearchNews(mock.Anything, domain.SearchNewsParams{
+					Query:     "상담",
+					SchoolIDs: []int{1, 2},
+					Status:    domain.NewsStatusPublished,
+				}).Return([]int{5}, nil).Once()
+				ts.searchRepository.EXPECT().ListNewsByIDs(mock.Anything, []int{5}).Return([]domain.News{
+					{Base: domain.Base{ID: 5}, SchoolID: 2, Title: "학부모 상담 안내", Status: domain.NewsStatusPublished},
+				}, nil).Once()
+			},
+			want: domain.SearchNewsResponse{
+				News: []domain.NewsDTO{
+					{BaseDTO: domain.BaseDTO{ID: 5}, SchoolID: 2, Title: "학부모 상담 안내", Status: domain.NewsStatusPublished},
+				},
+				Cursor: pointer.Int(5),
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 관리자는 멤버로 속한 학교의 모든 소식을 검색",
+			req:  domain.SearchNewsRequest{UserID: 1, UserType: domain.UserUseTypeAdmin, Query: "상담"},
+			mock: func(ts searchServiceTestSuite) {
+				ts.searchRepository.EXPECT().ListMemberSchoolIDs(mock.Anything, domain.ListMemberSchoolIDsParams{
+					UserID: 1,
+					Role:   domain.SchoolRoleViewer,
+				}).Return([]int{1}, nil).Once()
+				ts.searchIndex.EXPECT().SearchNews(mock.Anything, domain.SearchNewsParams{
+					Query:     "상담",
+					SchoolIDs: []int{1},
+				}).Return([]int{2}, nil).Once()
+				ts.searchRepository.EXPECT().ListNewsByIDs(mock.Anything, []int{2}).Return([]domain.News{
+					{Base: domain.Base{ID: 2}, SchoolID: 1, Title: "상담 일정", Status: domain.NewsStatusDraft},
+				}, nil).Once()
+			},
+			want: domain.SearchNewsResponse{
+				News: []domain.NewsDTO{
+					{BaseDTO: domain.BaseDTO{ID: 2}, SchoolID: 1, Title: "상담 일정", Status: domain.NewsStatusDraft},
+				},
+				Cursor: pointer.Int(2),
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 구독한 학교가 없으면 검색하지 않음",
+			req:  domain.SearchNewsRequest{UserID: 1, UserType: domain.UserUseTypeStudent, Query: "상담"},
+			mock: func(ts searchServiceTestSuite) {
+				ts.subscriptionRepository.EXPECT().ListSubscriptionSchoolIDs(mock.Anything, 1).Return(nil, nil).Once()
+			},
+			want:    domain.SearchNewsResponse{},
+			wantErr: false,
+		},
+		{
+			name:    "FAIL - 알 수 없는 유저 유형",
+			req:     domain.SearchNewsRequest{UserID: 1, Query: "상담"},
+			mock:    func(ts searchServiceTestSuite) {},
+			want:    domain.SearchNewsResponse{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSearchServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.service.SearchNews(context.Background(), tt.req)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
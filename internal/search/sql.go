@@ -0,0 +1,18 @@
+package search
+
+// searchSchoolsQuery, searchNewsQuery FULLTEXT 색인은 ngram 파서로 만들어 띄어쓰기 없는 한글도 두 글자 단위로 검색된다.
+const searchSchoolsQuery = `SELECT id FROM schools`
+
+const searchNewsQuery = `SELECT id FROM news`
+
+// listSchoolsByIDsQuery, listNewsByIDsQuery 아이디 조건은 db.Query로 붙인다.
+const listSchoolsByIDsQuery = `SELECT id, user_id, name, region FROM schools`
+
+const listNewsByIDsQuery = `SELECT id, create_date, update_date, school_id, user_id, title, summary, body, content_format, status, publish_date, edit_date, priority FROM news`
+
+// listMemberSchoolIDsQuery 유저 아이디, 역할 조건은 db.Query로 붙인다.
+const listMemberSchoolIDsQuery = `SELECT schools.id FROM school_members JOIN schools ON schools.id = school_members.school_id`
+
+const listIndexSchoolsQuery = `SELECT id, user_id, name, region FROM schools WHERE id > ? AND delete_date IS NULL ORDER BY id LIMIT ?`
+
+const listIndexNewsQuery = `SELECT id, create_date, update_date, school_id, user_id, title, summary, body, content_format, status, publish_date, edit_date, priority FROM news WHERE id > ? AND delete_date IS NULL ORDER BY id LIMIT ?`
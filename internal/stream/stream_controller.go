@@ -0,0 +1,386 @@
+package stream
+
+import (
+	"classting/config"
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"classting/pkg/router"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	defaultHeartbeatInterval = 15 * time.Second
+	gatewayWriteTimeout      = 10 * time.Second
+	gatewayRequestTimeout    = 30 * time.Second
+)
+
+func RegisterRoutes(e *gin.Engine, controller domain.StreamController, auth *router.JWTAuth) {
+	api := e.Group("/subscriptions")
+	{
+		api.GET("/stream", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeStudent}), controller.StreamSubscriptionNews)
+		api.GET("/ws", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeStudent}), controller.ConnectGateway)
+	}
+}
+
+type streamController struct {
+	service           domain.StreamService
+	heartbeatInterval time.Duration
+	allowedOrigins    []string
+	gateway           *gatewayConnections
+}
+
+func NewStreamController(service domain.StreamService, cfg *config.Config) *streamController {
+	heartbeatInterval := defaultHeartbeatInterval
+	if cfg.Stream.HeartbeatSeconds > 0 {
+		heartbeatInterval = time.Duration(cfg.Stream.HeartbeatSeconds) * time.Second
+	}
+
+	return &streamController{
+		service:           service,
+		heartbeatInterval: heartbeatInterval,
+		allowedOrigins:    cfg.Stream.AllowedOrigins,
+		gateway:           newGatewayConnections(),
+	}
+}
+
+var _ domain.StreamController = (*streamController)(nil)
+
+// Shutdown 새로운 웹소켓 연결을 거부하고 연결된 웹소켓을 종료한 뒤 모두 정리될 때까지 기다린다.
+// 웹소켓 연결은 http.Server가 관리하지 않으므로 srv.Shutdown 이후에 호출해야 한다.
+func (s streamController) Shutdown(ctx context.Context) error {
+	return s.gateway.shutdown(ctx)
+}
+
+// StreamSubscriptionNews
+// @Summary 구독 중인 학교 소식 실시간 스트림 [추가 구현] 권한 - 학생
+// @Description 구독 중인 학교의 소식 발행, 수정, 삭제 이벤트를 Server-Sent Events로 전달합니다.
+// @Description event는 news.created, news.updated, news.deleted 중 하나이며 data는 소식 정보입니다.
+// @Description id는 마지막으로 전달된 소식 ID 커서이며 재연결 시 Last-Event-ID 헤더로 놓친 소식을 다시 받을 수 있습니다.
+// @Description 연결 중에 구독을 취소한 학교의 소식은 더 이상 전달하지 않고, 새로 구독한 학교의 소식은 재연결 없이 전달합니다.
+// @Description 연결 유지를 위해 주기적으로 heartbeat 주석을 전송합니다.
+// @Tags Subscription
+// @Produce text/event-stream
+// @Security BearerAuth
+// @Param Last-Event-ID header int false "마지막으로 받은 이벤트 ID"
+// @Success 200 {object} domain.NewsDTO "소식 이벤트"
+// @Router /subscriptions/stream [get]
+func (s streamController) StreamSubscriptionNews(c *gin.Context) {
+	var req domain.OpenNewsStreamRequest
+
+	if err := c.ShouldBindHeader(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	stream, err := s.service.OpenNewsStream(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	defer stream.Close()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	var cursor int
+	if req.LastEventID != nil {
+		cursor = *req.LastEventID
+	}
+
+	for _, news := range stream.Missed {
+		cursor = max(cursor, news.ID)
+		if err := writeNewsEvent(c.Writer, cursor, domain.NewsEvent{Type: domain.NewsEventTypeCreated, News: news}); err != nil {
+			return
+		}
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(s.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err := io.WriteString(c.Writer, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case event, ok := <-stream.Events:
+			if !ok {
+				return
+			}
+			if event.Type == domain.NewsEventTypeCreated {
+				// 놓친 소식으로 이미 전달한 소식은 건너뛴다.
+				if event.News.ID <= cursor {
+					continue
+				}
+				cursor = event.News.ID
+			}
+			if err := writeNewsEvent(c.Writer, cursor, event); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+func writeNewsEvent(w io.Writer, cursor int, event domain.NewsEvent) error {
+	data, err := json.Marshal(domain.NewsDTOFrom(event.News))
+	if err != nil {
+		return err
+	}
+
+	if cursor > 0 {
+		if _, err := fmt.Fprintf(w, "id: %d\n", cursor); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+
+	return err
+}
+
+// ConnectGateway
+// @Summary 학교 채널 웹소켓 게이트웨이 [추가 구현] 권한 - 학생
+// @Description 웹소켓으로 연결 후 구독 중인 학교 채널에 참여하거나 나갈 수 있습니다.
+// @Description 요청 메시지는 {"action": "subscribe" | "unsubscribe" | "ping", "schoolID": 1} 형식이며 구독한 학교만 참여할 수 있습니다.
+// @Description 응답 메시지의 type은 news.created, news.updated, news.deleted, subscription.created, subscription.deleted 이벤트이거나 subscribed, unsubscribed, pong, error 입니다.
+// @Description 구독을 취소하면 해당 학교 채널에서 자동으로 나가며, 메시지를 제때 받지 못하는 느린 연결은 서버에서 종료합니다.
+// @Description 브라우저는 Authorization 헤더 대신 new WebSocket(url, ["bearer", accessToken])처럼 서브프로토콜로 토큰을 보내며, 허용한 출처에서만 연결할 수 있습니다.
+// @Tags Subscription
+// @Security BearerAuth
+// @Param request body domain.GatewayRequest true "웹소켓 요청 메시지"
+// @Success 101 {object} domain.GatewayMessage "웹소켓 응답 메시지"
+// @Router /subscriptions/ws [get]
+func (s streamController) ConnectGateway(c *gin.Context) {
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if !s.allowOrigin(c.Request) {
+		c.JSON(cerrors.NewSentinelAPIError(http.StatusForbidden, "허용되지 않은 출처입니다."))
+		return
+	}
+
+	if !s.gateway.add() {
+		c.JSON(cerrors.NewSentinelAPIError(http.StatusServiceUnavailable, "서버가 종료 중입니다."))
+		return
+	}
+	defer s.gateway.done()
+
+	ctx := c.Request.Context()
+
+	session, err := s.service.OpenGatewaySession(ctx, userID)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	defer session.Close()
+
+	websocket.Server{
+		Handshake: selectGatewayProtocol,
+		Handler: func(conn *websocket.Conn) {
+			s.serveGateway(ctx, conn, session)
+		},
+	}.ServeHTTP(c.Writer, c.Request)
+}
+
+// allowOrigin 다른 사이트의 페이지가 사용자의 권한으로 연결하지 못하도록 같은 호스트와 허용한 출처만 받는다.
+// 브라우저는 항상 Origin 헤더를 보내므로 헤더가 없는 브라우저 외 클라이언트는 허용한다.
+func (s streamController) allowOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" || slices.Contains(s.allowedOrigins, origin) {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+
+	return err == nil && u.Host == r.Host
+}
+
+// selectGatewayProtocol 서브프로토콜로 토큰을 보낸 브라우저에는 토큰을 돌려주지 않고 bearer만 선택해 응답한다.
+func selectGatewayProtocol(config *websocket.Config, _ *http.Request) error {
+	if slices.Contains(config.Protocol, router.WebSocketAuthProtocol) {
+		config.Protocol = []string{router.WebSocketAuthProtocol}
+	} else {
+		config.Protocol = nil
+	}
+
+	return nil
+}
+
+// serveGateway 클라이언트 요청은 별도의 고루틴에서 읽고 웹소켓 쓰기는 이 고루틴에서만 한다.
+func (s streamController) serveGateway(ctx context.Context, conn *websocket.Conn, session domain.GatewaySession) {
+	done := make(chan struct{})
+	defer close(done)
+
+	requests := make(chan domain.GatewayRequest)
+	go func() {
+		defer close(requests)
+		for {
+			var req domain.GatewayRequest
+			if err := websocket.JSON.Receive(conn, &req); err != nil {
+				return
+			}
+			select {
+			case requests <- req:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	send := func(msg domain.GatewayMessage) error {
+		if err := conn.SetWriteDeadline(time.Now().Add(gatewayWriteTimeout)); err != nil {
+			return err
+		}
+		return websocket.JSON.Send(conn, msg)
+	}
+
+	for {
+		var msg domain.GatewayMessage
+
+		select {
+		case <-s.gateway.closing:
+			return
+		case req, ok := <-requests:
+			if !ok {
+				return
+			}
+			msg = s.handleGatewayRequest(ctx, session, req)
+		case event, ok := <-session.NewsEvents():
+			if !ok {
+				// 서버가 종료되거나 버퍼가 가득 찬 느린 연결은 구독이 해제된다.
+				return
+			}
+			msg = domain.GatewayMessageFromNewsEvent(event)
+		case event, ok := <-session.SubscriptionEvents():
+			if !ok {
+				return
+			}
+			if event.Type == domain.SubscriptionEventTypeDeleted {
+				session.Leave(event.Subscription.SchoolID)
+			}
+			msg = domain.GatewayMessageFromSubscriptionEvent(event)
+		}
+
+		if err := send(msg); err != nil {
+			return
+		}
+	}
+}
+
+func (s streamController) handleGatewayRequest(ctx context.Context, session domain.GatewaySession, req domain.GatewayRequest) domain.GatewayMessage {
+	if err := req.Validate(); err != nil {
+		return gatewayErrorMessage(req.SchoolID, err)
+	}
+
+	switch req.Action {
+	case domain.GatewayActionSubscribe:
+		ctx, cancel := context.WithTimeout(ctx, gatewayRequestTimeout)
+		defer cancel()
+
+		if err := session.Join(ctx, req.SchoolID); err != nil {
+			return gatewayErrorMessage(req.SchoolID, err)
+		}
+		return domain.GatewayMessage{Type: domain.GatewayMessageTypeSubscribed, SchoolID: req.SchoolID}
+	case domain.GatewayActionUnsubscribe:
+		session.Leave(req.SchoolID)
+		return domain.GatewayMessage{Type: domain.GatewayMessageTypeUnsubscribed, SchoolID: req.SchoolID}
+	default:
+		return domain.GatewayMessage{Type: domain.GatewayMessageTypePong}
+	}
+}
+
+func gatewayErrorMessage(schoolID int, err error) domain.GatewayMessage {
+	_, apiErr := cerrors.ToSentinelAPIError(err)
+
+	return domain.GatewayMessage{
+		Type:     domain.GatewayMessageTypeError,
+		SchoolID: schoolID,
+		Message:  apiErr.Message,
+	}
+}
+
+// gatewayConnections 서버 종료 시 정리할 웹소켓 연결을 추적한다.
+type gatewayConnections struct {
+	mu      sync.Mutex
+	wg      sync.WaitGroup
+	closed  bool
+	closing chan struct{}
+}
+
+func newGatewayConnections() *gatewayConnections {
+	return &gatewayConnections{
+		closing: make(chan struct{}),
+	}
+}
+
+func (g *gatewayConnections) add() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.closed {
+		return false
+	}
+	g.wg.Add(1)
+
+	return true
+}
+
+func (g *gatewayConnections) done() {
+	g.wg.Done()
+}
+
+func (g *gatewayConnections) shutdown(ctx context.Context) error {
+	g.mu.Lock()
+	if !g.closed {
+		g.closed = true
+		close(g.closing)
+	}
+	g.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
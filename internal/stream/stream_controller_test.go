@@ -0,0 +1,336 @@
+package stream
+
+import (
+	"classting/config"
+	"classting/domain"
+	"classting/internal/user"
+	"classting/mocks"
+	"classting/pkg/cerrors"
+	"classting/pkg/router"
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/net/websocket"
+	"k8s.io/utils/pointer"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type streamControllerTestSuite struct {
+	router           *gin.Engine
+	cfg              *config.Config
+	streamService    *mocks.StreamService
+	streamController domain.StreamController
+}
+
+func setupStreamControllerTestSuite(t *testing.T) streamControllerTestSuite {
+	var us streamControllerTestSuite
+
+	gin.SetMode(gin.TestMode)
+	us.router = gin.Default()
+	us.streamService = mocks.NewStreamService(t)
+	us.cfg = &config.Config{
+		Auth: config.Auth{
+			Secret: "classting_test_secret",
+		},
+	}
+
+	us.streamController = NewStreamController(us.streamService, us.cfg)
+	RegisterRoutes(
+		us.router, us.streamController,
+		router.NewJWTAuth(us.cfg.Auth.Secret, nil, nil),
+	)
+
+	return us
+}
+
+func newNewsStream(events ...domain.NewsEvent) domain.NewsStream {
+	ch := make(chan domain.NewsEvent, len(events))
+	for _, event := range events {
+		ch <- event
+	}
+	close(ch)
+
+	return domain.NewsStream{
+		Events: ch,
+		Close:  func() {},
+	}
+}
+
+func Test_streamController_StreamSubscriptionNews(t *testing.T) {
+	tests := []struct {
+		name        string
+		lastEventID string
+		mock        func(ts streamControllerTestSuite)
+		code        int
+		contains    []string
+		notContains []string
+	}{
+		{
+			name: "PASS - 실시간 소식 이벤트 전달",
+			mock: func(ts streamControllerTestSuite) {
+				ts.streamService.EXPECT().OpenNewsStream(mock.Anything, domain.OpenNewsStreamRequest{
+					UserID: 1,
+				}).Return(newNewsStream(
+					domain.NewsEvent{
+						Type: domain.NewsEventTypeCreated,
+						News: domain.News{Base: domain.Base{ID: 3}, SchoolID: 1, Title: "새 소식"},
+					},
+					domain.NewsEvent{
+						Type: domain.NewsEventTypeUpdated,
+						News: domain.News{Base: domain.Base{ID: 2}, SchoolID: 1, Title: "수정된 소식"},
+					},
+				), nil).Once()
+			},
+			code: http.StatusOK,
+			contains: []string{
+				"id: 3\nevent: news.created\ndata: {",
+				`"title":"새 소식"`,
+				"id: 3\nevent: news.updated\ndata: {",
+				`"title":"수정된 소식"`,
+			},
+		},
+		{
+			name:        "PASS - Last-Event-ID로 재연결 시 놓친 소식을 먼저 전달하고 중복은 제외",
+			lastEventID: "1",
+			mock: func(ts streamControllerTestSuite) {
+				stream := newNewsStream(domain.NewsEvent{
+					Type: domain.NewsEventTypeCreated,
+					News: domain.News{Base: domain.Base{ID: 2}, SchoolID: 1, Title: "중복 소식"},
+				})
+				stream.Missed = []domain.News{
+					{Base: domain.Base{ID: 2}, SchoolID: 1, Title: "놓친 소식"},
+				}
+				ts.streamService.EXPECT().OpenNewsStream(mock.Anything, domain.OpenNewsStreamRequest{
+					UserID:      1,
+					LastEventID: pointer.Int(1),
+				}).Return(stream, nil).Once()
+			},
+			code: http.StatusOK,
+			contains: []string{
+				"id: 2\nevent: news.created\ndata: {",
+				`"title":"놓친 소식"`,
+			},
+			notContains: []string{
+				`"title":"중복 소식"`,
+			},
+		},
+		{
+			name:        "FAIL - 잘못된 Last-Event-ID",
+			lastEventID: "0",
+			mock:        func(ts streamControllerTestSuite) {},
+			code:        http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupStreamControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodGet, "/subscriptions/stream", nil)
+			if tt.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", tt.lastEventID)
+			}
+			token, _ := user.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+				Type: domain.UserUseTypeStudent,
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			for _, s := range tt.contains {
+				assert.Contains(t, rec.Body.String(), s)
+			}
+			for _, s := range tt.notContains {
+				assert.NotContains(t, rec.Body.String(), s)
+			}
+			ts.streamService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_streamController_ConnectGateway(t *testing.T) {
+	// given
+	ts := setupStreamControllerTestSuite(t)
+	server := httptest.NewServer(ts.router)
+	defer server.Close()
+
+	newsEvents := make(chan domain.NewsEvent, 1)
+	subscriptionEvents := make(chan domain.SubscriptionEvent, 1)
+	session := mocks.NewGatewaySession(t)
+	session.EXPECT().NewsEvents().Return((<-chan domain.NewsEvent)(newsEvents))
+	session.EXPECT().SubscriptionEvents().Return((<-chan domain.SubscriptionEvent)(subscriptionEvents))
+	session.EXPECT().Join(mock.Anything, 1).Return(nil).Once()
+	session.EXPECT().Join(mock.Anything, 2).Return(cerrors.E(cerrors.Op("test"), cerrors.Permission, "구독한 학교가 아닙니다.")).Once()
+	session.EXPECT().Leave(1).Return().Once()
+	session.EXPECT().Close().Return().Once()
+	ts.streamService.EXPECT().OpenGatewaySession(mock.Anything, 1).Return(session, nil).Once()
+
+	token, _ := user.CreateAccessToken(domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+		Type: domain.UserUseTypeStudent,
+	}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+	wsConfig, err := websocket.NewConfig("ws"+strings.TrimPrefix(server.URL, "http")+"/subscriptions/ws", server.URL)
+	assert.NoError(t, err)
+	wsConfig.Header.Set("Authorization", "Bearer "+token)
+
+	conn, err := websocket.DialConfig(wsConfig)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+
+	receive := func() domain.GatewayMessage {
+		var msg domain.GatewayMessage
+		_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+		assert.NoError(t, websocket.JSON.Receive(conn, &msg))
+		return msg
+	}
+
+	// when, then
+	assert.NoError(t, websocket.JSON.Send(conn, domain.GatewayRequest{Action: domain.GatewayActionSubscribe, SchoolID: 1}))
+	assert.Equal(t, domain.GatewayMessage{Type: domain.GatewayMessageTypeSubscribed, SchoolID: 1}, receive())
+
+	assert.NoError(t, websocket.JSON.Send(conn, domain.GatewayRequest{Action: domain.GatewayActionSubscribe, SchoolID: 2}))
+	assert.Equal(t, domain.GatewayMessage{Type: domain.GatewayMessageTypeError, SchoolID: 2, Message: "구독한 학교가 아닙니다."}, receive())
+
+	assert.NoError(t, websocket.JSON.Send(conn, domain.GatewayRequest{Action: "unknown"}))
+	assert.Equal(t, domain.GatewayMessageTypeError, receive().Type)
+
+	assert.NoError(t, websocket.JSON.Send(conn, domain.GatewayRequest{Action: domain.GatewayActionPing}))
+	assert.Equal(t, domain.GatewayMessage{Type: domain.GatewayMessageTypePong}, receive())
+
+	newsEvents <- domain.NewsEvent{
+		Type: domain.NewsEventTypeCreated,
+		News: domain.News{Base: domain.Base{ID: 3}, SchoolID: 1, Title: "새 소식"},
+	}
+	msg := receive()
+	assert.Equal(t, "news.created", msg.Type)
+	assert.Equal(t, 1, msg.SchoolID)
+	if assert.NotNil(t, msg.News) {
+		assert.Equal(t, "새 소식", msg.News.Title)
+	}
+
+	subscriptionEvents <- domain.SubscriptionEvent{
+		Type:         domain.SubscriptionEventTypeDeleted,
+		Subscription: domain.Subscription{UserID: 1, SchoolID: 1},
+	}
+	assert.Equal(t, domain.GatewayMessage{Type: "subscription.deleted", SchoolID: 1}, receive())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.NoError(t, ts.streamController.(*streamController).Shutdown(ctx))
+
+	var closed domain.GatewayMessage
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	assert.Error(t, websocket.JSON.Receive(conn, &closed))
+}
+
+func Test_streamController_ConnectGateway_Origin(t *testing.T) {
+	tests := []struct {
+		name       string
+		origin     string
+		mock       func(ts streamControllerTestSuite)
+		wantStatus int
+	}{
+		{
+			name:       "FAIL - 허용하지 않은 출처",
+			origin:     "https://evil.example.com",
+			mock:       func(ts streamControllerTestSuite) {},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:   "PASS - 허용한 출처",
+			origin: "https://classting.example.com",
+			mock: func(ts streamControllerTestSuite) {
+				ts.streamService.EXPECT().OpenGatewaySession(mock.Anything, 1).Return(nil, cerrors.E(cerrors.Op("test"), cerrors.Internal, "세션을 열 수 없습니다.")).Once()
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupStreamControllerTestSuite(t)
+			ts.cfg.Stream.AllowedOrigins = []string{"https://classting.example.com"}
+			ts.router = gin.Default()
+			ts.streamController = NewStreamController(ts.streamService, ts.cfg)
+			RegisterRoutes(ts.router, ts.streamController, router.NewJWTAuth(ts.cfg.Auth.Secret, nil, nil))
+			tt.mock(ts)
+
+			token, _ := user.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+				Type: domain.UserUseTypeStudent,
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour))
+			req := httptest.NewRequest(http.MethodGet, "/subscriptions/ws", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			req.Header.Set("Origin", tt.origin)
+			rec := httptest.NewRecorder()
+
+			// when
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func Test_streamController_ConnectGateway_ProtocolToken(t *testing.T) {
+	// given
+	ts := setupStreamControllerTestSuite(t)
+	server := httptest.NewServer(ts.router)
+	defer server.Close()
+
+	session := mocks.NewGatewaySession(t)
+	session.EXPECT().NewsEvents().Return((<-chan domain.NewsEvent)(make(chan domain.NewsEvent)))
+	session.EXPECT().SubscriptionEvents().Return((<-chan domain.SubscriptionEvent)(make(chan domain.SubscriptionEvent)))
+	session.EXPECT().Close().Return().Once()
+	ts.streamService.EXPECT().OpenGatewaySession(mock.Anything, 1).Return(session, nil).Once()
+
+	token, _ := user.CreateAccessToken(domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+		Type: domain.UserUseTypeStudent,
+	}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour))
+	wsConfig, err := websocket.NewConfig("ws"+strings.TrimPrefix(server.URL, "http")+"/subscriptions/ws", server.URL)
+	assert.NoError(t, err)
+	wsConfig.Protocol = []string{"bearer", token}
+
+	// when
+	conn, err := websocket.DialConfig(wsConfig)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+
+	// then
+	assert.Equal(t, []string{"bearer"}, conn.Config().Protocol)
+	assert.NoError(t, websocket.JSON.Send(conn, domain.GatewayRequest{Action: domain.GatewayActionPing}))
+	var msg domain.GatewayMessage
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	assert.NoError(t, websocket.JSON.Receive(conn, &msg))
+	assert.Equal(t, domain.GatewayMessage{Type: domain.GatewayMessageTypePong}, msg)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.NoError(t, ts.streamController.(*streamController).Shutdown(ctx))
+}
@@ -0,0 +1,177 @@
+package stream
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"classting/pkg/pubsub"
+	"context"
+	"fmt"
+)
+
+// maxMissedNews Last-Event-ID로 재연결 시 한 번에 다시 전달하는 최대 소식 수
+const maxMissedNews = 100
+
+type streamService struct {
+	newsHub                *pubsub.Hub[domain.NewsEvent]
+	subscriptionHub        *pubsub.Hub[domain.SubscriptionEvent]
+	subscriptionRepository domain.SubscriptionRepository
+	timelineRepository     domain.TimelineRepository
+}
+
+func NewStreamService(
+	newsHub *pubsub.Hub[domain.NewsEvent],
+	subscriptionHub *pubsub.Hub[domain.SubscriptionEvent],
+	subscriptionRepository domain.SubscriptionRepository,
+	timelineRepository domain.TimelineRepository,
+) *streamService {
+	return &streamService{
+		newsHub:                newsHub,
+		subscriptionHub:        subscriptionHub,
+		subscriptionRepository: subscriptionRepository,
+		timelineRepository:     timelineRepository,
+	}
+}
+
+var _ domain.StreamService = (*streamService)(nil)
+
+func (s streamService) PublishNewsEvent(ctx context.Context, event domain.NewsEvent) error {
+	s.newsHub.Publish(schoolTopic(event.News.SchoolID), event)
+
+	return nil
+}
+
+func (s streamService) PublishSubscriptionEvent(ctx context.Context, event domain.SubscriptionEvent) error {
+	s.subscriptionHub.Publish(userTopic(event.Subscription.UserID), event)
+
+	return nil
+}
+
+// OpenNewsStream 연결 시점에 구독 중인 학교 채널에 참여하고, 연결 중에 구독을 취소하거나 새로 구독하면 게이트웨이 세션과 같이 채널을 따라간다.
+func (s streamService) OpenNewsStream(ctx context.Context, req domain.OpenNewsStreamRequest) (domain.NewsStream, error) {
+	const op cerrors.Op = "stream/service/OpenNewsStream"
+
+	// 구독 학교를 조회하는 동안 생성, 취소된 구독을 놓치지 않도록 구독 이벤트를 먼저 구독한다.
+	session := s.newGatewaySession(req.UserID)
+
+	schoolIDs, err := s.subscriptionRepository.ListSubscriptionSchoolIDs(ctx, req.UserID)
+	if err != nil {
+		session.Close()
+		return domain.NewsStream{}, cerrors.E(op, cerrors.Internal, err, "구독한 학교를 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	// 놓친 소식을 조회하는 동안 발행된 소식이 유실되지 않도록 먼저 참여한다.
+	for _, schoolID := range schoolIDs {
+		session.news.Join(schoolTopic(schoolID))
+	}
+
+	var missed []domain.News
+	if req.LastEventID != nil {
+		missed, err = s.timelineRepository.ListTimelineNewsAfter(ctx, domain.ListTimelineNewsAfterParams{
+			UserID: req.UserID,
+			After:  *req.LastEventID,
+			Limit:  maxMissedNews,
+		})
+		if err != nil {
+			session.Close()
+			return domain.NewsStream{}, cerrors.E(op, cerrors.Internal, err, "소식을 조회하는 중에 에러가 발생했습니다.")
+		}
+	}
+
+	go session.followSubscriptions()
+
+	return domain.NewsStream{
+		Missed: missed,
+		Events: session.NewsEvents(),
+		Close:  session.Close,
+	}, nil
+}
+
+// OpenGatewaySession 학교 채널에 참여하지 않은 세션을 생성한다. 학교 채널은 세션의 Join으로 참여한다.
+func (s streamService) OpenGatewaySession(ctx context.Context, userID int) (domain.GatewaySession, error) {
+	return s.newGatewaySession(userID), nil
+}
+
+func (s streamService) newGatewaySession(userID int) *gatewaySession {
+	return &gatewaySession{
+		userID:                 userID,
+		subscriptionRepository: s.subscriptionRepository,
+		news:                   s.newsHub.Subscribe(),
+		subscriptions:          s.subscriptionHub.Subscribe(userTopic(userID)),
+	}
+}
+
+type gatewaySession struct {
+	userID                 int
+	subscriptionRepository domain.SubscriptionRepository
+	news                   *pubsub.Subscriber[domain.NewsEvent]
+	subscriptions          *pubsub.Subscriber[domain.SubscriptionEvent]
+}
+
+var _ domain.GatewaySession = (*gatewaySession)(nil)
+
+func (g *gatewaySession) NewsEvents() <-chan domain.NewsEvent {
+	return g.news.Messages()
+}
+
+func (g *gatewaySession) SubscriptionEvents() <-chan domain.SubscriptionEvent {
+	return g.subscriptions.Messages()
+}
+
+func (g *gatewaySession) Join(ctx context.Context, schoolID int) error {
+	const op cerrors.Op = "stream/gatewaySession/Join"
+
+	subscription, err := g.subscriptionRepository.FindSubscriptionByUserIDAndSchoolID(ctx, domain.FindSubscriptionByUserIDAndSchoolIDParams{
+		UserID:   g.userID,
+		SchoolID: schoolID,
+	})
+	if err != nil {
+		return err
+	}
+	if subscription == nil {
+		return cerrors.E(op, cerrors.Permission, "구독한 학교가 아닙니다.")
+	}
+
+	g.news.Join(schoolTopic(schoolID))
+
+	return nil
+}
+
+func (g *gatewaySession) Leave(schoolID int) {
+	g.news.Leave(schoolTopic(schoolID))
+}
+
+// followSubscriptions 구독을 취소한 학교 채널에서 나가고 새로 구독한 학교 채널에 참여한다.
+// 세션이 종료되거나 느린 연결로 구독이 해제될 때까지 실행한다.
+func (g *gatewaySession) followSubscriptions() {
+	defer g.Close()
+
+	for {
+		select {
+		case <-g.news.Done():
+			return
+		case event, ok := <-g.subscriptions.Messages():
+			if !ok {
+				return
+			}
+			switch event.Type {
+			case domain.SubscriptionEventTypeCreated:
+				g.news.Join(schoolTopic(event.Subscription.SchoolID))
+			case domain.SubscriptionEventTypeDeleted:
+				g.Leave(event.Subscription.SchoolID)
+			}
+		}
+	}
+}
+
+func (g *gatewaySession) Close() {
+	g.news.Close()
+	g.subscriptions.Close()
+}
+
+func schoolTopic(schoolID int) string {
+	return fmt.Sprintf("school:%d", schoolID)
+}
+
+func userTopic(userID int) string {
+	return fmt.Sprintf("user:%d", userID)
+}
@@ -0,0 +1,340 @@
+package stream
+
+import (
+	"classting/domain"
+	"classting/mocks"
+	"classting/pkg/pubsub"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/utils/pointer"
+	"testing"
+	"time"
+)
+
+type streamServiceTestSuite struct {
+	newsHub                *pubsub.Hub[domain.NewsEvent]
+	subscriptionHub        *pubsub.Hub[domain.SubscriptionEvent]
+	subscriptionRepository *mocks.SubscriptionRepository
+	timelineRepository     *mocks.TimelineRepository
+	service                domain.StreamService
+}
+
+func setupStreamServiceTestSuite(t *testing.T) streamServiceTestSuite {
+	var us streamServiceTestSuite
+
+	us.newsHub = pubsub.NewHub[domain.NewsEvent](10)
+	us.subscriptionHub = pubsub.NewHub[domain.SubscriptionEvent](10)
+	us.subscriptionRepository = mocks.NewSubscriptionRepository(t)
+	us.timelineRepository = mocks.NewTimelineRepository(t)
+	us.service = NewStreamService(us.newsHub, us.subscriptionHub, us.subscriptionRepository, us.timelineRepository)
+
+	return us
+}
+
+func Test_streamService_OpenNewsStream(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.OpenNewsStreamRequest
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		mock       func(ts streamServiceTestSuite)
+		wantMissed []domain.News
+		wantErr    bool
+	}{
+		{
+			name: "PASS - 스트림 연결",
+			args: args{
+				ctx: context.Background(),
+				req: domain.OpenNewsStreamRequest{
+					UserID: 1,
+				},
+			},
+			mock: func(ts streamServiceTestSuite) {
+				ts.subscriptionRepository.EXPECT().ListSubscriptionSchoolIDs(mock.Anything, 1).Return([]int{1, 2}, nil).Once()
+			},
+			wantMissed: nil,
+			wantErr:    false,
+		},
+		{
+			name: "PASS - Last-Event-ID로 재연결 시 놓친 소식 조회",
+			args: args{
+				ctx: context.Background(),
+				req: domain.OpenNewsStreamRequest{
+					UserID:      1,
+					LastEventID: pointer.Int(10),
+				},
+			},
+			mock: func(ts streamServiceTestSuite) {
+				ts.subscriptionRepository.EXPECT().ListSubscriptionSchoolIDs(mock.Anything, 1).Return([]int{1}, nil).Once()
+				ts.timelineRepository.EXPECT().ListTimelineNewsAfter(mock.Anything, domain.ListTimelineNewsAfterParams{
+					UserID: 1,
+					After:  10,
+					Limit:  maxMissedNews,
+				}).Return([]domain.News{
+					{
+						Base: domain.Base{
+							ID: 11,
+						},
+						SchoolID: 1,
+						Title:    "놓친 소식",
+					},
+				}, nil).Once()
+			},
+			wantMissed: []domain.News{
+				{
+					Base: domain.Base{
+						ID: 11,
+					},
+					SchoolID: 1,
+					Title:    "놓친 소식",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 구독 학교 조회 실패",
+			args: args{
+				ctx: context.Background(),
+				req: domain.OpenNewsStreamRequest{
+					UserID: 1,
+				},
+			},
+			mock: func(ts streamServiceTestSuite) {
+				ts.subscriptionRepository.EXPECT().ListSubscriptionSchoolIDs(mock.Anything, 1).Return(nil, errors.New("db error")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupStreamServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.service.OpenNewsStream(tt.args.ctx, tt.args.req)
+
+			// then
+			ts.subscriptionRepository.AssertExpectations(t)
+			ts.timelineRepository.AssertExpectations(t)
+			assert.Equal(t, tt.wantErr, err != nil)
+			if err == nil {
+				assert.Equal(t, tt.wantMissed, got.Missed)
+				got.Close()
+			}
+		})
+	}
+}
+
+func Test_streamService_PublishNewsEvent(t *testing.T) {
+	// given
+	ts := setupStreamServiceTestSuite(t)
+	ts.subscriptionRepository.EXPECT().ListSubscriptionSchoolIDs(mock.Anything, 1).Return([]int{1}, nil).Once()
+	stream, err := ts.service.OpenNewsStream(context.Background(), domain.OpenNewsStreamRequest{UserID: 1})
+	assert.NoError(t, err)
+	defer stream.Close()
+
+	subscribed := domain.NewsEvent{
+		Type: domain.NewsEventTypeCreated,
+		News: domain.News{Base: domain.Base{ID: 1}, SchoolID: 1, Title: "구독한 학교 소식"},
+	}
+	other := domain.NewsEvent{
+		Type: domain.NewsEventTypeCreated,
+		News: domain.News{Base: domain.Base{ID: 2}, SchoolID: 2, Title: "구독하지 않은 학교 소식"},
+	}
+
+	// when
+	assert.NoError(t, ts.service.PublishNewsEvent(context.Background(), other))
+	assert.NoError(t, ts.service.PublishNewsEvent(context.Background(), subscribed))
+
+	// then
+	assert.Equal(t, subscribed, <-stream.Events)
+	assert.Len(t, stream.Events, 0)
+}
+
+func Test_streamService_OpenNewsStream_followSubscriptions(t *testing.T) {
+	// given
+	ts := setupStreamServiceTestSuite(t)
+	ts.subscriptionRepository.EXPECT().ListSubscriptionSchoolIDs(mock.Anything, 1).Return([]int{1}, nil).Once()
+	stream, err := ts.service.OpenNewsStream(context.Background(), domain.OpenNewsStreamRequest{UserID: 1})
+	assert.NoError(t, err)
+	defer stream.Close()
+
+	// when
+	assert.NoError(t, ts.service.PublishSubscriptionEvent(context.Background(), domain.SubscriptionEvent{
+		Type:         domain.SubscriptionEventTypeDeleted,
+		Subscription: domain.Subscription{UserID: 1, SchoolID: 1},
+	}))
+	assert.NoError(t, ts.service.PublishSubscriptionEvent(context.Background(), domain.SubscriptionEvent{
+		Type:         domain.SubscriptionEventTypeCreated,
+		Subscription: domain.Subscription{UserID: 1, SchoolID: 2},
+	}))
+
+	// then
+	subscribed := domain.NewsEvent{
+		Type: domain.NewsEventTypeCreated,
+		News: domain.News{Base: domain.Base{ID: 2}, SchoolID: 2, Title: "새로 구독한 학교 소식"},
+	}
+	assert.Eventually(t, func() bool {
+		_ = ts.service.PublishNewsEvent(context.Background(), subscribed)
+		return len(stream.Events) > 0
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, subscribed, <-stream.Events)
+
+	assert.NoError(t, ts.service.PublishNewsEvent(context.Background(), domain.NewsEvent{
+		Type: domain.NewsEventTypeCreated,
+		News: domain.News{Base: domain.Base{ID: 3}, SchoolID: 1, Title: "구독 취소한 학교 소식"},
+	}))
+	assert.Len(t, stream.Events, 0)
+}
+
+func Test_streamService_SlowConsumer(t *testing.T) {
+	// given
+	hub := pubsub.NewHub[domain.NewsEvent](1)
+	subscriptionRepository := mocks.NewSubscriptionRepository(t)
+	service := NewStreamService(hub, pubsub.NewHub[domain.SubscriptionEvent](1), subscriptionRepository, mocks.NewTimelineRepository(t))
+	subscriptionRepository.EXPECT().ListSubscriptionSchoolIDs(mock.Anything, 1).Return([]int{1}, nil).Once()
+	stream, err := service.OpenNewsStream(context.Background(), domain.OpenNewsStreamRequest{UserID: 1})
+	assert.NoError(t, err)
+
+	// when
+	for i := 1; i <= 3; i++ {
+		_ = service.PublishNewsEvent(context.Background(), domain.NewsEvent{
+			Type: domain.NewsEventTypeCreated,
+			News: domain.News{Base: domain.Base{ID: i}, SchoolID: 1},
+		})
+	}
+
+	// then
+	var received int
+	for range stream.Events {
+		received++
+	}
+	assert.Equal(t, 1, received)
+}
+
+func Test_gatewaySession_Join(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		schoolID int
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		mock       func(ts streamServiceTestSuite)
+		wantJoined bool
+		wantErr    bool
+	}{
+		{
+			name: "PASS - 구독한 학교 채널 참여",
+			args: args{
+				ctx:      context.Background(),
+				schoolID: 1,
+			},
+			mock: func(ts streamServiceTestSuite) {
+				ts.subscriptionRepository.EXPECT().FindSubscriptionByUserIDAndSchoolID(mock.Anything, domain.FindSubscriptionByUserIDAndSchoolIDParams{
+					UserID:   1,
+					SchoolID: 1,
+				}).Return(&domain.Subscription{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID:   1,
+					SchoolID: 1,
+				}, nil).Once()
+			},
+			wantJoined: true,
+			wantErr:    false,
+		},
+		{
+			name: "FAIL - 구독하지 않은 학교 채널 참여",
+			args: args{
+				ctx:      context.Background(),
+				schoolID: 1,
+			},
+			mock: func(ts streamServiceTestSuite) {
+				ts.subscriptionRepository.EXPECT().FindSubscriptionByUserIDAndSchoolID(mock.Anything, domain.FindSubscriptionByUserIDAndSchoolIDParams{
+					UserID:   1,
+					SchoolID: 1,
+				}).Return(nil, nil).Once()
+			},
+			wantJoined: false,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupStreamServiceTestSuite(t)
+			tt.mock(ts)
+			session, err := ts.service.OpenGatewaySession(tt.args.ctx, 1)
+			assert.NoError(t, err)
+			defer session.Close()
+
+			// when
+			err = session.Join(tt.args.ctx, tt.args.schoolID)
+			_ = ts.service.PublishNewsEvent(context.Background(), domain.NewsEvent{
+				Type: domain.NewsEventTypeCreated,
+				News: domain.News{Base: domain.Base{ID: 1}, SchoolID: tt.args.schoolID},
+			})
+
+			// then
+			ts.subscriptionRepository.AssertExpectations(t)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantJoined, len(session.NewsEvents()) == 1)
+		})
+	}
+}
+
+func Test_gatewaySession_Leave(t *testing.T) {
+	// given
+	ts := setupStreamServiceTestSuite(t)
+	ts.subscriptionRepository.EXPECT().FindSubscriptionByUserIDAndSchoolID(mock.Anything, mock.Anything).Return(&domain.Subscription{UserID: 1, SchoolID: 1}, nil).Once()
+	session, err := ts.service.OpenGatewaySession(context.Background(), 1)
+	assert.NoError(t, err)
+	defer session.Close()
+	assert.NoError(t, session.Join(context.Background(), 1))
+
+	// when
+	session.Leave(1)
+	_ = ts.service.PublishNewsEvent(context.Background(), domain.NewsEvent{
+		Type: domain.NewsEventTypeCreated,
+		News: domain.News{Base: domain.Base{ID: 1}, SchoolID: 1},
+	})
+
+	// then
+	assert.Len(t, session.NewsEvents(), 0)
+}
+
+func Test_streamService_PublishSubscriptionEvent(t *testing.T) {
+	// given
+	ts := setupStreamServiceTestSuite(t)
+	session, err := ts.service.OpenGatewaySession(context.Background(), 1)
+	assert.NoError(t, err)
+	defer session.Close()
+
+	mine := domain.SubscriptionEvent{
+		Type:         domain.SubscriptionEventTypeDeleted,
+		Subscription: domain.Subscription{UserID: 1, SchoolID: 1},
+	}
+	other := domain.SubscriptionEvent{
+		Type:         domain.SubscriptionEventTypeCreated,
+		Subscription: domain.Subscription{UserID: 2, SchoolID: 1},
+	}
+
+	// when
+	assert.NoError(t, ts.service.PublishSubscriptionEvent(context.Background(), other))
+	assert.NoError(t, ts.service.PublishSubscriptionEvent(context.Background(), mine))
+
+	// then
+	assert.Equal(t, mine, <-session.SubscriptionEvents())
+	assert.Len(t, session.SubscriptionEvents(), 0)
+}
@@ -2,8 +2,41 @@ package subscription
 
 const createSubscriptionQuery = `INSERT INTO subscriptions (school_id, user_id) VALUES (?, ?)`
 
-const listSubscriptionSchoolsQuery = `SELECT subscriptions.id, subscriptions.create_date, subscriptions.update_date, subscriptions.school_id, schools.name, schools.region FROM schools JOIN subscriptions ON schools.id = subscriptions.school_id  WHERE subscriptions.user_id = ? %s ORDER BY id DESC LIMIT 10`
+// listSubscriptionSchoolsQuery 조건, 정렬, 조회 개수는 db.Query로 붙인다.
+const listSubscriptionSchoolsQuery = `SELECT subscriptions.id, subscriptions.create_date, subscriptions.update_date, subscriptions.school_id, schools.name, schools.region, schools.delete_date FROM schools JOIN subscriptions ON schools.id = subscriptions.school_id`
 
-const findSubscriptionByUserIDAndSchoolIDQuery = `SELECT id, create_date, update_date, school_id, user_id FROM subscriptions WHERE user_id = ? AND school_id = ?`
+const findSubscriptionByUserIDAndSchoolIDQuery = `SELECT id, create_date, update_date, school_id, user_id FROM subscriptions WHERE user_id = ? AND school_id = ? AND delete_date IS NULL`
 
-const deleteSubscriptionQuery = `DELETE FROM subscriptions WHERE id = ?`
+const findSubscriptionByIDQuery = `SELECT id, create_date, update_date, school_id, user_id FROM subscriptions WHERE id = ? AND delete_date IS NULL FOR UPDATE`
+
+// deleteSubscriptionQuery 구독자 증감을 집계할 수 있도록 구독 취소 기록을 남긴다.
+const deleteSubscriptionQuery = `UPDATE subscriptions SET delete_date = ? WHERE id = ?`
+
+// increaseSubscriberCountQuery 학교 목록을 구독자 수 순으로 정렬할 수 있도록 구독, 구독 취소와 같은 트랜잭션에서 갱신한다.
+const increaseSubscriberCountQuery = `UPDATE schools SET subscriber_count = subscriber_count + 1 WHERE id = ?`
+
+const decreaseSubscriberCountQuery = `UPDATE schools SET subscriber_count = GREATEST(subscriber_count - 1, 0) WHERE id = ?`
+
+const deleteNewsReadsQuery = `DELETE FROM news_reads WHERE user_id = ? AND school_id = ?`
+
+// deleteTimelinesQuery 구독 취소와 같은 트랜잭션에서 지워 취소 후에 끝난 백필이 다시 넣지 못하게 한다.
+const deleteTimelinesQuery = `DELETE FROM timelines WHERE user_id = ? AND school_id = ?`
+
+const listSubscriptionSchoolIDsQuery = `SELECT school_id FROM subscriptions WHERE user_id = ? AND delete_date IS NULL`
+
+// markNewsReadQuery 구독 중이 아니거나 이미 모두 읽음 처리된 범위의 소식은 기록하지 않는다.
+const markNewsReadQuery = `INSERT IGNORE INTO news_reads (user_id, school_id, news_id) SELECT user_id, school_id, ? FROM subscriptions WHERE user_id = ? AND school_id = ? AND delete_date IS NULL AND last_read_news_id < ?`
+
+// createNewsReadEventQuery 소식 통계를 위해 읽을 때마다 기록을 남긴다.
+const createNewsReadEventQuery = `INSERT INTO news_read_events (news_id, school_id, user_id) SELECT ?, school_id, user_id FROM subscriptions WHERE user_id = ? AND school_id = ? AND delete_date IS NULL`
+
+const markSchoolNewsReadUntilQuery = `UPDATE subscriptions SET last_read_news_id = GREATEST(last_read_news_id, ?) WHERE user_id = ? AND school_id = ? AND delete_date IS NULL`
+
+// deleteNewsReadsUntilQuery 읽음 기준 ID 이하의 개별 읽음 기록은 더 이상 필요 없다.
+const deleteNewsReadsUntilQuery = `DELETE FROM news_reads WHERE user_id = ? AND school_id = ? AND news_id <= ?`
+
+// countUnreadNewsQuery 학교별 읽음 기준 ID 이후의 발행된 소식 중 개별 읽음 기록이 없는 소식을 센다. 학교 ID 조건은 db.Query로 붙인다.
+const countUnreadNewsQuery = `SELECT subscriptions.school_id, COUNT(*) FROM subscriptions JOIN news ON news.school_id = subscriptions.school_id AND news.id > subscriptions.last_read_news_id AND news.status = 'PUBLISHED' AND news.delete_date IS NULL LEFT JOIN news_reads ON news_reads.user_id = subscriptions.user_id AND news_reads.news_id = news.id`
+
+// listReadNewsIDsQuery 플레이스홀더는 유저 ID, 소식 ID 조건은 db.Query로 붙인다.
+const listReadNewsIDsQuery = `SELECT news.id FROM news JOIN subscriptions ON subscriptions.school_id = news.school_id AND subscriptions.user_id = ? AND subscriptions.delete_date IS NULL LEFT JOIN news_reads ON news_reads.user_id = subscriptions.user_id AND news_reads.news_id = news.id`
@@ -2,11 +2,13 @@ package subscription
 
 import (
 	"classting/domain"
+	"classting/internal/outbox"
 	"classting/pkg/cerrors"
+	"classting/pkg/db"
 	"context"
 	"database/sql"
 	"errors"
-	"fmt"
+	"time"
 )
 
 type subscriptionRepository struct {
@@ -24,17 +26,29 @@ var _ domain.SubscriptionRepository = (*subscriptionRepository)(nil)
 func (n subscriptionRepository) CreateSubscription(ctx context.Context, subscription domain.Subscription) (int, error) {
 	const op cerrors.Op = "subscription/subscriptionRepository/CreateSubscription"
 
-	result, err := n.sqlDB.ExecContext(ctx, createSubscriptionQuery, subscription.SchoolID, subscription.UserID)
-	if err != nil {
-		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
-	}
+	err := db.WithTx(ctx, n.sqlDB, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, createSubscriptionQuery, subscription.SchoolID, subscription.UserID)
+		if err != nil {
+			return err
+		}
 
-	subscriptionID, err := result.LastInsertId()
+		subscriptionID, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		subscription.ID = int(subscriptionID)
+
+		if _, err := tx.ExecContext(ctx, increaseSubscriberCountQuery, subscription.SchoolID); err != nil {
+			return err
+		}
+
+		return appendSubscriptionEvent(ctx, tx, domain.SubscriptionEventTypeCreated, subscription)
+	})
 	if err != nil {
 		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 	}
 
-	return int(subscriptionID), nil
+	return subscription.ID, nil
 }
 
 func (n subscriptionRepository) ListSubscriptionSchools(ctx context.Context, params domain.ListSubscriptionSchoolsParams) ([]domain.SubscriptionSchool, error) {
@@ -42,11 +56,14 @@ func (n subscriptionRepository) ListSubscriptionSchools(ctx context.Context, par
 
 	var subscriptionSchools []domain.SubscriptionSchool
 
-	query := fmt.Sprintf(listSubscriptionSchoolsQuery, params.AfterCursor())
+	query, args := db.Select(listSubscriptionSchoolsQuery).
+		Where(db.Eq("subscriptions.user_id", params.UserID), db.Expr("subscriptions.delete_date IS NULL")).
+		Seek(params.Cursor, true, "subscriptions.id").
+		Limit(params.Limit).
+		Build()
 
-	rows, err := n.sqlDB.QueryContext(ctx, query, params.UserID)
+	rows, err := n.sqlDB.QueryContext(ctx, query, args...)
 	if err != nil {
-		fmt.Println(err)
 		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 	}
 	defer rows.Close()
@@ -60,6 +77,7 @@ func (n subscriptionRepository) ListSubscriptionSchools(ctx context.Context, par
 			&subscriptionSchool.SchoolID,
 			&subscriptionSchool.Name,
 			&subscriptionSchool.Region,
+			&subscriptionSchool.SchoolDeleteDate,
 		)
 		if err != nil {
 			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
@@ -73,7 +91,40 @@ func (n subscriptionRepository) ListSubscriptionSchools(ctx context.Context, par
 func (n subscriptionRepository) DeleteSubscription(ctx context.Context, subscriptionID int) error {
 	const op cerrors.Op = "subscription/subscriptionRepository/DeleteSubscription"
 
-	_, err := n.sqlDB.ExecContext(ctx, deleteSubscriptionQuery, subscriptionID)
+	err := db.WithTx(ctx, n.sqlDB, func(tx *sql.Tx) error {
+		var subscription domain.Subscription
+		err := tx.QueryRowContext(ctx, findSubscriptionByIDQuery, subscriptionID).Scan(
+			&subscription.ID,
+			&subscription.CreateDate,
+			&subscription.UpdateDate,
+			&subscription.SchoolID,
+			&subscription.UserID,
+		)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, deleteSubscriptionQuery, time.Now().UTC(), subscriptionID); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, decreaseSubscriberCountQuery, subscription.SchoolID); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, deleteNewsReadsQuery, subscription.UserID, subscription.SchoolID); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, deleteTimelinesQuery, subscription.UserID, subscription.SchoolID); err != nil {
+			return err
+		}
+
+		return appendSubscriptionEvent(ctx, tx, domain.SubscriptionEventTypeDeleted, subscription)
+	})
 	if err != nil {
 		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 	}
@@ -102,3 +153,144 @@ func (n subscriptionRepository) FindSubscriptionByUserIDAndSchoolID(ctx context.
 
 	return &subscription, nil
 }
+
+func (n subscriptionRepository) ListSubscriptionSchoolIDs(ctx context.Context, userID int) ([]int, error) {
+	const op cerrors.Op = "subscription/subscriptionRepository/ListSubscriptionSchoolIDs"
+
+	var schoolIDs []int
+
+	rows, err := n.sqlDB.QueryContext(ctx, listSubscriptionSchoolIDsQuery, userID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var schoolID int
+		if err := rows.Scan(&schoolID); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		schoolIDs = append(schoolIDs, schoolID)
+	}
+
+	return schoolIDs, nil
+}
+
+func (n subscriptionRepository) MarkNewsRead(ctx context.Context, params domain.MarkNewsReadParams) error {
+	const op cerrors.Op = "subscription/subscriptionRepository/MarkNewsRead"
+
+	err := db.WithTx(ctx, n.sqlDB, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, markNewsReadQuery, params.NewsID, params.UserID, params.SchoolID, params.NewsID); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, createNewsReadEventQuery, params.NewsID, params.UserID, params.SchoolID)
+		return err
+	})
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (n subscriptionRepository) MarkSchoolNewsReadUntil(ctx context.Context, params domain.MarkSchoolNewsReadUntilParams) error {
+	const op cerrors.Op = "subscription/subscriptionRepository/MarkSchoolNewsReadUntil"
+
+	err := db.WithTx(ctx, n.sqlDB, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, markSchoolNewsReadUntilQuery, params.NewsID, params.UserID, params.SchoolID); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, deleteNewsReadsUntilQuery, params.UserID, params.SchoolID, params.NewsID)
+		return err
+	})
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// CountUnreadNews 학교 ID별 읽지 않은 소식 수를 한 번의 쿼리로 센다. 읽지 않은 소식이 없는 학교는 결과에 없다.
+func (n subscriptionRepository) CountUnreadNews(ctx context.Context, params domain.CountUnreadNewsParams) (map[int]int, error) {
+	const op cerrors.Op = "subscription/subscriptionRepository/CountUnreadNews"
+
+	counts := make(map[int]int, len(params.SchoolIDs))
+	if len(params.SchoolIDs) == 0 {
+		return counts, nil
+	}
+
+	query, args := db.Select(countUnreadNewsQuery).
+		Where(
+			db.Eq("subscriptions.user_id", params.UserID),
+			db.In("subscriptions.school_id", params.SchoolIDs),
+			db.Expr("subscriptions.delete_date IS NULL"),
+			db.Expr("news_reads.news_id IS NULL"),
+		).
+		GroupBy("subscriptions.school_id").
+		Build()
+
+	rows, err := n.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var schoolID, count int
+		if err := rows.Scan(&schoolID, &count); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		counts[schoolID] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return counts, nil
+}
+
+func (n subscriptionRepository) ListReadNewsIDs(ctx context.Context, params domain.ListReadNewsIDsParams) (map[int]bool, error) {
+	const op cerrors.Op = "subscription/subscriptionRepository/ListReadNewsIDs"
+
+	read := make(map[int]bool, len(params.NewsIDs))
+	if len(params.NewsIDs) == 0 {
+		return read, nil
+	}
+
+	query, args := db.Select(listReadNewsIDsQuery, params.UserID).
+		Where(
+			db.In("news.id", params.NewsIDs),
+			db.Or(db.Expr("news.id <= subscriptions.last_read_news_id"), db.Expr("news_reads.news_id IS NOT NULL")),
+		).
+		Build()
+
+	rows, err := n.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var newsID int
+		if err := rows.Scan(&newsID); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		read[newsID] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return read, nil
+}
+
+func appendSubscriptionEvent(ctx context.Context, tx *sql.Tx, eventType domain.SubscriptionEventType, subscription domain.Subscription) error {
+	event, err := domain.OutboxEventFromSubscription(eventType, subscription)
+	if err != nil {
+		return err
+	}
+
+	return outbox.Append(ctx, tx, event)
+}
@@ -2,6 +2,7 @@ package subscription
 
 import (
 	"classting/domain"
+	"classting/pkg/pagination"
 	"context"
 	"database/sql"
 	"github.com/DATA-DOG/go-sqlmock"
@@ -58,9 +59,17 @@ func Test_subscriptionRepository_CreateSubscription(t *testing.T) {
 				},
 			},
 			mock: func(ts subscriptionRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
 				ts.sqlMock.ExpectExec(`INSERT INTO subscriptions`).
 					WithArgs(1, 1).
 					WillReturnResult(sqlmock.NewResult(1, 1))
+				ts.sqlMock.ExpectExec(`UPDATE schools SET subscriber_count = subscriber_count \+ 1 WHERE id = \?`).
+					WithArgs(1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				ts.sqlMock.ExpectExec("INSERT INTO outbox").
+					WithArgs(domain.OutboxAggregateTypeSubscription, 1, "subscription.created", sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				ts.sqlMock.ExpectCommit()
 			},
 			want:    1,
 			wantErr: false,
@@ -107,13 +116,14 @@ func Test_subscriptionRepository_ListSubscriptionSchools(t *testing.T) {
 				ctx: context.Background(),
 				params: domain.ListSubscriptionSchoolsParams{
 					UserID: 1,
+					Limit:  11,
 				},
 			},
 			mock: func(ts subscriptionRepositoryTestSuite) {
-				query := `SELECT (.+) FROM schools JOIN subscriptions ON schools.id = subscriptions.school_id`
-				columns := []string{"subscriptions.id", "subscriptions.create_date", "subscriptions.update_date", "schools.school_id", "schools.name", "schools.region"}
-				rows := sqlmock.NewRows(columns).AddRow(1, createDate, updateDate, 1, "클래스팅 초등학교", "서울")
-				ts.sqlMock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+				query := `SELECT (.+) FROM schools JOIN subscriptions ON schools.id = subscriptions.school_id WHERE subscriptions.user_id = \? AND subscriptions.delete_date IS NULL ORDER BY subscriptions.id DESC LIMIT \?`
+				columns := []string{"subscriptions.id", "subscriptions.create_date", "subscriptions.update_date", "schools.school_id", "schools.name", "schools.region", "schools.delete_date"}
+				rows := sqlmock.NewRows(columns).AddRow(1, createDate, updateDate, 1, "클래스팅 초등학교", "서울", nil)
+				ts.sqlMock.ExpectQuery(query).WithArgs(1, 11).WillReturnRows(rows)
 			},
 			want: []domain.SubscriptionSchool{
 				{
@@ -130,18 +140,20 @@ func Test_subscriptionRepository_ListSubscriptionSchools(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "PASS - 페이징 구독 조회",
+			name: "PASS - 이전 페이지 구독 조회 (역순 조회)",
 			args: args{
 				ctx: context.Background(),
 				params: domain.ListSubscriptionSchoolsParams{
 					UserID: 1,
+					Cursor: &pagination.Cursor{ID: 3, Direction: pagination.Backward},
+					Limit:  11,
 				},
 			},
 			mock: func(ts subscriptionRepositoryTestSuite) {
-				query := `SELECT (.+) FROM schools JOIN subscriptions ON schools.id = subscriptions.school_id`
-				columns := []string{"subscriptions.id", "subscriptions.create_date", "subscriptions.update_date", "schools.school_id", "schools.name", "schools.region"}
-				rows := sqlmock.NewRows(columns).AddRow(1, createDate, updateDate, 1, "클래스팅 초등학교", "서울")
-				ts.sqlMock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+				query := `SELECT (.+) FROM schools JOIN subscriptions ON schools.id = subscriptions.school_id WHERE subscriptions.user_id = \? AND subscriptions.delete_date IS NULL AND subscriptions.id > \? ORDER BY subscriptions.id ASC LIMIT \?`
+				columns := []string{"subscriptions.id", "subscriptions.create_date", "subscriptions.update_date", "schools.school_id", "schools.name", "schools.region", "schools.delete_date"}
+				rows := sqlmock.NewRows(columns).AddRow(1, createDate, updateDate, 1, "클래스팅 초등학교", "서울", nil)
+				ts.sqlMock.ExpectQuery(query).WithArgs(1, 3, 11).WillReturnRows(rows)
 			},
 			want: []domain.SubscriptionSchool{
 				{
@@ -199,7 +211,35 @@ func Test_subscriptionRepository_DeleteSubscription(t *testing.T) {
 				subscriptionID: 1,
 			},
 			mock: func(ts subscriptionRepositoryTestSuite) {
-				ts.sqlMock.ExpectExec("DELETE FROM subscriptions").WillReturnResult(sqlmock.NewResult(1, 1))
+				columns := []string{"id", "create_date", "update_date", "school_id", "user_id"}
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectQuery("SELECT (.+) FROM subscriptions WHERE id = \\? AND delete_date IS NULL FOR UPDATE").WithArgs(1).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(1, time.Now(), time.Now(), 1, 1))
+				ts.sqlMock.ExpectExec("UPDATE subscriptions SET delete_date = \\? WHERE id = \\?").WithArgs(sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+				ts.sqlMock.ExpectExec("UPDATE schools SET subscriber_count = GREATEST\\(subscriber_count - 1, 0\\) WHERE id = \\?").WithArgs(1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				ts.sqlMock.ExpectExec("DELETE FROM news_reads WHERE user_id = \\? AND school_id = \\?").WithArgs(1, 1).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				ts.sqlMock.ExpectExec("DELETE FROM timelines WHERE user_id = \\? AND school_id = \\?").WithArgs(1, 1).
+					WillReturnResult(sqlmock.NewResult(0, 5))
+				ts.sqlMock.ExpectExec("INSERT INTO outbox").
+					WithArgs(domain.OutboxAggregateTypeSubscription, 1, "subscription.deleted", sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				ts.sqlMock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 이미 취소된 구독",
+			args: args{
+				ctx:            context.Background(),
+				subscriptionID: 1,
+			},
+			mock: func(ts subscriptionRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectQuery("SELECT (.+) FROM subscriptions WHERE id = \\? AND delete_date IS NULL FOR UPDATE").WithArgs(1).
+					WillReturnError(sql.ErrNoRows)
+				ts.sqlMock.ExpectCommit()
 			},
 			wantErr: false,
 		},
@@ -224,3 +264,275 @@ func Test_subscriptionRepository_DeleteSubscription(t *testing.T) {
 		})
 	}
 }
+
+func Test_subscriptionRepository_ListSubscriptionSchoolIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		userID  int
+		mock    func(ts subscriptionRepositoryTestSuite)
+		want    []int
+		wantErr bool
+	}{
+		{
+			name:   "PASS - 구독한 학교 ID 조회",
+			userID: 1,
+			mock: func(ts subscriptionRepositoryTestSuite) {
+				rows := sqlmock.NewRows([]string{"school_id"}).AddRow(1).AddRow(3)
+				ts.sqlMock.ExpectQuery(`SELECT school_id FROM subscriptions WHERE user_id = \?`).WithArgs(1).WillReturnRows(rows)
+			},
+			want:    []int{1, 3},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSubscriptionRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.subscriptionRepository.ListSubscriptionSchoolIDs(context.Background(), tt.userID)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			if err != nil {
+				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			}
+		})
+	}
+}
+
+func Test_subscriptionRepository_MarkNewsRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  domain.MarkNewsReadParams
+		mock    func(ts subscriptionRepositoryTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 소식 읽음 처리",
+			params: domain.MarkNewsReadParams{
+				UserID:   1,
+				SchoolID: 2,
+				NewsID:   3,
+			},
+			mock: func(ts subscriptionRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectExec(`INSERT IGNORE INTO news_reads (.+) FROM subscriptions WHERE user_id = \? AND school_id = \? AND delete_date IS NULL AND last_read_news_id < \?`).
+					WithArgs(3, 1, 2, 3).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				ts.sqlMock.ExpectExec(`INSERT INTO news_read_events (.+) FROM subscriptions WHERE user_id = \? AND school_id = \? AND delete_date IS NULL`).
+					WithArgs(3, 1, 2).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				ts.sqlMock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 서버 에러",
+			params: domain.MarkNewsReadParams{
+				UserID:   1,
+				SchoolID: 2,
+				NewsID:   3,
+			},
+			mock: func(ts subscriptionRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectExec(`INSERT IGNORE INTO news_reads`).WillReturnError(sql.ErrConnDone)
+				ts.sqlMock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSubscriptionRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			err := ts.subscriptionRepository.MarkNewsRead(context.Background(), tt.params)
+
+			// then
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_subscriptionRepository_MarkSchoolNewsReadUntil(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  domain.MarkSchoolNewsReadUntilParams
+		mock    func(ts subscriptionRepositoryTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 학교 소식 모두 읽음 처리",
+			params: domain.MarkSchoolNewsReadUntilParams{
+				UserID:   1,
+				SchoolID: 2,
+				NewsID:   10,
+			},
+			mock: func(ts subscriptionRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectExec(`UPDATE subscriptions SET last_read_news_id = GREATEST\(last_read_news_id, \?\) WHERE user_id = \? AND school_id = \?`).
+					WithArgs(10, 1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				ts.sqlMock.ExpectExec(`DELETE FROM news_reads WHERE user_id = \? AND school_id = \? AND news_id <= \?`).
+					WithArgs(1, 2, 10).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				ts.sqlMock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 서버 에러",
+			params: domain.MarkSchoolNewsReadUntilParams{
+				UserID:   1,
+				SchoolID: 2,
+				NewsID:   10,
+			},
+			mock: func(ts subscriptionRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectExec(`UPDATE subscriptions SET last_read_news_id`).WillReturnError(sql.ErrConnDone)
+				ts.sqlMock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSubscriptionRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			err := ts.subscriptionRepository.MarkSchoolNewsReadUntil(context.Background(), tt.params)
+
+			// then
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_subscriptionRepository_CountUnreadNews(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  domain.CountUnreadNewsParams
+		mock    func(ts subscriptionRepositoryTestSuite)
+		want    map[int]int
+		wantErr bool
+	}{
+		{
+			name: "PASS - 학교별 읽지 않은 소식 수 조회",
+			params: domain.CountUnreadNewsParams{
+				UserID:    1,
+				SchoolIDs: []int{1, 2, 3},
+			},
+			mock: func(ts subscriptionRepositoryTestSuite) {
+				rows := sqlmock.NewRows([]string{"school_id", "count"}).AddRow(1, 4).AddRow(3, 1)
+				ts.sqlMock.ExpectQuery(`SELECT subscriptions.school_id, COUNT\(\*\) FROM subscriptions (.+) WHERE subscriptions.user_id = \? AND subscriptions.school_id IN \(\?, \?, \?\) (.+) GROUP BY subscriptions.school_id`).
+					WithArgs(1, 1, 2, 3).
+					WillReturnRows(rows)
+			},
+			want:    map[int]int{1: 4, 3: 1},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 구독한 학교가 없는 경우",
+			params: domain.CountUnreadNewsParams{
+				UserID: 1,
+			},
+			mock:    func(ts subscriptionRepositoryTestSuite) {},
+			want:    map[int]int{},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSubscriptionRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.subscriptionRepository.CountUnreadNews(context.Background(), tt.params)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			if err != nil {
+				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			}
+		})
+	}
+}
+
+func Test_subscriptionRepository_ListReadNewsIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  domain.ListReadNewsIDsParams
+		mock    func(ts subscriptionRepositoryTestSuite)
+		want    map[int]bool
+		wantErr bool
+	}{
+		{
+			name: "PASS - 읽은 소식 ID 조회",
+			params: domain.ListReadNewsIDsParams{
+				UserID:  1,
+				NewsIDs: []int{5, 3, 1},
+			},
+			mock: func(ts subscriptionRepositoryTestSuite) {
+				rows := sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(1)
+				ts.sqlMock.ExpectQuery(`SELECT news.id FROM news (.+) WHERE news.id IN \(\?, \?, \?\)`).
+					WithArgs(1, 5, 3, 1).
+					WillReturnRows(rows)
+			},
+			want:    map[int]bool{3: true, 1: true},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 조회할 소식이 없는 경우",
+			params: domain.ListReadNewsIDsParams{
+				UserID:  1,
+				NewsIDs: []int{},
+			},
+			mock:    func(ts subscriptionRepositoryTestSuite) {},
+			want:    map[int]bool{},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSubscriptionRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.subscriptionRepository.ListReadNewsIDs(context.Background(), tt.params)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			if err != nil {
+				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			}
+		})
+	}
+}
@@ -3,25 +3,45 @@ package subscription
 import (
 	"classting/domain"
 	"classting/pkg/cerrors"
+	"classting/pkg/pagination"
 	"context"
 	"k8s.io/utils/pointer"
+	"log"
+	"time"
 )
 
 type subscriptionService struct {
 	newsRepository         domain.NewsRepository
 	schoolRepository       domain.SchoolRepository
 	subscriptionRepository domain.SubscriptionRepository
+	timelineRepository     domain.TimelineRepository
+	timelineService        domain.TimelineService
+	attachmentService      domain.AttachmentService
+	reactionService        domain.ReactionService
+	paginator              *pagination.Paginator
+	now                    func() time.Time
 }
 
 func NewSubscriptionService(
 	newsRepository domain.NewsRepository,
 	schoolRepository domain.SchoolRepository,
 	subscriptionRepository domain.SubscriptionRepository,
+	timelineRepository domain.TimelineRepository,
+	timelineService domain.TimelineService,
+	attachmentService domain.AttachmentService,
+	reactionService domain.ReactionService,
+	paginator *pagination.Paginator,
 ) *subscriptionService {
 	return &subscriptionService{
 		newsRepository:         newsRepository,
 		schoolRepository:       schoolRepository,
 		subscriptionRepository: subscriptionRepository,
+		timelineRepository:     timelineRepository,
+		timelineService:        timelineService,
+		attachmentService:      attachmentService,
+		reactionService:        reactionService,
+		paginator:              paginator,
+		now:                    time.Now,
 	}
 }
 
@@ -37,6 +57,9 @@ func (s subscriptionService) CreateSubscription(ctx context.Context, req domain.
 	if school == nil {
 		return cerrors.E(op, cerrors.Invalid, "해당 학교가 존재하지 않습니다.")
 	}
+	if school.Deleted() {
+		return cerrors.E(op, cerrors.Invalid, "삭제된 학교는 구독할 수 없습니다.")
+	}
 
 	subscription, err := s.subscriptionRepository.FindSubscriptionByUserIDAndSchoolID(ctx, domain.FindSubscriptionByUserIDAndSchoolIDParams{
 		UserID:   req.UserID,
@@ -49,41 +72,69 @@ func (s subscriptionService) CreateSubscription(ctx context.Context, req domain.
 		return cerrors.E(op, cerrors.Invalid, "이미 구독한 학교입니다.")
 	}
 
-	_, err = s.subscriptionRepository.CreateSubscription(ctx, domain.Subscription{
+	subscription = &domain.Subscription{
 		SchoolID: req.SchoolID,
 		UserID:   req.UserID,
-	})
+	}
+	subscription.ID, err = s.subscriptionRepository.CreateSubscription(ctx, *subscription)
 	if err != nil {
 		return err
 	}
 
+	if err := s.timelineService.BackfillSubscription(ctx, *subscription); err != nil {
+		log.Printf("subscription: backfill subscription %d: %v", subscription.ID, err)
+	}
+
 	return nil
 }
 
 func (s subscriptionService) ListSubscriptionSchools(ctx context.Context, req domain.ListSubscriptionSchoolsRequest) (domain.ListSubscriptionSchoolsResponse, error) {
 	const op cerrors.Op = "subscription/service/ListSubscriptionSchools"
 
-	subscriptionSchools, err := s.subscriptionRepository.ListSubscriptionSchools(ctx, domain.ListSubscriptionSchoolsParams{
+	// 다른 유저의 커서로 조회하지 못하도록 유저 아이디를 조회 조건 지문에 넣는다.
+	filter := pagination.Fingerprint("subscriptions", req.UserID)
+	pageReq, err := s.paginator.Request(req.Cursor, req.Size, filter)
+	if err != nil {
+		return domain.ListSubscriptionSchoolsResponse{}, cerrors.E(op, cerrors.Invalid, err, "커서를 확인해주세요.")
+	}
+
+	listed, err := s.subscriptionRepository.ListSubscriptionSchools(ctx, domain.ListSubscriptionSchoolsParams{
 		UserID: req.UserID,
-		Cursor: req.Cursor,
+		Cursor: pageReq.Cursor,
+		Limit:  pageReq.Limit(),
 	})
 	if err != nil {
 		return domain.ListSubscriptionSchoolsResponse{}, cerrors.E(op, cerrors.Internal, err, "구독한 학교를 조회하는 중에 에러가 발생했습니다.")
 	}
 
-	var subscriptionSchoolsDTOS []domain.SubscriptionSchoolDTO
+	page := pagination.NewPage(listed, pageReq, func(n domain.SubscriptionSchool) pagination.Cursor {
+		return pagination.Cursor{ID: n.ID, Filter: filter}
+	})
+	subscriptionSchools := page.Items
+
+	schoolIDs := make([]int, 0, len(subscriptionSchools))
 	for _, n := range subscriptionSchools {
-		subscriptionSchoolsDTOS = append(subscriptionSchoolsDTOS, domain.SubscriptionSchoolDTOFrom(n))
+		schoolIDs = append(schoolIDs, n.SchoolID)
+	}
+	unreadCounts, err := s.subscriptionRepository.CountUnreadNews(ctx, domain.CountUnreadNewsParams{
+		UserID:    req.UserID,
+		SchoolIDs: schoolIDs,
+	})
+	if err != nil {
+		return domain.ListSubscriptionSchoolsResponse{}, cerrors.E(op, cerrors.Internal, err, "구독한 학교를 조회하는 중에 에러가 발생했습니다.")
 	}
 
-	var cursor *int
-	if len(subscriptionSchoolsDTOS) > 0 {
-		cursor = &subscriptionSchoolsDTOS[len(subscriptionSchoolsDTOS)-1].ID
+	var subscriptionSchoolsDTOS []domain.SubscriptionSchoolDTO
+	for _, n := range subscriptionSchools {
+		subscriptionSchoolDTO := domain.SubscriptionSchoolDTOFrom(n)
+		subscriptionSchoolDTO.UnreadCount = unreadCounts[n.SchoolID]
+		subscriptionSchoolsDTOS = append(subscriptionSchoolsDTOS, subscriptionSchoolDTO)
 	}
 
 	return domain.ListSubscriptionSchoolsResponse{
 		SubscriptionSchools: subscriptionSchoolsDTOS,
-		Cursor:              cursor,
+		Cursor:              s.paginator.Encode(page.Next),
+		PrevCursor:          s.paginator.Encode(page.Prev),
 	}, nil
 }
 
@@ -101,27 +152,145 @@ func (s subscriptionService) ListSubscriptionSchoolNews(ctx context.Context, req
 		return domain.ListSubscriptionSchoolNewsResponse{}, cerrors.E(op, cerrors.Invalid, "구독한 학교가 아닙니다.")
 	}
 
-	news, err := s.newsRepository.ListNews(ctx, domain.ListNewsParams{
-		SchoolID: pointer.Int(req.SchoolID),
-		Cursor:   req.Cursor,
+	// 다른 유저나 다른 학교의 커서로 조회하지 못하도록 유저 아이디와 학교 아이디를 조회 조건 지문에 넣는다.
+	filter := pagination.Fingerprint("subscription_news", req.UserID, req.SchoolID)
+	pageReq, err := s.paginator.Request(req.Cursor, req.Size, filter)
+	if err != nil {
+		return domain.ListSubscriptionSchoolNewsResponse{}, cerrors.E(op, cerrors.Invalid, err, "커서를 확인해주세요.")
+	}
+
+	// 고정된 소식은 첫 페이지 상단에만 보여주고 커서로 이어지는 목록에서는 제외한다.
+	pinned, err := s.newsRepository.ListPinnedNews(ctx, domain.ListPinnedNewsParams{
+		SchoolID: req.SchoolID,
+		Now:      s.now().UTC(),
 	})
 	if err != nil {
 		return domain.ListSubscriptionSchoolNewsResponse{}, cerrors.E(op, cerrors.Internal, err, "소식을 조회하는 중에 에러가 발생했습니다.")
 	}
 
-	var newsDTOS []domain.SubscriptionSchoolNewsDTO
-	for _, n := range news {
-		newsDTOS = append(newsDTOS, domain.SubscriptionSchoolNewsDTOFrom(n))
+	rows, err := s.newsRepository.ListNews(ctx, domain.ListNewsParams{
+		SchoolID:   pointer.Int(req.SchoolID),
+		Cursor:     pageReq.Cursor,
+		Limit:      pageReq.Limit(),
+		ExcludeIDs: domain.NewsIDs(pinned),
+	})
+	if err != nil {
+		return domain.ListSubscriptionSchoolNewsResponse{}, cerrors.E(op, cerrors.Internal, err, "소식을 조회하는 중에 에러가 발생했습니다.")
 	}
 
-	var cursor *int
-	if len(newsDTOS) > 0 {
-		cursor = &newsDTOS[len(newsDTOS)-1].ID
+	page := pagination.NewPage(rows, pageReq, func(n domain.News) pagination.Cursor {
+		return pagination.Cursor{ID: n.ID, Filter: filter}
+	})
+	listed := page.Items
+
+	// 이전 페이지가 없으면 첫 페이지다. 이전 페이지 커서로 첫 페이지에 돌아온 경우에도 고정된 소식을 보여준다.
+	var news []domain.News
+	if page.Prev == nil {
+		news = append(news, pinned...)
+	}
+	news = append(news, listed...)
+
+	attachments, err := s.attachmentService.ListNewsAttachments(ctx, domain.NewsIDs(news))
+	if err != nil {
+		return domain.ListSubscriptionSchoolNewsResponse{}, err
+	}
+
+	read, err := s.subscriptionRepository.ListReadNewsIDs(ctx, domain.ListReadNewsIDsParams{
+		UserID:  req.UserID,
+		NewsIDs: domain.NewsIDs(news),
+	})
+	if err != nil {
+		return domain.ListSubscriptionSchoolNewsResponse{}, cerrors.E(op, cerrors.Internal, err, "소식을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	reactions, err := s.reactionService.ListNewsReactions(ctx, domain.ListReactionCountsParams{
+		UserID:  req.UserID,
+		NewsIDs: domain.NewsIDs(news),
+	})
+	if err != nil {
+		return domain.ListSubscriptionSchoolNewsResponse{}, err
+	}
+
+	var newsDTOS []domain.SubscriptionSchoolNewsDTO
+	for i, n := range news {
+		newsDTO := domain.SubscriptionSchoolNewsDTOFrom(n)
+		newsDTO.Pinned = i < len(news)-len(listed)
+		newsDTO.Attachments = attachments[n.ID]
+		newsDTO.Read = read[n.ID]
+		newsDTO.Reactions = reactions[n.ID]
+		if req.Format == domain.NewsResponseFormatHTML {
+			newsDTO = newsDTO.RenderHTML()
+		}
+		newsDTOS = append(newsDTOS, newsDTO)
 	}
 
 	return domain.ListSubscriptionSchoolNewsResponse{
 		SubscriptionSchoolNews: newsDTOS,
-		Cursor:                 cursor,
+		Cursor:                 s.paginator.Encode(page.Next),
+		PrevCursor:             s.paginator.Encode(page.Prev),
+	}, nil
+}
+
+func (s subscriptionService) ListSubscriptionFeed(ctx context.Context, req domain.ListSubscriptionFeedRequest) (domain.ListSubscriptionFeedResponse, error) {
+	const op cerrors.Op = "subscription/service/ListSubscriptionFeed"
+
+	filter := pagination.Fingerprint("feed", req.UserID)
+	pageReq, err := s.paginator.Request(req.Cursor, req.Size, filter)
+	if err != nil {
+		return domain.ListSubscriptionFeedResponse{}, cerrors.E(op, cerrors.Invalid, err, "커서를 확인해주세요.")
+	}
+
+	rows, err := s.timelineRepository.ListTimelineNews(ctx, domain.ListTimelineNewsParams{
+		UserID: req.UserID,
+		Cursor: pageReq.Cursor,
+		Limit:  pageReq.Limit(),
+	})
+	if err != nil {
+		return domain.ListSubscriptionFeedResponse{}, cerrors.E(op, cerrors.Internal, err, "소식을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	page := pagination.NewPage(rows, pageReq, func(n domain.News) pagination.Cursor {
+		return pagination.Cursor{ID: n.ID, Filter: filter}
+	})
+	news := page.Items
+
+	attachments, err := s.attachmentService.ListNewsAttachments(ctx, domain.NewsIDs(news))
+	if err != nil {
+		return domain.ListSubscriptionFeedResponse{}, err
+	}
+
+	read, err := s.subscriptionRepository.ListReadNewsIDs(ctx, domain.ListReadNewsIDsParams{
+		UserID:  req.UserID,
+		NewsIDs: domain.NewsIDs(news),
+	})
+	if err != nil {
+		return domain.ListSubscriptionFeedResponse{}, cerrors.E(op, cerrors.Internal, err, "소식을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	reactions, err := s.reactionService.ListNewsReactions(ctx, domain.ListReactionCountsParams{
+		UserID:  req.UserID,
+		NewsIDs: domain.NewsIDs(news),
+	})
+	if err != nil {
+		return domain.ListSubscriptionFeedResponse{}, err
+	}
+
+	var newsDTOS []domain.SubscriptionSchoolNewsDTO
+	for _, n := range news {
+		newsDTO := domain.SubscriptionSchoolNewsDTOFrom(n)
+		newsDTO.Attachments = attachments[n.ID]
+		newsDTO.Read = read[n.ID]
+		newsDTO.Reactions = reactions[n.ID]
+		if req.Format == domain.NewsResponseFormatHTML {
+			newsDTO = newsDTO.RenderHTML()
+		}
+		newsDTOS = append(newsDTOS, newsDTO)
+	}
+
+	return domain.ListSubscriptionFeedResponse{
+		News:       newsDTOS,
+		Cursor:     s.paginator.Encode(page.Next),
+		PrevCursor: s.paginator.Encode(page.Prev),
 	}, nil
 }
 
@@ -148,3 +317,53 @@ func (s subscriptionService) DeleteSubscription(ctx context.Context, req domain.
 
 	return nil
 }
+
+func (s subscriptionService) MarkNewsRead(ctx context.Context, req domain.MarkNewsReadRequest) error {
+	const op cerrors.Op = "subscription/service/MarkNewsRead"
+
+	news, err := s.newsRepository.FindNewsByID(ctx, req.NewsID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	if news == nil || news.DeleteDate.Valid || news.Status != domain.NewsStatusPublished {
+		return cerrors.E(op, cerrors.NotExist, "소식을 찾을 수 없습니다.")
+	}
+
+	subscription, err := s.subscriptionRepository.FindSubscriptionByUserIDAndSchoolID(ctx, domain.FindSubscriptionByUserIDAndSchoolIDParams{
+		UserID:   req.UserID,
+		SchoolID: news.SchoolID,
+	})
+	if err != nil {
+		return err
+	}
+	if subscription == nil {
+		return cerrors.E(op, cerrors.Invalid, "구독한 학교의 소식이 아닙니다.")
+	}
+
+	return s.subscriptionRepository.MarkNewsRead(ctx, domain.MarkNewsReadParams{
+		UserID:   req.UserID,
+		SchoolID: news.SchoolID,
+		NewsID:   news.ID,
+	})
+}
+
+func (s subscriptionService) MarkSchoolNewsRead(ctx context.Context, req domain.MarkSchoolNewsReadRequest) error {
+	const op cerrors.Op = "subscription/service/MarkSchoolNewsRead"
+
+	subscription, err := s.subscriptionRepository.FindSubscriptionByUserIDAndSchoolID(ctx, domain.FindSubscriptionByUserIDAndSchoolIDParams{
+		UserID:   req.UserID,
+		SchoolID: req.SchoolID,
+	})
+	if err != nil {
+		return err
+	}
+	if subscription == nil {
+		return cerrors.E(op, cerrors.Invalid, "구독한 학교가 아닙니다.")
+	}
+
+	return s.subscriptionRepository.MarkSchoolNewsReadUntil(ctx, domain.MarkSchoolNewsReadUntilParams{
+		UserID:   req.UserID,
+		SchoolID: req.SchoolID,
+		NewsID:   req.Cursor,
+	})
+}
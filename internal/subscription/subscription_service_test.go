@@ -3,27 +3,53 @@ package subscription
 import (
 	"classting/domain"
 	"classting/mocks"
+	"classting/pkg/pagination"
 	"context"
+	"database/sql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"k8s.io/utils/pointer"
 	"testing"
+	"time"
 )
 
 type subscriptionServiceTestSuite struct {
 	schoolRepository       *mocks.SchoolRepository
 	newsRepository         *mocks.NewsRepository
 	subscriptionRepository *mocks.SubscriptionRepository
+	timelineRepository     *mocks.TimelineRepository
+	timelineService        *mocks.TimelineService
+	attachmentService      *mocks.AttachmentService
+	reactionService        *mocks.ReactionService
 	service                domain.SubscriptionService
 }
 
+var testNow = time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+var testPaginator = pagination.New("classting_test_secret", 2, 5)
+
 func setupSubscriptionServiceTestSuite(t *testing.T) subscriptionServiceTestSuite {
 	var us subscriptionServiceTestSuite
 
 	us.schoolRepository = mocks.NewSchoolRepository(t)
 	us.newsRepository = mocks.NewNewsRepository(t)
 	us.subscriptionRepository = mocks.NewSubscriptionRepository(t)
-	us.service = NewSubscriptionService(us.newsRepository, us.schoolRepository, us.subscriptionRepository)
+	us.timelineRepository = mocks.NewTimelineRepository(t)
+	us.timelineService = mocks.NewTimelineService(t)
+	us.attachmentService = mocks.NewAttachmentService(t)
+	us.reactionService = mocks.NewReactionService(t)
+	service := NewSubscriptionService(
+		us.newsRepository,
+		us.schoolRepository,
+		us.subscriptionRepository,
+		us.timelineRepository,
+		us.timelineService,
+		us.attachmentService,
+		us.reactionService,
+		testPaginator,
+	)
+	service.now = func() time.Time { return testNow }
+	us.service = service
 
 	return us
 }
@@ -66,9 +92,38 @@ func Test_subscriptionService_CreateSubscription(t *testing.T) {
 					UserID:   1,
 					SchoolID: 1,
 				}).Return(1, nil).Once()
+				ts.timelineService.EXPECT().BackfillSubscription(mock.Anything, domain.Subscription{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID:   1,
+					SchoolID: 1,
+				}).Return(nil).Once()
 			},
 			wantErr: false,
 		},
+		{
+			name: "FAIL - 삭제된 학교 구독",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateSubscriptionRequest{
+					UserID:   1,
+					SchoolID: 1,
+				},
+			},
+			mock: func(ts subscriptionServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID:         1,
+						DeleteDate: sql.NullTime{Time: time.Now(), Valid: true},
+					},
+					UserID: 1,
+					Name:   "클래스팅",
+					Region: "서울",
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
 		{
 			name: "FAIL - 이미 구독한 학교",
 			args: args{
@@ -109,6 +164,7 @@ func Test_subscriptionService_CreateSubscription(t *testing.T) {
 			ts.schoolRepository.AssertExpectations(t)
 			ts.newsRepository.AssertExpectations(t)
 			ts.subscriptionRepository.AssertExpectations(t)
+			ts.timelineService.AssertExpectations(t)
 			if err != nil {
 				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
 			}
@@ -122,6 +178,8 @@ func Test_subscriptionService_ListSubscriptionSchools(t *testing.T) {
 		req domain.ListSubscriptionSchoolsRequest
 	}
 
+	filter := pagination.Fingerprint("subscriptions", 1)
+
 	tests := []struct {
 		name    string
 		args    args
@@ -135,13 +193,12 @@ func Test_subscriptionService_ListSubscriptionSchools(t *testing.T) {
 				ctx: context.Background(),
 				req: domain.ListSubscriptionSchoolsRequest{
 					UserID: 1,
-					Cursor: nil,
 				},
 			},
 			mock: func(ts subscriptionServiceTestSuite) {
 				ts.subscriptionRepository.EXPECT().ListSubscriptionSchools(mock.Anything, domain.ListSubscriptionSchoolsParams{
 					UserID: 1,
-					Cursor: nil,
+					Limit:  3,
 				}).Return([]domain.SubscriptionSchool{
 					{
 						Base: domain.Base{
@@ -152,6 +209,10 @@ func Test_subscriptionService_ListSubscriptionSchools(t *testing.T) {
 						Region:   "서울",
 					},
 				}, nil).Once()
+				ts.subscriptionRepository.EXPECT().CountUnreadNews(mock.Anything, domain.CountUnreadNewsParams{
+					UserID:    1,
+					SchoolIDs: []int{1},
+				}).Return(map[int]int{1: 3}, nil).Once()
 			},
 			want: domain.ListSubscriptionSchoolsResponse{
 				SubscriptionSchools: []domain.SubscriptionSchoolDTO{
@@ -159,12 +220,12 @@ func Test_subscriptionService_ListSubscriptionSchools(t *testing.T) {
 						BaseDTO: domain.BaseDTO{
 							ID: 1,
 						},
-						SchoolID: 1,
-						Name:     "클래스팅",
-						Region:   "서울",
+						SchoolID:    1,
+						Name:        "클래스팅",
+						Region:      "서울",
+						UnreadCount: 3,
 					},
 				},
-				Cursor: pointer.Int(1),
 			},
 			wantErr: false,
 		},
@@ -174,39 +235,68 @@ func Test_subscriptionService_ListSubscriptionSchools(t *testing.T) {
 				ctx: context.Background(),
 				req: domain.ListSubscriptionSchoolsRequest{
 					UserID: 1,
-					Cursor: pointer.Int(1),
+					Cursor: *testPaginator.Encode(&pagination.Cursor{ID: 5, Direction: pagination.Forward, Filter: filter}),
+					Size:   1,
 				},
 			},
 			mock: func(ts subscriptionServiceTestSuite) {
 				ts.subscriptionRepository.EXPECT().ListSubscriptionSchools(mock.Anything, domain.ListSubscriptionSchoolsParams{
 					UserID: 1,
-					Cursor: pointer.Int(1),
+					Cursor: &pagination.Cursor{ID: 5, Direction: pagination.Forward, Filter: filter},
+					Limit:  2,
 				}).Return([]domain.SubscriptionSchool{
 					{
 						Base: domain.Base{
-							ID: 2,
+							ID: 4,
 						},
 						SchoolID: 1,
 						Name:     "클래스팅",
 						Region:   "서울",
 					},
+					{
+						Base: domain.Base{
+							ID: 2,
+						},
+						SchoolID: 2,
+						Name:     "해운대초등학교",
+						Region:   "부산",
+					},
 				}, nil).Once()
+				ts.subscriptionRepository.EXPECT().CountUnreadNews(mock.Anything, domain.CountUnreadNewsParams{
+					UserID:    1,
+					SchoolIDs: []int{1},
+				}).Return(map[int]int{1: 3}, nil).Once()
 			},
 			want: domain.ListSubscriptionSchoolsResponse{
 				SubscriptionSchools: []domain.SubscriptionSchoolDTO{
 					{
 						BaseDTO: domain.BaseDTO{
-							ID: 2,
+							ID: 4,
 						},
-						SchoolID: 1,
-						Name:     "클래스팅",
-						Region:   "서울",
+						SchoolID:    1,
+						Name:        "클래스팅",
+						Region:      "서울",
+						UnreadCount: 3,
 					},
 				},
-				Cursor: pointer.Int(2),
+				Cursor:     testPaginator.Encode(&pagination.Cursor{ID: 4, Direction: pagination.Forward, Filter: filter}),
+				PrevCursor: testPaginator.Encode(&pagination.Cursor{ID: 4, Direction: pagination.Backward, Filter: filter}),
 			},
 			wantErr: false,
 		},
+		{
+			name: "FAIL - 다른 유저의 커서",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListSubscriptionSchoolsRequest{
+					UserID: 1,
+					Cursor: *testPaginator.Encode(&pagination.Cursor{ID: 5, Direction: pagination.Forward, Filter: pagination.Fingerprint("subscriptions", 2)}),
+				},
+			},
+			mock:    func(ts subscriptionServiceTestSuite) {},
+			want:    domain.ListSubscriptionSchoolsResponse{},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -219,12 +309,9 @@ func Test_subscriptionService_ListSubscriptionSchools(t *testing.T) {
 			got, err := ts.service.ListSubscriptionSchools(tt.args.ctx, tt.args.req)
 
 			// then
-			ts.newsRepository.AssertExpectations(t)
-			ts.schoolRepository.AssertExpectations(t)
+			ts.subscriptionRepository.AssertExpectations(t)
 			assert.Equal(t, tt.want, got)
-			if err != nil {
-				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
-			}
+			assert.Equal(t, tt.wantErr, err != nil)
 		})
 	}
 }
@@ -296,6 +383,7 @@ func Test_subscriptionService_DeleteSubscription(t *testing.T) {
 			// then
 			ts.newsRepository.AssertExpectations(t)
 			ts.schoolRepository.AssertExpectations(t)
+			ts.timelineRepository.AssertExpectations(t)
 			if err != nil {
 				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
 			}
@@ -309,6 +397,8 @@ func Test_subscriptionService_ListSubscriptionSchoolNews(t *testing.T) {
 		req domain.ListSubscriptionSchoolNewsRequest
 	}
 
+	filter := pagination.Fingerprint("subscription_news", 1, 1)
+
 	tests := []struct {
 		name    string
 		args    args
@@ -323,7 +413,6 @@ func Test_subscriptionService_ListSubscriptionSchoolNews(t *testing.T) {
 				req: domain.ListSubscriptionSchoolNewsRequest{
 					UserID:   1,
 					SchoolID: 1,
-					Cursor:   nil,
 				},
 			},
 			mock: func(ts subscriptionServiceTestSuite) {
@@ -337,9 +426,23 @@ func Test_subscriptionService_ListSubscriptionSchoolNews(t *testing.T) {
 					UserID:   1,
 					SchoolID: 1,
 				}, nil).Once()
+				ts.newsRepository.EXPECT().ListPinnedNews(mock.Anything, domain.ListPinnedNewsParams{
+					SchoolID: 1,
+					Now:      testNow,
+				}).Return(nil, nil).Once()
 				ts.newsRepository.EXPECT().ListNews(mock.Anything, domain.ListNewsParams{
-					SchoolID: pointer.Int(1),
+					SchoolID:   pointer.Int(1),
+					Limit:      3,
+					ExcludeIDs: []int{},
 				}).Return([]domain.News{
+					{
+						Base: domain.Base{
+							ID: 3,
+						},
+						SchoolID: 1,
+						UserID:   2,
+						Title:    "새 뉴스",
+					},
 					{
 						Base: domain.Base{
 							ID: 1,
@@ -348,30 +451,60 @@ func Test_subscriptionService_ListSubscriptionSchoolNews(t *testing.T) {
 						UserID:   2,
 						Title:    "구독한 뉴스",
 					},
+					{
+						Base: domain.Base{
+							ID: 0,
+						},
+						SchoolID: 1,
+						UserID:   2,
+						Title:    "다음 페이지 뉴스",
+					},
+				}, nil).Once()
+				ts.attachmentService.EXPECT().ListNewsAttachments(mock.Anything, []int{3, 1}).Return(nil, nil).Once()
+				ts.subscriptionRepository.EXPECT().ListReadNewsIDs(mock.Anything, domain.ListReadNewsIDsParams{
+					UserID:  1,
+					NewsIDs: []int{3, 1},
+				}).Return(map[int]bool{1: true}, nil).Once()
+				ts.reactionService.EXPECT().ListNewsReactions(mock.Anything, domain.ListReactionCountsParams{
+					UserID:  1,
+					NewsIDs: []int{3, 1},
+				}).Return(map[int][]domain.ReactionDTO{
+					1: {{Emoji: domain.ReactionEmojiThumbsUp, Count: 3, ReactedByMe: true}},
 				}, nil).Once()
 			},
 			want: domain.ListSubscriptionSchoolNewsResponse{
 				SubscriptionSchoolNews: []domain.SubscriptionSchoolNewsDTO{
+					{
+						BaseDTO: domain.BaseDTO{
+							ID: 3,
+						},
+						SchoolID: 1,
+						Title:    "새 뉴스",
+					},
 					{
 						BaseDTO: domain.BaseDTO{
 							ID: 1,
 						},
 						SchoolID: 1,
 						Title:    "구독한 뉴스",
+						Read:     true,
+						Reactions: []domain.ReactionDTO{
+							{Emoji: domain.ReactionEmojiThumbsUp, Count: 3, ReactedByMe: true},
+						},
 					},
 				},
-				Cursor: pointer.Int(1),
+				Cursor: testPaginator.Encode(&pagination.Cursor{ID: 1, Direction: pagination.Forward, Filter: filter}),
 			},
 			wantErr: false,
 		},
 		{
-			name: "PASS - 페이징 구독한 학교의 소식 조회",
+			name: "PASS - 다음 페이지에는 고정된 소식을 포함하지 않음",
 			args: args{
 				ctx: context.Background(),
 				req: domain.ListSubscriptionSchoolNewsRequest{
 					UserID:   1,
 					SchoolID: 1,
-					Cursor:   pointer.Int(1),
+					Cursor:   *testPaginator.Encode(&pagination.Cursor{ID: 3, Direction: pagination.Forward, Filter: filter}),
 				},
 			},
 			mock: func(ts subscriptionServiceTestSuite) {
@@ -385,9 +518,15 @@ func Test_subscriptionService_ListSubscriptionSchoolNews(t *testing.T) {
 					UserID:   1,
 					SchoolID: 1,
 				}, nil).Once()
+				ts.newsRepository.EXPECT().ListPinnedNews(mock.Anything, domain.ListPinnedNewsParams{
+					SchoolID: 1,
+					Now:      testNow,
+				}).Return(nil, nil).Once()
 				ts.newsRepository.EXPECT().ListNews(mock.Anything, domain.ListNewsParams{
-					SchoolID: pointer.Int(1),
-					Cursor:   pointer.Int(1),
+					SchoolID:   pointer.Int(1),
+					Cursor:     &pagination.Cursor{ID: 3, Direction: pagination.Forward, Filter: filter},
+					Limit:      3,
+					ExcludeIDs: []int{},
 				}).Return([]domain.News{
 					{
 						Base: domain.Base{
@@ -398,6 +537,15 @@ func Test_subscriptionService_ListSubscriptionSchoolNews(t *testing.T) {
 						Title:    "구독한 뉴스",
 					},
 				}, nil).Once()
+				ts.attachmentService.EXPECT().ListNewsAttachments(mock.Anything, []int{2}).Return(nil, nil).Once()
+				ts.subscriptionRepository.EXPECT().ListReadNewsIDs(mock.Anything, domain.ListReadNewsIDsParams{
+					UserID:  1,
+					NewsIDs: []int{2},
+				}).Return(map[int]bool{}, nil).Once()
+				ts.reactionService.EXPECT().ListNewsReactions(mock.Anything, domain.ListReactionCountsParams{
+					UserID:  1,
+					NewsIDs: []int{2},
+				}).Return(nil, nil).Once()
 			},
 			want: domain.ListSubscriptionSchoolNewsResponse{
 				SubscriptionSchoolNews: []domain.SubscriptionSchoolNewsDTO{
@@ -409,7 +557,87 @@ func Test_subscriptionService_ListSubscriptionSchoolNews(t *testing.T) {
 						Title:    "구독한 뉴스",
 					},
 				},
-				Cursor: pointer.Int(2),
+				PrevCursor: testPaginator.Encode(&pagination.Cursor{ID: 2, Direction: pagination.Backward, Filter: filter}),
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 첫 페이지 상단에 고정된 소식 조회",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListSubscriptionSchoolNewsRequest{
+					UserID:   1,
+					SchoolID: 1,
+				},
+			},
+			mock: func(ts subscriptionServiceTestSuite) {
+				ts.subscriptionRepository.EXPECT().FindSubscriptionByUserIDAndSchoolID(mock.Anything, domain.FindSubscriptionByUserIDAndSchoolIDParams{
+					UserID:   1,
+					SchoolID: 1,
+				}).Return(&domain.Subscription{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID:   1,
+					SchoolID: 1,
+				}, nil).Once()
+				ts.newsRepository.EXPECT().ListPinnedNews(mock.Anything, domain.ListPinnedNewsParams{
+					SchoolID: 1,
+					Now:      testNow,
+				}).Return([]domain.News{
+					{
+						Base: domain.Base{
+							ID: 1,
+						},
+						SchoolID: 1,
+						Title:    "휴교 안내",
+						Priority: domain.NewsPriorityUrgent,
+					},
+				}, nil).Once()
+				ts.newsRepository.EXPECT().ListNews(mock.Anything, domain.ListNewsParams{
+					SchoolID:   pointer.Int(1),
+					Limit:      3,
+					ExcludeIDs: []int{1},
+				}).Return([]domain.News{
+					{
+						Base: domain.Base{
+							ID: 3,
+						},
+						SchoolID: 1,
+						Title:    "구독한 뉴스",
+						Priority: domain.NewsPriorityNormal,
+					},
+				}, nil).Once()
+				ts.attachmentService.EXPECT().ListNewsAttachments(mock.Anything, []int{1, 3}).Return(nil, nil).Once()
+				ts.subscriptionRepository.EXPECT().ListReadNewsIDs(mock.Anything, domain.ListReadNewsIDsParams{
+					UserID:  1,
+					NewsIDs: []int{1, 3},
+				}).Return(map[int]bool{}, nil).Once()
+				ts.reactionService.EXPECT().ListNewsReactions(mock.Anything, domain.ListReactionCountsParams{
+					UserID:  1,
+					NewsIDs: []int{1, 3},
+				}).Return(nil, nil).Once()
+			},
+			want: domain.ListSubscriptionSchoolNewsResponse{
+				SubscriptionSchoolNews: []domain.SubscriptionSchoolNewsDTO{
+					{
+						BaseDTO: domain.BaseDTO{
+							ID: 1,
+						},
+						SchoolID: 1,
+						Title:    "휴교 안내",
+						Priority: domain.NewsPriorityUrgent,
+						Pinned:   true,
+					},
+					{
+						BaseDTO: domain.BaseDTO{
+							ID: 3,
+						},
+						SchoolID: 1,
+						Title:    "구독한 뉴스",
+						Priority: domain.NewsPriorityNormal,
+					},
+				},
 			},
 			wantErr: false,
 		},
@@ -420,7 +648,6 @@ func Test_subscriptionService_ListSubscriptionSchoolNews(t *testing.T) {
 				req: domain.ListSubscriptionSchoolNewsRequest{
 					UserID:   1,
 					SchoolID: 1,
-					Cursor:   pointer.Int(1),
 				},
 			},
 			mock: func(ts subscriptionServiceTestSuite) {
@@ -432,6 +659,31 @@ func Test_subscriptionService_ListSubscriptionSchoolNews(t *testing.T) {
 			want:    domain.ListSubscriptionSchoolNewsResponse{},
 			wantErr: true,
 		},
+		{
+			name: "FAIL - 다른 학교 소식 목록의 커서",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListSubscriptionSchoolNewsRequest{
+					UserID:   1,
+					SchoolID: 1,
+					Cursor:   *testPaginator.Encode(&pagination.Cursor{ID: 3, Direction: pagination.Forward, Filter: pagination.Fingerprint("subscription_news", 1, 2)}),
+				},
+			},
+			mock: func(ts subscriptionServiceTestSuite) {
+				ts.subscriptionRepository.EXPECT().FindSubscriptionByUserIDAndSchoolID(mock.Anything, domain.FindSubscriptionByUserIDAndSchoolIDParams{
+					UserID:   1,
+					SchoolID: 1,
+				}).Return(&domain.Subscription{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID:   1,
+					SchoolID: 1,
+				}, nil).Once()
+			},
+			want:    domain.ListSubscriptionSchoolNewsResponse{},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -453,3 +705,355 @@ func Test_subscriptionService_ListSubscriptionSchoolNews(t *testing.T) {
 		})
 	}
 }
+
+func Test_subscriptionService_ListSubscriptionFeed(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.ListSubscriptionFeedRequest
+	}
+
+	filter := pagination.Fingerprint("feed", 1)
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts subscriptionServiceTestSuite)
+		want    domain.ListSubscriptionFeedResponse
+		wantErr bool
+	}{
+		{
+			name: "PASS - 구독한 학교들의 소식 피드 조회",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListSubscriptionFeedRequest{
+					UserID: 1,
+				},
+			},
+			mock: func(ts subscriptionServiceTestSuite) {
+				ts.timelineRepository.EXPECT().ListTimelineNews(mock.Anything, domain.ListTimelineNewsParams{
+					UserID: 1,
+					Limit:  3,
+				}).Return([]domain.News{
+					{
+						Base: domain.Base{
+							ID: 3,
+						},
+						SchoolID: 2,
+						UserID:   3,
+						Title:    "다른 학교 뉴스",
+					},
+					{
+						Base: domain.Base{
+							ID: 1,
+						},
+						SchoolID: 1,
+						UserID:   2,
+						Title:    "구독한 뉴스",
+					},
+				}, nil).Once()
+				ts.attachmentService.EXPECT().ListNewsAttachments(mock.Anything, []int{3, 1}).Return(nil, nil).Once()
+				ts.subscriptionRepository.EXPECT().ListReadNewsIDs(mock.Anything, domain.ListReadNewsIDsParams{
+					UserID:  1,
+					NewsIDs: []int{3, 1},
+				}).Return(map[int]bool{1: true}, nil).Once()
+				ts.reactionService.EXPECT().ListNewsReactions(mock.Anything, domain.ListReactionCountsParams{
+					UserID:  1,
+					NewsIDs: []int{3, 1},
+				}).Return(map[int][]domain.ReactionDTO{
+					1: {{Emoji: domain.ReactionEmojiThumbsUp, Count: 3, ReactedByMe: true}},
+				}, nil).Once()
+			},
+			want: domain.ListSubscriptionFeedResponse{
+				News: []domain.SubscriptionSchoolNewsDTO{
+					{
+						BaseDTO: domain.BaseDTO{
+							ID: 3,
+						},
+						SchoolID: 2,
+						Title:    "다른 학교 뉴스",
+					},
+					{
+						BaseDTO: domain.BaseDTO{
+							ID: 1,
+						},
+						SchoolID: 1,
+						Title:    "구독한 뉴스",
+						Read:     true,
+						Reactions: []domain.ReactionDTO{
+							{Emoji: domain.ReactionEmojiThumbsUp, Count: 3, ReactedByMe: true},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 다음 페이지 소식이 없는 경우",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListSubscriptionFeedRequest{
+					UserID: 1,
+					Cursor: *testPaginator.Encode(&pagination.Cursor{ID: 10, Direction: pagination.Forward, Filter: filter}),
+					Size:   10,
+				},
+			},
+			mock: func(ts subscriptionServiceTestSuite) {
+				ts.timelineRepository.EXPECT().ListTimelineNews(mock.Anything, domain.ListTimelineNewsParams{
+					UserID: 1,
+					Cursor: &pagination.Cursor{ID: 10, Direction: pagination.Forward, Filter: filter},
+					Limit:  6,
+				}).Return(nil, nil).Once()
+				ts.attachmentService.EXPECT().ListNewsAttachments(mock.Anything, []int{}).Return(nil, nil).Once()
+				ts.subscriptionRepository.EXPECT().ListReadNewsIDs(mock.Anything, domain.ListReadNewsIDsParams{
+					UserID:  1,
+					NewsIDs: []int{},
+				}).Return(map[int]bool{}, nil).Once()
+				ts.reactionService.EXPECT().ListNewsReactions(mock.Anything, domain.ListReactionCountsParams{
+					UserID:  1,
+					NewsIDs: []int{},
+				}).Return(nil, nil).Once()
+			},
+			want:    domain.ListSubscriptionFeedResponse{},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 변조된 커서",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListSubscriptionFeedRequest{
+					UserID: 1,
+					Cursor: *testPaginator.Encode(&pagination.Cursor{ID: 10, Direction: pagination.Forward, Filter: filter}) + "a",
+				},
+			},
+			mock:    func(ts subscriptionServiceTestSuite) {},
+			want:    domain.ListSubscriptionFeedResponse{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSubscriptionServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.service.ListSubscriptionFeed(tt.args.ctx, tt.args.req)
+
+			// then
+			ts.timelineRepository.AssertExpectations(t)
+			assert.Equal(t, tt.want, got)
+			if err != nil {
+				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			}
+		})
+	}
+}
+
+func Test_subscriptionService_MarkNewsRead(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.MarkNewsReadRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts subscriptionServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 구독한 학교의 소식 읽음 처리",
+			args: args{
+				ctx: context.Background(),
+				req: domain.MarkNewsReadRequest{
+					UserID: 1,
+					NewsID: 3,
+				},
+			},
+			mock: func(ts subscriptionServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(&domain.News{
+					Base: domain.Base{
+						ID: 3,
+					},
+					SchoolID: 2,
+					Status:   domain.NewsStatusPublished,
+				}, nil).Once()
+				ts.subscriptionRepository.EXPECT().FindSubscriptionByUserIDAndSchoolID(mock.Anything, domain.FindSubscriptionByUserIDAndSchoolIDParams{
+					UserID:   1,
+					SchoolID: 2,
+				}).Return(&domain.Subscription{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID:   1,
+					SchoolID: 2,
+				}, nil).Once()
+				ts.subscriptionRepository.EXPECT().MarkNewsRead(mock.Anything, domain.MarkNewsReadParams{
+					UserID:   1,
+					SchoolID: 2,
+					NewsID:   3,
+				}).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 발행되지 않은 소식",
+			args: args{
+				ctx: context.Background(),
+				req: domain.MarkNewsReadRequest{
+					UserID: 1,
+					NewsID: 3,
+				},
+			},
+			mock: func(ts subscriptionServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(&domain.News{
+					Base: domain.Base{
+						ID: 3,
+					},
+					SchoolID: 2,
+					Status:   domain.NewsStatusDraft,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 삭제된 소식",
+			args: args{
+				ctx: context.Background(),
+				req: domain.MarkNewsReadRequest{
+					UserID: 1,
+					NewsID: 3,
+				},
+			},
+			mock: func(ts subscriptionServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(&domain.News{
+					Base: domain.Base{
+						ID:         3,
+						DeleteDate: sql.NullTime{Time: time.Now(), Valid: true},
+					},
+					SchoolID: 2,
+					Status:   domain.NewsStatusPublished,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 구독하지 않은 학교의 소식",
+			args: args{
+				ctx: context.Background(),
+				req: domain.MarkNewsReadRequest{
+					UserID: 1,
+					NewsID: 3,
+				},
+			},
+			mock: func(ts subscriptionServiceTestSuite) {
+				ts.newsRepository.EXPECT().FindNewsByID(mock.Anything, 3).Return(&domain.News{
+					Base: domain.Base{
+						ID: 3,
+					},
+					SchoolID: 2,
+					Status:   domain.NewsStatusPublished,
+				}, nil).Once()
+				ts.subscriptionRepository.EXPECT().FindSubscriptionByUserIDAndSchoolID(mock.Anything, domain.FindSubscriptionByUserIDAndSchoolIDParams{
+					UserID:   1,
+					SchoolID: 2,
+				}).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSubscriptionServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.MarkNewsRead(tt.args.ctx, tt.args.req)
+
+			// then
+			ts.newsRepository.AssertExpectations(t)
+			ts.subscriptionRepository.AssertExpectations(t)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_subscriptionService_MarkSchoolNewsRead(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.MarkSchoolNewsReadRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts subscriptionServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 학교 소식 모두 읽음 처리",
+			args: args{
+				ctx: context.Background(),
+				req: domain.MarkSchoolNewsReadRequest{
+					UserID:   1,
+					SchoolID: 2,
+					Cursor:   10,
+				},
+			},
+			mock: func(ts subscriptionServiceTestSuite) {
+				ts.subscriptionRepository.EXPECT().FindSubscriptionByUserIDAndSchoolID(mock.Anything, domain.FindSubscriptionByUserIDAndSchoolIDParams{
+					UserID:   1,
+					SchoolID: 2,
+				}).Return(&domain.Subscription{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID:   1,
+					SchoolID: 2,
+				}, nil).Once()
+				ts.subscriptionRepository.EXPECT().MarkSchoolNewsReadUntil(mock.Anything, domain.MarkSchoolNewsReadUntilParams{
+					UserID:   1,
+					SchoolID: 2,
+					NewsID:   10,
+				}).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 구독하지 않은 학교",
+			args: args{
+				ctx: context.Background(),
+				req: domain.MarkSchoolNewsReadRequest{
+					UserID:   1,
+					SchoolID: 2,
+					Cursor:   10,
+				},
+			},
+			mock: func(ts subscriptionServiceTestSuite) {
+				ts.subscriptionRepository.EXPECT().FindSubscriptionByUserIDAndSchoolID(mock.Anything, domain.FindSubscriptionByUserIDAndSchoolIDParams{
+					UserID:   1,
+					SchoolID: 2,
+				}).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSubscriptionServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.MarkSchoolNewsRead(tt.args.ctx, tt.args.req)
+
+			// then
+			ts.subscriptionRepository.AssertExpectations(t)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package subscription
 
 import (
-	"classting/config"
 	"classting/domain"
 	"classting/pkg/cerrors"
 	"classting/pkg/router"
@@ -11,13 +10,16 @@ import (
 	"time"
 )
 
-func RegisterRoutes(e *gin.Engine, controller domain.SubscriptionController, cfg *config.Config) {
+func RegisterRoutes(e *gin.Engine, controller domain.SubscriptionController, auth *router.JWTAuth) {
 	api := e.Group("/subscriptions")
 	{
-		api.POST("", router.JWTMiddleware(cfg.Auth.Secret, []domain.UserType{domain.UserUseTypeStudent}), controller.CreateSubscription)
-		api.GET("", router.JWTMiddleware(cfg.Auth.Secret, []domain.UserType{domain.UserUseTypeStudent}), controller.ListSubscriptionSchools)
-		api.GET("/news/:schoolID", router.JWTMiddleware(cfg.Auth.Secret, []domain.UserType{domain.UserUseTypeStudent}), controller.ListSubscriptionSchoolNews)
-		api.DELETE("/:schoolID", router.JWTMiddleware(cfg.Auth.Secret, []domain.UserType{domain.UserUseTypeStudent}), controller.DeleteSubscription)
+		api.POST("", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeStudent}), controller.CreateSubscription)
+		api.GET("", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeStudent}), controller.ListSubscriptionSchools)
+		api.GET("/news/:schoolID", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeStudent}), controller.ListSubscriptionSchoolNews)
+		api.GET("/feed", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeStudent}), controller.ListSubscriptionFeed)
+		api.DELETE("/:schoolID", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeStudent}), controller.DeleteSubscription)
+		api.POST("/news/:newsID/read", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeStudent}), controller.MarkNewsRead)
+		api.POST("/:schoolID/read", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeStudent}), controller.MarkSchoolNewsRead)
 	}
 }
 
@@ -82,7 +84,8 @@ func (n subscriptionController) CreateSubscription(c *gin.Context) {
 // @Tags Subscription
 // @Produce json
 // @Security BearerAuth
-// @Param cursor query int false "커서"
+// @Param cursor query string false "이전 응답의 cursor 또는 prevCursor"
+// @Param size query int false "페이지 크기"
 // @Success 200 {object} domain.ListSubscriptionSchoolsResponse "구독 학교 목록"
 // @Router /subscriptions [get]
 func (n subscriptionController) ListSubscriptionSchools(c *gin.Context) {
@@ -161,12 +164,13 @@ func (n subscriptionController) DeleteSubscription(c *gin.Context) {
 // ListSubscriptionSchoolNews
 // @Summary 구독 중인 학교 페이지별 소식 조회 [필수 구현] 권한 - 학생
 // @Description 구독 중인 각각의 학교 페이지 소식을 10개씩 조회합니다 (커서로 페이징 가능)
-// @Description id을 기준으로 최신 소식순으로 조회합니다.
+// @Description id을 기준으로 최신 소식순으로 조회하고 고정된 소식은 첫 페이지 상단에만 포함됩니다.
 // @Description classting_student_1은 schoolID 1, 2, 3의 소식을 조회할 수 있습니다.
 // @Tags Subscription
 // @Produce json
 // @Security BearerAuth
-// @Param cursor query int false "커서"
+// @Param cursor query string false "이전 응답의 cursor 또는 prevCursor"
+// @Param size query int false "페이지 크기"
 // @Param schoolID path int true "학교 ID"
 // @Success 200 {object} domain.ListSubscriptionSchoolNewsResponse "구독 중인 학교 페이지별 소식 조회"
 // @Router /subscriptions/news/{schoolID} [get]
@@ -206,3 +210,135 @@ func (n subscriptionController) ListSubscriptionSchoolNews(c *gin.Context) {
 
 	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
 }
+
+// ListSubscriptionFeed
+// @Summary 구독 중인 학교 전체 소식 피드 조회 [추가 구현] 권한 - 학생
+// @Description 구독 중인 모든 학교의 소식을 하나의 피드로 10개씩 조회합니다 (커서로 페이징 가능)
+// @Description id을 기준으로 최신 소식순으로 조회하며 구독 시점의 최근 소식과 구독 이후에 발행된 소식이 노출됩니다.
+// @Description 구독을 취소한 학교의 소식은 피드에서 제외됩니다.
+// @Tags Subscription
+// @Produce json
+// @Security BearerAuth
+// @Param cursor query string false "이전 응답의 cursor 또는 prevCursor"
+// @Param size query int false "페이지 크기"
+// @Success 200 {object} domain.ListSubscriptionFeedResponse "구독 중인 학교 전체 소식 피드"
+// @Router /subscriptions/feed [get]
+func (n subscriptionController) ListSubscriptionFeed(c *gin.Context) {
+	var req domain.ListSubscriptionFeedRequest
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := n.service.ListSubscriptionFeed(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
+}
+
+// MarkNewsRead
+// @Summary 소식 읽음 처리 [추가 구현] 권한 - 학생
+// @Description 구독 중인 학교의 소식을 읽음으로 처리합니다.
+// @Tags Subscription
+// @Produce json
+// @Param newsID path int true "소식 ID"
+// @Security BearerAuth
+// @Success 204
+// @Router /subscriptions/news/{newsID}/read [post]
+func (n subscriptionController) MarkNewsRead(c *gin.Context) {
+	var req domain.MarkNewsReadRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := n.service.MarkNewsRead(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// MarkSchoolNewsRead
+// @Summary 학교 소식 모두 읽음 처리 [추가 구현] 권한 - 학생
+// @Description 구독 중인 학교에서 cursor 이하 ID의 소식을 모두 읽음으로 처리합니다.
+// @Description 가장 최근에 본 소식 ID를 cursor로 보내면 그 이전 소식까지 모두 읽음 처리됩니다.
+// @Tags Subscription
+// @Accept json
+// @Produce json
+// @Param schoolID path int true "학교 ID"
+// @Param MarkSchoolNewsReadRequest body domain.MarkSchoolNewsReadRequest true "모두 읽음 처리 요청"
+// @Security BearerAuth
+// @Success 204
+// @Router /subscriptions/{schoolID}/read [post]
+func (n subscriptionController) MarkSchoolNewsRead(c *gin.Context) {
+	var req domain.MarkSchoolNewsReadRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := n.service.MarkSchoolNewsRead(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
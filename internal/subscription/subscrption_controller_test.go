@@ -6,11 +6,11 @@ import (
 	"classting/domain"
 	"classting/internal/user"
 	"classting/mocks"
+	"classting/pkg/router"
 	"encoding/json"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
-	"k8s.io/utils/pointer"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -40,7 +40,7 @@ func setupSubscriptionControllerTestSuite(t *testing.T) subscriptionControllerTe
 	us.subscriptionController = NewSubscriptionController(us.subscriptionService)
 	RegisterRoutes(
 		us.router, us.subscriptionController,
-		us.cfg,
+		router.NewJWTAuth(us.cfg.Auth.Secret, nil, nil),
 	)
 
 	return us
@@ -130,7 +130,6 @@ func Test_subscriptionController_ListSubscriptionSchools(t *testing.T) {
 			mock: func(ts subscriptionControllerTestSuite) {
 				ts.subscriptionService.EXPECT().ListSubscriptionSchools(mock.Anything, domain.ListSubscriptionSchoolsRequest{
 					UserID: 1,
-					Cursor: nil,
 				}).Return(domain.ListSubscriptionSchoolsResponse{}, nil).Once()
 			},
 			code: http.StatusOK,
@@ -139,17 +138,29 @@ func Test_subscriptionController_ListSubscriptionSchools(t *testing.T) {
 			name: "PASS - 일부 조회 (커서 입력)",
 			query: func() string {
 				params := url.Values{}
-				params.Add("cursor", "1")
+				params.Add("cursor", "eyJpIjoxLCJkIjoibmV4dCJ9.c2lnbmF0dXJl")
+				params.Add("size", "20")
 				return params.Encode()
 			},
 			mock: func(ts subscriptionControllerTestSuite) {
 				ts.subscriptionService.EXPECT().ListSubscriptionSchools(mock.Anything, domain.ListSubscriptionSchoolsRequest{
 					UserID: 1,
-					Cursor: pointer.Int(1),
+					Cursor: "eyJpIjoxLCJkIjoibmV4dCJ9.c2lnbmF0dXJl",
+					Size:   20,
 				}).Return(domain.ListSubscriptionSchoolsResponse{}, nil).Once()
 			},
 			code: http.StatusOK,
 		},
+		{
+			name: "FAIL - 음수 페이지 크기",
+			query: func() string {
+				params := url.Values{}
+				params.Add("size", "-1")
+				return params.Encode()
+			},
+			mock: func(ts subscriptionControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -258,7 +269,6 @@ func Test_subscriptionController_ListSubscriptionSchoolNews(t *testing.T) {
 				ts.subscriptionService.EXPECT().ListSubscriptionSchoolNews(mock.Anything, domain.ListSubscriptionSchoolNewsRequest{
 					UserID:   1,
 					SchoolID: 1,
-					Cursor:   nil,
 				}).Return(domain.ListSubscriptionSchoolNewsResponse{}, nil).Once()
 			},
 			code: http.StatusOK,
@@ -271,14 +281,16 @@ func Test_subscriptionController_ListSubscriptionSchoolNews(t *testing.T) {
 			},
 			query: func() string {
 				params := url.Values{}
-				params.Add("cursor", "1")
+				params.Add("cursor", "eyJpIjoxLCJkIjoibmV4dCJ9.c2lnbmF0dXJl")
+				params.Add("size", "5")
 				return params.Encode()
 			},
 			mock: func(ts subscriptionControllerTestSuite) {
 				ts.subscriptionService.EXPECT().ListSubscriptionSchoolNews(mock.Anything, domain.ListSubscriptionSchoolNewsRequest{
 					UserID:   1,
 					SchoolID: 1,
-					Cursor:   pointer.Int(1),
+					Cursor:   "eyJpIjoxLCJkIjoibmV4dCJ9.c2lnbmF0dXJl",
+					Size:     5,
 				}).Return(domain.ListSubscriptionSchoolNewsResponse{}, nil).Once()
 			},
 			code: http.StatusOK,
@@ -291,21 +303,20 @@ func Test_subscriptionController_ListSubscriptionSchoolNews(t *testing.T) {
 			},
 			query: func() string {
 				params := url.Values{}
-				params.Add("cursor", "1")
 				return params.Encode()
 			},
 			mock: func(ts subscriptionControllerTestSuite) {},
 			code: http.StatusBadRequest,
 		},
 		{
-			name: "PASS - 잘못 된 커서 조회 시도",
+			name: "PASS - 잘못 된 페이지 크기 조회 시도",
 			path: func() string {
 				path, _ := url.JoinPath("/subscriptions/news", "1")
 				return path
 			},
 			query: func() string {
 				params := url.Values{}
-				params.Add("cursor", "0")
+				params.Add("size", "-1")
 				return params.Encode()
 			},
 			mock: func(ts subscriptionControllerTestSuite) {},
@@ -339,3 +350,199 @@ func Test_subscriptionController_ListSubscriptionSchoolNews(t *testing.T) {
 		})
 	}
 }
+
+func Test_subscriptionController_ListSubscriptionFeed(t *testing.T) {
+	tests := []struct {
+		name  string
+		query func() string
+		mock  func(ts subscriptionControllerTestSuite)
+		code  int
+	}{
+		{
+			name: "PASS - 전체 조회 (커서 미 입력)",
+			query: func() string {
+				params := url.Values{}
+				return params.Encode()
+			},
+			mock: func(ts subscriptionControllerTestSuite) {
+				ts.subscriptionService.EXPECT().ListSubscriptionFeed(mock.Anything, domain.ListSubscriptionFeedRequest{
+					UserID: 1,
+				}).Return(domain.ListSubscriptionFeedResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "PASS - 일부 조회 (커서 입력)",
+			query: func() string {
+				params := url.Values{}
+				params.Add("cursor", "eyJpIjoxLCJkIjoibmV4dCJ9.c2lnbmF0dXJl")
+				return params.Encode()
+			},
+			mock: func(ts subscriptionControllerTestSuite) {
+				ts.subscriptionService.EXPECT().ListSubscriptionFeed(mock.Anything, domain.ListSubscriptionFeedRequest{
+					UserID: 1,
+					Cursor: "eyJpIjoxLCJkIjoibmV4dCJ9.c2lnbmF0dXJl",
+				}).Return(domain.ListSubscriptionFeedResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 잘못 된 페이지 크기 조회 시도",
+			query: func() string {
+				params := url.Values{}
+				params.Add("size", "-1")
+				return params.Encode()
+			},
+			mock: func(ts subscriptionControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSubscriptionControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodGet, "/subscriptions/feed", nil)
+			req.URL.RawQuery = tt.query()
+			token, _ := user.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+				Type: domain.UserUseTypeStudent,
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.subscriptionService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_subscriptionController_MarkNewsRead(t *testing.T) {
+	tests := []struct {
+		name string
+		path func() string
+		mock func(ts subscriptionControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 소식 읽음 처리",
+			path: func() string {
+				path, _ := url.JoinPath("/subscriptions/news", "3", "read")
+				return path
+			},
+			mock: func(ts subscriptionControllerTestSuite) {
+				ts.subscriptionService.EXPECT().MarkNewsRead(mock.Anything, domain.MarkNewsReadRequest{
+					UserID: 1,
+					NewsID: 3,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "FAIL - 잘못된 소식 ID",
+			path: func() string {
+				path, _ := url.JoinPath("/subscriptions/news", "-1", "read")
+				return path
+			},
+			mock: func(ts subscriptionControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSubscriptionControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodPost, tt.path(), nil)
+			token, _ := user.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+				Type: domain.UserUseTypeStudent,
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.subscriptionService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_subscriptionController_MarkSchoolNewsRead(t *testing.T) {
+	tests := []struct {
+		name string
+		path func() string
+		body func() *bytes.Reader
+		mock func(ts subscriptionControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 학교 소식 모두 읽음 처리",
+			path: func() string {
+				path, _ := url.JoinPath("/subscriptions", "2", "read")
+				return path
+			},
+			body: func() *bytes.Reader {
+				return bytes.NewReader([]byte(`{"cursor": 10}`))
+			},
+			mock: func(ts subscriptionControllerTestSuite) {
+				ts.subscriptionService.EXPECT().MarkSchoolNewsRead(mock.Anything, domain.MarkSchoolNewsReadRequest{
+					UserID:   1,
+					SchoolID: 2,
+					Cursor:   10,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "FAIL - 커서 누락",
+			path: func() string {
+				path, _ := url.JoinPath("/subscriptions", "2", "read")
+				return path
+			},
+			body: func() *bytes.Reader {
+				return bytes.NewReader([]byte(`{}`))
+			},
+			mock: func(ts subscriptionControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupSubscriptionControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodPost, tt.path(), tt.body())
+			req.Header.Set("Content-Type", "application/json")
+			token, _ := user.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+				Type: domain.UserUseTypeStudent,
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.subscriptionService.AssertExpectations(t)
+		})
+	}
+}
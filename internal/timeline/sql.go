@@ -0,0 +1,14 @@
+package timeline
+
+const createTimelinesQuery = `INSERT INTO timelines (user_id, school_id, news_id) SELECT user_id, school_id, ? FROM subscriptions WHERE school_id = ? AND delete_date IS NULL ON DUPLICATE KEY UPDATE news_id = VALUES(news_id)`
+
+// backfillTimelinesQuery 구독 후 큐에서 기다리는 사이 구독을 취소했으면 아무것도 넣지 않도록 구독 중인 행과 조인하고,
+// 구독 기간에 발행된 소식만 받도록 구독 시작 이후 발행된 소식으로 한정한다.
+const backfillTimelinesQuery = `INSERT INTO timelines (user_id, school_id, news_id) SELECT subscriptions.user_id, news.school_id, news.id FROM news JOIN subscriptions ON subscriptions.school_id = news.school_id AND subscriptions.user_id = ? AND subscriptions.delete_date IS NULL WHERE news.school_id = ? AND news.status = 'PUBLISHED' AND news.delete_date IS NULL AND news.publish_date >= subscriptions.create_date ORDER BY news.id DESC LIMIT ? ON DUPLICATE KEY UPDATE news_id = VALUES(news_id)`
+
+// listTimelineNewsQuery 조건, 정렬, 조회 개수는 db.Query로 붙인다.
+const listTimelineNewsQuery = `SELECT news.id, news.create_date, news.update_date, news.delete_date, news.school_id, news.user_id, news.title, news.summary, news.body, news.content_format, news.status, news.publish_date, news.edit_date, news.priority FROM timelines JOIN news ON news.id = timelines.news_id`
+
+const hideTimelinesByNewsIDQuery = `UPDATE timelines SET delete_date = ? WHERE news_id = ? AND delete_date IS NULL`
+
+const listTimelineNewsAfterQuery = `SELECT news.id, news.create_date, news.update_date, news.delete_date, news.school_id, news.user_id, news.title, news.summary, news.body, news.content_format, news.status, news.publish_date, news.edit_date, news.priority FROM timelines JOIN news ON news.id = timelines.news_id WHERE timelines.user_id = ? AND timelines.news_id > ? AND timelines.delete_date IS NULL AND news.delete_date IS NULL AND news.status = 'PUBLISHED' ORDER BY timelines.news_id ASC LIMIT ?`
@@ -0,0 +1,127 @@
+package timeline
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"classting/pkg/db"
+	"context"
+	"database/sql"
+	"time"
+)
+
+type timelineRepository struct {
+	sqlDB *sql.DB
+}
+
+func NewTimelineRepository(sqlDB *sql.DB) *timelineRepository {
+	return &timelineRepository{
+		sqlDB: sqlDB,
+	}
+}
+
+var _ domain.TimelineRepository = (*timelineRepository)(nil)
+
+func (t timelineRepository) CreateTimelines(ctx context.Context, params domain.CreateTimelinesParams) error {
+	const op cerrors.Op = "timeline/timelineRepository/CreateTimelines"
+
+	_, err := t.sqlDB.ExecContext(ctx, createTimelinesQuery, params.NewsID, params.SchoolID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (t timelineRepository) BackfillTimelines(ctx context.Context, params domain.BackfillTimelinesParams) error {
+	const op cerrors.Op = "timeline/timelineRepository/BackfillTimelines"
+
+	_, err := t.sqlDB.ExecContext(ctx, backfillTimelinesQuery, params.UserID, params.SchoolID, params.Limit)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (t timelineRepository) ListTimelineNews(ctx context.Context, params domain.ListTimelineNewsParams) ([]domain.News, error) {
+	const op cerrors.Op = "timeline/timelineRepository/ListTimelineNews"
+
+	q := db.Select(listTimelineNewsQuery).Where(
+		db.Eq("timelines.user_id", params.UserID),
+		db.Expr("timelines.delete_date IS NULL"),
+		db.Expr("news.delete_date IS NULL"),
+		db.Eq("news.status", domain.NewsStatusPublished),
+	)
+	query, args := q.Seek(params.Cursor, true, "timelines.news_id").Limit(params.Limit).Build()
+
+	rows, err := t.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	news, err := scanNews(rows)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return news, nil
+}
+
+func (t timelineRepository) ListTimelineNewsAfter(ctx context.Context, params domain.ListTimelineNewsAfterParams) ([]domain.News, error) {
+	const op cerrors.Op = "timeline/timelineRepository/ListTimelineNewsAfter"
+
+	rows, err := t.sqlDB.QueryContext(ctx, listTimelineNewsAfterQuery, params.UserID, params.After, params.Limit)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	news, err := scanNews(rows)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return news, nil
+}
+
+func (t timelineRepository) HideTimelinesByNewsID(ctx context.Context, newsID int) error {
+	const op cerrors.Op = "timeline/timelineRepository/HideTimelinesByNewsID"
+
+	_, err := t.sqlDB.ExecContext(ctx, hideTimelinesByNewsIDQuery, time.Now().UTC(), newsID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func scanNews(rows *sql.Rows) ([]domain.News, error) {
+	var news []domain.News
+
+	for rows.Next() {
+		var item domain.News
+		err := rows.Scan(
+			&item.ID,
+			&item.CreateDate,
+			&item.UpdateDate,
+			&item.DeleteDate,
+			&item.SchoolID,
+			&item.UserID,
+			&item.Title,
+			&item.Summary,
+			&item.Body,
+			&item.ContentFormat,
+			&item.Status,
+			&item.PublishDate,
+			&item.EditDate,
+			&item.Priority,
+		)
+		if err != nil {
+			return nil, err
+		}
+		news = append(news, item)
+	}
+
+	return news, rows.Err()
+}
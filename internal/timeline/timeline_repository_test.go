@@ -0,0 +1,383 @@
+package timeline
+
+import (
+	"classting/domain"
+	"classting/pkg/pagination"
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type timelineRepositoryTestSuite struct {
+	sqlDB              *sql.DB
+	sqlMock            sqlmock.Sqlmock
+	timelineRepository domain.TimelineRepository
+}
+
+func setupTimelineRepositoryTestSuite() timelineRepositoryTestSuite {
+	var us timelineRepositoryTestSuite
+
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	us.sqlDB = mockDB
+	us.sqlMock = mock
+	us.timelineRepository = NewTimelineRepository(mockDB)
+
+	return us
+}
+
+func Test_timelineRepository_CreateTimelines(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params domain.CreateTimelinesParams
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts timelineRepositoryTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 학교 구독자 타임라인 생성",
+			args: args{
+				ctx: context.Background(),
+				params: domain.CreateTimelinesParams{
+					NewsID:   10,
+					SchoolID: 1,
+				},
+			},
+			mock: func(ts timelineRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`INSERT INTO timelines (.+) SELECT user_id, school_id, \? FROM subscriptions WHERE school_id = \? AND delete_date IS NULL ON DUPLICATE KEY UPDATE`).
+					WithArgs(10, 1).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupTimelineRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			err := ts.timelineRepository.CreateTimelines(tt.args.ctx, tt.args.params)
+
+			// then
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_timelineRepository_BackfillTimelines(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params domain.BackfillTimelinesParams
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts timelineRepositoryTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 구독 이후 발행된 최근 소식으로 타임라인 채우기",
+			args: args{
+				ctx: context.Background(),
+				params: domain.BackfillTimelinesParams{
+					UserID:   1,
+					SchoolID: 2,
+					Limit:    10,
+				},
+			},
+			mock: func(ts timelineRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`INSERT INTO timelines (.+) FROM news JOIN subscriptions ON subscriptions.school_id = news.school_id AND subscriptions.user_id = \? AND subscriptions.delete_date IS NULL WHERE news.school_id = \? (.+) AND news.publish_date >= subscriptions.create_date ORDER BY news.id DESC LIMIT \?`).
+					WithArgs(1, 2, 10).
+					WillReturnResult(sqlmock.NewResult(0, 10))
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupTimelineRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			err := ts.timelineRepository.BackfillTimelines(tt.args.ctx, tt.args.params)
+
+			// then
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_timelineRepository_ListTimelineNews(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params domain.ListTimelineNewsParams
+	}
+
+	createDate := time.Now()
+	updateDate := time.Now()
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts timelineRepositoryTestSuite)
+		want    []domain.News
+		wantErr bool
+	}{
+		{
+			name: "PASS - 타임라인 조회",
+			args: args{
+				ctx: context.Background(),
+				params: domain.ListTimelineNewsParams{
+					UserID: 1,
+					Limit:  11,
+				},
+			},
+			mock: func(ts timelineRepositoryTestSuite) {
+				query := `SELECT (.+) FROM timelines JOIN news ON news.id = timelines.news_id WHERE timelines.user_id = \? (.+) AND news.status = \? ORDER BY timelines.news_id DESC LIMIT \?`
+				columns := []string{"id", "create_date", "update_date", "delete_date", "school_id", "user_id", "title", "summary", "body", "content_format", "status", "publish_date", "edit_date", "priority"}
+				rows := sqlmock.NewRows(columns).
+					AddRow(11, createDate, updateDate, nil, 2, 2, "클래스팅 다른 학교 새소식", "", "클래스팅 다른 학교 새소식 본문", domain.NewsContentFormatPlain, domain.NewsStatusPublished, nil, nil, domain.NewsPriorityNormal).
+					AddRow(10, createDate, updateDate, nil, 1, 1, "클래스팅 새소식", "", "클래스팅 새소식 본문", domain.NewsContentFormatPlain, domain.NewsStatusPublished, nil, nil, domain.NewsPriorityNormal)
+				ts.sqlMock.ExpectQuery(query).WithArgs(1, domain.NewsStatusPublished, 11).WillReturnRows(rows)
+			},
+			want: []domain.News{
+				{
+					Base: domain.Base{
+						ID:         11,
+						CreateDate: createDate,
+						UpdateDate: updateDate,
+					},
+					SchoolID:      2,
+					UserID:        2,
+					Title:         "클래스팅 다른 학교 새소식",
+					Body:          "클래스팅 다른 학교 새소식 본문",
+					ContentFormat: domain.NewsContentFormatPlain,
+					Status:        domain.NewsStatusPublished,
+					Priority:      domain.NewsPriorityNormal,
+				},
+				{
+					Base: domain.Base{
+						ID:         10,
+						CreateDate: createDate,
+						UpdateDate: updateDate,
+					},
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 새소식",
+					Body:          "클래스팅 새소식 본문",
+					ContentFormat: domain.NewsContentFormatPlain,
+					Status:        domain.NewsStatusPublished,
+					Priority:      domain.NewsPriorityNormal,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 페이징 타임라인 조회",
+			args: args{
+				ctx: context.Background(),
+				params: domain.ListTimelineNewsParams{
+					UserID: 1,
+					Cursor: &pagination.Cursor{ID: 11, Direction: pagination.Forward},
+					Limit:  11,
+				},
+			},
+			mock: func(ts timelineRepositoryTestSuite) {
+				query := `SELECT (.+) FROM timelines (.+) AND timelines.news_id < \? ORDER BY timelines.news_id DESC LIMIT \?`
+				columns := []string{"id", "create_date", "update_date", "delete_date", "school_id", "user_id", "title", "summary", "body", "content_format", "status", "publish_date", "edit_date", "priority"}
+				rows := sqlmock.NewRows(columns).AddRow(10, createDate, updateDate, nil, 1, 1, "클래스팅 새소식", "", "클래스팅 새소식 본문", domain.NewsContentFormatPlain, domain.NewsStatusPublished, nil, nil, domain.NewsPriorityNormal)
+				ts.sqlMock.ExpectQuery(query).WithArgs(1, domain.NewsStatusPublished, 11, 11).WillReturnRows(rows)
+			},
+			want: []domain.News{
+				{
+					Base: domain.Base{
+						ID:         10,
+						CreateDate: createDate,
+						UpdateDate: updateDate,
+					},
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 새소식",
+					Body:          "클래스팅 새소식 본문",
+					ContentFormat: domain.NewsContentFormatPlain,
+					Status:        domain.NewsStatusPublished,
+					Priority:      domain.NewsPriorityNormal,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 이전 페이지 타임라인은 오름차순으로 조회",
+			args: args{
+				ctx: context.Background(),
+				params: domain.ListTimelineNewsParams{
+					UserID: 1,
+					Cursor: &pagination.Cursor{ID: 10, Direction: pagination.Backward},
+					Limit:  11,
+				},
+			},
+			mock: func(ts timelineRepositoryTestSuite) {
+				query := `SELECT (.+) FROM timelines (.+) AND timelines.news_id > \? ORDER BY timelines.news_id ASC LIMIT \?`
+				columns := []string{"id", "create_date", "update_date", "delete_date", "school_id", "user_id", "title", "summary", "body", "content_format", "status", "publish_date", "edit_date", "priority"}
+				rows := sqlmock.NewRows(columns).AddRow(11, createDate, updateDate, nil, 2, 2, "클래스팅 다른 학교 새소식", "", "클래스팅 다른 학교 새소식 본문", domain.NewsContentFormatPlain, domain.NewsStatusPublished, nil, nil, domain.NewsPriorityNormal)
+				ts.sqlMock.ExpectQuery(query).WithArgs(1, domain.NewsStatusPublished, 10, 11).WillReturnRows(rows)
+			},
+			want: []domain.News{
+				{
+					Base: domain.Base{
+						ID:         11,
+						CreateDate: createDate,
+						UpdateDate: updateDate,
+					},
+					SchoolID:      2,
+					UserID:        2,
+					Title:         "클래스팅 다른 학교 새소식",
+					Body:          "클래스팅 다른 학교 새소식 본문",
+					ContentFormat: domain.NewsContentFormatPlain,
+					Status:        domain.NewsStatusPublished,
+					Priority:      domain.NewsPriorityNormal,
+				},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupTimelineRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.timelineRepository.ListTimelineNews(tt.args.ctx, tt.args.params)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_timelineRepository_ListTimelineNewsAfter(t *testing.T) {
+	createDate := time.Now()
+	updateDate := time.Now()
+
+	tests := []struct {
+		name    string
+		params  domain.ListTimelineNewsAfterParams
+		mock    func(ts timelineRepositoryTestSuite)
+		want    []domain.News
+		wantErr bool
+	}{
+		{
+			name: "PASS - 커서 이후 타임라인 조회",
+			params: domain.ListTimelineNewsAfterParams{
+				UserID: 1,
+				After:  10,
+				Limit:  100,
+			},
+			mock: func(ts timelineRepositoryTestSuite) {
+				query := `SELECT (.+) FROM timelines (.+) AND timelines.news_id > \? (.+) ORDER BY timelines.news_id ASC LIMIT \?`
+				columns := []string{"id", "create_date", "update_date", "delete_date", "school_id", "user_id", "title", "summary", "body", "content_format", "status", "publish_date", "edit_date", "priority"}
+				rows := sqlmock.NewRows(columns).AddRow(11, createDate, updateDate, nil, 1, 1, "클래스팅 새소식", "", "클래스팅 새소식 본문", domain.NewsContentFormatPlain, domain.NewsStatusPublished, nil, nil, domain.NewsPriorityNormal)
+				ts.sqlMock.ExpectQuery(query).WithArgs(1, 10, 100).WillReturnRows(rows)
+			},
+			want: []domain.News{
+				{
+					Base: domain.Base{
+						ID:         11,
+						CreateDate: createDate,
+						UpdateDate: updateDate,
+					},
+					SchoolID:      1,
+					UserID:        1,
+					Title:         "클래스팅 새소식",
+					Body:          "클래스팅 새소식 본문",
+					ContentFormat: domain.NewsContentFormatPlain,
+					Status:        domain.NewsStatusPublished,
+					Priority:      domain.NewsPriorityNormal,
+				},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupTimelineRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.timelineRepository.ListTimelineNewsAfter(context.Background(), tt.params)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_timelineRepository_HideTimelinesByNewsID(t *testing.T) {
+	tests := []struct {
+		name    string
+		newsID  int
+		mock    func(ts timelineRepositoryTestSuite)
+		wantErr bool
+	}{
+		{
+			name:   "PASS - 삭제된 소식 타임라인 숨김",
+			newsID: 10,
+			mock: func(ts timelineRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`UPDATE timelines SET delete_date = \? WHERE news_id = \?`).
+					WithArgs(sqlmock.AnyArg(), 10).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupTimelineRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			err := ts.timelineRepository.HideTimelinesByNewsID(context.Background(), tt.newsID)
+
+			// then
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
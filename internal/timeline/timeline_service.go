@@ -0,0 +1,162 @@
+package timeline
+
+import (
+	"classting/config"
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	maxJobAttempts   = 3
+	jobRetryInterval = 500 * time.Millisecond
+	jobTimeout       = 30 * time.Second
+)
+
+type timelineJob struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+// timelineService 타임라인 갱신 작업을 큐에 쌓고 워커에서 처리한다.
+// 모든 작업은 멱등하게 작성되어 있어 실패 시 재시도해도 안전하다.
+type timelineService struct {
+	timelineRepository domain.TimelineRepository
+	workers            int
+	backfillSize       int
+
+	jobs   chan timelineJob
+	mu     sync.RWMutex
+	closed bool
+	wg     sync.WaitGroup
+}
+
+func NewTimelineService(
+	timelineRepository domain.TimelineRepository,
+	cfg *config.Config,
+) *timelineService {
+	return &timelineService{
+		timelineRepository: timelineRepository,
+		workers:            max(cfg.Timeline.Workers, 1),
+		backfillSize:       cfg.Timeline.BackfillSize,
+		jobs:               make(chan timelineJob, max(cfg.Timeline.QueueSize, 1)),
+	}
+}
+
+var _ domain.TimelineService = (*timelineService)(nil)
+
+// Run 워커를 실행한다.
+func (s *timelineService) Run() {
+	for i := 0; i < s.workers; i++ {
+		s.wg.Add(1)
+		go s.work()
+	}
+}
+
+// Shutdown 새로운 작업을 받지 않고 큐에 남은 작업을 처리할 때까지 기다린다.
+func (s *timelineService) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.jobs)
+	}
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *timelineService) FanOutNews(ctx context.Context, news domain.News) error {
+	return s.enqueue(ctx, timelineJob{
+		name: fmt.Sprintf("fan out news %d", news.ID),
+		run: func(ctx context.Context) error {
+			return s.timelineRepository.CreateTimelines(ctx, domain.CreateTimelinesParams{
+				NewsID:   news.ID,
+				SchoolID: news.SchoolID,
+			})
+		},
+	})
+}
+
+func (s *timelineService) HideNews(ctx context.Context, newsID int) error {
+	return s.enqueue(ctx, timelineJob{
+		name: fmt.Sprintf("hide news %d", newsID),
+		run: func(ctx context.Context) error {
+			return s.timelineRepository.HideTimelinesByNewsID(ctx, newsID)
+		},
+	})
+}
+
+func (s *timelineService) BackfillSubscription(ctx context.Context, subscription domain.Subscription) error {
+	if s.backfillSize <= 0 {
+		return nil
+	}
+
+	return s.enqueue(ctx, timelineJob{
+		name: fmt.Sprintf("backfill user %d school %d", subscription.UserID, subscription.SchoolID),
+		run: func(ctx context.Context) error {
+			return s.timelineRepository.BackfillTimelines(ctx, domain.BackfillTimelinesParams{
+				UserID:   subscription.UserID,
+				SchoolID: subscription.SchoolID,
+				Limit:    s.backfillSize,
+			})
+		},
+	})
+}
+
+func (s *timelineService) enqueue(ctx context.Context, job timelineJob) error {
+	const op cerrors.Op = "timeline/service/enqueue"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return cerrors.E(op, cerrors.Internal, "타임라인 작업을 처리할 수 없는 상태입니다.")
+	}
+
+	// 큐가 가득 차면 요청을 붙잡지 않고 바로 실패한다. 호출하는 쪽은 로그만 남긴다.
+	select {
+	case s.jobs <- job:
+		return nil
+	default:
+		return cerrors.E(op, cerrors.Internal, "타임라인 작업 큐가 가득 찼습니다.")
+	}
+}
+
+func (s *timelineService) work() {
+	defer s.wg.Done()
+
+	for job := range s.jobs {
+		s.process(job)
+	}
+}
+
+func (s *timelineService) process(job timelineJob) {
+	for attempt := 1; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), jobTimeout)
+		err := job.run(ctx)
+		cancel()
+		if err == nil {
+			return
+		}
+		if attempt >= maxJobAttempts {
+			log.Printf("timeline: %s failed after %d attempts: %v", job.name, attempt, err)
+			return
+		}
+		time.Sleep(time.Duration(attempt) * jobRetryInterval)
+	}
+}
@@ -0,0 +1,158 @@
+package timeline
+
+import (
+	"classting/config"
+	"classting/domain"
+	"classting/mocks"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+type timelineServiceTestSuite struct {
+	timelineRepository *mocks.TimelineRepository
+	service            *timelineService
+}
+
+func setupTimelineServiceTestSuite(t *testing.T) timelineServiceTestSuite {
+	var us timelineServiceTestSuite
+
+	us.timelineRepository = mocks.NewTimelineRepository(t)
+	us.service = NewTimelineService(us.timelineRepository, &config.Config{
+		Timeline: config.Timeline{
+			Workers:      1,
+			QueueSize:    10,
+			BackfillSize: 10,
+		},
+	})
+
+	return us
+}
+
+func Test_timelineService_FanOutNews(t *testing.T) {
+	tests := []struct {
+		name string
+		news domain.News
+		mock func(ts timelineServiceTestSuite)
+	}{
+		{
+			name: "PASS - 구독자 타임라인에 소식 반영",
+			news: domain.News{
+				Base: domain.Base{
+					ID: 10,
+				},
+				SchoolID: 1,
+			},
+			mock: func(ts timelineServiceTestSuite) {
+				ts.timelineRepository.EXPECT().CreateTimelines(mock.Anything, domain.CreateTimelinesParams{
+					NewsID:   10,
+					SchoolID: 1,
+				}).Return(nil).Once()
+			},
+		},
+		{
+			name: "PASS - 실패 시 재시도",
+			news: domain.News{
+				Base: domain.Base{
+					ID: 10,
+				},
+				SchoolID: 1,
+			},
+			mock: func(ts timelineServiceTestSuite) {
+				ts.timelineRepository.EXPECT().CreateTimelines(mock.Anything, domain.CreateTimelinesParams{
+					NewsID:   10,
+					SchoolID: 1,
+				}).Return(errors.New("deadlock")).Once()
+				ts.timelineRepository.EXPECT().CreateTimelines(mock.Anything, domain.CreateTimelinesParams{
+					NewsID:   10,
+					SchoolID: 1,
+				}).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupTimelineServiceTestSuite(t)
+			tt.mock(ts)
+			ts.service.Run()
+
+			// when
+			err := ts.service.FanOutNews(context.Background(), tt.news)
+
+			// then
+			assert.NoError(t, err)
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			assert.NoError(t, ts.service.Shutdown(ctx))
+			ts.timelineRepository.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_timelineService_HideNews(t *testing.T) {
+	// given
+	ts := setupTimelineServiceTestSuite(t)
+	ts.timelineRepository.EXPECT().HideTimelinesByNewsID(mock.Anything, 10).Return(nil).Once()
+	ts.service.Run()
+
+	// when
+	err := ts.service.HideNews(context.Background(), 10)
+
+	// then
+	assert.NoError(t, err)
+	assert.NoError(t, ts.service.Shutdown(context.Background()))
+	ts.timelineRepository.AssertExpectations(t)
+}
+
+func Test_timelineService_BackfillSubscription(t *testing.T) {
+	// given
+	ts := setupTimelineServiceTestSuite(t)
+	ts.timelineRepository.EXPECT().BackfillTimelines(mock.Anything, domain.BackfillTimelinesParams{
+		UserID:   1,
+		SchoolID: 2,
+		Limit:    10,
+	}).Return(nil).Once()
+	ts.service.Run()
+
+	// when
+	err := ts.service.BackfillSubscription(context.Background(), domain.Subscription{
+		UserID:   1,
+		SchoolID: 2,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.NoError(t, ts.service.Shutdown(context.Background()))
+	ts.timelineRepository.AssertExpectations(t)
+}
+
+func Test_timelineService_enqueue(t *testing.T) {
+	// given
+	ts := setupTimelineServiceTestSuite(t)
+	ts.service.jobs = make(chan timelineJob, 1)
+	assert.NoError(t, ts.service.HideNews(context.Background(), 10))
+
+	// when
+	err := ts.service.HideNews(context.Background(), 11)
+
+	// then
+	assert.Error(t, err)
+}
+
+func Test_timelineService_Shutdown(t *testing.T) {
+	// given
+	ts := setupTimelineServiceTestSuite(t)
+	ts.service.Run()
+	assert.NoError(t, ts.service.Shutdown(context.Background()))
+
+	// when
+	err := ts.service.HideNews(context.Background(), 10)
+
+	// then
+	assert.Error(t, err)
+}
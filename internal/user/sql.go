@@ -3,3 +3,15 @@ package user
 const createUserQuery = `INSERT INTO users (user_name, password, user_type) VALUES (?, ?, ?)`
 
 const findUserByUserNameQuery = `SELECT id, user_name, password, user_type FROM users WHERE user_name = ?`
+
+const findUserByIDQuery = `SELECT id, user_name, password, user_type FROM users WHERE id = ?`
+
+const createRefreshTokenQuery = `INSERT INTO refresh_tokens (user_id, family_id, token_hash, expire_date) VALUES (?, ?, ?, ?)`
+
+const findRefreshTokenByHashQuery = `SELECT id, create_date, update_date, user_id, family_id, token_hash, expire_date, rotate_date, revoke_date FROM refresh_tokens WHERE token_hash = ?`
+
+const rotateRefreshTokenQuery = `UPDATE refresh_tokens SET rotate_date = ? WHERE id = ? AND rotate_date IS NULL AND revoke_date IS NULL`
+
+const revokeRefreshTokenFamilyQuery = `UPDATE refresh_tokens SET revoke_date = ? WHERE family_id = ? AND revoke_date IS NULL`
+
+const revokeUserRefreshTokensQuery = `UPDATE refresh_tokens SET revoke_date = ? WHERE user_id = ? AND revoke_date IS NULL`
@@ -3,17 +3,21 @@ package user
 import (
 	"classting/domain"
 	cerrors "classting/pkg/cerrors"
+	"classting/pkg/router"
 	"context"
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"time"
 )
 
-func RegisterRoutes(e *gin.Engine, controller domain.UserController) {
+func RegisterRoutes(e *gin.Engine, controller domain.UserController, auth *router.JWTAuth) {
 	api := e.Group("/users")
 	{
 		api.POST("", controller.CreateUser)
 		api.POST("/login", controller.LoginUser)
+		api.POST("/token/refresh", controller.RefreshToken)
+		api.POST("/logout", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin, domain.UserUseTypeStudent}), controller.LogoutUser)
+		api.DELETE("/:userID/sessions", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.RevokeUserSessions)
 	}
 }
 
@@ -97,3 +101,128 @@ func (u userController) LoginUser(c *gin.Context) {
 
 	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
 }
+
+// RefreshToken
+// @Tags User
+// @Summary 토큰 재발급 [추가 구현]
+// @Description 리프레시 토큰으로 새 액세스 토큰과 리프레시 토큰을 발급 (사용한 리프레시 토큰은 더 이상 사용할 수 없음)
+// @Description 이미 사용한 리프레시 토큰을 다시 사용하면 같은 로그인에서 발급된 리프레시 토큰이 모두 폐기됩니다.
+// @Accept json
+// @Produce json
+// @Param RefreshTokenRequest body domain.RefreshTokenRequest true "토큰 재발급 요청"
+// @Success 200 {object} domain.LoginUserResponse
+// @Router /users/token/refresh [post]
+func (u userController) RefreshToken(c *gin.Context) {
+	var req domain.RefreshTokenRequest
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := u.service.RefreshToken(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
+}
+
+// LogoutUser
+// @Tags User
+// @Summary 로그아웃 [추가 구현]
+// @Description 현재 액세스 토큰을 만료 전에 폐기합니다. 리프레시 토큰을 함께 보내면 같은 로그인에서 발급된 리프레시 토큰도 폐기됩니다.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param LogoutUserRequest body domain.LogoutUserRequest false "로그아웃 요청"
+// @Success 204
+// @Router /users/logout [post]
+func (u userController) LogoutUser(c *gin.Context) {
+	var req domain.LogoutUserRequest
+
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBind(&req); err != nil {
+			c.JSON(cerrors.ToSentinelAPIError(err))
+			return
+		}
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	tokenID, err := router.GetTokenIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.TokenID = tokenID
+
+	tokenExpireDate, err := router.GetTokenExpireDateFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.TokenExpireDate = tokenExpireDate
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := u.service.LogoutUser(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeUserSessions
+// @Tags User
+// @Summary 유저 전체 세션 폐기 [추가 구현] 권한 - 관리자
+// @Description 유저에게 지금까지 발급된 모든 액세스 토큰과 리프레시 토큰을 폐기합니다. 유저는 다시 로그인해야 합니다.
+// @Produce json
+// @Security BearerAuth
+// @Param userID path int true "유저 ID"
+// @Success 204
+// @Router /users/{userID}/sessions [delete]
+func (u userController) RevokeUserSessions(c *gin.Context) {
+	var req domain.RevokeUserSessionsRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := u.service.RevokeUserSessions(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -2,19 +2,26 @@ package user
 
 import (
 	"bytes"
+	"classting/config"
 	"classting/domain"
 	"classting/mocks"
+	cerrors "classting/pkg/cerrors"
+	"classting/pkg/jwtkey"
+	"classting/pkg/router"
 	"encoding/json"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 type userControllerTestSuite struct {
 	router         *gin.Engine
+	cfg            *config.Config
 	userService    *mocks.UserService
 	userController domain.UserController
 }
@@ -25,10 +32,16 @@ func setupUserControllerTestSuite(t *testing.T) userControllerTestSuite {
 	gin.SetMode(gin.TestMode)
 	us.router = gin.Default()
 	us.userService = mocks.NewUserService(t)
+	us.cfg = &config.Config{
+		Auth: config.Auth{
+			Secret: "classting_test_secret",
+		},
+	}
 
 	us.userController = NewUserController(us.userService)
 	RegisterRoutes(
 		us.router, us.userController,
+		router.NewJWTAuth(us.cfg.Auth.Secret, nil, nil),
 	)
 
 	return us
@@ -185,3 +198,234 @@ func Test_userController_LoginUser(t *testing.T) {
 		})
 	}
 }
+
+func Test_userController_RefreshToken(t *testing.T) {
+	tests := []struct {
+		name  string
+		input func() *bytes.Reader
+		mock  func(ts userControllerTestSuite)
+		code  int
+	}{
+		{
+			name: "PASS - 토큰 재발급",
+			input: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.RefreshTokenRequest{
+					RefreshToken: "classting_refresh_token",
+				})
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts userControllerTestSuite) {
+				ts.userService.EXPECT().RefreshToken(mock.Anything, domain.RefreshTokenRequest{
+					RefreshToken: "classting_refresh_token",
+				}).Return(domain.LoginUserResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 재사용된 리프레시 토큰",
+			input: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.RefreshTokenRequest{
+					RefreshToken: "reused_refresh_token",
+				})
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts userControllerTestSuite) {
+				ts.userService.EXPECT().RefreshToken(mock.Anything, domain.RefreshTokenRequest{
+					RefreshToken: "reused_refresh_token",
+				}).Return(domain.LoginUserResponse{}, cerrors.E(cerrors.Op("test"), cerrors.Auth, "이미 사용된 토큰입니다. 다시 로그인해주세요.")).Once()
+			},
+			code: http.StatusUnauthorized,
+		},
+		{
+			name: "FAIL - 리프레시 토큰 빈 문자열",
+			input: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.RefreshTokenRequest{})
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts userControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodPost, "/users/token/refresh", tt.input())
+			req.Header.Set("Content-Type", "application/json")
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.userService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_userController_LogoutUser(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		denied bool
+		mock   func(ts userControllerTestSuite)
+		code   int
+	}{
+		{
+			name: "PASS - 로그아웃",
+			mock: func(ts userControllerTestSuite) {
+				ts.userService.EXPECT().LogoutUser(mock.Anything, mock.MatchedBy(func(req domain.LogoutUserRequest) bool {
+					return req.UserID == 1 && req.TokenID != "" && !req.TokenExpireDate.IsZero() && req.RefreshToken == ""
+				})).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "PASS - 리프레시 토큰과 함께 로그아웃",
+			body: `{"refreshToken":"classting_refresh_token"}`,
+			mock: func(ts userControllerTestSuite) {
+				ts.userService.EXPECT().LogoutUser(mock.Anything, mock.MatchedBy(func(req domain.LogoutUserRequest) bool {
+					return req.UserID == 1 && req.RefreshToken == "classting_refresh_token"
+				})).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name:   "FAIL - 폐기된 토큰으로 요청",
+			denied: true,
+			mock:   func(ts userControllerTestSuite) {},
+			code:   http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserControllerTestSuite(t)
+			tt.mock(ts)
+			tokenDenylist := mocks.NewTokenDenylist(t)
+			tokenDenylist.EXPECT().IsTokenRevoked(mock.Anything, mock.Anything).Return(tt.denied, nil).Once()
+			ts.router = gin.New()
+			RegisterRoutes(ts.router, ts.userController, router.NewJWTAuth(ts.cfg.Auth.Secret, nil, tokenDenylist))
+
+			token, _ := CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+				Type: domain.UserUseTypeStudent,
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour))
+			req, _ := http.NewRequest(http.MethodPost, "/users/logout", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.userService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_userController_RevokeUserSessions(t *testing.T) {
+	tests := []struct {
+		name     string
+		userID   string
+		userType domain.UserType
+		mock     func(ts userControllerTestSuite)
+		code     int
+	}{
+		{
+			name:     "PASS - 관리자가 유저 세션 폐기",
+			userID:   "2",
+			userType: domain.UserUseTypeAdmin,
+			mock: func(ts userControllerTestSuite) {
+				ts.userService.EXPECT().RevokeUserSessions(mock.Anything, domain.RevokeUserSessionsRequest{
+					UserID: 2,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name:     "FAIL - 학생은 세션 폐기 불가",
+			userID:   "2",
+			userType: domain.UserUseTypeStudent,
+			mock:     func(ts userControllerTestSuite) {},
+			code:     http.StatusUnauthorized,
+		},
+		{
+			name:     "FAIL - 잘못된 유저 ID",
+			userID:   "0",
+			userType: domain.UserUseTypeAdmin,
+			mock:     func(ts userControllerTestSuite) {},
+			code:     http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserControllerTestSuite(t)
+			tt.mock(ts)
+			token, _ := CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+				Type: tt.userType,
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour))
+			req, _ := http.NewRequest(http.MethodDelete, "/users/"+tt.userID+"/sessions", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.userService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_userController_LogoutUser_SignedAccessToken(t *testing.T) {
+	// given
+	ts := setupUserControllerTestSuite(t)
+	auth, _ := writeTestKeys(t)
+	keySet, err := jwtkey.Load(auth)
+	if !assert.NoError(t, err) {
+		return
+	}
+	ts.router = gin.New()
+	RegisterRoutes(ts.router, ts.userController, router.NewJWTAuth(ts.cfg.Auth.Secret, keySet, nil))
+	ts.userService.EXPECT().LogoutUser(mock.Anything, mock.Anything).Return(nil).Once()
+
+	signedToken, _ := CreateSignedAccessToken(domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+		Type: domain.UserUseTypeStudent,
+	}, keySet, time.Now().UTC().Add(time.Hour))
+	hmacToken, _ := CreateAccessToken(domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+		Type: domain.UserUseTypeStudent,
+	}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour))
+
+	// when, then
+	for token, code := range map[string]int{signedToken: http.StatusNoContent, hmacToken: http.StatusUnauthorized} {
+		req, _ := http.NewRequest(http.MethodPost, "/users/logout", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		ts.router.ServeHTTP(rec, req)
+		assert.Equal(t, code, rec.Code)
+	}
+}
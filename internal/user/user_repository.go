@@ -3,9 +3,11 @@ package user
 import (
 	"classting/domain"
 	cerrors "classting/pkg/cerrors"
+	"classting/pkg/db"
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 )
 
 type userRepository struct {
@@ -51,3 +53,117 @@ func (u userRepository) FindUserByUserName(ctx context.Context, mobileID string)
 
 	return &user, nil
 }
+
+func (u userRepository) FindUserByID(ctx context.Context, userID int) (*domain.User, error) {
+	const op cerrors.Op = "user/userRepository/FindUserByID"
+	var user domain.User
+
+	err := u.sqlDB.QueryRowContext(ctx, findUserByIDQuery, userID).
+		Scan(&user.ID, &user.UserName, &user.Password, &user.Type)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return &user, nil
+}
+
+func (u userRepository) CreateRefreshToken(ctx context.Context, token domain.RefreshToken) (int, error) {
+	const op cerrors.Op = "user/userRepository/CreateRefreshToken"
+
+	result, err := u.sqlDB.ExecContext(ctx, createRefreshTokenQuery, token.UserID, token.FamilyID, token.TokenHash, token.ExpireDate)
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	tokenID, err := result.LastInsertId()
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return int(tokenID), nil
+}
+
+func (u userRepository) FindRefreshTokenByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	const op cerrors.Op = "user/userRepository/FindRefreshTokenByHash"
+	var token domain.RefreshToken
+
+	err := u.sqlDB.QueryRowContext(ctx, findRefreshTokenByHashQuery, tokenHash).Scan(
+		&token.ID,
+		&token.CreateDate,
+		&token.UpdateDate,
+		&token.UserID,
+		&token.FamilyID,
+		&token.TokenHash,
+		&token.ExpireDate,
+		&token.RotateDate,
+		&token.RevokeDate,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return &token, nil
+}
+
+// RotateRefreshToken 기존 토큰을 사용 처리하고 다음 토큰을 저장한다.
+// 동시에 같은 토큰으로 회전을 시도하면 한 요청만 성공하고 나머지는 false를 반환한다.
+func (u userRepository) RotateRefreshToken(ctx context.Context, params domain.RotateRefreshTokenParams) (bool, error) {
+	const op cerrors.Op = "user/userRepository/RotateRefreshToken"
+
+	var rotated bool
+	err := db.WithTx(ctx, u.sqlDB, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, rotateRefreshTokenQuery, time.Now().UTC(), params.ID)
+		if err != nil {
+			return err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return nil
+		}
+
+		next := params.Next
+		if _, err := tx.ExecContext(ctx, createRefreshTokenQuery, next.UserID, next.FamilyID, next.TokenHash, next.ExpireDate); err != nil {
+			return err
+		}
+		rotated = true
+
+		return nil
+	})
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return rotated, nil
+}
+
+func (u userRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	const op cerrors.Op = "user/userRepository/RevokeRefreshTokenFamily"
+
+	_, err := u.sqlDB.ExecContext(ctx, revokeRefreshTokenFamilyQuery, time.Now().UTC(), familyID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (u userRepository) RevokeUserRefreshTokens(ctx context.Context, userID int) error {
+	const op cerrors.Op = "user/userRepository/RevokeUserRefreshTokens"
+
+	_, err := u.sqlDB.ExecContext(ctx, revokeUserRefreshTokensQuery, time.Now().UTC(), userID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
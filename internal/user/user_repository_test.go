@@ -8,6 +8,7 @@ import (
 	"github.com/go-sql-driver/mysql"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 type userRepositoryTestSuite struct {
@@ -171,3 +172,66 @@ func Test_userRepository_FindUserByUserName(t *testing.T) {
 		})
 	}
 }
+
+func Test_userRepository_RotateRefreshToken(t *testing.T) {
+	next := domain.RefreshToken{
+		UserID:     1,
+		FamilyID:   "family",
+		TokenHash:  "next_hash",
+		ExpireDate: time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name    string
+		mock    func(ts userRepositoryTestSuite)
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "PASS - 토큰 회전",
+			mock: func(ts userRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectExec(`UPDATE refresh_tokens SET rotate_date = \? WHERE id = \? AND rotate_date IS NULL AND revoke_date IS NULL`).
+					WithArgs(sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				ts.sqlMock.ExpectExec("INSERT INTO refresh_tokens").
+					WithArgs(1, "family", "next_hash", next.ExpireDate).
+					WillReturnResult(sqlmock.NewResult(2, 1))
+				ts.sqlMock.ExpectCommit()
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "PASS - 이미 회전된 토큰은 새 토큰을 저장하지 않음",
+			mock: func(ts userRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectExec("UPDATE refresh_tokens SET rotate_date").
+					WithArgs(sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				ts.sqlMock.ExpectCommit()
+			},
+			want:    false,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.userRepository.RotateRefreshToken(context.Background(), domain.RotateRefreshTokenParams{
+				ID:   1,
+				Next: next,
+			})
+
+			// then
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+		})
+	}
+}
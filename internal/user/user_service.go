@@ -4,23 +4,40 @@ import (
 	"classting/config"
 	"classting/domain"
 	cerrors "classting/pkg/cerrors"
+	"classting/pkg/jwtkey"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/crypto/bcrypt"
 	"time"
 )
 
+const (
+	defaultAccessTokenMinutes = 15
+	defaultRefreshTokenHours  = 24 * 14
+)
+
 type userService struct {
 	userRepository domain.UserRepository
+	tokenDenylist  domain.TokenDenylist
+	keySet         *jwtkey.KeySet
 	cfg            *config.Config
 }
 
+// NewUserService keySet이 nil이면 cfg.Auth.Secret으로 HS256 서명한다.
 func NewUserService(
 	userRepository domain.UserRepository,
+	tokenDenylist domain.TokenDenylist,
+	keySet *jwtkey.KeySet,
 	cfg *config.Config,
 ) *userService {
 	return &userService{
 		userRepository: userRepository,
+		tokenDenylist:  tokenDenylist,
+		keySet:         keySet,
 		cfg:            cfg,
 	}
 }
@@ -80,20 +97,189 @@ func (us userService) LoginUser(ctx context.Context, req domain.LoginUserRequest
 		return domain.LoginUserResponse{}, cerrors.E(op, cerrors.Invalid, "아이디 또는 비밀번호를 확인해주세요.")
 	}
 
-	creationTime := time.Now().UTC()
-	expirationTime := creationTime.Add(time.Hour * time.Duration(us.cfg.ExpiryHours))
+	familyID, err := generateOpaqueToken(16)
+	if err != nil {
+		return domain.LoginUserResponse{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	refreshToken, refreshTokenHash, refreshExpirationTime, err := us.newRefreshToken()
+	if err != nil {
+		return domain.LoginUserResponse{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	_, err = us.userRepository.CreateRefreshToken(ctx, domain.RefreshToken{
+		UserID:     user.ID,
+		FamilyID:   familyID,
+		TokenHash:  refreshTokenHash,
+		ExpireDate: refreshExpirationTime,
+	})
+	if err != nil {
+		return domain.LoginUserResponse{}, err
+	}
+
+	return us.loginUserResponse(*user, refreshToken, refreshExpirationTime)
+}
+
+// RefreshToken 리프레시 토큰을 회전하고 새 액세스 토큰을 발행한다.
+// 이미 회전된 토큰이 다시 사용되면 탈취된 것으로 보고 같은 로그인에서 발행된 토큰을 모두 폐기한다.
+func (us userService) RefreshToken(ctx context.Context, req domain.RefreshTokenRequest) (domain.LoginUserResponse, error) {
+	const op cerrors.Op = "user/service/RefreshToken"
+
+	token, err := us.userRepository.FindRefreshTokenByHash(ctx, hashRefreshToken(req.RefreshToken))
+	if err != nil {
+		return domain.LoginUserResponse{}, err
+	}
+	if token == nil || token.RevokeDate.Valid {
+		return domain.LoginUserResponse{}, cerrors.E(op, cerrors.Auth, "올바르지 않은 토큰입니다.")
+	}
+	if token.RotateDate.Valid {
+		return domain.LoginUserResponse{}, us.revokeReusedRefreshToken(ctx, *token)
+	}
+	if !time.Now().UTC().Before(token.ExpireDate) {
+		return domain.LoginUserResponse{}, cerrors.E(op, cerrors.Auth, "만료된 토큰입니다. 다시 로그인해주세요.")
+	}
+
+	user, err := us.userRepository.FindUserByID(ctx, token.UserID)
+	if err != nil {
+		return domain.LoginUserResponse{}, err
+	}
+	if user == nil {
+		return domain.LoginUserResponse{}, cerrors.E(op, cerrors.Auth, "올바르지 않은 토큰입니다.")
+	}
 
-	accessToken, err := CreateAccessToken(*user, us.cfg.Auth.Secret, expirationTime)
+	refreshToken, refreshTokenHash, refreshExpirationTime, err := us.newRefreshToken()
+	if err != nil {
+		return domain.LoginUserResponse{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	rotated, err := us.userRepository.RotateRefreshToken(ctx, domain.RotateRefreshTokenParams{
+		ID: token.ID,
+		Next: domain.RefreshToken{
+			UserID:     token.UserID,
+			FamilyID:   token.FamilyID,
+			TokenHash:  refreshTokenHash,
+			ExpireDate: refreshExpirationTime,
+		},
+	})
+	if err != nil {
+		return domain.LoginUserResponse{}, err
+	}
+	if !rotated {
+		// 조회 이후 다른 요청이 먼저 같은 토큰을 회전했다.
+		return domain.LoginUserResponse{}, us.revokeReusedRefreshToken(ctx, *token)
+	}
+
+	return us.loginUserResponse(*user, refreshToken, refreshExpirationTime)
+}
+
+// LogoutUser 현재 액세스 토큰을 폐기하고 함께 받은 리프레시 토큰이 있으면 같은 로그인의 리프레시 토큰도 폐기한다.
+func (us userService) LogoutUser(ctx context.Context, req domain.LogoutUserRequest) error {
+	err := us.tokenDenylist.RevokeToken(ctx, domain.RevokeTokenParams{
+		TokenID:    req.TokenID,
+		ExpireDate: req.TokenExpireDate,
+	})
+	if err != nil {
+		return err
+	}
+
+	if req.RefreshToken == "" {
+		return nil
+	}
+
+	token, err := us.userRepository.FindRefreshTokenByHash(ctx, hashRefreshToken(req.RefreshToken))
+	if err != nil {
+		return err
+	}
+	if token == nil || token.UserID != req.UserID {
+		return nil
+	}
+
+	return us.userRepository.RevokeRefreshTokenFamily(ctx, token.FamilyID)
+}
+
+// RevokeUserSessions 유저에게 지금까지 발행된 모든 액세스 토큰과 리프레시 토큰을 폐기한다.
+func (us userService) RevokeUserSessions(ctx context.Context, req domain.RevokeUserSessionsRequest) error {
+	const op cerrors.Op = "user/service/RevokeUserSessions"
+
+	user, err := us.userRepository.FindUserByID(ctx, req.UserID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return cerrors.E(op, cerrors.NotExist, "유저를 찾을 수 없습니다.")
+	}
+
+	// 폐기 경계 이전에 발행된 액세스 토큰은 늦어도 경계부터 액세스 토큰 수명이 지나면 만료된다.
+	issuedBefore := domain.TokenRevocationBoundary(time.Now().UTC())
+	err = us.tokenDenylist.RevokeUserTokens(ctx, domain.RevokeUserTokensParams{
+		UserID:       user.ID,
+		IssuedBefore: issuedBefore,
+		ExpireDate:   issuedBefore.Add(us.accessTokenLifetime()),
+	})
+	if err != nil {
+		return err
+	}
+
+	return us.userRepository.RevokeUserRefreshTokens(ctx, user.ID)
+}
+
+func (us userService) revokeReusedRefreshToken(ctx context.Context, token domain.RefreshToken) error {
+	const op cerrors.Op = "user/service/revokeReusedRefreshToken"
+
+	if err := us.userRepository.RevokeRefreshTokenFamily(ctx, token.FamilyID); err != nil {
+		return err
+	}
+
+	return cerrors.E(op, cerrors.Auth, "이미 사용된 토큰입니다. 다시 로그인해주세요.")
+}
+
+func (us userService) newRefreshToken() (token string, tokenHash string, exp time.Time, err error) {
+	token, err = generateOpaqueToken(32)
+	if err != nil {
+		return "", "", time.Time{}, err
+	}
+
+	refreshTokenHours := defaultRefreshTokenHours
+	if us.cfg.Auth.RefreshTokenHours > 0 {
+		refreshTokenHours = us.cfg.Auth.RefreshTokenHours
+	}
+
+	return token, hashRefreshToken(token), time.Now().UTC().Add(time.Hour * time.Duration(refreshTokenHours)), nil
+}
+
+func (us userService) loginUserResponse(user domain.User, refreshToken string, refreshExpirationTime time.Time) (domain.LoginUserResponse, error) {
+	const op cerrors.Op = "user/service/loginUserResponse"
+
+	expirationTime := time.Now().UTC().Add(us.accessTokenLifetime())
+
+	var accessToken string
+	var err error
+	if us.keySet != nil {
+		accessToken, err = CreateSignedAccessToken(user, us.keySet, expirationTime)
+	} else {
+		accessToken, err = CreateAccessToken(user, us.cfg.Auth.Secret, expirationTime)
+	}
 	if err != nil {
 		return domain.LoginUserResponse{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 	}
 
 	return domain.LoginUserResponse{
-		AccessToken: accessToken,
-		ExpiresIn:   expirationTime.Unix(),
+		AccessToken:      accessToken,
+		ExpiresIn:        expirationTime.Unix(),
+		RefreshToken:     refreshToken,
+		RefreshExpiresIn: refreshExpirationTime.Unix(),
 	}, nil
 }
 
+func (us userService) accessTokenLifetime() time.Duration {
+	accessTokenMinutes := defaultAccessTokenMinutes
+	if us.cfg.Auth.AccessTokenMinutes > 0 {
+		accessTokenMinutes = us.cfg.Auth.AccessTokenMinutes
+	}
+
+	return time.Minute * time.Duration(accessTokenMinutes)
+}
+
 func hashPasswordWithSalt(password string) (string, error) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
@@ -117,17 +303,52 @@ func validateUserName(userName string) (string, error) {
 	return userName, nil
 }
 
+// generateOpaqueToken 추측할 수 없는 임의의 토큰을 URL에 안전한 문자열로 만든다.
+func generateOpaqueToken(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// CreateAccessToken HS256으로 서명한 액세스 토큰을 만든다.
 func CreateAccessToken(user domain.User, secret string, exp time.Time) (accessToken string, err error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"userID":   user.ID,
-		"userType": user.Type,
-		"exp":      exp.Unix(),
-	})
+	claims, err := accessTokenClaims(user, exp)
+	if err != nil {
+		return "", err
+	}
 
-	tokenString, err := token.SignedString([]byte(secret))
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+}
+
+// CreateSignedAccessToken 키 셋의 서명 키(RS256, EdDSA)로 서명한 액세스 토큰을 만든다.
+func CreateSignedAccessToken(user domain.User, keySet *jwtkey.KeySet, exp time.Time) (accessToken string, err error) {
+	claims, err := accessTokenClaims(user, exp)
 	if err != nil {
 		return "", err
 	}
 
-	return tokenString, err
+	return keySet.Sign(claims)
+}
+
+func accessTokenClaims(user domain.User, exp time.Time) (jwt.MapClaims, error) {
+	tokenID, err := generateOpaqueToken(16)
+	if err != nil {
+		return nil, err
+	}
+
+	return jwt.MapClaims{
+		"jti":      tokenID,
+		"userID":   user.ID,
+		"userType": user.Type,
+		"iat":      time.Now().Unix(),
+		"exp":      exp.Unix(),
+	}, nil
 }
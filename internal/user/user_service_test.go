@@ -4,14 +4,26 @@ import (
 	"classting/config"
 	"classting/domain"
 	"classting/mocks"
+	"classting/pkg/jwtkey"
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"database/sql"
+	"encoding/pem"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
 type userServiceTestSuite struct {
 	userRepository *mocks.UserRepository
+	tokenDenylist  *mocks.TokenDenylist
 	service        domain.UserService
 }
 
@@ -19,10 +31,12 @@ func setupUserServiceTestSuite(t *testing.T) userServiceTestSuite {
 	var us userServiceTestSuite
 
 	us.userRepository = mocks.NewUserRepository(t)
-	us.service = NewUserService(us.userRepository, &config.Config{
+	us.tokenDenylist = mocks.NewTokenDenylist(t)
+	us.service = NewUserService(us.userRepository, us.tokenDenylist, nil, &config.Config{
 		Auth: config.Auth{
-			Secret:      "test_secret",
-			ExpiryHours: 24,
+			Secret:             "test_secret",
+			AccessTokenMinutes: 15,
+			RefreshTokenHours:  24,
 		},
 	})
 
@@ -125,6 +139,9 @@ func Test_userService_LoginUser(t *testing.T) {
 						Password: hashPassword,
 						Type:     domain.UserUseTypeAdmin,
 					}, nil).Once()
+				ts.userRepository.EXPECT().CreateRefreshToken(mock.Anything, mock.MatchedBy(func(token domain.RefreshToken) bool {
+					return token.UserID == 1 && token.FamilyID != "" && len(token.TokenHash) == 64
+				})).Return(1, nil).Once()
 			},
 			wantErr: false,
 		},
@@ -171,6 +188,322 @@ func Test_userService_LoginUser(t *testing.T) {
 	}
 }
 
+func Test_userService_RefreshToken(t *testing.T) {
+	const refreshToken = "classting_refresh_token"
+
+	refreshTokenHash := hashRefreshToken(refreshToken)
+	user := &domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+		UserName: "classting_admin",
+		Type:     domain.UserUseTypeAdmin,
+	}
+
+	tests := []struct {
+		name    string
+		mock    func(ts userServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 리프레시 토큰 회전",
+			mock: func(ts userServiceTestSuite) {
+				ts.userRepository.EXPECT().FindRefreshTokenByHash(mock.Anything, refreshTokenHash).Return(&domain.RefreshToken{
+					Base:       domain.Base{ID: 1},
+					UserID:     1,
+					FamilyID:   "family",
+					TokenHash:  refreshTokenHash,
+					ExpireDate: time.Now().Add(time.Hour),
+				}, nil).Once()
+				ts.userRepository.EXPECT().FindUserByID(mock.Anything, 1).Return(user, nil).Once()
+				ts.userRepository.EXPECT().RotateRefreshToken(mock.Anything, mock.MatchedBy(func(params domain.RotateRefreshTokenParams) bool {
+					return params.ID == 1 && params.Next.UserID == 1 && params.Next.FamilyID == "family" &&
+						params.Next.TokenHash != refreshTokenHash
+				})).Return(true, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 존재하지 않는 리프레시 토큰",
+			mock: func(ts userServiceTestSuite) {
+				ts.userRepository.EXPECT().FindRefreshTokenByHash(mock.Anything, refreshTokenHash).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 만료된 리프레시 토큰",
+			mock: func(ts userServiceTestSuite) {
+				ts.userRepository.EXPECT().FindRefreshTokenByHash(mock.Anything, refreshTokenHash).Return(&domain.RefreshToken{
+					Base:       domain.Base{ID: 1},
+					UserID:     1,
+					FamilyID:   "family",
+					ExpireDate: time.Now().Add(-time.Hour),
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 이미 회전된 리프레시 토큰 재사용 시 토큰 패밀리 폐기",
+			mock: func(ts userServiceTestSuite) {
+				ts.userRepository.EXPECT().FindRefreshTokenByHash(mock.Anything, refreshTokenHash).Return(&domain.RefreshToken{
+					Base:       domain.Base{ID: 1},
+					UserID:     1,
+					FamilyID:   "family",
+					ExpireDate: time.Now().Add(time.Hour),
+					RotateDate: sql.NullTime{Time: time.Now(), Valid: true},
+				}, nil).Once()
+				ts.userRepository.EXPECT().RevokeRefreshTokenFamily(mock.Anything, "family").Return(nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 동시에 회전된 리프레시 토큰은 토큰 패밀리 폐기",
+			mock: func(ts userServiceTestSuite) {
+				ts.userRepository.EXPECT().FindRefreshTokenByHash(mock.Anything, refreshTokenHash).Return(&domain.RefreshToken{
+					Base:       domain.Base{ID: 1},
+					UserID:     1,
+					FamilyID:   "family",
+					ExpireDate: time.Now().Add(time.Hour),
+				}, nil).Once()
+				ts.userRepository.EXPECT().FindUserByID(mock.Anything, 1).Return(user, nil).Once()
+				ts.userRepository.EXPECT().RotateRefreshToken(mock.Anything, mock.Anything).Return(false, nil).Once()
+				ts.userRepository.EXPECT().RevokeRefreshTokenFamily(mock.Anything, "family").Return(nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.service.RefreshToken(context.Background(), domain.RefreshTokenRequest{
+				RefreshToken: refreshToken,
+			})
+
+			// then
+			ts.userRepository.AssertExpectations(t)
+			assert.Equal(t, tt.wantErr, err != nil)
+			if err == nil {
+				assert.NotEmpty(t, got.AccessToken)
+				assert.NotEqual(t, refreshToken, got.RefreshToken)
+			}
+		})
+	}
+}
+
+func Test_userService_LogoutUser(t *testing.T) {
+	const refreshToken = "classting_refresh_token"
+
+	tokenExpireDate := time.Now().Add(15 * time.Minute)
+
+	tests := []struct {
+		name    string
+		req     domain.LogoutUserRequest
+		mock    func(ts userServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 액세스 토큰 폐기",
+			req: domain.LogoutUserRequest{
+				UserID:          1,
+				TokenID:         "token",
+				TokenExpireDate: tokenExpireDate,
+			},
+			mock: func(ts userServiceTestSuite) {
+				ts.tokenDenylist.EXPECT().RevokeToken(mock.Anything, domain.RevokeTokenParams{
+					TokenID:    "token",
+					ExpireDate: tokenExpireDate,
+				}).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 리프레시 토큰과 함께 로그아웃하면 토큰 패밀리 폐기",
+			req: domain.LogoutUserRequest{
+				UserID:          1,
+				TokenID:         "token",
+				TokenExpireDate: tokenExpireDate,
+				RefreshToken:    refreshToken,
+			},
+			mock: func(ts userServiceTestSuite) {
+				ts.tokenDenylist.EXPECT().RevokeToken(mock.Anything, mock.Anything).Return(nil).Once()
+				ts.userRepository.EXPECT().FindRefreshTokenByHash(mock.Anything, hashRefreshToken(refreshToken)).Return(&domain.RefreshToken{
+					UserID:   1,
+					FamilyID: "family",
+				}, nil).Once()
+				ts.userRepository.EXPECT().RevokeRefreshTokenFamily(mock.Anything, "family").Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 다른 유저의 리프레시 토큰은 폐기하지 않음",
+			req: domain.LogoutUserRequest{
+				UserID:          1,
+				TokenID:         "token",
+				TokenExpireDate: tokenExpireDate,
+				RefreshToken:    refreshToken,
+			},
+			mock: func(ts userServiceTestSuite) {
+				ts.tokenDenylist.EXPECT().RevokeToken(mock.Anything, mock.Anything).Return(nil).Once()
+				ts.userRepository.EXPECT().FindRefreshTokenByHash(mock.Anything, hashRefreshToken(refreshToken)).Return(&domain.RefreshToken{
+					UserID:   2,
+					FamilyID: "family",
+				}, nil).Once()
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.LogoutUser(context.Background(), tt.req)
+
+			// then
+			ts.userRepository.AssertExpectations(t)
+			ts.tokenDenylist.AssertExpectations(t)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_userService_RevokeUserSessions(t *testing.T) {
+	tests := []struct {
+		name    string
+		mock    func(ts userServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 유저의 모든 세션 폐기",
+			mock: func(ts userServiceTestSuite) {
+				ts.userRepository.EXPECT().FindUserByID(mock.Anything, 2).Return(&domain.User{
+					Base: domain.Base{ID: 2},
+				}, nil).Once()
+				ts.tokenDenylist.EXPECT().RevokeUserTokens(mock.Anything, mock.MatchedBy(func(params domain.RevokeUserTokensParams) bool {
+					return params.UserID == 2 && params.ExpireDate.Sub(params.IssuedBefore) == 15*time.Minute && params.IssuedBefore.Nanosecond() == 0
+				})).Return(nil).Once()
+				ts.userRepository.EXPECT().RevokeUserRefreshTokens(mock.Anything, 2).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 존재하지 않는 유저",
+			mock: func(ts userServiceTestSuite) {
+				ts.userRepository.EXPECT().FindUserByID(mock.Anything, 2).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.RevokeUserSessions(context.Background(), domain.RevokeUserSessionsRequest{
+				UserID: 2,
+			})
+
+			// then
+			ts.userRepository.AssertExpectations(t)
+			ts.tokenDenylist.AssertExpectations(t)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+// writeTestKeys 이전 RS256 키(검증용 공개키)와 현재 EdDSA 키(서명용 개인키)를 PEM 파일로 만든다.
+func writeTestKeys(t *testing.T) (config.Auth, *rsa.PrivateKey) {
+	dir := t.TempDir()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	rsaPublicKey, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	assert.NoError(t, err)
+	rsaPublicKeyPath := filepath.Join(dir, "rs256.pub.pem")
+	assert.NoError(t, os.WriteFile(rsaPublicKeyPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: rsaPublicKey}), 0600))
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	edPrivateKey, err := x509.MarshalPKCS8PrivateKey(edKey)
+	assert.NoError(t, err)
+	edPrivateKeyPath := filepath.Join(dir, "eddsa.pem")
+	assert.NoError(t, os.WriteFile(edPrivateKeyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: edPrivateKey}), 0600))
+
+	return config.Auth{
+		SigningKeyID: "2024-03",
+		Keys: []config.SigningKey{
+			{ID: "2024-02", Algorithm: jwtkey.AlgorithmRS256, PublicKeyPath: rsaPublicKeyPath},
+			{ID: "2024-03", Algorithm: jwtkey.AlgorithmEdDSA, PrivateKeyPath: edPrivateKeyPath},
+		},
+	}, rsaKey
+}
+
+func Test_userService_LoginUser_SignedAccessToken(t *testing.T) {
+	// given
+	auth, rsaKey := writeTestKeys(t)
+	keySet, err := jwtkey.Load(auth)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	userRepository := mocks.NewUserRepository(t)
+	service := NewUserService(userRepository, mocks.NewTokenDenylist(t), keySet, &config.Config{Auth: auth})
+	hashPassword, _ := hashPasswordWithSalt("classting")
+	userRepository.EXPECT().FindUserByUserName(mock.Anything, "classting_admin").Return(&domain.User{
+		Base:     domain.Base{ID: 1},
+		UserName: "classting_admin",
+		Password: hashPassword,
+		Type:     domain.UserUseTypeAdmin,
+	}, nil).Once()
+	userRepository.EXPECT().CreateRefreshToken(mock.Anything, mock.Anything).Return(1, nil).Once()
+
+	// when
+	got, err := service.LoginUser(context.Background(), domain.LoginUserRequest{
+		UserName: "classting_admin",
+		Password: "classting",
+	})
+
+	// then
+	assert.NoError(t, err)
+	token, err := jwt.Parse(got.AccessToken, keySet.Keyfunc)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "2024-03", token.Header["kid"])
+		assert.Equal(t, jwtkey.AlgorithmEdDSA, token.Method.Alg())
+	}
+
+	// 교체 전 키로 서명된 토큰도 만료 전까지 검증된다.
+	rotated := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"exp": time.Now().Add(time.Hour).Unix()})
+	rotated.Header["kid"] = "2024-02"
+	rotatedToken, err := rotated.SignedString(rsaKey)
+	assert.NoError(t, err)
+	_, err = jwt.Parse(rotatedToken, keySet.Keyfunc)
+	assert.NoError(t, err)
+
+	// HS256 시크릿으로 서명된 토큰은 거부한다.
+	hmacToken, err := CreateAccessToken(domain.User{Base: domain.Base{ID: 1}}, "classting", time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	_, err = jwt.Parse(hmacToken, keySet.Keyfunc)
+	assert.Error(t, err)
+
+	jwks := keySet.JWKS()
+	if assert.Len(t, jwks.Keys, 2) {
+		assert.Equal(t, "RSA", jwks.Keys[0].KeyType)
+		assert.Equal(t, "OKP", jwks.Keys[1].KeyType)
+		assert.Equal(t, "Ed25519", jwks.Keys[1].Curve)
+	}
+}
+
 func Test_validateUserName(t *testing.T) {
 	tests := []struct {
 		name    string
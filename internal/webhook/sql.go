@@ -0,0 +1,36 @@
+package webhook
+
+const createWebhookQuery = `INSERT INTO webhooks (school_id, user_id, url, secret) VALUES (?, ?, ?, ?)`
+
+const findWebhookByIDQuery = `SELECT id, create_date, update_date, delete_date, school_id, user_id, url, secret FROM webhooks WHERE id = ? AND delete_date IS NULL`
+
+// listWebhooksQuery, listWebhookDeliveriesQuery 조건, 정렬, 조회 개수는 db.Query로 붙인다.
+const listWebhooksQuery = `SELECT id, create_date, update_date, delete_date, school_id, user_id, url, secret FROM webhooks`
+
+const deleteWebhookQuery = `UPDATE webhooks SET delete_date = ? WHERE id = ?`
+
+// createWebhookDeliveriesQuery 학교에 등록된 모든 웹훅의 전송 기록을 한 번에 생성한다.
+// 같은 이벤트가 다시 전달되면 (webhook_id, event_id) 유니크 키로 무시한다.
+const createWebhookDeliveriesQuery = `INSERT IGNORE INTO webhook_deliveries (webhook_id, event_id, event_type, news_id, payload, status, next_attempt_date)
+SELECT id, ?, ?, ?, ?, 'PENDING', ? FROM webhooks WHERE school_id = ? AND delete_date IS NULL`
+
+// claimWebhookDeliveriesQuery MySQL 5.7에는 SKIP LOCKED가 없어 전송할 기록에 토큰을 남기고 다음 전송 시각을 리스 만료 시각으로 미룬다.
+// 여러 인스턴스가 동시에 실행해도 한 기록은 한 인스턴스만 가져가고, 전송 중에 종료되면 리스가 끝난 뒤 다시 전송된다.
+const claimWebhookDeliveriesQuery = `UPDATE webhook_deliveries SET claim_token = ?, next_attempt_date = ?
+WHERE status = 'PENDING' AND next_attempt_date <= ? AND webhook_id IN (SELECT id FROM webhooks WHERE delete_date IS NULL)
+ORDER BY next_attempt_date, id
+LIMIT ?`
+
+const listClaimedWebhookDeliveriesQuery = `SELECT webhook_deliveries.id, webhook_deliveries.create_date, webhook_deliveries.update_date,
+       webhook_deliveries.webhook_id, webhook_deliveries.event_id, webhook_deliveries.event_type, webhook_deliveries.news_id, webhook_deliveries.payload,
+       webhook_deliveries.status, webhook_deliveries.attempts, webhook_deliveries.next_attempt_date,
+       webhooks.url, webhooks.secret
+FROM webhook_deliveries
+JOIN webhooks ON webhooks.id = webhook_deliveries.webhook_id
+WHERE webhook_deliveries.claim_token = ? AND webhook_deliveries.status = 'PENDING'
+ORDER BY webhook_deliveries.id`
+
+const updateWebhookDeliveryQuery = `UPDATE webhook_deliveries SET status = ?, attempts = ?, next_attempt_date = ?, response_code = ?, last_error = ?, claim_token = NULL WHERE id = ?`
+
+const listWebhookDeliveriesQuery = `SELECT id, create_date, update_date, webhook_id, event_id, event_type, news_id, payload, status, attempts, next_attempt_date, response_code, last_error
+FROM webhook_deliveries`
@@ -0,0 +1,209 @@
+package webhook
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"classting/pkg/router"
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+func RegisterRoutes(e *gin.Engine, controller domain.WebhookController, auth *router.JWTAuth) {
+	api := e.Group("/webhooks")
+	{
+		api.POST("", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.CreateWebhook)
+		api.GET("", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.ListWebhooks)
+		api.DELETE("/:webhookID", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.DeleteWebhook)
+		api.GET("/:webhookID/deliveries", auth.JWTMiddleware([]domain.UserType{domain.UserUseTypeAdmin}), controller.ListWebhookDeliveries)
+	}
+}
+
+type webhookController struct {
+	service domain.WebhookService
+}
+
+func NewWebhookController(service domain.WebhookService) *webhookController {
+	return &webhookController{
+		service: service,
+	}
+}
+
+var _ domain.WebhookController = (*webhookController)(nil)
+
+// CreateWebhook
+// @Tags Webhooks
+// @Summary 웹훅 등록 [추가 구현] 권한 - 관리자
+// @Description 자신이 OWNER인 학교의 소식 발행, 수정, 삭제 이벤트를 전달 받을 https 주소를 등록합니다.
+// @Description 응답의 secret은 등록 시에만 확인할 수 있으며 전송 본문의 서명 검증에 사용합니다.
+// @Description 전송 요청에는 X-Classting-Event, X-Classting-Delivery, X-Classting-Timestamp, X-Classting-Signature 헤더가 포함됩니다.
+// @Description X-Classting-Signature는 "sha256=" + hex(HMAC-SHA256(secret, timestamp + "." + 본문)) 입니다.
+// @Description 2xx 이외의 응답은 지수 백오프로 재시도하며 최대 재시도 횟수를 넘기면 DEAD 상태가 됩니다.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param CreateWebhookRequest body domain.CreateWebhookRequest true "웹훅 등록 요청"
+// @Success 200 {object} domain.CreateWebhookResponse "등록된 웹훅"
+// @Router /webhooks [post]
+func (w webhookController) CreateWebhook(c *gin.Context) {
+	var req domain.CreateWebhookRequest
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := w.service.CreateWebhook(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
+}
+
+// ListWebhooks
+// @Tags Webhooks
+// @Summary 웹훅 목록 조회 [추가 구현] 권한 - 관리자
+// @Description 자신이 OWNER인 학교에 등록된 웹훅을 10개씩 조회합니다 (커서로 페이징 가능)
+// @Produce json
+// @Security BearerAuth
+// @Param schoolID query int true "학교 ID"
+// @Param cursor query int false "커서"
+// @Success 200 {object} domain.ListWebhooksResponse "웹훅 목록"
+// @Router /webhooks [get]
+func (w webhookController) ListWebhooks(c *gin.Context) {
+	var req domain.ListWebhooksRequest
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := w.service.ListWebhooks(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
+}
+
+// DeleteWebhook
+// @Tags Webhooks
+// @Summary 웹훅 삭제 [추가 구현] 권한 - 관리자
+// @Description 자신이 OWNER인 학교의 웹훅을 삭제합니다. 전송 대기 중인 이벤트는 더 이상 전송되지 않습니다.
+// @Produce json
+// @Security BearerAuth
+// @Param webhookID path int true "웹훅 ID"
+// @Success 204
+// @Router /webhooks/{webhookID} [delete]
+func (w webhookController) DeleteWebhook(c *gin.Context) {
+	var req domain.DeleteWebhookRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := w.service.DeleteWebhook(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListWebhookDeliveries
+// @Tags Webhooks
+// @Summary 웹훅 전송 기록 조회 [추가 구현] 권한 - 관리자
+// @Description 웹훅의 전송 기록을 최신순으로 10개씩 조회합니다 (커서로 페이징 가능)
+// @Description status는 PENDING(전송 대기, 재시도 대기), SUCCEEDED(전송 성공), DEAD(재시도 횟수 초과) 중 하나입니다.
+// @Produce json
+// @Security BearerAuth
+// @Param webhookID path int true "웹훅 ID"
+// @Param cursor query int false "커서"
+// @Success 200 {object} domain.ListWebhookDeliveriesResponse "웹훅 전송 기록"
+// @Router /webhooks/{webhookID}/deliveries [get]
+func (w webhookController) ListWebhookDeliveries(c *gin.Context) {
+	var req domain.ListWebhookDeliveriesRequest
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := w.service.ListWebhookDeliveries(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.ClasstingResponseFrom(http.StatusOK, res))
+}
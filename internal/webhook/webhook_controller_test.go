@@ -0,0 +1,195 @@
+package webhook
+
+import (
+	"bytes"
+	"classting/config"
+	"classting/domain"
+	"classting/internal/user"
+	"classting/mocks"
+	"classting/pkg/router"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/utils/pointer"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type webhookControllerTestSuite struct {
+	router            *gin.Engine
+	cfg               *config.Config
+	webhookService    *mocks.WebhookService
+	webhookController domain.WebhookController
+}
+
+func setupWebhookControllerTestSuite(t *testing.T) webhookControllerTestSuite {
+	var us webhookControllerTestSuite
+
+	gin.SetMode(gin.TestMode)
+	us.router = gin.Default()
+	us.webhookService = mocks.NewWebhookService(t)
+	us.cfg = &config.Config{
+		Auth: config.Auth{
+			Secret: "classting_test_secret",
+		},
+	}
+
+	us.webhookController = NewWebhookController(us.webhookService)
+	RegisterRoutes(
+		us.router, us.webhookController,
+		router.NewJWTAuth(us.cfg.Auth.Secret, nil, nil),
+	)
+
+	return us
+}
+
+func adminToken(ts webhookControllerTestSuite) string {
+	token, _ := user.CreateAccessToken(domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+		Type: domain.UserUseTypeAdmin,
+	}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+
+	return token
+}
+
+func Test_webhookController_CreateWebhook(t *testing.T) {
+	tests := []struct {
+		name string
+		body func() *bytes.Reader
+		mock func(ts webhookControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 웹훅 등록",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.CreateWebhookRequest{
+					SchoolID: 1,
+					URL:      "https://example.com/hook",
+				})
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts webhookControllerTestSuite) {
+				ts.webhookService.EXPECT().CreateWebhook(mock.Anything, domain.CreateWebhookRequest{
+					UserID:   1,
+					SchoolID: 1,
+					URL:      "https://example.com/hook",
+				}).Return(domain.CreateWebhookResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - https가 아닌 주소",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.CreateWebhookRequest{
+					SchoolID: 1,
+					URL:      "http://example.com/hook",
+				})
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts webhookControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 학교 ID 누락",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.CreateWebhookRequest{
+					URL: "https://example.com/hook",
+				})
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts webhookControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupWebhookControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodPost, "/webhooks", tt.body())
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+adminToken(ts))
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.webhookService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_webhookController_ListWebhookDeliveries(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		mock func(ts webhookControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 웹훅 전송 기록 조회",
+			path: "/webhooks/1/deliveries?cursor=10",
+			mock: func(ts webhookControllerTestSuite) {
+				ts.webhookService.EXPECT().ListWebhookDeliveries(mock.Anything, domain.ListWebhookDeliveriesRequest{
+					UserID:    1,
+					WebhookID: 1,
+					Cursor:    pointer.Int(10),
+				}).Return(domain.ListWebhookDeliveriesResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 잘못된 커서",
+			path: "/webhooks/1/deliveries?cursor=0",
+			mock: func(ts webhookControllerTestSuite) {},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupWebhookControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer "+adminToken(ts))
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.webhookService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_webhookController_DeleteWebhook(t *testing.T) {
+	// given
+	ts := setupWebhookControllerTestSuite(t)
+	ts.webhookService.EXPECT().DeleteWebhook(mock.Anything, domain.DeleteWebhookRequest{
+		UserID: 1,
+		ID:     1,
+	}).Return(nil).Once()
+	req, _ := http.NewRequest(http.MethodDelete, "/webhooks/1", nil)
+	req.Header.Set("Authorization", "Bearer "+adminToken(ts))
+
+	// when
+	rec := httptest.NewRecorder()
+	ts.router.ServeHTTP(rec, req)
+
+	// then
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
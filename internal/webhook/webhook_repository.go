@@ -0,0 +1,248 @@
+package webhook
+
+import (
+	"classting/domain"
+	"classting/pkg/cerrors"
+	"classting/pkg/db"
+	"classting/pkg/pagination"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+type webhookRepository struct {
+	sqlDB *sql.DB
+}
+
+func NewWebhookRepository(sqlDB *sql.DB) *webhookRepository {
+	return &webhookRepository{
+		sqlDB: sqlDB,
+	}
+}
+
+var _ domain.WebhookRepository = (*webhookRepository)(nil)
+
+func (w webhookRepository) CreateWebhook(ctx context.Context, webhook domain.Webhook) (int, error) {
+	const op cerrors.Op = "webhook/webhookRepository/CreateWebhook"
+
+	result, err := w.sqlDB.ExecContext(ctx, createWebhookQuery, webhook.SchoolID, webhook.UserID, webhook.URL, webhook.Secret)
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	webhookID, err := result.LastInsertId()
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return int(webhookID), nil
+}
+
+func (w webhookRepository) FindWebhookByID(ctx context.Context, webhookID int) (*domain.Webhook, error) {
+	const op cerrors.Op = "webhook/webhookRepository/FindWebhookByID"
+
+	var webhook domain.Webhook
+
+	err := w.sqlDB.QueryRowContext(ctx, findWebhookByIDQuery, webhookID).Scan(
+		&webhook.ID,
+		&webhook.CreateDate,
+		&webhook.UpdateDate,
+		&webhook.DeleteDate,
+		&webhook.SchoolID,
+		&webhook.UserID,
+		&webhook.URL,
+		&webhook.Secret,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return &webhook, nil
+}
+
+func (w webhookRepository) ListWebhooks(ctx context.Context, params domain.ListWebhooksParams) ([]domain.Webhook, error) {
+	const op cerrors.Op = "webhook/webhookRepository/ListWebhooks"
+
+	var webhooks []domain.Webhook
+
+	q := db.Select(listWebhooksQuery).Where(db.Expr("delete_date IS NULL"), db.Eq("school_id", params.SchoolID))
+	if params.Cursor != nil {
+		q.Where(db.Expr("id < ?", *params.Cursor))
+	}
+	query, args := q.OrderBy("id", true).Limit(pagination.DefaultSize).Build()
+
+	rows, err := w.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var webhook domain.Webhook
+		err := rows.Scan(
+			&webhook.ID,
+			&webhook.CreateDate,
+			&webhook.UpdateDate,
+			&webhook.DeleteDate,
+			&webhook.SchoolID,
+			&webhook.UserID,
+			&webhook.URL,
+			&webhook.Secret,
+		)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
+
+func (w webhookRepository) DeleteWebhook(ctx context.Context, webhookID int) error {
+	const op cerrors.Op = "webhook/webhookRepository/DeleteWebhook"
+
+	_, err := w.sqlDB.ExecContext(ctx, deleteWebhookQuery, time.Now().UTC(), webhookID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (w webhookRepository) CreateWebhookDeliveries(ctx context.Context, params domain.CreateWebhookDeliveriesParams) error {
+	const op cerrors.Op = "webhook/webhookRepository/CreateWebhookDeliveries"
+
+	_, err := w.sqlDB.ExecContext(ctx, createWebhookDeliveriesQuery, params.EventID, params.EventType, params.NewsID, params.Payload, time.Now().UTC(), params.SchoolID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (w webhookRepository) ClaimWebhookDeliveries(ctx context.Context, params domain.ClaimWebhookDeliveriesParams) ([]domain.PendingWebhookDelivery, error) {
+	const op cerrors.Op = "webhook/webhookRepository/ClaimWebhookDeliveries"
+
+	var deliveries []domain.PendingWebhookDelivery
+
+	claimToken, err := newClaimToken()
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	now := time.Now().UTC()
+	result, err := w.sqlDB.ExecContext(ctx, claimWebhookDeliveriesQuery, claimToken, now.Add(params.Lease), now, params.Limit)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	if claimed, err := result.RowsAffected(); err == nil && claimed == 0 {
+		return nil, nil
+	}
+
+	rows, err := w.sqlDB.QueryContext(ctx, listClaimedWebhookDeliveriesQuery, claimToken)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var delivery domain.PendingWebhookDelivery
+		err := rows.Scan(
+			&delivery.ID,
+			&delivery.CreateDate,
+			&delivery.UpdateDate,
+			&delivery.WebhookID,
+			&delivery.EventID,
+			&delivery.EventType,
+			&delivery.NewsID,
+			&delivery.Payload,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.NextAttemptDate,
+			&delivery.URL,
+			&delivery.Secret,
+		)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+func (w webhookRepository) UpdateWebhookDelivery(ctx context.Context, delivery domain.WebhookDelivery) error {
+	const op cerrors.Op = "webhook/webhookRepository/UpdateWebhookDelivery"
+
+	_, err := w.sqlDB.ExecContext(ctx, updateWebhookDeliveryQuery,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.NextAttemptDate,
+		delivery.ResponseCode,
+		delivery.LastError,
+		delivery.ID,
+	)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (w webhookRepository) ListWebhookDeliveries(ctx context.Context, params domain.ListWebhookDeliveriesParams) ([]domain.WebhookDelivery, error) {
+	const op cerrors.Op = "webhook/webhookRepository/ListWebhookDeliveries"
+
+	var deliveries []domain.WebhookDelivery
+
+	q := db.Select(listWebhookDeliveriesQuery).Where(db.Eq("webhook_id", params.WebhookID))
+	if params.Cursor != nil {
+		q.Where(db.Expr("id < ?", *params.Cursor))
+	}
+	query, args := q.OrderBy("id", true).Limit(pagination.DefaultSize).Build()
+
+	rows, err := w.sqlDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var delivery domain.WebhookDelivery
+		err := rows.Scan(
+			&delivery.ID,
+			&delivery.CreateDate,
+			&delivery.UpdateDate,
+			&delivery.WebhookID,
+			&delivery.EventID,
+			&delivery.EventType,
+			&delivery.NewsID,
+			&delivery.Payload,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.NextAttemptDate,
+			&delivery.ResponseCode,
+			&delivery.LastError,
+		)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+func newClaimToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,186 @@
+package webhook
+
+import (
+	"classting/domain"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+	"testing"
+	"time"
+)
+
+type webhookRepositoryTestSuite struct {
+	sqlDB             *sql.DB
+	sqlMock           sqlmock.Sqlmock
+	webhookRepository domain.WebhookRepository
+}
+
+func setupWebhookRepositoryTestSuite() webhookRepositoryTestSuite {
+	var us webhookRepositoryTestSuite
+
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	us.sqlDB = mockDB
+	us.sqlMock = mock
+	us.webhookRepository = NewWebhookRepository(mockDB)
+
+	return us
+}
+
+func Test_webhookRepository_CreateWebhook(t *testing.T) {
+	// given
+	ts := setupWebhookRepositoryTestSuite()
+	ts.sqlMock.ExpectExec("INSERT INTO webhooks").
+		WithArgs(1, 1, "https://example.com/hook", "secret").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// when
+	got, err := ts.webhookRepository.CreateWebhook(context.Background(), domain.Webhook{
+		SchoolID: 1,
+		UserID:   1,
+		URL:      "https://example.com/hook",
+		Secret:   "secret",
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, 1, got)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_webhookRepository_CreateWebhookDeliveries(t *testing.T) {
+	// given
+	ts := setupWebhookRepositoryTestSuite()
+	ts.sqlMock.ExpectExec("INSERT IGNORE INTO webhook_deliveries (.+) SELECT (.+) FROM webhooks WHERE school_id = \\? AND delete_date IS NULL").
+		WithArgs(7, domain.NewsEventTypeCreated, 3, `{"type":"news.created"}`, sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+
+	// when
+	err := ts.webhookRepository.CreateWebhookDeliveries(context.Background(), domain.CreateWebhookDeliveriesParams{
+		EventID:   7,
+		SchoolID:  1,
+		EventType: domain.NewsEventTypeCreated,
+		NewsID:    3,
+		Payload:   `{"type":"news.created"}`,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_webhookRepository_ClaimWebhookDeliveries(t *testing.T) {
+	// given
+	ts := setupWebhookRepositoryTestSuite()
+	var claimToken string
+	ts.sqlMock.ExpectExec("UPDATE webhook_deliveries SET claim_token = \\?, next_attempt_date = \\? WHERE status = 'PENDING' AND next_attempt_date <= \\? (.+) LIMIT \\?").
+		WithArgs(tokenArg{&claimToken}, sqlmock.AnyArg(), sqlmock.AnyArg(), 50).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	createDate := time.Now()
+	nextAttemptDate := time.Now()
+	columns := []string{"id", "create_date", "update_date", "webhook_id", "event_id", "event_type", "news_id", "payload", "status", "attempts", "next_attempt_date", "url", "secret"}
+	rows := sqlmock.NewRows(columns).
+		AddRow(1, createDate, createDate, 1, 7, "news.created", 3, "{}", "PENDING", 1, nextAttemptDate, "https://example.com/hook", "secret")
+	ts.sqlMock.ExpectQuery("SELECT (.+) FROM webhook_deliveries JOIN webhooks (.+) WHERE webhook_deliveries.claim_token = \\?").
+		WithArgs(tokenArg{&claimToken}).
+		WillReturnRows(rows)
+
+	// when
+	got, err := ts.webhookRepository.ClaimWebhookDeliveries(context.Background(), domain.ClaimWebhookDeliveriesParams{
+		Limit: 50,
+		Lease: time.Minute,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.PendingWebhookDelivery{
+		{
+			WebhookDelivery: domain.WebhookDelivery{
+				Base: domain.Base{
+					ID:         1,
+					CreateDate: createDate,
+					UpdateDate: createDate,
+				},
+				WebhookID:       1,
+				EventID:         7,
+				EventType:       domain.NewsEventTypeCreated,
+				NewsID:          3,
+				Payload:         "{}",
+				Status:          domain.WebhookDeliveryStatusPending,
+				Attempts:        1,
+				NextAttemptDate: nextAttemptDate,
+			},
+			URL:    "https://example.com/hook",
+			Secret: "secret",
+		},
+	}, got)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_webhookRepository_ClaimWebhookDeliveries_Empty(t *testing.T) {
+	// given
+	ts := setupWebhookRepositoryTestSuite()
+	ts.sqlMock.ExpectExec("UPDATE webhook_deliveries SET claim_token").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 50).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// when
+	got, err := ts.webhookRepository.ClaimWebhookDeliveries(context.Background(), domain.ClaimWebhookDeliveriesParams{
+		Limit: 50,
+		Lease: time.Minute,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+// tokenArg 가져갈 때 남긴 토큰으로 조회하는지 확인한다.
+type tokenArg struct {
+	token *string
+}
+
+func (a tokenArg) Match(v driver.Value) bool {
+	s, ok := v.(string)
+	if !ok || len(s) != 32 {
+		return false
+	}
+	if *a.token == "" {
+		*a.token = s
+	}
+
+	return *a.token == s
+}
+
+func Test_webhookRepository_ListWebhookDeliveries(t *testing.T) {
+	// given
+	ts := setupWebhookRepositoryTestSuite()
+	createDate := time.Now()
+	columns := []string{"id", "create_date", "update_date", "webhook_id", "event_id", "event_type", "news_id", "payload", "status", "attempts", "next_attempt_date", "response_code", "last_error"}
+	rows := sqlmock.NewRows(columns).
+		AddRow(9, createDate, createDate, 1, 8, "news.deleted", 3, "{}", "DEAD", 8, createDate, 500, "unexpected status code 500")
+	ts.sqlMock.ExpectQuery("SELECT (.+) FROM webhook_deliveries WHERE webhook_id = \\? AND id < \\? ORDER BY id DESC LIMIT \\?").
+		WithArgs(1, 10, 10).
+		WillReturnRows(rows)
+
+	// when
+	got, err := ts.webhookRepository.ListWebhookDeliveries(context.Background(), domain.ListWebhookDeliveriesParams{
+		WebhookID: 1,
+		Cursor:    pointer.Int(10),
+	})
+
+	// then
+	assert.NoError(t, err)
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, domain.WebhookDeliveryStatusDead, got[0].Status)
+		assert.Equal(t, pointer.Int(500), got[0].ResponseCode)
+		assert.Equal(t, pointer.String("unexpected status code 500"), got[0].LastError)
+	}
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
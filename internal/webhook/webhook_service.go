@@ -0,0 +1,475 @@
+package webhook
+
+import (
+	"bytes"
+	"classting/config"
+	"classting/domain"
+	"classting/internal/school"
+	"classting/pkg/cerrors"
+	"classting/pkg/content"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	EventHeader     = "X-Classting-Event"
+	DeliveryHeader  = "X-Classting-Delivery"
+	TimestampHeader = "X-Classting-Timestamp"
+	SignatureHeader = "X-Classting-Signature"
+
+	defaultPollInterval = 5 * time.Second
+	defaultBatchSize    = 50
+	defaultMaxAttempts  = 8
+	defaultBackoff      = 30 * time.Second
+	defaultTimeout      = 10 * time.Second
+	maxBackoff          = 6 * time.Hour
+	maxLastErrorLength  = 255
+)
+
+// webhookService 소식 이벤트마다 전송 기록을 남기고 디스패처가 주기적으로 전송한다.
+// 전송에 실패하면 지수 백오프로 재시도하고 최대 재시도 횟수를 넘기면 DEAD 상태로 남긴다.
+type webhookService struct {
+	webhookRepository domain.WebhookRepository
+	schoolRepository  domain.SchoolRepository
+	client            *http.Client
+	lookupIP          func(ctx context.Context, host string) ([]net.IP, error)
+	pollInterval      time.Duration
+	batchSize         int
+	maxAttempts       int
+	backoff           time.Duration
+	lease             time.Duration
+
+	done     chan struct{}
+	doneOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+func NewWebhookService(
+	webhookRepository domain.WebhookRepository,
+	schoolRepository domain.SchoolRepository,
+	cfg *config.Config,
+) *webhookService {
+	timeout := secondsOr(cfg.Webhook.TimeoutSeconds, defaultTimeout)
+	pollInterval := secondsOr(cfg.Webhook.PollIntervalSeconds, defaultPollInterval)
+	batchSize := intOr(cfg.Webhook.BatchSize, defaultBatchSize)
+
+	return &webhookService{
+		webhookRepository: webhookRepository,
+		schoolRepository:  schoolRepository,
+		client:            newWebhookClient(timeout),
+		lookupIP:          lookupIP,
+		pollInterval:      pollInterval,
+		batchSize:         batchSize,
+		maxAttempts:       intOr(cfg.Webhook.MaxAttempts, defaultMaxAttempts),
+		backoff:           secondsOr(cfg.Webhook.BackoffSeconds, defaultBackoff),
+		// 가져간 기록을 모두 시간 초과로 보내더라도 리스가 끝나기 전에 결과를 기록한다.
+		lease: timeout*time.Duration(batchSize) + pollInterval,
+		done:  make(chan struct{}),
+	}
+}
+
+var _ domain.WebhookService = (*webhookService)(nil)
+
+// Run 전송 대기 중인 웹훅을 주기적으로 전송하는 디스패처를 실행한다.
+func (s *webhookService) Run() {
+	s.wg.Add(1)
+	go s.dispatch()
+}
+
+// Shutdown 디스패처를 멈추고 진행 중인 전송이 끝날 때까지 기다린다.
+func (s *webhookService) Shutdown(ctx context.Context) error {
+	s.doneOnce.Do(func() {
+		close(s.done)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *webhookService) PublishNewsEvent(ctx context.Context, event domain.NewsEvent) error {
+	const op cerrors.Op = "webhook/service/PublishNewsEvent"
+
+	payload, err := json.Marshal(domain.WebhookPayload{
+		ID:         event.ID,
+		Type:       event.Type,
+		OccurredAt: time.Now().UTC(),
+		News:       domain.NewsDTOFrom(event.News),
+	})
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "웹훅 본문을 생성하는 중에 에러가 발생했습니다.")
+	}
+
+	return s.webhookRepository.CreateWebhookDeliveries(ctx, domain.CreateWebhookDeliveriesParams{
+		EventID:   event.ID,
+		SchoolID:  event.News.SchoolID,
+		EventType: event.Type,
+		NewsID:    event.News.ID,
+		Payload:   string(payload),
+	})
+}
+
+func (s *webhookService) CreateWebhook(ctx context.Context, req domain.CreateWebhookRequest) (domain.CreateWebhookResponse, error) {
+	const op cerrors.Op = "webhook/service/CreateWebhook"
+
+	if _, err := school.AuthorizeActiveMember(ctx, s.schoolRepository, op, req.SchoolID, req.UserID, domain.SchoolRoleOwner); err != nil {
+		return domain.CreateWebhookResponse{}, err
+	}
+
+	if err := s.checkWebhookHost(ctx, op, req.URL); err != nil {
+		return domain.CreateWebhookResponse{}, err
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return domain.CreateWebhookResponse{}, cerrors.E(op, cerrors.Internal, err, "웹훅 시크릿을 생성하는 중에 에러가 발생했습니다.")
+	}
+
+	webhook := domain.Webhook{
+		SchoolID: req.SchoolID,
+		UserID:   req.UserID,
+		URL:      req.URL,
+		Secret:   secret,
+	}
+	webhook.ID, err = s.webhookRepository.CreateWebhook(ctx, webhook)
+	if err != nil {
+		return domain.CreateWebhookResponse{}, err
+	}
+
+	return domain.CreateWebhookResponse{
+		WebhookDTO: domain.WebhookDTOFrom(webhook),
+		Secret:     webhook.Secret,
+	}, nil
+}
+
+func (s *webhookService) ListWebhooks(ctx context.Context, req domain.ListWebhooksRequest) (domain.ListWebhooksResponse, error) {
+	const op cerrors.Op = "webhook/service/ListWebhooks"
+
+	if _, err := school.AuthorizeMember(ctx, s.schoolRepository, op, req.SchoolID, req.UserID, domain.SchoolRoleOwner); err != nil {
+		return domain.ListWebhooksResponse{}, err
+	}
+
+	webhooks, err := s.webhookRepository.ListWebhooks(ctx, domain.ListWebhooksParams{
+		SchoolID: req.SchoolID,
+		Cursor:   req.Cursor,
+	})
+	if err != nil {
+		return domain.ListWebhooksResponse{}, cerrors.E(op, cerrors.Internal, err, "웹훅을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	var webhookDTOS []domain.WebhookDTO
+	for _, w := range webhooks {
+		webhookDTOS = append(webhookDTOS, domain.WebhookDTOFrom(w))
+	}
+
+	var cursor *int
+	if len(webhookDTOS) > 0 {
+		cursor = &webhookDTOS[len(webhookDTOS)-1].ID
+	}
+
+	return domain.ListWebhooksResponse{
+		Webhooks: webhookDTOS,
+		Cursor:   cursor,
+	}, nil
+}
+
+func (s *webhookService) DeleteWebhook(ctx context.Context, req domain.DeleteWebhookRequest) error {
+	const op cerrors.Op = "webhook/service/DeleteWebhook"
+
+	webhook, err := s.findOwnedWebhook(ctx, op, req.ID, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.webhookRepository.DeleteWebhook(ctx, webhook.ID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "웹훅을 삭제하는 중에 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (s *webhookService) ListWebhookDeliveries(ctx context.Context, req domain.ListWebhookDeliveriesRequest) (domain.ListWebhookDeliveriesResponse, error) {
+	const op cerrors.Op = "webhook/service/ListWebhookDeliveries"
+
+	webhook, err := s.findOwnedWebhook(ctx, op, req.WebhookID, req.UserID)
+	if err != nil {
+		return domain.ListWebhookDeliveriesResponse{}, err
+	}
+
+	deliveries, err := s.webhookRepository.ListWebhookDeliveries(ctx, domain.ListWebhookDeliveriesParams{
+		WebhookID: webhook.ID,
+		Cursor:    req.Cursor,
+	})
+	if err != nil {
+		return domain.ListWebhookDeliveriesResponse{}, cerrors.E(op, cerrors.Internal, err, "웹훅 전송 기록을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	var deliveryDTOS []domain.WebhookDeliveryDTO
+	for _, d := range deliveries {
+		deliveryDTOS = append(deliveryDTOS, domain.WebhookDeliveryDTOFrom(d))
+	}
+
+	var cursor *int
+	if len(deliveryDTOS) > 0 {
+		cursor = &deliveryDTOS[len(deliveryDTOS)-1].ID
+	}
+
+	return domain.ListWebhookDeliveriesResponse{
+		Deliveries: deliveryDTOS,
+		Cursor:     cursor,
+	}, nil
+}
+
+// checkWebhookHost 웹훅 주소가 서버 내부망을 가리키지 않는지 등록할 때 확인한다.
+// 등록 후 DNS 응답이 바뀌는 경우는 전송할 때 newWebhookClient가 연결하는 주소를 다시 확인한다.
+func (s *webhookService) checkWebhookHost(ctx context.Context, op cerrors.Op, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return cerrors.E(op, cerrors.Invalid, err, "웹훅 주소를 확인해주세요.")
+	}
+
+	ips, err := s.lookupIP(ctx, u.Hostname())
+	if err != nil || len(ips) == 0 {
+		return cerrors.E(op, cerrors.Invalid, "웹훅 주소의 호스트를 찾을 수 없습니다.")
+	}
+	for _, ip := range ips {
+		if !publicIP(ip) {
+			return cerrors.E(op, cerrors.Invalid, "내부망 주소로는 웹훅을 등록할 수 없습니다.")
+		}
+	}
+
+	return nil
+}
+
+func (s *webhookService) findOwnedWebhook(ctx context.Context, op cerrors.Op, webhookID int, userID int) (*domain.Webhook, error) {
+	webhook, err := s.webhookRepository.FindWebhookByID(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if webhook == nil {
+		return nil, cerrors.E(op, cerrors.Invalid, "해당 웹훅이 존재하지 않습니다.")
+	}
+
+	if _, err := school.AuthorizeMember(ctx, s.schoolRepository, op, webhook.SchoolID, userID, domain.SchoolRoleOwner); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func (s *webhookService) dispatch() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			if err := s.deliverPending(context.Background()); err != nil {
+				log.Printf("webhook: deliver pending webhooks: %v", err)
+			}
+		}
+	}
+}
+
+// deliverPending 전송 시각이 된 웹훅을 전송하고 결과를 기록한다.
+func (s *webhookService) deliverPending(ctx context.Context) error {
+	deliveries, err := s.webhookRepository.ClaimWebhookDeliveries(ctx, domain.ClaimWebhookDeliveriesParams{
+		Limit: s.batchSize,
+		Lease: s.lease,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		select {
+		case <-s.done:
+			return nil
+		default:
+		}
+
+		result := s.deliver(ctx, delivery)
+		if err := s.webhookRepository.UpdateWebhookDelivery(ctx, result); err != nil {
+			log.Printf("webhook: update delivery %d: %v", delivery.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *webhookService) deliver(ctx context.Context, pending domain.PendingWebhookDelivery) domain.WebhookDelivery {
+	delivery := pending.WebhookDelivery
+	delivery.Attempts++
+	delivery.ResponseCode = nil
+	delivery.LastError = nil
+
+	code, err := s.send(ctx, pending)
+	if code != 0 {
+		delivery.ResponseCode = &code
+	}
+	if err == nil {
+		delivery.Status = domain.WebhookDeliveryStatusSucceeded
+		return delivery
+	}
+
+	lastError := content.Truncate(err.Error(), maxLastErrorLength)
+	delivery.LastError = &lastError
+
+	if delivery.Attempts >= s.maxAttempts {
+		delivery.Status = domain.WebhookDeliveryStatusDead
+		return delivery
+	}
+	delivery.Status = domain.WebhookDeliveryStatusPending
+	delivery.NextAttemptDate = time.Now().UTC().Add(s.backoffFor(delivery.Attempts))
+
+	return delivery
+}
+
+func (s *webhookService) send(ctx context.Context, delivery domain.PendingWebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewBufferString(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(delivery.EventType))
+	req.Header.Set(DeliveryHeader, strconv.Itoa(delivery.ID))
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(delivery.Secret, timestamp, []byte(delivery.Payload)))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res.StatusCode, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	return res.StatusCode, nil
+}
+
+// backoffFor 재시도 간격은 backoff * 2^(attempts-1)이며 maxBackoff를 넘지 않는다.
+func (s *webhookService) backoffFor(attempts int) time.Duration {
+	backoff := s.backoff
+	for i := 1; i < attempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, maxBackoff)
+}
+
+// Sign 수신 측은 X-Classting-Timestamp와 본문을 "."으로 이어 붙인 값을 웹훅 시크릿으로 HMAC-SHA256 서명해 비교한다.
+func Sign(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+var errNonPublicAddress = errors.New("webhook: non-public address")
+
+// cgnat 통신사 내부망(100.64.0.0/10)은 net.IP.IsPrivate에 포함되지 않는다.
+var cgnat = netip.MustParsePrefix("100.64.0.0/10")
+
+// publicIP 사설, 루프백, 링크 로컬(클라우드 메타데이터 169.254.169.254 포함), 멀티캐스트, 미지정 주소가 아닌지 확인한다.
+func publicIP(ip net.IP) bool {
+	if ip.IsPrivate() || ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+
+	addr, ok := netip.AddrFromSlice(ip)
+
+	return ok && !cgnat.Contains(addr.Unmap())
+}
+
+func lookupIP(ctx context.Context, host string) ([]net.IP, error) {
+	return net.DefaultResolver.LookupIP(ctx, "ip", host)
+}
+
+// newWebhookClient 리다이렉트를 포함해 실제로 연결하는 주소가 공인 주소인지 연결 직전에 확인한다.
+// 프록시를 거치면 연결 주소를 확인할 수 없으므로 환경 변수의 프록시 설정은 사용하지 않는다.
+func newWebhookClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !publicIP(ip) {
+				return fmt.Errorf("%w: %s", errNonPublicAddress, host)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	}
+}
+
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func secondsOr(seconds int, fallback time.Duration) time.Duration {
+	if seconds <= 0 {
+		return fallback
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+func intOr(value int, fallback int) int {
+	if value <= 0 {
+		return fallback
+	}
+
+	return value
+}
@@ -0,0 +1,490 @@
+package webhook
+
+import (
+	"classting/config"
+	"classting/domain"
+	"classting/mocks"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+type webhookServiceTestSuite struct {
+	webhookRepository *mocks.WebhookRepository
+	schoolRepository  *mocks.SchoolRepository
+	service           *webhookService
+}
+
+func setupWebhookServiceTestSuite(t *testing.T) webhookServiceTestSuite {
+	var us webhookServiceTestSuite
+
+	us.webhookRepository = mocks.NewWebhookRepository(t)
+	us.schoolRepository = mocks.NewSchoolRepository(t)
+	us.service = NewWebhookService(us.webhookRepository, us.schoolRepository, &config.Config{
+		Webhook: config.Webhook{
+			MaxAttempts:    3,
+			BackoffSeconds: 10,
+		},
+	})
+	us.service.lookupIP = func(ctx context.Context, host string) ([]net.IP, error) {
+		if ip := net.ParseIP(host); ip != nil {
+			return []net.IP{ip}, nil
+		}
+		return []net.IP{net.ParseIP("93.184.216.34")}, nil
+	}
+
+	return us
+}
+
+func Test_webhookService_CreateWebhook(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.CreateWebhookRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts webhookServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 소유한 학교에 웹훅 등록",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateWebhookRequest{
+					UserID:   1,
+					SchoolID: 1,
+					URL:      "https://example.com/hook",
+				},
+			},
+			mock: func(ts webhookServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base:   domain.Base{ID: 1},
+					UserID: 1,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+				ts.webhookRepository.EXPECT().CreateWebhook(mock.Anything, mock.MatchedBy(func(webhook domain.Webhook) bool {
+					return webhook.SchoolID == 1 && webhook.UserID == 1 && webhook.URL == "https://example.com/hook" && len(webhook.Secret) == 64
+				})).Return(1, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - OWNER가 아닌 학교 멤버",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateWebhookRequest{
+					UserID:   2,
+					SchoolID: 1,
+					URL:      "https://example.com/hook",
+				},
+			},
+			mock: func(ts webhookServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base:   domain.Base{ID: 1},
+					UserID: 1,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   2,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   2,
+					Role:     domain.SchoolRoleEditor,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 삭제된 학교에 웹훅 등록",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateWebhookRequest{
+					UserID:   1,
+					SchoolID: 1,
+					URL:      "https://example.com/hook",
+				},
+			},
+			mock: func(ts webhookServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base: domain.Base{
+						ID:         1,
+						DeleteDate: sql.NullTime{Time: time.Now(), Valid: true},
+					},
+					UserID: 1,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 루프백 주소",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateWebhookRequest{
+					UserID:   1,
+					SchoolID: 1,
+					URL:      "https://127.0.0.1/hook",
+				},
+			},
+			mock: func(ts webhookServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base:   domain.Base{ID: 1},
+					UserID: 1,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 클라우드 메타데이터 주소",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateWebhookRequest{
+					UserID:   1,
+					SchoolID: 1,
+					URL:      "https://169.254.169.254/latest/meta-data",
+				},
+			},
+			mock: func(ts webhookServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base:   domain.Base{ID: 1},
+					UserID: 1,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 사설망 주소",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateWebhookRequest{
+					UserID:   1,
+					SchoolID: 1,
+					URL:      "https://10.0.0.5/hook",
+				},
+			},
+			mock: func(ts webhookServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base:   domain.Base{ID: 1},
+					UserID: 1,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 존재하지 않는 학교",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateWebhookRequest{
+					UserID:   1,
+					SchoolID: 7777,
+					URL:      "https://example.com/hook",
+				},
+			},
+			mock: func(ts webhookServiceTestSuite) {
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 7777).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupWebhookServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.service.CreateWebhook(tt.args.ctx, tt.args.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+			if err == nil {
+				assert.Equal(t, 1, got.ID)
+				assert.Len(t, got.Secret, 64)
+			}
+		})
+	}
+}
+
+func Test_webhookService_DeleteWebhook(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.DeleteWebhookRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts webhookServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 웹훅 삭제",
+			args: args{
+				ctx: context.Background(),
+				req: domain.DeleteWebhookRequest{UserID: 1, ID: 1},
+			},
+			mock: func(ts webhookServiceTestSuite) {
+				ts.webhookRepository.EXPECT().FindWebhookByID(mock.Anything, 1).Return(&domain.Webhook{
+					Base:     domain.Base{ID: 1},
+					SchoolID: 1,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolByID(mock.Anything, 1).Return(&domain.School{
+					Base:   domain.Base{ID: 1},
+					UserID: 1,
+				}, nil).Once()
+				ts.schoolRepository.EXPECT().FindSchoolMember(mock.Anything, domain.FindSchoolMemberParams{
+					SchoolID: 1,
+					UserID:   1,
+				}).Return(&domain.SchoolMember{
+					SchoolID: 1,
+					UserID:   1,
+					Role:     domain.SchoolRoleOwner,
+				}, nil).Once()
+				ts.webhookRepository.EXPECT().DeleteWebhook(mock.Anything, 1).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 존재하지 않는 웹훅",
+			args: args{
+				ctx: context.Background(),
+				req: domain.DeleteWebhookRequest{UserID: 1, ID: 7777},
+			},
+			mock: func(ts webhookServiceTestSuite) {
+				ts.webhookRepository.EXPECT().FindWebhookByID(mock.Anything, 7777).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupWebhookServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.service.DeleteWebhook(tt.args.ctx, tt.args.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_webhookService_PublishNewsEvent(t *testing.T) {
+	// given
+	ts := setupWebhookServiceTestSuite(t)
+	ts.webhookRepository.EXPECT().CreateWebhookDeliveries(mock.Anything, mock.MatchedBy(func(params domain.CreateWebhookDeliveriesParams) bool {
+		var payload domain.WebhookPayload
+		if err := json.Unmarshal([]byte(params.Payload), &payload); err != nil {
+			return false
+		}
+		return params.EventID == 7 && params.SchoolID == 1 && params.NewsID == 3 && params.EventType == domain.NewsEventTypeCreated &&
+			payload.ID == 7 && payload.Type == domain.NewsEventTypeCreated && payload.News.Title == "새 소식"
+	})).Return(nil).Once()
+
+	// when
+	err := ts.service.PublishNewsEvent(context.Background(), domain.NewsEvent{
+		ID:   7,
+		Type: domain.NewsEventTypeCreated,
+		News: domain.News{Base: domain.Base{ID: 3}, SchoolID: 1, Title: "새 소식"},
+	})
+
+	// then
+	assert.NoError(t, err)
+}
+
+func Test_webhookService_deliverPending(t *testing.T) {
+	const (
+		secret  = "classting_webhook_secret"
+		payload = `{"type":"news.created"}`
+	)
+
+	tests := []struct {
+		name       string
+		statusCode int
+		attempts   int
+		want       func(delivery domain.WebhookDelivery) bool
+	}{
+		{
+			name:       "PASS - 전송 성공",
+			statusCode: http.StatusOK,
+			attempts:   0,
+			want: func(delivery domain.WebhookDelivery) bool {
+				return delivery.Status == domain.WebhookDeliveryStatusSucceeded &&
+					delivery.Attempts == 1 && *delivery.ResponseCode == http.StatusOK && delivery.LastError == nil
+			},
+		},
+		{
+			name:       "PASS - 전송 실패 시 지수 백오프로 재시도 예약",
+			statusCode: http.StatusInternalServerError,
+			attempts:   1,
+			want: func(delivery domain.WebhookDelivery) bool {
+				wait := time.Until(delivery.NextAttemptDate)
+				return delivery.Status == domain.WebhookDeliveryStatusPending &&
+					delivery.Attempts == 2 && *delivery.ResponseCode == http.StatusInternalServerError &&
+					delivery.LastError != nil && wait > 15*time.Second && wait <= 20*time.Second
+			},
+		},
+		{
+			name:       "PASS - 최대 재시도 횟수 초과 시 DEAD",
+			statusCode: http.StatusInternalServerError,
+			attempts:   2,
+			want: func(delivery domain.WebhookDelivery) bool {
+				return delivery.Status == domain.WebhookDeliveryStatusDead && delivery.Attempts == 3
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupWebhookServiceTestSuite(t)
+			receiver := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, payload, string(body))
+				assert.Equal(t, "news.created", r.Header.Get(EventHeader))
+				assert.Equal(t, "1", r.Header.Get(DeliveryHeader))
+				assert.Equal(t, Sign(secret, r.Header.Get(TimestampHeader), body), r.Header.Get(SignatureHeader))
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer receiver.Close()
+			ts.service.client = receiver.Client()
+
+			ts.webhookRepository.EXPECT().ClaimWebhookDeliveries(mock.Anything, domain.ClaimWebhookDeliveriesParams{
+				Limit: defaultBatchSize,
+				Lease: ts.service.lease,
+			}).Return([]domain.PendingWebhookDelivery{
+				{
+					WebhookDelivery: domain.WebhookDelivery{
+						Base:      domain.Base{ID: 1},
+						WebhookID: 1,
+						EventType: domain.NewsEventTypeCreated,
+						NewsID:    1,
+						Payload:   payload,
+						Status:    domain.WebhookDeliveryStatusPending,
+						Attempts:  tt.attempts,
+					},
+					URL:    receiver.URL,
+					Secret: secret,
+				},
+			}, nil).Once()
+			ts.webhookRepository.EXPECT().UpdateWebhookDelivery(mock.Anything, mock.MatchedBy(tt.want)).Return(nil).Once()
+
+			// when
+			err := ts.service.deliverPending(context.Background())
+
+			// then
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_webhookService_deliver_LastError(t *testing.T) {
+	// given
+	ts := setupWebhookServiceTestSuite(t)
+	ts.service.client = &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			return nil, errors.New(strings.Repeat("연결 실패", 100))
+		}),
+	}
+
+	// when
+	got := ts.service.deliver(context.Background(), domain.PendingWebhookDelivery{URL: "https://example.com/hook"})
+
+	// then
+	if assert.NotNil(t, got.LastError) {
+		assert.True(t, utf8.ValidString(*got.LastError))
+		assert.Equal(t, maxLastErrorLength, utf8.RuneCountInString(*got.LastError))
+	}
+}
+
+type roundTripFunc func(r *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func Test_newWebhookClient(t *testing.T) {
+	// given
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+	client := newWebhookClient(time.Second)
+
+	// when
+	_, err := client.Post(receiver.URL, "application/json", strings.NewReader("{}"))
+
+	// then
+	assert.ErrorIs(t, err, errNonPublicAddress)
+}
+
+func Test_webhookService_Shutdown(t *testing.T) {
+	// given
+	ts := setupWebhookServiceTestSuite(t)
+	ts.service.Run()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// when
+	err := ts.service.Shutdown(ctx)
+
+	// then
+	assert.NoError(t, err)
+	assert.NoError(t, ts.service.Shutdown(ctx))
+}
@@ -0,0 +1,101 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AnalyticsController is an autogenerated mock type for the AnalyticsController type
+type AnalyticsController struct {
+	mock.Mock
+}
+
+type AnalyticsController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AnalyticsController) EXPECT() *AnalyticsController_Expecter {
+	return &AnalyticsController_Expecter{mock: &_m.Mock}
+}
+
+// GetNewsAnalytics provides a mock function with given fields: c
+func (_m *AnalyticsController) GetNewsAnalytics(c *gin.Context) {
+	_m.Called(c)
+}
+
+// AnalyticsController_GetNewsAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNewsAnalytics'
+type AnalyticsController_GetNewsAnalytics_Call struct {
+	*mock.Call
+}
+
+// GetNewsAnalytics is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *AnalyticsController_Expecter) GetNewsAnalytics(c interface{}) *AnalyticsController_GetNewsAnalytics_Call {
+	return &AnalyticsController_GetNewsAnalytics_Call{Call: _e.mock.On("GetNewsAnalytics", c)}
+}
+
+func (_c *AnalyticsController_GetNewsAnalytics_Call) Run(run func(c *gin.Context)) *AnalyticsController_GetNewsAnalytics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *AnalyticsController_GetNewsAnalytics_Call) Return() *AnalyticsController_GetNewsAnalytics_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *AnalyticsController_GetNewsAnalytics_Call) RunAndReturn(run func(*gin.Context)) *AnalyticsController_GetNewsAnalytics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSchoolAnalytics provides a mock function with given fields: c
+func (_m *AnalyticsController) GetSchoolAnalytics(c *gin.Context) {
+	_m.Called(c)
+}
+
+// AnalyticsController_GetSchoolAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSchoolAnalytics'
+type AnalyticsController_GetSchoolAnalytics_Call struct {
+	*mock.Call
+}
+
+// GetSchoolAnalytics is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *AnalyticsController_Expecter) GetSchoolAnalytics(c interface{}) *AnalyticsController_GetSchoolAnalytics_Call {
+	return &AnalyticsController_GetSchoolAnalytics_Call{Call: _e.mock.On("GetSchoolAnalytics", c)}
+}
+
+func (_c *AnalyticsController_GetSchoolAnalytics_Call) Run(run func(c *gin.Context)) *AnalyticsController_GetSchoolAnalytics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *AnalyticsController_GetSchoolAnalytics_Call) Return() *AnalyticsController_GetSchoolAnalytics_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *AnalyticsController_GetSchoolAnalytics_Call) RunAndReturn(run func(*gin.Context)) *AnalyticsController_GetSchoolAnalytics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAnalyticsController creates a new instance of AnalyticsController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnalyticsController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AnalyticsController {
+	mock := &AnalyticsController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
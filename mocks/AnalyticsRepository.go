@@ -0,0 +1,200 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AnalyticsRepository is an autogenerated mock type for the AnalyticsRepository type
+type AnalyticsRepository struct {
+	mock.Mock
+}
+
+type AnalyticsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AnalyticsRepository) EXPECT() *AnalyticsRepository_Expecter {
+	return &AnalyticsRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountSubscribersAt provides a mock function with given fields: ctx, params
+func (_m *AnalyticsRepository) CountSubscribersAt(ctx context.Context, params domain.CountSubscribersAtParams) (int, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CountSubscribersAtParams) (int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CountSubscribersAtParams) int); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.CountSubscribersAtParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnalyticsRepository_CountSubscribersAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSubscribersAt'
+type AnalyticsRepository_CountSubscribersAt_Call struct {
+	*mock.Call
+}
+
+// CountSubscribersAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.CountSubscribersAtParams
+func (_e *AnalyticsRepository_Expecter) CountSubscribersAt(ctx interface{}, params interface{}) *AnalyticsRepository_CountSubscribersAt_Call {
+	return &AnalyticsRepository_CountSubscribersAt_Call{Call: _e.mock.On("CountSubscribersAt", ctx, params)}
+}
+
+func (_c *AnalyticsRepository_CountSubscribersAt_Call) Run(run func(ctx context.Context, params domain.CountSubscribersAtParams)) *AnalyticsRepository_CountSubscribersAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.CountSubscribersAtParams))
+	})
+	return _c
+}
+
+func (_c *AnalyticsRepository_CountSubscribersAt_Call) Return(_a0 int, _a1 error) *AnalyticsRepository_CountSubscribersAt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnalyticsRepository_CountSubscribersAt_Call) RunAndReturn(run func(context.Context, domain.CountSubscribersAtParams) (int, error)) *AnalyticsRepository_CountSubscribersAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNewsDailyReaders provides a mock function with given fields: ctx, newsID
+func (_m *AnalyticsRepository) ListNewsDailyReaders(ctx context.Context, newsID int) ([]domain.NewsDailyReaders, error) {
+	ret := _m.Called(ctx, newsID)
+
+	var r0 []domain.NewsDailyReaders
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.NewsDailyReaders, error)); ok {
+		return rf(ctx, newsID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.NewsDailyReaders); ok {
+		r0 = rf(ctx, newsID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.NewsDailyReaders)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, newsID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnalyticsRepository_ListNewsDailyReaders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNewsDailyReaders'
+type AnalyticsRepository_ListNewsDailyReaders_Call struct {
+	*mock.Call
+}
+
+// ListNewsDailyReaders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newsID int
+func (_e *AnalyticsRepository_Expecter) ListNewsDailyReaders(ctx interface{}, newsID interface{}) *AnalyticsRepository_ListNewsDailyReaders_Call {
+	return &AnalyticsRepository_ListNewsDailyReaders_Call{Call: _e.mock.On("ListNewsDailyReaders", ctx, newsID)}
+}
+
+func (_c *AnalyticsRepository_ListNewsDailyReaders_Call) Run(run func(ctx context.Context, newsID int)) *AnalyticsRepository_ListNewsDailyReaders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *AnalyticsRepository_ListNewsDailyReaders_Call) Return(_a0 []domain.NewsDailyReaders, _a1 error) *AnalyticsRepository_ListNewsDailyReaders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnalyticsRepository_ListNewsDailyReaders_Call) RunAndReturn(run func(context.Context, int) ([]domain.NewsDailyReaders, error)) *AnalyticsRepository_ListNewsDailyReaders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSubscriptionDailyChanges provides a mock function with given fields: ctx, params
+func (_m *AnalyticsRepository) ListSubscriptionDailyChanges(ctx context.Context, params domain.ListSubscriptionDailyChangesParams) ([]domain.SubscriptionDailyChange, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.SubscriptionDailyChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListSubscriptionDailyChangesParams) ([]domain.SubscriptionDailyChange, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListSubscriptionDailyChangesParams) []domain.SubscriptionDailyChange); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SubscriptionDailyChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListSubscriptionDailyChangesParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnalyticsRepository_ListSubscriptionDailyChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubscriptionDailyChanges'
+type AnalyticsRepository_ListSubscriptionDailyChanges_Call struct {
+	*mock.Call
+}
+
+// ListSubscriptionDailyChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListSubscriptionDailyChangesParams
+func (_e *AnalyticsRepository_Expecter) ListSubscriptionDailyChanges(ctx interface{}, params interface{}) *AnalyticsRepository_ListSubscriptionDailyChanges_Call {
+	return &AnalyticsRepository_ListSubscriptionDailyChanges_Call{Call: _e.mock.On("ListSubscriptionDailyChanges", ctx, params)}
+}
+
+func (_c *AnalyticsRepository_ListSubscriptionDailyChanges_Call) Run(run func(ctx context.Context, params domain.ListSubscriptionDailyChangesParams)) *AnalyticsRepository_ListSubscriptionDailyChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListSubscriptionDailyChangesParams))
+	})
+	return _c
+}
+
+func (_c *AnalyticsRepository_ListSubscriptionDailyChanges_Call) Return(_a0 []domain.SubscriptionDailyChange, _a1 error) *AnalyticsRepository_ListSubscriptionDailyChanges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnalyticsRepository_ListSubscriptionDailyChanges_Call) RunAndReturn(run func(context.Context, domain.ListSubscriptionDailyChangesParams) ([]domain.SubscriptionDailyChange, error)) *AnalyticsRepository_ListSubscriptionDailyChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAnalyticsRepository creates a new instance of AnalyticsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnalyticsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AnalyticsRepository {
+	mock := &AnalyticsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
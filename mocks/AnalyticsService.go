@@ -0,0 +1,143 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AnalyticsService is an autogenerated mock type for the AnalyticsService type
+type AnalyticsService struct {
+	mock.Mock
+}
+
+type AnalyticsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AnalyticsService) EXPECT() *AnalyticsService_Expecter {
+	return &AnalyticsService_Expecter{mock: &_m.Mock}
+}
+
+// GetNewsAnalytics provides a mock function with given fields: ctx, req
+func (_m *AnalyticsService) GetNewsAnalytics(ctx context.Context, req domain.GetNewsAnalyticsRequest) (domain.NewsAnalyticsDTO, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.NewsAnalyticsDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetNewsAnalyticsRequest) (domain.NewsAnalyticsDTO, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetNewsAnalyticsRequest) domain.NewsAnalyticsDTO); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.NewsAnalyticsDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.GetNewsAnalyticsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnalyticsService_GetNewsAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNewsAnalytics'
+type AnalyticsService_GetNewsAnalytics_Call struct {
+	*mock.Call
+}
+
+// GetNewsAnalytics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.GetNewsAnalyticsRequest
+func (_e *AnalyticsService_Expecter) GetNewsAnalytics(ctx interface{}, req interface{}) *AnalyticsService_GetNewsAnalytics_Call {
+	return &AnalyticsService_GetNewsAnalytics_Call{Call: _e.mock.On("GetNewsAnalytics", ctx, req)}
+}
+
+func (_c *AnalyticsService_GetNewsAnalytics_Call) Run(run func(ctx context.Context, req domain.GetNewsAnalyticsRequest)) *AnalyticsService_GetNewsAnalytics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.GetNewsAnalyticsRequest))
+	})
+	return _c
+}
+
+func (_c *AnalyticsService_GetNewsAnalytics_Call) Return(_a0 domain.NewsAnalyticsDTO, _a1 error) *AnalyticsService_GetNewsAnalytics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnalyticsService_GetNewsAnalytics_Call) RunAndReturn(run func(context.Context, domain.GetNewsAnalyticsRequest) (domain.NewsAnalyticsDTO, error)) *AnalyticsService_GetNewsAnalytics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSchoolAnalytics provides a mock function with given fields: ctx, req
+func (_m *AnalyticsService) GetSchoolAnalytics(ctx context.Context, req domain.GetSchoolAnalyticsRequest) (domain.SchoolAnalyticsDTO, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.SchoolAnalyticsDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetSchoolAnalyticsRequest) (domain.SchoolAnalyticsDTO, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetSchoolAnalyticsRequest) domain.SchoolAnalyticsDTO); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.SchoolAnalyticsDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.GetSchoolAnalyticsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnalyticsService_GetSchoolAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSchoolAnalytics'
+type AnalyticsService_GetSchoolAnalytics_Call struct {
+	*mock.Call
+}
+
+// GetSchoolAnalytics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.GetSchoolAnalyticsRequest
+func (_e *AnalyticsService_Expecter) GetSchoolAnalytics(ctx interface{}, req interface{}) *AnalyticsService_GetSchoolAnalytics_Call {
+	return &AnalyticsService_GetSchoolAnalytics_Call{Call: _e.mock.On("GetSchoolAnalytics", ctx, req)}
+}
+
+func (_c *AnalyticsService_GetSchoolAnalytics_Call) Run(run func(ctx context.Context, req domain.GetSchoolAnalyticsRequest)) *AnalyticsService_GetSchoolAnalytics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.GetSchoolAnalyticsRequest))
+	})
+	return _c
+}
+
+func (_c *AnalyticsService_GetSchoolAnalytics_Call) Return(_a0 domain.SchoolAnalyticsDTO, _a1 error) *AnalyticsService_GetSchoolAnalytics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnalyticsService_GetSchoolAnalytics_Call) RunAndReturn(run func(context.Context, domain.GetSchoolAnalyticsRequest) (domain.SchoolAnalyticsDTO, error)) *AnalyticsService_GetSchoolAnalytics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAnalyticsService creates a new instance of AnalyticsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnalyticsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AnalyticsService {
+	mock := &AnalyticsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
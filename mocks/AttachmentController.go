@@ -0,0 +1,101 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttachmentController is an autogenerated mock type for the AttachmentController type
+type AttachmentController struct {
+	mock.Mock
+}
+
+type AttachmentController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AttachmentController) EXPECT() *AttachmentController_Expecter {
+	return &AttachmentController_Expecter{mock: &_m.Mock}
+}
+
+// DownloadAttachment provides a mock function with given fields: c
+func (_m *AttachmentController) DownloadAttachment(c *gin.Context) {
+	_m.Called(c)
+}
+
+// AttachmentController_DownloadAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadAttachment'
+type AttachmentController_DownloadAttachment_Call struct {
+	*mock.Call
+}
+
+// DownloadAttachment is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *AttachmentController_Expecter) DownloadAttachment(c interface{}) *AttachmentController_DownloadAttachment_Call {
+	return &AttachmentController_DownloadAttachment_Call{Call: _e.mock.On("DownloadAttachment", c)}
+}
+
+func (_c *AttachmentController_DownloadAttachment_Call) Run(run func(c *gin.Context)) *AttachmentController_DownloadAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *AttachmentController_DownloadAttachment_Call) Return() *AttachmentController_DownloadAttachment_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *AttachmentController_DownloadAttachment_Call) RunAndReturn(run func(*gin.Context)) *AttachmentController_DownloadAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadAttachment provides a mock function with given fields: c
+func (_m *AttachmentController) UploadAttachment(c *gin.Context) {
+	_m.Called(c)
+}
+
+// AttachmentController_UploadAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadAttachment'
+type AttachmentController_UploadAttachment_Call struct {
+	*mock.Call
+}
+
+// UploadAttachment is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *AttachmentController_Expecter) UploadAttachment(c interface{}) *AttachmentController_UploadAttachment_Call {
+	return &AttachmentController_UploadAttachment_Call{Call: _e.mock.On("UploadAttachment", c)}
+}
+
+func (_c *AttachmentController_UploadAttachment_Call) Run(run func(c *gin.Context)) *AttachmentController_UploadAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *AttachmentController_UploadAttachment_Call) Return() *AttachmentController_UploadAttachment_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *AttachmentController_UploadAttachment_Call) RunAndReturn(run func(*gin.Context)) *AttachmentController_UploadAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAttachmentController creates a new instance of AttachmentController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttachmentController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttachmentController {
+	mock := &AttachmentController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,243 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttachmentRepository is an autogenerated mock type for the AttachmentRepository type
+type AttachmentRepository struct {
+	mock.Mock
+}
+
+type AttachmentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AttachmentRepository) EXPECT() *AttachmentRepository_Expecter {
+	return &AttachmentRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateAttachment provides a mock function with given fields: ctx, attachment
+func (_m *AttachmentRepository) CreateAttachment(ctx context.Context, attachment domain.Attachment) (int, error) {
+	ret := _m.Called(ctx, attachment)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Attachment) (int, error)); ok {
+		return rf(ctx, attachment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Attachment) int); ok {
+		r0 = rf(ctx, attachment)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Attachment) error); ok {
+		r1 = rf(ctx, attachment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentRepository_CreateAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAttachment'
+type AttachmentRepository_CreateAttachment_Call struct {
+	*mock.Call
+}
+
+// CreateAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attachment domain.Attachment
+func (_e *AttachmentRepository_Expecter) CreateAttachment(ctx interface{}, attachment interface{}) *AttachmentRepository_CreateAttachment_Call {
+	return &AttachmentRepository_CreateAttachment_Call{Call: _e.mock.On("CreateAttachment", ctx, attachment)}
+}
+
+func (_c *AttachmentRepository_CreateAttachment_Call) Run(run func(ctx context.Context, attachment domain.Attachment)) *AttachmentRepository_CreateAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Attachment))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_CreateAttachment_Call) Return(_a0 int, _a1 error) *AttachmentRepository_CreateAttachment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentRepository_CreateAttachment_Call) RunAndReturn(run func(context.Context, domain.Attachment) (int, error)) *AttachmentRepository_CreateAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAttachmentsByNewsID provides a mock function with given fields: ctx, newsID
+func (_m *AttachmentRepository) DeleteAttachmentsByNewsID(ctx context.Context, newsID int) error {
+	ret := _m.Called(ctx, newsID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, newsID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachmentRepository_DeleteAttachmentsByNewsID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAttachmentsByNewsID'
+type AttachmentRepository_DeleteAttachmentsByNewsID_Call struct {
+	*mock.Call
+}
+
+// DeleteAttachmentsByNewsID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newsID int
+func (_e *AttachmentRepository_Expecter) DeleteAttachmentsByNewsID(ctx interface{}, newsID interface{}) *AttachmentRepository_DeleteAttachmentsByNewsID_Call {
+	return &AttachmentRepository_DeleteAttachmentsByNewsID_Call{Call: _e.mock.On("DeleteAttachmentsByNewsID", ctx, newsID)}
+}
+
+func (_c *AttachmentRepository_DeleteAttachmentsByNewsID_Call) Run(run func(ctx context.Context, newsID int)) *AttachmentRepository_DeleteAttachmentsByNewsID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_DeleteAttachmentsByNewsID_Call) Return(_a0 error) *AttachmentRepository_DeleteAttachmentsByNewsID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AttachmentRepository_DeleteAttachmentsByNewsID_Call) RunAndReturn(run func(context.Context, int) error) *AttachmentRepository_DeleteAttachmentsByNewsID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAttachmentByID provides a mock function with given fields: ctx, attachmentID
+func (_m *AttachmentRepository) FindAttachmentByID(ctx context.Context, attachmentID int) (*domain.Attachment, error) {
+	ret := _m.Called(ctx, attachmentID)
+
+	var r0 *domain.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Attachment, error)); ok {
+		return rf(ctx, attachmentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Attachment); ok {
+		r0 = rf(ctx, attachmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, attachmentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentRepository_FindAttachmentByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAttachmentByID'
+type AttachmentRepository_FindAttachmentByID_Call struct {
+	*mock.Call
+}
+
+// FindAttachmentByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attachmentID int
+func (_e *AttachmentRepository_Expecter) FindAttachmentByID(ctx interface{}, attachmentID interface{}) *AttachmentRepository_FindAttachmentByID_Call {
+	return &AttachmentRepository_FindAttachmentByID_Call{Call: _e.mock.On("FindAttachmentByID", ctx, attachmentID)}
+}
+
+func (_c *AttachmentRepository_FindAttachmentByID_Call) Run(run func(ctx context.Context, attachmentID int)) *AttachmentRepository_FindAttachmentByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_FindAttachmentByID_Call) Return(_a0 *domain.Attachment, _a1 error) *AttachmentRepository_FindAttachmentByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentRepository_FindAttachmentByID_Call) RunAndReturn(run func(context.Context, int) (*domain.Attachment, error)) *AttachmentRepository_FindAttachmentByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAttachmentsByNewsIDs provides a mock function with given fields: ctx, newsIDs
+func (_m *AttachmentRepository) ListAttachmentsByNewsIDs(ctx context.Context, newsIDs []int) ([]domain.Attachment, error) {
+	ret := _m.Called(ctx, newsIDs)
+
+	var r0 []domain.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) ([]domain.Attachment, error)); ok {
+		return rf(ctx, newsIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) []domain.Attachment); ok {
+		r0 = rf(ctx, newsIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, newsIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentRepository_ListAttachmentsByNewsIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAttachmentsByNewsIDs'
+type AttachmentRepository_ListAttachmentsByNewsIDs_Call struct {
+	*mock.Call
+}
+
+// ListAttachmentsByNewsIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newsIDs []int
+func (_e *AttachmentRepository_Expecter) ListAttachmentsByNewsIDs(ctx interface{}, newsIDs interface{}) *AttachmentRepository_ListAttachmentsByNewsIDs_Call {
+	return &AttachmentRepository_ListAttachmentsByNewsIDs_Call{Call: _e.mock.On("ListAttachmentsByNewsIDs", ctx, newsIDs)}
+}
+
+func (_c *AttachmentRepository_ListAttachmentsByNewsIDs_Call) Run(run func(ctx context.Context, newsIDs []int)) *AttachmentRepository_ListAttachmentsByNewsIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_ListAttachmentsByNewsIDs_Call) Return(_a0 []domain.Attachment, _a1 error) *AttachmentRepository_ListAttachmentsByNewsIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentRepository_ListAttachmentsByNewsIDs_Call) RunAndReturn(run func(context.Context, []int) ([]domain.Attachment, error)) *AttachmentRepository_ListAttachmentsByNewsIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAttachmentRepository creates a new instance of AttachmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttachmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttachmentRepository {
+	mock := &AttachmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,241 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttachmentService is an autogenerated mock type for the AttachmentService type
+type AttachmentService struct {
+	mock.Mock
+}
+
+type AttachmentService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AttachmentService) EXPECT() *AttachmentService_Expecter {
+	return &AttachmentService_Expecter{mock: &_m.Mock}
+}
+
+// DeleteNewsAttachments provides a mock function with given fields: ctx, newsID
+func (_m *AttachmentService) DeleteNewsAttachments(ctx context.Context, newsID int) error {
+	ret := _m.Called(ctx, newsID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, newsID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachmentService_DeleteNewsAttachments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteNewsAttachments'
+type AttachmentService_DeleteNewsAttachments_Call struct {
+	*mock.Call
+}
+
+// DeleteNewsAttachments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newsID int
+func (_e *AttachmentService_Expecter) DeleteNewsAttachments(ctx interface{}, newsID interface{}) *AttachmentService_DeleteNewsAttachments_Call {
+	return &AttachmentService_DeleteNewsAttachments_Call{Call: _e.mock.On("DeleteNewsAttachments", ctx, newsID)}
+}
+
+func (_c *AttachmentService_DeleteNewsAttachments_Call) Run(run func(ctx context.Context, newsID int)) *AttachmentService_DeleteNewsAttachments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *AttachmentService_DeleteNewsAttachments_Call) Return(_a0 error) *AttachmentService_DeleteNewsAttachments_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AttachmentService_DeleteNewsAttachments_Call) RunAndReturn(run func(context.Context, int) error) *AttachmentService_DeleteNewsAttachments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DownloadAttachment provides a mock function with given fields: ctx, req
+func (_m *AttachmentService) DownloadAttachment(ctx context.Context, req domain.DownloadAttachmentRequest) (domain.AttachmentFile, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.AttachmentFile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DownloadAttachmentRequest) (domain.AttachmentFile, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DownloadAttachmentRequest) domain.AttachmentFile); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.AttachmentFile)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.DownloadAttachmentRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentService_DownloadAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadAttachment'
+type AttachmentService_DownloadAttachment_Call struct {
+	*mock.Call
+}
+
+// DownloadAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.DownloadAttachmentRequest
+func (_e *AttachmentService_Expecter) DownloadAttachment(ctx interface{}, req interface{}) *AttachmentService_DownloadAttachment_Call {
+	return &AttachmentService_DownloadAttachment_Call{Call: _e.mock.On("DownloadAttachment", ctx, req)}
+}
+
+func (_c *AttachmentService_DownloadAttachment_Call) Run(run func(ctx context.Context, req domain.DownloadAttachmentRequest)) *AttachmentService_DownloadAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DownloadAttachmentRequest))
+	})
+	return _c
+}
+
+func (_c *AttachmentService_DownloadAttachment_Call) Return(_a0 domain.AttachmentFile, _a1 error) *AttachmentService_DownloadAttachment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentService_DownloadAttachment_Call) RunAndReturn(run func(context.Context, domain.DownloadAttachmentRequest) (domain.AttachmentFile, error)) *AttachmentService_DownloadAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNewsAttachments provides a mock function with given fields: ctx, newsIDs
+func (_m *AttachmentService) ListNewsAttachments(ctx context.Context, newsIDs []int) (map[int][]domain.AttachmentDTO, error) {
+	ret := _m.Called(ctx, newsIDs)
+
+	var r0 map[int][]domain.AttachmentDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) (map[int][]domain.AttachmentDTO, error)); ok {
+		return rf(ctx, newsIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) map[int][]domain.AttachmentDTO); ok {
+		r0 = rf(ctx, newsIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int][]domain.AttachmentDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, newsIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentService_ListNewsAttachments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNewsAttachments'
+type AttachmentService_ListNewsAttachments_Call struct {
+	*mock.Call
+}
+
+// ListNewsAttachments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newsIDs []int
+func (_e *AttachmentService_Expecter) ListNewsAttachments(ctx interface{}, newsIDs interface{}) *AttachmentService_ListNewsAttachments_Call {
+	return &AttachmentService_ListNewsAttachments_Call{Call: _e.mock.On("ListNewsAttachments", ctx, newsIDs)}
+}
+
+func (_c *AttachmentService_ListNewsAttachments_Call) Run(run func(ctx context.Context, newsIDs []int)) *AttachmentService_ListNewsAttachments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *AttachmentService_ListNewsAttachments_Call) Return(_a0 map[int][]domain.AttachmentDTO, _a1 error) *AttachmentService_ListNewsAttachments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentService_ListNewsAttachments_Call) RunAndReturn(run func(context.Context, []int) (map[int][]domain.AttachmentDTO, error)) *AttachmentService_ListNewsAttachments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadAttachment provides a mock function with given fields: ctx, req
+func (_m *AttachmentService) UploadAttachment(ctx context.Context, req domain.UploadAttachmentRequest) (domain.AttachmentDTO, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.AttachmentDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UploadAttachmentRequest) (domain.AttachmentDTO, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UploadAttachmentRequest) domain.AttachmentDTO); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.AttachmentDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.UploadAttachmentRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentService_UploadAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadAttachment'
+type AttachmentService_UploadAttachment_Call struct {
+	*mock.Call
+}
+
+// UploadAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.UploadAttachmentRequest
+func (_e *AttachmentService_Expecter) UploadAttachment(ctx interface{}, req interface{}) *AttachmentService_UploadAttachment_Call {
+	return &AttachmentService_UploadAttachment_Call{Call: _e.mock.On("UploadAttachment", ctx, req)}
+}
+
+func (_c *AttachmentService_UploadAttachment_Call) Run(run func(ctx context.Context, req domain.UploadAttachmentRequest)) *AttachmentService_UploadAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UploadAttachmentRequest))
+	})
+	return _c
+}
+
+func (_c *AttachmentService_UploadAttachment_Call) Return(_a0 domain.AttachmentDTO, _a1 error) *AttachmentService_UploadAttachment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentService_UploadAttachment_Call) RunAndReturn(run func(context.Context, domain.UploadAttachmentRequest) (domain.AttachmentDTO, error)) *AttachmentService_UploadAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAttachmentService creates a new instance of AttachmentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttachmentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttachmentService {
+	mock := &AttachmentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
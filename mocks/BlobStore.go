@@ -0,0 +1,190 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BlobStore is an autogenerated mock type for the BlobStore type
+type BlobStore struct {
+	mock.Mock
+}
+
+type BlobStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BlobStore) EXPECT() *BlobStore_Expecter {
+	return &BlobStore_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, key
+func (_m *BlobStore) Delete(ctx context.Context, key string) error {
+	ret := _m.Called(ctx, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BlobStore_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type BlobStore_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *BlobStore_Expecter) Delete(ctx interface{}, key interface{}) *BlobStore_Delete_Call {
+	return &BlobStore_Delete_Call{Call: _e.mock.On("Delete", ctx, key)}
+}
+
+func (_c *BlobStore_Delete_Call) Run(run func(ctx context.Context, key string)) *BlobStore_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *BlobStore_Delete_Call) Return(_a0 error) *BlobStore_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BlobStore_Delete_Call) RunAndReturn(run func(context.Context, string) error) *BlobStore_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Open provides a mock function with given fields: ctx, key
+func (_m *BlobStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	ret := _m.Called(ctx, key)
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (io.ReadCloser, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) io.ReadCloser); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlobStore_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type BlobStore_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *BlobStore_Expecter) Open(ctx interface{}, key interface{}) *BlobStore_Open_Call {
+	return &BlobStore_Open_Call{Call: _e.mock.On("Open", ctx, key)}
+}
+
+func (_c *BlobStore_Open_Call) Run(run func(ctx context.Context, key string)) *BlobStore_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *BlobStore_Open_Call) Return(_a0 io.ReadCloser, _a1 error) *BlobStore_Open_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlobStore_Open_Call) RunAndReturn(run func(context.Context, string) (io.ReadCloser, error)) *BlobStore_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Put provides a mock function with given fields: ctx, key, r
+func (_m *BlobStore) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	ret := _m.Called(ctx, key, r)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader) (int64, error)); ok {
+		return rf(ctx, key, r)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader) int64); ok {
+		r0 = rf(ctx, key, r)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, io.Reader) error); ok {
+		r1 = rf(ctx, key, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlobStore_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type BlobStore_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - r io.Reader
+func (_e *BlobStore_Expecter) Put(ctx interface{}, key interface{}, r interface{}) *BlobStore_Put_Call {
+	return &BlobStore_Put_Call{Call: _e.mock.On("Put", ctx, key, r)}
+}
+
+func (_c *BlobStore_Put_Call) Run(run func(ctx context.Context, key string, r io.Reader)) *BlobStore_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *BlobStore_Put_Call) Return(_a0 int64, _a1 error) *BlobStore_Put_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlobStore_Put_Call) RunAndReturn(run func(context.Context, string, io.Reader) (int64, error)) *BlobStore_Put_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBlobStore creates a new instance of BlobStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBlobStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BlobStore {
+	mock := &BlobStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,200 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CommentController is an autogenerated mock type for the CommentController type
+type CommentController struct {
+	mock.Mock
+}
+
+type CommentController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CommentController) EXPECT() *CommentController_Expecter {
+	return &CommentController_Expecter{mock: &_m.Mock}
+}
+
+// CreateComment provides a mock function with given fields: c
+func (_m *CommentController) CreateComment(c *gin.Context) {
+	_m.Called(c)
+}
+
+// CommentController_CreateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComment'
+type CommentController_CreateComment_Call struct {
+	*mock.Call
+}
+
+// CreateComment is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *CommentController_Expecter) CreateComment(c interface{}) *CommentController_CreateComment_Call {
+	return &CommentController_CreateComment_Call{Call: _e.mock.On("CreateComment", c)}
+}
+
+func (_c *CommentController_CreateComment_Call) Run(run func(c *gin.Context)) *CommentController_CreateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *CommentController_CreateComment_Call) Return() *CommentController_CreateComment_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CommentController_CreateComment_Call) RunAndReturn(run func(*gin.Context)) *CommentController_CreateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteComment provides a mock function with given fields: c
+func (_m *CommentController) DeleteComment(c *gin.Context) {
+	_m.Called(c)
+}
+
+// CommentController_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type CommentController_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *CommentController_Expecter) DeleteComment(c interface{}) *CommentController_DeleteComment_Call {
+	return &CommentController_DeleteComment_Call{Call: _e.mock.On("DeleteComment", c)}
+}
+
+func (_c *CommentController_DeleteComment_Call) Run(run func(c *gin.Context)) *CommentController_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *CommentController_DeleteComment_Call) Return() *CommentController_DeleteComment_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CommentController_DeleteComment_Call) RunAndReturn(run func(*gin.Context)) *CommentController_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HideComment provides a mock function with given fields: c
+func (_m *CommentController) HideComment(c *gin.Context) {
+	_m.Called(c)
+}
+
+// CommentController_HideComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HideComment'
+type CommentController_HideComment_Call struct {
+	*mock.Call
+}
+
+// HideComment is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *CommentController_Expecter) HideComment(c interface{}) *CommentController_HideComment_Call {
+	return &CommentController_HideComment_Call{Call: _e.mock.On("HideComment", c)}
+}
+
+func (_c *CommentController_HideComment_Call) Run(run func(c *gin.Context)) *CommentController_HideComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *CommentController_HideComment_Call) Return() *CommentController_HideComment_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CommentController_HideComment_Call) RunAndReturn(run func(*gin.Context)) *CommentController_HideComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListComments provides a mock function with given fields: c
+func (_m *CommentController) ListComments(c *gin.Context) {
+	_m.Called(c)
+}
+
+// CommentController_ListComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListComments'
+type CommentController_ListComments_Call struct {
+	*mock.Call
+}
+
+// ListComments is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *CommentController_Expecter) ListComments(c interface{}) *CommentController_ListComments_Call {
+	return &CommentController_ListComments_Call{Call: _e.mock.On("ListComments", c)}
+}
+
+func (_c *CommentController_ListComments_Call) Run(run func(c *gin.Context)) *CommentController_ListComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *CommentController_ListComments_Call) Return() *CommentController_ListComments_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CommentController_ListComments_Call) RunAndReturn(run func(*gin.Context)) *CommentController_ListComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCommentSetting provides a mock function with given fields: c
+func (_m *CommentController) UpdateCommentSetting(c *gin.Context) {
+	_m.Called(c)
+}
+
+// CommentController_UpdateCommentSetting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCommentSetting'
+type CommentController_UpdateCommentSetting_Call struct {
+	*mock.Call
+}
+
+// UpdateCommentSetting is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *CommentController_Expecter) UpdateCommentSetting(c interface{}) *CommentController_UpdateCommentSetting_Call {
+	return &CommentController_UpdateCommentSetting_Call{Call: _e.mock.On("UpdateCommentSetting", c)}
+}
+
+func (_c *CommentController_UpdateCommentSetting_Call) Run(run func(c *gin.Context)) *CommentController_UpdateCommentSetting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *CommentController_UpdateCommentSetting_Call) Return() *CommentController_UpdateCommentSetting_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CommentController_UpdateCommentSetting_Call) RunAndReturn(run func(*gin.Context)) *CommentController_UpdateCommentSetting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCommentController creates a new instance of CommentController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCommentController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CommentController {
+	mock := &CommentController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
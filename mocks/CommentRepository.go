@@ -0,0 +1,382 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CommentRepository is an autogenerated mock type for the CommentRepository type
+type CommentRepository struct {
+	mock.Mock
+}
+
+type CommentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CommentRepository) EXPECT() *CommentRepository_Expecter {
+	return &CommentRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateComment provides a mock function with given fields: ctx, comment
+func (_m *CommentRepository) CreateComment(ctx context.Context, comment domain.Comment) (int, error) {
+	ret := _m.Called(ctx, comment)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Comment) (int, error)); ok {
+		return rf(ctx, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Comment) int); ok {
+		r0 = rf(ctx, comment)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Comment) error); ok {
+		r1 = rf(ctx, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentRepository_CreateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComment'
+type CommentRepository_CreateComment_Call struct {
+	*mock.Call
+}
+
+// CreateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - comment domain.Comment
+func (_e *CommentRepository_Expecter) CreateComment(ctx interface{}, comment interface{}) *CommentRepository_CreateComment_Call {
+	return &CommentRepository_CreateComment_Call{Call: _e.mock.On("CreateComment", ctx, comment)}
+}
+
+func (_c *CommentRepository_CreateComment_Call) Run(run func(ctx context.Context, comment domain.Comment)) *CommentRepository_CreateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Comment))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_CreateComment_Call) Return(_a0 int, _a1 error) *CommentRepository_CreateComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentRepository_CreateComment_Call) RunAndReturn(run func(context.Context, domain.Comment) (int, error)) *CommentRepository_CreateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteComment provides a mock function with given fields: ctx, commentID
+func (_m *CommentRepository) DeleteComment(ctx context.Context, commentID int) error {
+	ret := _m.Called(ctx, commentID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CommentRepository_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type CommentRepository_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int
+func (_e *CommentRepository_Expecter) DeleteComment(ctx interface{}, commentID interface{}) *CommentRepository_DeleteComment_Call {
+	return &CommentRepository_DeleteComment_Call{Call: _e.mock.On("DeleteComment", ctx, commentID)}
+}
+
+func (_c *CommentRepository_DeleteComment_Call) Run(run func(ctx context.Context, commentID int)) *CommentRepository_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_DeleteComment_Call) Return(_a0 error) *CommentRepository_DeleteComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CommentRepository_DeleteComment_Call) RunAndReturn(run func(context.Context, int) error) *CommentRepository_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCommentByID provides a mock function with given fields: ctx, commentID
+func (_m *CommentRepository) FindCommentByID(ctx context.Context, commentID int) (*domain.Comment, error) {
+	ret := _m.Called(ctx, commentID)
+
+	var r0 *domain.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Comment, error)); ok {
+		return rf(ctx, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Comment); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentRepository_FindCommentByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCommentByID'
+type CommentRepository_FindCommentByID_Call struct {
+	*mock.Call
+}
+
+// FindCommentByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID int
+func (_e *CommentRepository_Expecter) FindCommentByID(ctx interface{}, commentID interface{}) *CommentRepository_FindCommentByID_Call {
+	return &CommentRepository_FindCommentByID_Call{Call: _e.mock.On("FindCommentByID", ctx, commentID)}
+}
+
+func (_c *CommentRepository_FindCommentByID_Call) Run(run func(ctx context.Context, commentID int)) *CommentRepository_FindCommentByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_FindCommentByID_Call) Return(_a0 *domain.Comment, _a1 error) *CommentRepository_FindCommentByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentRepository_FindCommentByID_Call) RunAndReturn(run func(context.Context, int) (*domain.Comment, error)) *CommentRepository_FindCommentByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCommentsEnabled provides a mock function with given fields: ctx, newsID
+func (_m *CommentRepository) FindCommentsEnabled(ctx context.Context, newsID int) (bool, error) {
+	ret := _m.Called(ctx, newsID)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (bool, error)); ok {
+		return rf(ctx, newsID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) bool); ok {
+		r0 = rf(ctx, newsID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, newsID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentRepository_FindCommentsEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCommentsEnabled'
+type CommentRepository_FindCommentsEnabled_Call struct {
+	*mock.Call
+}
+
+// FindCommentsEnabled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newsID int
+func (_e *CommentRepository_Expecter) FindCommentsEnabled(ctx interface{}, newsID interface{}) *CommentRepository_FindCommentsEnabled_Call {
+	return &CommentRepository_FindCommentsEnabled_Call{Call: _e.mock.On("FindCommentsEnabled", ctx, newsID)}
+}
+
+func (_c *CommentRepository_FindCommentsEnabled_Call) Run(run func(ctx context.Context, newsID int)) *CommentRepository_FindCommentsEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_FindCommentsEnabled_Call) Return(_a0 bool, _a1 error) *CommentRepository_FindCommentsEnabled_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentRepository_FindCommentsEnabled_Call) RunAndReturn(run func(context.Context, int) (bool, error)) *CommentRepository_FindCommentsEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListComments provides a mock function with given fields: ctx, params
+func (_m *CommentRepository) ListComments(ctx context.Context, params domain.ListCommentsParams) ([]domain.Comment, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListCommentsParams) ([]domain.Comment, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListCommentsParams) []domain.Comment); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListCommentsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentRepository_ListComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListComments'
+type CommentRepository_ListComments_Call struct {
+	*mock.Call
+}
+
+// ListComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListCommentsParams
+func (_e *CommentRepository_Expecter) ListComments(ctx interface{}, params interface{}) *CommentRepository_ListComments_Call {
+	return &CommentRepository_ListComments_Call{Call: _e.mock.On("ListComments", ctx, params)}
+}
+
+func (_c *CommentRepository_ListComments_Call) Run(run func(ctx context.Context, params domain.ListCommentsParams)) *CommentRepository_ListComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListCommentsParams))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_ListComments_Call) Return(_a0 []domain.Comment, _a1 error) *CommentRepository_ListComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentRepository_ListComments_Call) RunAndReturn(run func(context.Context, domain.ListCommentsParams) ([]domain.Comment, error)) *CommentRepository_ListComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCommentHidden provides a mock function with given fields: ctx, params
+func (_m *CommentRepository) UpdateCommentHidden(ctx context.Context, params domain.UpdateCommentHiddenParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UpdateCommentHiddenParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CommentRepository_UpdateCommentHidden_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCommentHidden'
+type CommentRepository_UpdateCommentHidden_Call struct {
+	*mock.Call
+}
+
+// UpdateCommentHidden is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.UpdateCommentHiddenParams
+func (_e *CommentRepository_Expecter) UpdateCommentHidden(ctx interface{}, params interface{}) *CommentRepository_UpdateCommentHidden_Call {
+	return &CommentRepository_UpdateCommentHidden_Call{Call: _e.mock.On("UpdateCommentHidden", ctx, params)}
+}
+
+func (_c *CommentRepository_UpdateCommentHidden_Call) Run(run func(ctx context.Context, params domain.UpdateCommentHiddenParams)) *CommentRepository_UpdateCommentHidden_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UpdateCommentHiddenParams))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_UpdateCommentHidden_Call) Return(_a0 error) *CommentRepository_UpdateCommentHidden_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CommentRepository_UpdateCommentHidden_Call) RunAndReturn(run func(context.Context, domain.UpdateCommentHiddenParams) error) *CommentRepository_UpdateCommentHidden_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCommentsEnabled provides a mock function with given fields: ctx, params
+func (_m *CommentRepository) UpdateCommentsEnabled(ctx context.Context, params domain.UpdateCommentsEnabledParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UpdateCommentsEnabledParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CommentRepository_UpdateCommentsEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCommentsEnabled'
+type CommentRepository_UpdateCommentsEnabled_Call struct {
+	*mock.Call
+}
+
+// UpdateCommentsEnabled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.UpdateCommentsEnabledParams
+func (_e *CommentRepository_Expecter) UpdateCommentsEnabled(ctx interface{}, params interface{}) *CommentRepository_UpdateCommentsEnabled_Call {
+	return &CommentRepository_UpdateCommentsEnabled_Call{Call: _e.mock.On("UpdateCommentsEnabled", ctx, params)}
+}
+
+func (_c *CommentRepository_UpdateCommentsEnabled_Call) Run(run func(ctx context.Context, params domain.UpdateCommentsEnabledParams)) *CommentRepository_UpdateCommentsEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UpdateCommentsEnabledParams))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_UpdateCommentsEnabled_Call) Return(_a0 error) *CommentRepository_UpdateCommentsEnabled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CommentRepository_UpdateCommentsEnabled_Call) RunAndReturn(run func(context.Context, domain.UpdateCommentsEnabledParams) error) *CommentRepository_UpdateCommentsEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCommentRepository creates a new instance of CommentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCommentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CommentRepository {
+	mock := &CommentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,262 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CommentService is an autogenerated mock type for the CommentService type
+type CommentService struct {
+	mock.Mock
+}
+
+type CommentService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CommentService) EXPECT() *CommentService_Expecter {
+	return &CommentService_Expecter{mock: &_m.Mock}
+}
+
+// CreateComment provides a mock function with given fields: ctx, req
+func (_m *CommentService) CreateComment(ctx context.Context, req domain.CreateCommentRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateCommentRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CommentService_CreateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComment'
+type CommentService_CreateComment_Call struct {
+	*mock.Call
+}
+
+// CreateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.CreateCommentRequest
+func (_e *CommentService_Expecter) CreateComment(ctx interface{}, req interface{}) *CommentService_CreateComment_Call {
+	return &CommentService_CreateComment_Call{Call: _e.mock.On("CreateComment", ctx, req)}
+}
+
+func (_c *CommentService_CreateComment_Call) Run(run func(ctx context.Context, req domain.CreateCommentRequest)) *CommentService_CreateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.CreateCommentRequest))
+	})
+	return _c
+}
+
+func (_c *CommentService_CreateComment_Call) Return(_a0 error) *CommentService_CreateComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CommentService_CreateComment_Call) RunAndReturn(run func(context.Context, domain.CreateCommentRequest) error) *CommentService_CreateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteComment provides a mock function with given fields: ctx, req
+func (_m *CommentService) DeleteComment(ctx context.Context, req domain.DeleteCommentRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DeleteCommentRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CommentService_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type CommentService_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.DeleteCommentRequest
+func (_e *CommentService_Expecter) DeleteComment(ctx interface{}, req interface{}) *CommentService_DeleteComment_Call {
+	return &CommentService_DeleteComment_Call{Call: _e.mock.On("DeleteComment", ctx, req)}
+}
+
+func (_c *CommentService_DeleteComment_Call) Run(run func(ctx context.Context, req domain.DeleteCommentRequest)) *CommentService_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DeleteCommentRequest))
+	})
+	return _c
+}
+
+func (_c *CommentService_DeleteComment_Call) Return(_a0 error) *CommentService_DeleteComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CommentService_DeleteComment_Call) RunAndReturn(run func(context.Context, domain.DeleteCommentRequest) error) *CommentService_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HideComment provides a mock function with given fields: ctx, req
+func (_m *CommentService) HideComment(ctx context.Context, req domain.HideCommentRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.HideCommentRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CommentService_HideComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HideComment'
+type CommentService_HideComment_Call struct {
+	*mock.Call
+}
+
+// HideComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.HideCommentRequest
+func (_e *CommentService_Expecter) HideComment(ctx interface{}, req interface{}) *CommentService_HideComment_Call {
+	return &CommentService_HideComment_Call{Call: _e.mock.On("HideComment", ctx, req)}
+}
+
+func (_c *CommentService_HideComment_Call) Run(run func(ctx context.Context, req domain.HideCommentRequest)) *CommentService_HideComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.HideCommentRequest))
+	})
+	return _c
+}
+
+func (_c *CommentService_HideComment_Call) Return(_a0 error) *CommentService_HideComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CommentService_HideComment_Call) RunAndReturn(run func(context.Context, domain.HideCommentRequest) error) *CommentService_HideComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListComments provides a mock function with given fields: ctx, req
+func (_m *CommentService) ListComments(ctx context.Context, req domain.ListCommentsRequest) (domain.ListCommentsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ListCommentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListCommentsRequest) (domain.ListCommentsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListCommentsRequest) domain.ListCommentsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ListCommentsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListCommentsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentService_ListComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListComments'
+type CommentService_ListComments_Call struct {
+	*mock.Call
+}
+
+// ListComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ListCommentsRequest
+func (_e *CommentService_Expecter) ListComments(ctx interface{}, req interface{}) *CommentService_ListComments_Call {
+	return &CommentService_ListComments_Call{Call: _e.mock.On("ListComments", ctx, req)}
+}
+
+func (_c *CommentService_ListComments_Call) Run(run func(ctx context.Context, req domain.ListCommentsRequest)) *CommentService_ListComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListCommentsRequest))
+	})
+	return _c
+}
+
+func (_c *CommentService_ListComments_Call) Return(_a0 domain.ListCommentsResponse, _a1 error) *CommentService_ListComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentService_ListComments_Call) RunAndReturn(run func(context.Context, domain.ListCommentsRequest) (domain.ListCommentsResponse, error)) *CommentService_ListComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCommentSetting provides a mock function with given fields: ctx, req
+func (_m *CommentService) UpdateCommentSetting(ctx context.Context, req domain.UpdateCommentSettingRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UpdateCommentSettingRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CommentService_UpdateCommentSetting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCommentSetting'
+type CommentService_UpdateCommentSetting_Call struct {
+	*mock.Call
+}
+
+// UpdateCommentSetting is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.UpdateCommentSettingRequest
+func (_e *CommentService_Expecter) UpdateCommentSetting(ctx interface{}, req interface{}) *CommentService_UpdateCommentSetting_Call {
+	return &CommentService_UpdateCommentSetting_Call{Call: _e.mock.On("UpdateCommentSetting", ctx, req)}
+}
+
+func (_c *CommentService_UpdateCommentSetting_Call) Run(run func(ctx context.Context, req domain.UpdateCommentSettingRequest)) *CommentService_UpdateCommentSetting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UpdateCommentSettingRequest))
+	})
+	return _c
+}
+
+func (_c *CommentService_UpdateCommentSetting_Call) Return(_a0 error) *CommentService_UpdateCommentSetting_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CommentService_UpdateCommentSetting_Call) RunAndReturn(run func(context.Context, domain.UpdateCommentSettingRequest) error) *CommentService_UpdateCommentSetting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCommentService creates a new instance of CommentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCommentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CommentService {
+	mock := &CommentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,231 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GatewaySession is an autogenerated mock type for the GatewaySession type
+type GatewaySession struct {
+	mock.Mock
+}
+
+type GatewaySession_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GatewaySession) EXPECT() *GatewaySession_Expecter {
+	return &GatewaySession_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with given fields:
+func (_m *GatewaySession) Close() {
+	_m.Called()
+}
+
+// GatewaySession_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type GatewaySession_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *GatewaySession_Expecter) Close() *GatewaySession_Close_Call {
+	return &GatewaySession_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *GatewaySession_Close_Call) Run(run func()) *GatewaySession_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GatewaySession_Close_Call) Return() *GatewaySession_Close_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *GatewaySession_Close_Call) RunAndReturn(run func()) *GatewaySession_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Join provides a mock function with given fields: ctx, schoolID
+func (_m *GatewaySession) Join(ctx context.Context, schoolID int) error {
+	ret := _m.Called(ctx, schoolID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, schoolID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GatewaySession_Join_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Join'
+type GatewaySession_Join_Call struct {
+	*mock.Call
+}
+
+// Join is a helper method to define mock.On call
+//   - ctx context.Context
+//   - schoolID int
+func (_e *GatewaySession_Expecter) Join(ctx interface{}, schoolID interface{}) *GatewaySession_Join_Call {
+	return &GatewaySession_Join_Call{Call: _e.mock.On("Join", ctx, schoolID)}
+}
+
+func (_c *GatewaySession_Join_Call) Run(run func(ctx context.Context, schoolID int)) *GatewaySession_Join_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *GatewaySession_Join_Call) Return(_a0 error) *GatewaySession_Join_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GatewaySession_Join_Call) RunAndReturn(run func(context.Context, int) error) *GatewaySession_Join_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Leave provides a mock function with given fields: schoolID
+func (_m *GatewaySession) Leave(schoolID int) {
+	_m.Called(schoolID)
+}
+
+// GatewaySession_Leave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Leave'
+type GatewaySession_Leave_Call struct {
+	*mock.Call
+}
+
+// Leave is a helper method to define mock.On call
+//   - schoolID int
+func (_e *GatewaySession_Expecter) Leave(schoolID interface{}) *GatewaySession_Leave_Call {
+	return &GatewaySession_Leave_Call{Call: _e.mock.On("Leave", schoolID)}
+}
+
+func (_c *GatewaySession_Leave_Call) Run(run func(schoolID int)) *GatewaySession_Leave_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *GatewaySession_Leave_Call) Return() *GatewaySession_Leave_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *GatewaySession_Leave_Call) RunAndReturn(run func(int)) *GatewaySession_Leave_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewsEvents provides a mock function with given fields:
+func (_m *GatewaySession) NewsEvents() <-chan domain.NewsEvent {
+	ret := _m.Called()
+
+	var r0 <-chan domain.NewsEvent
+	if rf, ok := ret.Get(0).(func() <-chan domain.NewsEvent); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan domain.NewsEvent)
+		}
+	}
+
+	return r0
+}
+
+// GatewaySession_NewsEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewsEvents'
+type GatewaySession_NewsEvents_Call struct {
+	*mock.Call
+}
+
+// NewsEvents is a helper method to define mock.On call
+func (_e *GatewaySession_Expecter) NewsEvents() *GatewaySession_NewsEvents_Call {
+	return &GatewaySession_NewsEvents_Call{Call: _e.mock.On("NewsEvents")}
+}
+
+func (_c *GatewaySession_NewsEvents_Call) Run(run func()) *GatewaySession_NewsEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GatewaySession_NewsEvents_Call) Return(_a0 <-chan domain.NewsEvent) *GatewaySession_NewsEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GatewaySession_NewsEvents_Call) RunAndReturn(run func() <-chan domain.NewsEvent) *GatewaySession_NewsEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscriptionEvents provides a mock function with given fields:
+func (_m *GatewaySession) SubscriptionEvents() <-chan domain.SubscriptionEvent {
+	ret := _m.Called()
+
+	var r0 <-chan domain.SubscriptionEvent
+	if rf, ok := ret.Get(0).(func() <-chan domain.SubscriptionEvent); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan domain.SubscriptionEvent)
+		}
+	}
+
+	return r0
+}
+
+// GatewaySession_SubscriptionEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscriptionEvents'
+type GatewaySession_SubscriptionEvents_Call struct {
+	*mock.Call
+}
+
+// SubscriptionEvents is a helper method to define mock.On call
+func (_e *GatewaySession_Expecter) SubscriptionEvents() *GatewaySession_SubscriptionEvents_Call {
+	return &GatewaySession_SubscriptionEvents_Call{Call: _e.mock.On("SubscriptionEvents")}
+}
+
+func (_c *GatewaySession_SubscriptionEvents_Call) Run(run func()) *GatewaySession_SubscriptionEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GatewaySession_SubscriptionEvents_Call) Return(_a0 <-chan domain.SubscriptionEvent) *GatewaySession_SubscriptionEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GatewaySession_SubscriptionEvents_Call) RunAndReturn(run func() <-chan domain.SubscriptionEvent) *GatewaySession_SubscriptionEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewGatewaySession creates a new instance of GatewaySession. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGatewaySession(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GatewaySession {
+	mock := &GatewaySession{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -86,6 +86,39 @@ func (_c *NewsController_DeleteNews_Call) RunAndReturn(run func(*gin.Context)) *
 	return _c
 }
 
+// DiffNewsRevisions provides a mock function with given fields: c
+func (_m *NewsController) DiffNewsRevisions(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewsController_DiffNewsRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffNewsRevisions'
+type NewsController_DiffNewsRevisions_Call struct {
+	*mock.Call
+}
+
+// DiffNewsRevisions is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *NewsController_Expecter) DiffNewsRevisions(c interface{}) *NewsController_DiffNewsRevisions_Call {
+	return &NewsController_DiffNewsRevisions_Call{Call: _e.mock.On("DiffNewsRevisions", c)}
+}
+
+func (_c *NewsController_DiffNewsRevisions_Call) Run(run func(c *gin.Context)) *NewsController_DiffNewsRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *NewsController_DiffNewsRevisions_Call) Return() *NewsController_DiffNewsRevisions_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *NewsController_DiffNewsRevisions_Call) RunAndReturn(run func(*gin.Context)) *NewsController_DiffNewsRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListNews provides a mock function with given fields: c
 func (_m *NewsController) ListNews(c *gin.Context) {
 	_m.Called(c)
@@ -119,6 +152,138 @@ func (_c *NewsController_ListNews_Call) RunAndReturn(run func(*gin.Context)) *Ne
 	return _c
 }
 
+// ListNewsRevisions provides a mock function with given fields: c
+func (_m *NewsController) ListNewsRevisions(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewsController_ListNewsRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNewsRevisions'
+type NewsController_ListNewsRevisions_Call struct {
+	*mock.Call
+}
+
+// ListNewsRevisions is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *NewsController_Expecter) ListNewsRevisions(c interface{}) *NewsController_ListNewsRevisions_Call {
+	return &NewsController_ListNewsRevisions_Call{Call: _e.mock.On("ListNewsRevisions", c)}
+}
+
+func (_c *NewsController_ListNewsRevisions_Call) Run(run func(c *gin.Context)) *NewsController_ListNewsRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *NewsController_ListNewsRevisions_Call) Return() *NewsController_ListNewsRevisions_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *NewsController_ListNewsRevisions_Call) RunAndReturn(run func(*gin.Context)) *NewsController_ListNewsRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PinNews provides a mock function with given fields: c
+func (_m *NewsController) PinNews(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewsController_PinNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PinNews'
+type NewsController_PinNews_Call struct {
+	*mock.Call
+}
+
+// PinNews is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *NewsController_Expecter) PinNews(c interface{}) *NewsController_PinNews_Call {
+	return &NewsController_PinNews_Call{Call: _e.mock.On("PinNews", c)}
+}
+
+func (_c *NewsController_PinNews_Call) Run(run func(c *gin.Context)) *NewsController_PinNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *NewsController_PinNews_Call) Return() *NewsController_PinNews_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *NewsController_PinNews_Call) RunAndReturn(run func(*gin.Context)) *NewsController_PinNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RollbackNews provides a mock function with given fields: c
+func (_m *NewsController) RollbackNews(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewsController_RollbackNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackNews'
+type NewsController_RollbackNews_Call struct {
+	*mock.Call
+}
+
+// RollbackNews is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *NewsController_Expecter) RollbackNews(c interface{}) *NewsController_RollbackNews_Call {
+	return &NewsController_RollbackNews_Call{Call: _e.mock.On("RollbackNews", c)}
+}
+
+func (_c *NewsController_RollbackNews_Call) Run(run func(c *gin.Context)) *NewsController_RollbackNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *NewsController_RollbackNews_Call) Return() *NewsController_RollbackNews_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *NewsController_RollbackNews_Call) RunAndReturn(run func(*gin.Context)) *NewsController_RollbackNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnpinNews provides a mock function with given fields: c
+func (_m *NewsController) UnpinNews(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewsController_UnpinNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnpinNews'
+type NewsController_UnpinNews_Call struct {
+	*mock.Call
+}
+
+// UnpinNews is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *NewsController_Expecter) UnpinNews(c interface{}) *NewsController_UnpinNews_Call {
+	return &NewsController_UnpinNews_Call{Call: _e.mock.On("UnpinNews", c)}
+}
+
+func (_c *NewsController_UnpinNews_Call) Run(run func(c *gin.Context)) *NewsController_UnpinNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *NewsController_UnpinNews_Call) Return() *NewsController_UnpinNews_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *NewsController_UnpinNews_Call) RunAndReturn(run func(*gin.Context)) *NewsController_UnpinNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateNews provides a mock function with given fields: c
 func (_m *NewsController) UpdateNews(c *gin.Context) {
 	_m.Called(c)
@@ -152,6 +317,39 @@ func (_c *NewsController_UpdateNews_Call) RunAndReturn(run func(*gin.Context)) *
 	return _c
 }
 
+// UpdateNewsPriority provides a mock function with given fields: c
+func (_m *NewsController) UpdateNewsPriority(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewsController_UpdateNewsPriority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNewsPriority'
+type NewsController_UpdateNewsPriority_Call struct {
+	*mock.Call
+}
+
+// UpdateNewsPriority is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *NewsController_Expecter) UpdateNewsPriority(c interface{}) *NewsController_UpdateNewsPriority_Call {
+	return &NewsController_UpdateNewsPriority_Call{Call: _e.mock.On("UpdateNewsPriority", c)}
+}
+
+func (_c *NewsController_UpdateNewsPriority_Call) Run(run func(c *gin.Context)) *NewsController_UpdateNewsPriority_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *NewsController_UpdateNewsPriority_Call) Return() *NewsController_UpdateNewsPriority_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *NewsController_UpdateNewsPriority_Call) RunAndReturn(run func(*gin.Context)) *NewsController_UpdateNewsPriority_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewNewsController creates a new instance of NewsController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNewsController(t interface {
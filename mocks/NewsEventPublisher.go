@@ -0,0 +1,80 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewsEventPublisher is an autogenerated mock type for the NewsEventPublisher type
+type NewsEventPublisher struct {
+	mock.Mock
+}
+
+type NewsEventPublisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NewsEventPublisher) EXPECT() *NewsEventPublisher_Expecter {
+	return &NewsEventPublisher_Expecter{mock: &_m.Mock}
+}
+
+// PublishNewsEvent provides a mock function with given fields: ctx, event
+func (_m *NewsEventPublisher) PublishNewsEvent(ctx context.Context, event domain.NewsEvent) error {
+	ret := _m.Called(ctx, event)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NewsEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewsEventPublisher_PublishNewsEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishNewsEvent'
+type NewsEventPublisher_PublishNewsEvent_Call struct {
+	*mock.Call
+}
+
+// PublishNewsEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event domain.NewsEvent
+func (_e *NewsEventPublisher_Expecter) PublishNewsEvent(ctx interface{}, event interface{}) *NewsEventPublisher_PublishNewsEvent_Call {
+	return &NewsEventPublisher_PublishNewsEvent_Call{Call: _e.mock.On("PublishNewsEvent", ctx, event)}
+}
+
+func (_c *NewsEventPublisher_PublishNewsEvent_Call) Run(run func(ctx context.Context, event domain.NewsEvent)) *NewsEventPublisher_PublishNewsEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.NewsEvent))
+	})
+	return _c
+}
+
+func (_c *NewsEventPublisher_PublishNewsEvent_Call) Return(_a0 error) *NewsEventPublisher_PublishNewsEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NewsEventPublisher_PublishNewsEvent_Call) RunAndReturn(run func(context.Context, domain.NewsEvent) error) *NewsEventPublisher_PublishNewsEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNewsEventPublisher creates a new instance of NewsEventPublisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNewsEventPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NewsEventPublisher {
+	mock := &NewsEventPublisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -173,6 +173,116 @@ func (_c *NewsRepository_FindNewsByID_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// FindNewsRevision provides a mock function with given fields: ctx, params
+func (_m *NewsRepository) FindNewsRevision(ctx context.Context, params domain.FindNewsRevisionParams) (*domain.NewsRevision, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domain.NewsRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.FindNewsRevisionParams) (*domain.NewsRevision, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.FindNewsRevisionParams) *domain.NewsRevision); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NewsRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.FindNewsRevisionParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewsRepository_FindNewsRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindNewsRevision'
+type NewsRepository_FindNewsRevision_Call struct {
+	*mock.Call
+}
+
+// FindNewsRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.FindNewsRevisionParams
+func (_e *NewsRepository_Expecter) FindNewsRevision(ctx interface{}, params interface{}) *NewsRepository_FindNewsRevision_Call {
+	return &NewsRepository_FindNewsRevision_Call{Call: _e.mock.On("FindNewsRevision", ctx, params)}
+}
+
+func (_c *NewsRepository_FindNewsRevision_Call) Run(run func(ctx context.Context, params domain.FindNewsRevisionParams)) *NewsRepository_FindNewsRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.FindNewsRevisionParams))
+	})
+	return _c
+}
+
+func (_c *NewsRepository_FindNewsRevision_Call) Return(_a0 *domain.NewsRevision, _a1 error) *NewsRepository_FindNewsRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NewsRepository_FindNewsRevision_Call) RunAndReturn(run func(context.Context, domain.FindNewsRevisionParams) (*domain.NewsRevision, error)) *NewsRepository_FindNewsRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDueScheduledNews provides a mock function with given fields: ctx, params
+func (_m *NewsRepository) ListDueScheduledNews(ctx context.Context, params domain.ListDueScheduledNewsParams) ([]domain.News, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.News
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListDueScheduledNewsParams) ([]domain.News, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListDueScheduledNewsParams) []domain.News); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.News)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListDueScheduledNewsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewsRepository_ListDueScheduledNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDueScheduledNews'
+type NewsRepository_ListDueScheduledNews_Call struct {
+	*mock.Call
+}
+
+// ListDueScheduledNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListDueScheduledNewsParams
+func (_e *NewsRepository_Expecter) ListDueScheduledNews(ctx interface{}, params interface{}) *NewsRepository_ListDueScheduledNews_Call {
+	return &NewsRepository_ListDueScheduledNews_Call{Call: _e.mock.On("ListDueScheduledNews", ctx, params)}
+}
+
+func (_c *NewsRepository_ListDueScheduledNews_Call) Run(run func(ctx context.Context, params domain.ListDueScheduledNewsParams)) *NewsRepository_ListDueScheduledNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListDueScheduledNewsParams))
+	})
+	return _c
+}
+
+func (_c *NewsRepository_ListDueScheduledNews_Call) Return(_a0 []domain.News, _a1 error) *NewsRepository_ListDueScheduledNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NewsRepository_ListDueScheduledNews_Call) RunAndReturn(run func(context.Context, domain.ListDueScheduledNewsParams) ([]domain.News, error)) *NewsRepository_ListDueScheduledNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListNews provides a mock function with given fields: ctx, params
 func (_m *NewsRepository) ListNews(ctx context.Context, params domain.ListNewsParams) ([]domain.News, error) {
 	ret := _m.Called(ctx, params)
@@ -228,13 +338,272 @@ func (_c *NewsRepository_ListNews_Call) RunAndReturn(run func(context.Context, d
 	return _c
 }
 
-// UpdateNews provides a mock function with given fields: ctx, news
-func (_m *NewsRepository) UpdateNews(ctx context.Context, news domain.News) error {
-	ret := _m.Called(ctx, news)
+// ListNewsRevisions provides a mock function with given fields: ctx, newsID
+func (_m *NewsRepository) ListNewsRevisions(ctx context.Context, newsID int) ([]domain.NewsRevision, error) {
+	ret := _m.Called(ctx, newsID)
+
+	var r0 []domain.NewsRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.NewsRevision, error)); ok {
+		return rf(ctx, newsID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.NewsRevision); ok {
+		r0 = rf(ctx, newsID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.NewsRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, newsID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewsRepository_ListNewsRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNewsRevisions'
+type NewsRepository_ListNewsRevisions_Call struct {
+	*mock.Call
+}
+
+// ListNewsRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newsID int
+func (_e *NewsRepository_Expecter) ListNewsRevisions(ctx interface{}, newsID interface{}) *NewsRepository_ListNewsRevisions_Call {
+	return &NewsRepository_ListNewsRevisions_Call{Call: _e.mock.On("ListNewsRevisions", ctx, newsID)}
+}
+
+func (_c *NewsRepository_ListNewsRevisions_Call) Run(run func(ctx context.Context, newsID int)) *NewsRepository_ListNewsRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *NewsRepository_ListNewsRevisions_Call) Return(_a0 []domain.NewsRevision, _a1 error) *NewsRepository_ListNewsRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NewsRepository_ListNewsRevisions_Call) RunAndReturn(run func(context.Context, int) ([]domain.NewsRevision, error)) *NewsRepository_ListNewsRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPinnedNews provides a mock function with given fields: ctx, params
+func (_m *NewsRepository) ListPinnedNews(ctx context.Context, params domain.ListPinnedNewsParams) ([]domain.News, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.News
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListPinnedNewsParams) ([]domain.News, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListPinnedNewsParams) []domain.News); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.News)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListPinnedNewsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewsRepository_ListPinnedNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPinnedNews'
+type NewsRepository_ListPinnedNews_Call struct {
+	*mock.Call
+}
+
+// ListPinnedNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListPinnedNewsParams
+func (_e *NewsRepository_Expecter) ListPinnedNews(ctx interface{}, params interface{}) *NewsRepository_ListPinnedNews_Call {
+	return &NewsRepository_ListPinnedNews_Call{Call: _e.mock.On("ListPinnedNews", ctx, params)}
+}
+
+func (_c *NewsRepository_ListPinnedNews_Call) Run(run func(ctx context.Context, params domain.ListPinnedNewsParams)) *NewsRepository_ListPinnedNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListPinnedNewsParams))
+	})
+	return _c
+}
+
+func (_c *NewsRepository_ListPinnedNews_Call) Return(_a0 []domain.News, _a1 error) *NewsRepository_ListPinnedNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NewsRepository_ListPinnedNews_Call) RunAndReturn(run func(context.Context, domain.ListPinnedNewsParams) ([]domain.News, error)) *NewsRepository_ListPinnedNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PinNews provides a mock function with given fields: ctx, params
+func (_m *NewsRepository) PinNews(ctx context.Context, params domain.PinNewsParams) (bool, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PinNewsParams) (bool, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PinNewsParams) bool); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PinNewsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewsRepository_PinNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PinNews'
+type NewsRepository_PinNews_Call struct {
+	*mock.Call
+}
+
+// PinNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.PinNewsParams
+func (_e *NewsRepository_Expecter) PinNews(ctx interface{}, params interface{}) *NewsRepository_PinNews_Call {
+	return &NewsRepository_PinNews_Call{Call: _e.mock.On("PinNews", ctx, params)}
+}
+
+func (_c *NewsRepository_PinNews_Call) Run(run func(ctx context.Context, params domain.PinNewsParams)) *NewsRepository_PinNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PinNewsParams))
+	})
+	return _c
+}
+
+func (_c *NewsRepository_PinNews_Call) Return(_a0 bool, _a1 error) *NewsRepository_PinNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NewsRepository_PinNews_Call) RunAndReturn(run func(context.Context, domain.PinNewsParams) (bool, error)) *NewsRepository_PinNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishScheduledNews provides a mock function with given fields: ctx, params
+func (_m *NewsRepository) PublishScheduledNews(ctx context.Context, params domain.PublishScheduledNewsParams) (bool, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PublishScheduledNewsParams) (bool, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PublishScheduledNewsParams) bool); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PublishScheduledNewsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewsRepository_PublishScheduledNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishScheduledNews'
+type NewsRepository_PublishScheduledNews_Call struct {
+	*mock.Call
+}
+
+// PublishScheduledNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.PublishScheduledNewsParams
+func (_e *NewsRepository_Expecter) PublishScheduledNews(ctx interface{}, params interface{}) *NewsRepository_PublishScheduledNews_Call {
+	return &NewsRepository_PublishScheduledNews_Call{Call: _e.mock.On("PublishScheduledNews", ctx, params)}
+}
+
+func (_c *NewsRepository_PublishScheduledNews_Call) Run(run func(ctx context.Context, params domain.PublishScheduledNewsParams)) *NewsRepository_PublishScheduledNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PublishScheduledNewsParams))
+	})
+	return _c
+}
+
+func (_c *NewsRepository_PublishScheduledNews_Call) Return(_a0 bool, _a1 error) *NewsRepository_PublishScheduledNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NewsRepository_PublishScheduledNews_Call) RunAndReturn(run func(context.Context, domain.PublishScheduledNewsParams) (bool, error)) *NewsRepository_PublishScheduledNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnpinNews provides a mock function with given fields: ctx, newsID
+func (_m *NewsRepository) UnpinNews(ctx context.Context, newsID int) error {
+	ret := _m.Called(ctx, newsID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.News) error); ok {
-		r0 = rf(ctx, news)
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, newsID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewsRepository_UnpinNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnpinNews'
+type NewsRepository_UnpinNews_Call struct {
+	*mock.Call
+}
+
+// UnpinNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newsID int
+func (_e *NewsRepository_Expecter) UnpinNews(ctx interface{}, newsID interface{}) *NewsRepository_UnpinNews_Call {
+	return &NewsRepository_UnpinNews_Call{Call: _e.mock.On("UnpinNews", ctx, newsID)}
+}
+
+func (_c *NewsRepository_UnpinNews_Call) Run(run func(ctx context.Context, newsID int)) *NewsRepository_UnpinNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *NewsRepository_UnpinNews_Call) Return(_a0 error) *NewsRepository_UnpinNews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NewsRepository_UnpinNews_Call) RunAndReturn(run func(context.Context, int) error) *NewsRepository_UnpinNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNews provides a mock function with given fields: ctx, params
+func (_m *NewsRepository) UpdateNews(ctx context.Context, params domain.UpdateNewsParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UpdateNewsParams) error); ok {
+		r0 = rf(ctx, params)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -249,14 +618,14 @@ type NewsRepository_UpdateNews_Call struct {
 
 // UpdateNews is a helper method to define mock.On call
 //   - ctx context.Context
-//   - news domain.News
-func (_e *NewsRepository_Expecter) UpdateNews(ctx interface{}, news interface{}) *NewsRepository_UpdateNews_Call {
-	return &NewsRepository_UpdateNews_Call{Call: _e.mock.On("UpdateNews", ctx, news)}
+//   - params domain.UpdateNewsParams
+func (_e *NewsRepository_Expecter) UpdateNews(ctx interface{}, params interface{}) *NewsRepository_UpdateNews_Call {
+	return &NewsRepository_UpdateNews_Call{Call: _e.mock.On("UpdateNews", ctx, params)}
 }
 
-func (_c *NewsRepository_UpdateNews_Call) Run(run func(ctx context.Context, news domain.News)) *NewsRepository_UpdateNews_Call {
+func (_c *NewsRepository_UpdateNews_Call) Run(run func(ctx context.Context, params domain.UpdateNewsParams)) *NewsRepository_UpdateNews_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.News))
+		run(args[0].(context.Context), args[1].(domain.UpdateNewsParams))
 	})
 	return _c
 }
@@ -266,7 +635,50 @@ func (_c *NewsRepository_UpdateNews_Call) Return(_a0 error) *NewsRepository_Upda
 	return _c
 }
 
-func (_c *NewsRepository_UpdateNews_Call) RunAndReturn(run func(context.Context, domain.News) error) *NewsRepository_UpdateNews_Call {
+func (_c *NewsRepository_UpdateNews_Call) RunAndReturn(run func(context.Context, domain.UpdateNewsParams) error) *NewsRepository_UpdateNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNewsPriority provides a mock function with given fields: ctx, params
+func (_m *NewsRepository) UpdateNewsPriority(ctx context.Context, params domain.UpdateNewsPriorityParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UpdateNewsPriorityParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewsRepository_UpdateNewsPriority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNewsPriority'
+type NewsRepository_UpdateNewsPriority_Call struct {
+	*mock.Call
+}
+
+// UpdateNewsPriority is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.UpdateNewsPriorityParams
+func (_e *NewsRepository_Expecter) UpdateNewsPriority(ctx interface{}, params interface{}) *NewsRepository_UpdateNewsPriority_Call {
+	return &NewsRepository_UpdateNewsPriority_Call{Call: _e.mock.On("UpdateNewsPriority", ctx, params)}
+}
+
+func (_c *NewsRepository_UpdateNewsPriority_Call) Run(run func(ctx context.Context, params domain.UpdateNewsPriorityParams)) *NewsRepository_UpdateNewsPriority_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UpdateNewsPriorityParams))
+	})
+	return _c
+}
+
+func (_c *NewsRepository_UpdateNewsPriority_Call) Return(_a0 error) *NewsRepository_UpdateNewsPriority_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NewsRepository_UpdateNewsPriority_Call) RunAndReturn(run func(context.Context, domain.UpdateNewsPriorityParams) error) *NewsRepository_UpdateNewsPriority_Call {
 	_c.Call.Return(run)
 	return _c
 }
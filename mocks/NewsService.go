@@ -108,6 +108,59 @@ func (_c *NewsService_DeleteNews_Call) RunAndReturn(run func(context.Context, do
 	return _c
 }
 
+// DiffNewsRevisions provides a mock function with given fields: ctx, req
+func (_m *NewsService) DiffNewsRevisions(ctx context.Context, req domain.DiffNewsRevisionsRequest) (domain.NewsRevisionDiffDTO, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.NewsRevisionDiffDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DiffNewsRevisionsRequest) (domain.NewsRevisionDiffDTO, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DiffNewsRevisionsRequest) domain.NewsRevisionDiffDTO); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.NewsRevisionDiffDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.DiffNewsRevisionsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewsService_DiffNewsRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffNewsRevisions'
+type NewsService_DiffNewsRevisions_Call struct {
+	*mock.Call
+}
+
+// DiffNewsRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.DiffNewsRevisionsRequest
+func (_e *NewsService_Expecter) DiffNewsRevisions(ctx interface{}, req interface{}) *NewsService_DiffNewsRevisions_Call {
+	return &NewsService_DiffNewsRevisions_Call{Call: _e.mock.On("DiffNewsRevisions", ctx, req)}
+}
+
+func (_c *NewsService_DiffNewsRevisions_Call) Run(run func(ctx context.Context, req domain.DiffNewsRevisionsRequest)) *NewsService_DiffNewsRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DiffNewsRevisionsRequest))
+	})
+	return _c
+}
+
+func (_c *NewsService_DiffNewsRevisions_Call) Return(_a0 domain.NewsRevisionDiffDTO, _a1 error) *NewsService_DiffNewsRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NewsService_DiffNewsRevisions_Call) RunAndReturn(run func(context.Context, domain.DiffNewsRevisionsRequest) (domain.NewsRevisionDiffDTO, error)) *NewsService_DiffNewsRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListNews provides a mock function with given fields: ctx, req
 func (_m *NewsService) ListNews(ctx context.Context, req domain.ListNewsRequest) (domain.ListNewsResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -161,6 +214,188 @@ func (_c *NewsService_ListNews_Call) RunAndReturn(run func(context.Context, doma
 	return _c
 }
 
+// ListNewsRevisions provides a mock function with given fields: ctx, req
+func (_m *NewsService) ListNewsRevisions(ctx context.Context, req domain.ListNewsRevisionsRequest) (domain.ListNewsRevisionsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ListNewsRevisionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListNewsRevisionsRequest) (domain.ListNewsRevisionsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListNewsRevisionsRequest) domain.ListNewsRevisionsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ListNewsRevisionsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListNewsRevisionsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewsService_ListNewsRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNewsRevisions'
+type NewsService_ListNewsRevisions_Call struct {
+	*mock.Call
+}
+
+// ListNewsRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ListNewsRevisionsRequest
+func (_e *NewsService_Expecter) ListNewsRevisions(ctx interface{}, req interface{}) *NewsService_ListNewsRevisions_Call {
+	return &NewsService_ListNewsRevisions_Call{Call: _e.mock.On("ListNewsRevisions", ctx, req)}
+}
+
+func (_c *NewsService_ListNewsRevisions_Call) Run(run func(ctx context.Context, req domain.ListNewsRevisionsRequest)) *NewsService_ListNewsRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListNewsRevisionsRequest))
+	})
+	return _c
+}
+
+func (_c *NewsService_ListNewsRevisions_Call) Return(_a0 domain.ListNewsRevisionsResponse, _a1 error) *NewsService_ListNewsRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NewsService_ListNewsRevisions_Call) RunAndReturn(run func(context.Context, domain.ListNewsRevisionsRequest) (domain.ListNewsRevisionsResponse, error)) *NewsService_ListNewsRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PinNews provides a mock function with given fields: ctx, req
+func (_m *NewsService) PinNews(ctx context.Context, req domain.PinNewsRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PinNewsRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewsService_PinNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PinNews'
+type NewsService_PinNews_Call struct {
+	*mock.Call
+}
+
+// PinNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.PinNewsRequest
+func (_e *NewsService_Expecter) PinNews(ctx interface{}, req interface{}) *NewsService_PinNews_Call {
+	return &NewsService_PinNews_Call{Call: _e.mock.On("PinNews", ctx, req)}
+}
+
+func (_c *NewsService_PinNews_Call) Run(run func(ctx context.Context, req domain.PinNewsRequest)) *NewsService_PinNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PinNewsRequest))
+	})
+	return _c
+}
+
+func (_c *NewsService_PinNews_Call) Return(_a0 error) *NewsService_PinNews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NewsService_PinNews_Call) RunAndReturn(run func(context.Context, domain.PinNewsRequest) error) *NewsService_PinNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RollbackNews provides a mock function with given fields: ctx, req
+func (_m *NewsService) RollbackNews(ctx context.Context, req domain.RollbackNewsRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RollbackNewsRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewsService_RollbackNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackNews'
+type NewsService_RollbackNews_Call struct {
+	*mock.Call
+}
+
+// RollbackNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.RollbackNewsRequest
+func (_e *NewsService_Expecter) RollbackNews(ctx interface{}, req interface{}) *NewsService_RollbackNews_Call {
+	return &NewsService_RollbackNews_Call{Call: _e.mock.On("RollbackNews", ctx, req)}
+}
+
+func (_c *NewsService_RollbackNews_Call) Run(run func(ctx context.Context, req domain.RollbackNewsRequest)) *NewsService_RollbackNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.RollbackNewsRequest))
+	})
+	return _c
+}
+
+func (_c *NewsService_RollbackNews_Call) Return(_a0 error) *NewsService_RollbackNews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NewsService_RollbackNews_Call) RunAndReturn(run func(context.Context, domain.RollbackNewsRequest) error) *NewsService_RollbackNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnpinNews provides a mock function with given fields: ctx, req
+func (_m *NewsService) UnpinNews(ctx context.Context, req domain.UnpinNewsRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UnpinNewsRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewsService_UnpinNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnpinNews'
+type NewsService_UnpinNews_Call struct {
+	*mock.Call
+}
+
+// UnpinNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.UnpinNewsRequest
+func (_e *NewsService_Expecter) UnpinNews(ctx interface{}, req interface{}) *NewsService_UnpinNews_Call {
+	return &NewsService_UnpinNews_Call{Call: _e.mock.On("UnpinNews", ctx, req)}
+}
+
+func (_c *NewsService_UnpinNews_Call) Run(run func(ctx context.Context, req domain.UnpinNewsRequest)) *NewsService_UnpinNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UnpinNewsRequest))
+	})
+	return _c
+}
+
+func (_c *NewsService_UnpinNews_Call) Return(_a0 error) *NewsService_UnpinNews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NewsService_UnpinNews_Call) RunAndReturn(run func(context.Context, domain.UnpinNewsRequest) error) *NewsService_UnpinNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateNews provides a mock function with given fields: ctx, req
 func (_m *NewsService) UpdateNews(ctx context.Context, req domain.UpdateNewsRequest) error {
 	ret := _m.Called(ctx, req)
@@ -204,6 +439,49 @@ func (_c *NewsService_UpdateNews_Call) RunAndReturn(run func(context.Context, do
 	return _c
 }
 
+// UpdateNewsPriority provides a mock function with given fields: ctx, req
+func (_m *NewsService) UpdateNewsPriority(ctx context.Context, req domain.UpdateNewsPriorityRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UpdateNewsPriorityRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewsService_UpdateNewsPriority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNewsPriority'
+type NewsService_UpdateNewsPriority_Call struct {
+	*mock.Call
+}
+
+// UpdateNewsPriority is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.UpdateNewsPriorityRequest
+func (_e *NewsService_Expecter) UpdateNewsPriority(ctx interface{}, req interface{}) *NewsService_UpdateNewsPriority_Call {
+	return &NewsService_UpdateNewsPriority_Call{Call: _e.mock.On("UpdateNewsPriority", ctx, req)}
+}
+
+func (_c *NewsService_UpdateNewsPriority_Call) Run(run func(ctx context.Context, req domain.UpdateNewsPriorityRequest)) *NewsService_UpdateNewsPriority_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UpdateNewsPriorityRequest))
+	})
+	return _c
+}
+
+func (_c *NewsService_UpdateNewsPriority_Call) Return(_a0 error) *NewsService_UpdateNewsPriority_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NewsService_UpdateNewsPriority_Call) RunAndReturn(run func(context.Context, domain.UpdateNewsPriorityRequest) error) *NewsService_UpdateNewsPriority_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewNewsService creates a new instance of NewsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNewsService(t interface {
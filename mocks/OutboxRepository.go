@@ -0,0 +1,285 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OutboxRepository is an autogenerated mock type for the OutboxRepository type
+type OutboxRepository struct {
+	mock.Mock
+}
+
+type OutboxRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OutboxRepository) EXPECT() *OutboxRepository_Expecter {
+	return &OutboxRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimOutboxEvents provides a mock function with given fields: ctx, params
+func (_m *OutboxRepository) ClaimOutboxEvents(ctx context.Context, params domain.ClaimOutboxEventsParams) ([]domain.OutboxEvent, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.OutboxEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ClaimOutboxEventsParams) ([]domain.OutboxEvent, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ClaimOutboxEventsParams) []domain.OutboxEvent); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.OutboxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ClaimOutboxEventsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OutboxRepository_ClaimOutboxEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimOutboxEvents'
+type OutboxRepository_ClaimOutboxEvents_Call struct {
+	*mock.Call
+}
+
+// ClaimOutboxEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ClaimOutboxEventsParams
+func (_e *OutboxRepository_Expecter) ClaimOutboxEvents(ctx interface{}, params interface{}) *OutboxRepository_ClaimOutboxEvents_Call {
+	return &OutboxRepository_ClaimOutboxEvents_Call{Call: _e.mock.On("ClaimOutboxEvents", ctx, params)}
+}
+
+func (_c *OutboxRepository_ClaimOutboxEvents_Call) Run(run func(ctx context.Context, params domain.ClaimOutboxEventsParams)) *OutboxRepository_ClaimOutboxEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ClaimOutboxEventsParams))
+	})
+	return _c
+}
+
+func (_c *OutboxRepository_ClaimOutboxEvents_Call) Return(_a0 []domain.OutboxEvent, _a1 error) *OutboxRepository_ClaimOutboxEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OutboxRepository_ClaimOutboxEvents_Call) RunAndReturn(run func(context.Context, domain.ClaimOutboxEventsParams) ([]domain.OutboxEvent, error)) *OutboxRepository_ClaimOutboxEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindLastOutboxEventID provides a mock function with given fields: ctx
+func (_m *OutboxRepository) FindLastOutboxEventID(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OutboxRepository_FindLastOutboxEventID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLastOutboxEventID'
+type OutboxRepository_FindLastOutboxEventID_Call struct {
+	*mock.Call
+}
+
+// FindLastOutboxEventID is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OutboxRepository_Expecter) FindLastOutboxEventID(ctx interface{}) *OutboxRepository_FindLastOutboxEventID_Call {
+	return &OutboxRepository_FindLastOutboxEventID_Call{Call: _e.mock.On("FindLastOutboxEventID", ctx)}
+}
+
+func (_c *OutboxRepository_FindLastOutboxEventID_Call) Run(run func(ctx context.Context)) *OutboxRepository_FindLastOutboxEventID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OutboxRepository_FindLastOutboxEventID_Call) Return(_a0 int, _a1 error) *OutboxRepository_FindLastOutboxEventID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OutboxRepository_FindLastOutboxEventID_Call) RunAndReturn(run func(context.Context) (int, error)) *OutboxRepository_FindLastOutboxEventID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOutboxEvents provides a mock function with given fields: ctx, params
+func (_m *OutboxRepository) ListOutboxEvents(ctx context.Context, params domain.ListOutboxEventsParams) ([]domain.OutboxEvent, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.OutboxEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListOutboxEventsParams) ([]domain.OutboxEvent, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListOutboxEventsParams) []domain.OutboxEvent); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.OutboxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListOutboxEventsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OutboxRepository_ListOutboxEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOutboxEvents'
+type OutboxRepository_ListOutboxEvents_Call struct {
+	*mock.Call
+}
+
+// ListOutboxEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListOutboxEventsParams
+func (_e *OutboxRepository_Expecter) ListOutboxEvents(ctx interface{}, params interface{}) *OutboxRepository_ListOutboxEvents_Call {
+	return &OutboxRepository_ListOutboxEvents_Call{Call: _e.mock.On("ListOutboxEvents", ctx, params)}
+}
+
+func (_c *OutboxRepository_ListOutboxEvents_Call) Run(run func(ctx context.Context, params domain.ListOutboxEventsParams)) *OutboxRepository_ListOutboxEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListOutboxEventsParams))
+	})
+	return _c
+}
+
+func (_c *OutboxRepository_ListOutboxEvents_Call) Return(_a0 []domain.OutboxEvent, _a1 error) *OutboxRepository_ListOutboxEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OutboxRepository_ListOutboxEvents_Call) RunAndReturn(run func(context.Context, domain.ListOutboxEventsParams) ([]domain.OutboxEvent, error)) *OutboxRepository_ListOutboxEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkOutboxEventDispatched provides a mock function with given fields: ctx, eventID
+func (_m *OutboxRepository) MarkOutboxEventDispatched(ctx context.Context, eventID int) error {
+	ret := _m.Called(ctx, eventID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxRepository_MarkOutboxEventDispatched_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkOutboxEventDispatched'
+type OutboxRepository_MarkOutboxEventDispatched_Call struct {
+	*mock.Call
+}
+
+// MarkOutboxEventDispatched is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID int
+func (_e *OutboxRepository_Expecter) MarkOutboxEventDispatched(ctx interface{}, eventID interface{}) *OutboxRepository_MarkOutboxEventDispatched_Call {
+	return &OutboxRepository_MarkOutboxEventDispatched_Call{Call: _e.mock.On("MarkOutboxEventDispatched", ctx, eventID)}
+}
+
+func (_c *OutboxRepository_MarkOutboxEventDispatched_Call) Run(run func(ctx context.Context, eventID int)) *OutboxRepository_MarkOutboxEventDispatched_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *OutboxRepository_MarkOutboxEventDispatched_Call) Return(_a0 error) *OutboxRepository_MarkOutboxEventDispatched_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxRepository_MarkOutboxEventDispatched_Call) RunAndReturn(run func(context.Context, int) error) *OutboxRepository_MarkOutboxEventDispatched_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkOutboxEventFailed provides a mock function with given fields: ctx, params
+func (_m *OutboxRepository) MarkOutboxEventFailed(ctx context.Context, params domain.MarkOutboxEventFailedParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MarkOutboxEventFailedParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxRepository_MarkOutboxEventFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkOutboxEventFailed'
+type OutboxRepository_MarkOutboxEventFailed_Call struct {
+	*mock.Call
+}
+
+// MarkOutboxEventFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.MarkOutboxEventFailedParams
+func (_e *OutboxRepository_Expecter) MarkOutboxEventFailed(ctx interface{}, params interface{}) *OutboxRepository_MarkOutboxEventFailed_Call {
+	return &OutboxRepository_MarkOutboxEventFailed_Call{Call: _e.mock.On("MarkOutboxEventFailed", ctx, params)}
+}
+
+func (_c *OutboxRepository_MarkOutboxEventFailed_Call) Run(run func(ctx context.Context, params domain.MarkOutboxEventFailedParams)) *OutboxRepository_MarkOutboxEventFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MarkOutboxEventFailedParams))
+	})
+	return _c
+}
+
+func (_c *OutboxRepository_MarkOutboxEventFailed_Call) Return(_a0 error) *OutboxRepository_MarkOutboxEventFailed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxRepository_MarkOutboxEventFailed_Call) RunAndReturn(run func(context.Context, domain.MarkOutboxEventFailedParams) error) *OutboxRepository_MarkOutboxEventFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOutboxRepository creates a new instance of OutboxRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxRepository {
+	mock := &OutboxRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,80 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OutboxSink is an autogenerated mock type for the OutboxSink type
+type OutboxSink struct {
+	mock.Mock
+}
+
+type OutboxSink_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OutboxSink) EXPECT() *OutboxSink_Expecter {
+	return &OutboxSink_Expecter{mock: &_m.Mock}
+}
+
+// HandleOutboxEvent provides a mock function with given fields: ctx, event
+func (_m *OutboxSink) HandleOutboxEvent(ctx context.Context, event domain.OutboxEvent) error {
+	ret := _m.Called(ctx, event)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.OutboxEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxSink_HandleOutboxEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleOutboxEvent'
+type OutboxSink_HandleOutboxEvent_Call struct {
+	*mock.Call
+}
+
+// HandleOutboxEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event domain.OutboxEvent
+func (_e *OutboxSink_Expecter) HandleOutboxEvent(ctx interface{}, event interface{}) *OutboxSink_HandleOutboxEvent_Call {
+	return &OutboxSink_HandleOutboxEvent_Call{Call: _e.mock.On("HandleOutboxEvent", ctx, event)}
+}
+
+func (_c *OutboxSink_HandleOutboxEvent_Call) Run(run func(ctx context.Context, event domain.OutboxEvent)) *OutboxSink_HandleOutboxEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.OutboxEvent))
+	})
+	return _c
+}
+
+func (_c *OutboxSink_HandleOutboxEvent_Call) Return(_a0 error) *OutboxSink_HandleOutboxEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxSink_HandleOutboxEvent_Call) RunAndReturn(run func(context.Context, domain.OutboxEvent) error) *OutboxSink_HandleOutboxEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOutboxSink creates a new instance of OutboxSink. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxSink(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxSink {
+	mock := &OutboxSink{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,101 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReactionController is an autogenerated mock type for the ReactionController type
+type ReactionController struct {
+	mock.Mock
+}
+
+type ReactionController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReactionController) EXPECT() *ReactionController_Expecter {
+	return &ReactionController_Expecter{mock: &_m.Mock}
+}
+
+// AddReaction provides a mock function with given fields: c
+func (_m *ReactionController) AddReaction(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ReactionController_AddReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReaction'
+type ReactionController_AddReaction_Call struct {
+	*mock.Call
+}
+
+// AddReaction is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ReactionController_Expecter) AddReaction(c interface{}) *ReactionController_AddReaction_Call {
+	return &ReactionController_AddReaction_Call{Call: _e.mock.On("AddReaction", c)}
+}
+
+func (_c *ReactionController_AddReaction_Call) Run(run func(c *gin.Context)) *ReactionController_AddReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ReactionController_AddReaction_Call) Return() *ReactionController_AddReaction_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ReactionController_AddReaction_Call) RunAndReturn(run func(*gin.Context)) *ReactionController_AddReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveReaction provides a mock function with given fields: c
+func (_m *ReactionController) RemoveReaction(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ReactionController_RemoveReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveReaction'
+type ReactionController_RemoveReaction_Call struct {
+	*mock.Call
+}
+
+// RemoveReaction is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ReactionController_Expecter) RemoveReaction(c interface{}) *ReactionController_RemoveReaction_Call {
+	return &ReactionController_RemoveReaction_Call{Call: _e.mock.On("RemoveReaction", c)}
+}
+
+func (_c *ReactionController_RemoveReaction_Call) Run(run func(c *gin.Context)) *ReactionController_RemoveReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ReactionController_RemoveReaction_Call) Return() *ReactionController_RemoveReaction_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ReactionController_RemoveReaction_Call) RunAndReturn(run func(*gin.Context)) *ReactionController_RemoveReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReactionController creates a new instance of ReactionController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReactionController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReactionController {
+	mock := &ReactionController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,178 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReactionRepository is an autogenerated mock type for the ReactionRepository type
+type ReactionRepository struct {
+	mock.Mock
+}
+
+type ReactionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReactionRepository) EXPECT() *ReactionRepository_Expecter {
+	return &ReactionRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateReaction provides a mock function with given fields: ctx, reaction
+func (_m *ReactionRepository) CreateReaction(ctx context.Context, reaction domain.Reaction) error {
+	ret := _m.Called(ctx, reaction)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Reaction) error); ok {
+		r0 = rf(ctx, reaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReactionRepository_CreateReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReaction'
+type ReactionRepository_CreateReaction_Call struct {
+	*mock.Call
+}
+
+// CreateReaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reaction domain.Reaction
+func (_e *ReactionRepository_Expecter) CreateReaction(ctx interface{}, reaction interface{}) *ReactionRepository_CreateReaction_Call {
+	return &ReactionRepository_CreateReaction_Call{Call: _e.mock.On("CreateReaction", ctx, reaction)}
+}
+
+func (_c *ReactionRepository_CreateReaction_Call) Run(run func(ctx context.Context, reaction domain.Reaction)) *ReactionRepository_CreateReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Reaction))
+	})
+	return _c
+}
+
+func (_c *ReactionRepository_CreateReaction_Call) Return(_a0 error) *ReactionRepository_CreateReaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReactionRepository_CreateReaction_Call) RunAndReturn(run func(context.Context, domain.Reaction) error) *ReactionRepository_CreateReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReaction provides a mock function with given fields: ctx, params
+func (_m *ReactionRepository) DeleteReaction(ctx context.Context, params domain.DeleteReactionParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DeleteReactionParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReactionRepository_DeleteReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReaction'
+type ReactionRepository_DeleteReaction_Call struct {
+	*mock.Call
+}
+
+// DeleteReaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.DeleteReactionParams
+func (_e *ReactionRepository_Expecter) DeleteReaction(ctx interface{}, params interface{}) *ReactionRepository_DeleteReaction_Call {
+	return &ReactionRepository_DeleteReaction_Call{Call: _e.mock.On("DeleteReaction", ctx, params)}
+}
+
+func (_c *ReactionRepository_DeleteReaction_Call) Run(run func(ctx context.Context, params domain.DeleteReactionParams)) *ReactionRepository_DeleteReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DeleteReactionParams))
+	})
+	return _c
+}
+
+func (_c *ReactionRepository_DeleteReaction_Call) Return(_a0 error) *ReactionRepository_DeleteReaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReactionRepository_DeleteReaction_Call) RunAndReturn(run func(context.Context, domain.DeleteReactionParams) error) *ReactionRepository_DeleteReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReactionCounts provides a mock function with given fields: ctx, params
+func (_m *ReactionRepository) ListReactionCounts(ctx context.Context, params domain.ListReactionCountsParams) ([]domain.ReactionCount, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.ReactionCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListReactionCountsParams) ([]domain.ReactionCount, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListReactionCountsParams) []domain.ReactionCount); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ReactionCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListReactionCountsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReactionRepository_ListReactionCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReactionCounts'
+type ReactionRepository_ListReactionCounts_Call struct {
+	*mock.Call
+}
+
+// ListReactionCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListReactionCountsParams
+func (_e *ReactionRepository_Expecter) ListReactionCounts(ctx interface{}, params interface{}) *ReactionRepository_ListReactionCounts_Call {
+	return &ReactionRepository_ListReactionCounts_Call{Call: _e.mock.On("ListReactionCounts", ctx, params)}
+}
+
+func (_c *ReactionRepository_ListReactionCounts_Call) Run(run func(ctx context.Context, params domain.ListReactionCountsParams)) *ReactionRepository_ListReactionCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListReactionCountsParams))
+	})
+	return _c
+}
+
+func (_c *ReactionRepository_ListReactionCounts_Call) Return(_a0 []domain.ReactionCount, _a1 error) *ReactionRepository_ListReactionCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReactionRepository_ListReactionCounts_Call) RunAndReturn(run func(context.Context, domain.ListReactionCountsParams) ([]domain.ReactionCount, error)) *ReactionRepository_ListReactionCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReactionRepository creates a new instance of ReactionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReactionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReactionRepository {
+	mock := &ReactionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
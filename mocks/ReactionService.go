@@ -0,0 +1,178 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReactionService is an autogenerated mock type for the ReactionService type
+type ReactionService struct {
+	mock.Mock
+}
+
+type ReactionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReactionService) EXPECT() *ReactionService_Expecter {
+	return &ReactionService_Expecter{mock: &_m.Mock}
+}
+
+// AddReaction provides a mock function with given fields: ctx, req
+func (_m *ReactionService) AddReaction(ctx context.Context, req domain.AddReactionRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AddReactionRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReactionService_AddReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReaction'
+type ReactionService_AddReaction_Call struct {
+	*mock.Call
+}
+
+// AddReaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.AddReactionRequest
+func (_e *ReactionService_Expecter) AddReaction(ctx interface{}, req interface{}) *ReactionService_AddReaction_Call {
+	return &ReactionService_AddReaction_Call{Call: _e.mock.On("AddReaction", ctx, req)}
+}
+
+func (_c *ReactionService_AddReaction_Call) Run(run func(ctx context.Context, req domain.AddReactionRequest)) *ReactionService_AddReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AddReactionRequest))
+	})
+	return _c
+}
+
+func (_c *ReactionService_AddReaction_Call) Return(_a0 error) *ReactionService_AddReaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReactionService_AddReaction_Call) RunAndReturn(run func(context.Context, domain.AddReactionRequest) error) *ReactionService_AddReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNewsReactions provides a mock function with given fields: ctx, params
+func (_m *ReactionService) ListNewsReactions(ctx context.Context, params domain.ListReactionCountsParams) (map[int][]domain.ReactionDTO, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 map[int][]domain.ReactionDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListReactionCountsParams) (map[int][]domain.ReactionDTO, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListReactionCountsParams) map[int][]domain.ReactionDTO); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int][]domain.ReactionDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListReactionCountsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReactionService_ListNewsReactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNewsReactions'
+type ReactionService_ListNewsReactions_Call struct {
+	*mock.Call
+}
+
+// ListNewsReactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListReactionCountsParams
+func (_e *ReactionService_Expecter) ListNewsReactions(ctx interface{}, params interface{}) *ReactionService_ListNewsReactions_Call {
+	return &ReactionService_ListNewsReactions_Call{Call: _e.mock.On("ListNewsReactions", ctx, params)}
+}
+
+func (_c *ReactionService_ListNewsReactions_Call) Run(run func(ctx context.Context, params domain.ListReactionCountsParams)) *ReactionService_ListNewsReactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListReactionCountsParams))
+	})
+	return _c
+}
+
+func (_c *ReactionService_ListNewsReactions_Call) Return(_a0 map[int][]domain.ReactionDTO, _a1 error) *ReactionService_ListNewsReactions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReactionService_ListNewsReactions_Call) RunAndReturn(run func(context.Context, domain.ListReactionCountsParams) (map[int][]domain.ReactionDTO, error)) *ReactionService_ListNewsReactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveReaction provides a mock function with given fields: ctx, req
+func (_m *ReactionService) RemoveReaction(ctx context.Context, req domain.RemoveReactionRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RemoveReactionRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReactionService_RemoveReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveReaction'
+type ReactionService_RemoveReaction_Call struct {
+	*mock.Call
+}
+
+// RemoveReaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.RemoveReactionRequest
+func (_e *ReactionService_Expecter) RemoveReaction(ctx interface{}, req interface{}) *ReactionService_RemoveReaction_Call {
+	return &ReactionService_RemoveReaction_Call{Call: _e.mock.On("RemoveReaction", ctx, req)}
+}
+
+func (_c *ReactionService_RemoveReaction_Call) Run(run func(ctx context.Context, req domain.RemoveReactionRequest)) *ReactionService_RemoveReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.RemoveReactionRequest))
+	})
+	return _c
+}
+
+func (_c *ReactionService_RemoveReaction_Call) Return(_a0 error) *ReactionService_RemoveReaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReactionService_RemoveReaction_Call) RunAndReturn(run func(context.Context, domain.RemoveReactionRequest) error) *ReactionService_RemoveReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReactionService creates a new instance of ReactionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReactionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReactionService {
+	mock := &ReactionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -53,6 +53,138 @@ func (_c *SchoolController_CreateSchool_Call) RunAndReturn(run func(*gin.Context
 	return _c
 }
 
+// DeleteSchool provides a mock function with given fields: c
+func (_m *SchoolController) DeleteSchool(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SchoolController_DeleteSchool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSchool'
+type SchoolController_DeleteSchool_Call struct {
+	*mock.Call
+}
+
+// DeleteSchool is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *SchoolController_Expecter) DeleteSchool(c interface{}) *SchoolController_DeleteSchool_Call {
+	return &SchoolController_DeleteSchool_Call{Call: _e.mock.On("DeleteSchool", c)}
+}
+
+func (_c *SchoolController_DeleteSchool_Call) Run(run func(c *gin.Context)) *SchoolController_DeleteSchool_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *SchoolController_DeleteSchool_Call) Return() *SchoolController_DeleteSchool_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SchoolController_DeleteSchool_Call) RunAndReturn(run func(*gin.Context)) *SchoolController_DeleteSchool_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InviteSchoolMember provides a mock function with given fields: c
+func (_m *SchoolController) InviteSchoolMember(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SchoolController_InviteSchoolMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InviteSchoolMember'
+type SchoolController_InviteSchoolMember_Call struct {
+	*mock.Call
+}
+
+// InviteSchoolMember is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *SchoolController_Expecter) InviteSchoolMember(c interface{}) *SchoolController_InviteSchoolMember_Call {
+	return &SchoolController_InviteSchoolMember_Call{Call: _e.mock.On("InviteSchoolMember", c)}
+}
+
+func (_c *SchoolController_InviteSchoolMember_Call) Run(run func(c *gin.Context)) *SchoolController_InviteSchoolMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *SchoolController_InviteSchoolMember_Call) Return() *SchoolController_InviteSchoolMember_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SchoolController_InviteSchoolMember_Call) RunAndReturn(run func(*gin.Context)) *SchoolController_InviteSchoolMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRegions provides a mock function with given fields: c
+func (_m *SchoolController) ListRegions(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SchoolController_ListRegions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRegions'
+type SchoolController_ListRegions_Call struct {
+	*mock.Call
+}
+
+// ListRegions is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *SchoolController_Expecter) ListRegions(c interface{}) *SchoolController_ListRegions_Call {
+	return &SchoolController_ListRegions_Call{Call: _e.mock.On("ListRegions", c)}
+}
+
+func (_c *SchoolController_ListRegions_Call) Run(run func(c *gin.Context)) *SchoolController_ListRegions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *SchoolController_ListRegions_Call) Return() *SchoolController_ListRegions_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SchoolController_ListRegions_Call) RunAndReturn(run func(*gin.Context)) *SchoolController_ListRegions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSchoolMembers provides a mock function with given fields: c
+func (_m *SchoolController) ListSchoolMembers(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SchoolController_ListSchoolMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSchoolMembers'
+type SchoolController_ListSchoolMembers_Call struct {
+	*mock.Call
+}
+
+// ListSchoolMembers is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *SchoolController_Expecter) ListSchoolMembers(c interface{}) *SchoolController_ListSchoolMembers_Call {
+	return &SchoolController_ListSchoolMembers_Call{Call: _e.mock.On("ListSchoolMembers", c)}
+}
+
+func (_c *SchoolController_ListSchoolMembers_Call) Run(run func(c *gin.Context)) *SchoolController_ListSchoolMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *SchoolController_ListSchoolMembers_Call) Return() *SchoolController_ListSchoolMembers_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SchoolController_ListSchoolMembers_Call) RunAndReturn(run func(*gin.Context)) *SchoolController_ListSchoolMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListSchools provides a mock function with given fields: c
 func (_m *SchoolController) ListSchools(c *gin.Context) {
 	_m.Called(c)
@@ -86,6 +218,138 @@ func (_c *SchoolController_ListSchools_Call) RunAndReturn(run func(*gin.Context)
 	return _c
 }
 
+// RemoveSchoolMember provides a mock function with given fields: c
+func (_m *SchoolController) RemoveSchoolMember(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SchoolController_RemoveSchoolMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSchoolMember'
+type SchoolController_RemoveSchoolMember_Call struct {
+	*mock.Call
+}
+
+// RemoveSchoolMember is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *SchoolController_Expecter) RemoveSchoolMember(c interface{}) *SchoolController_RemoveSchoolMember_Call {
+	return &SchoolController_RemoveSchoolMember_Call{Call: _e.mock.On("RemoveSchoolMember", c)}
+}
+
+func (_c *SchoolController_RemoveSchoolMember_Call) Run(run func(c *gin.Context)) *SchoolController_RemoveSchoolMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *SchoolController_RemoveSchoolMember_Call) Return() *SchoolController_RemoveSchoolMember_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SchoolController_RemoveSchoolMember_Call) RunAndReturn(run func(*gin.Context)) *SchoolController_RemoveSchoolMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreSchool provides a mock function with given fields: c
+func (_m *SchoolController) RestoreSchool(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SchoolController_RestoreSchool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSchool'
+type SchoolController_RestoreSchool_Call struct {
+	*mock.Call
+}
+
+// RestoreSchool is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *SchoolController_Expecter) RestoreSchool(c interface{}) *SchoolController_RestoreSchool_Call {
+	return &SchoolController_RestoreSchool_Call{Call: _e.mock.On("RestoreSchool", c)}
+}
+
+func (_c *SchoolController_RestoreSchool_Call) Run(run func(c *gin.Context)) *SchoolController_RestoreSchool_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *SchoolController_RestoreSchool_Call) Return() *SchoolController_RestoreSchool_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SchoolController_RestoreSchool_Call) RunAndReturn(run func(*gin.Context)) *SchoolController_RestoreSchool_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferSchoolOwnership provides a mock function with given fields: c
+func (_m *SchoolController) TransferSchoolOwnership(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SchoolController_TransferSchoolOwnership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferSchoolOwnership'
+type SchoolController_TransferSchoolOwnership_Call struct {
+	*mock.Call
+}
+
+// TransferSchoolOwnership is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *SchoolController_Expecter) TransferSchoolOwnership(c interface{}) *SchoolController_TransferSchoolOwnership_Call {
+	return &SchoolController_TransferSchoolOwnership_Call{Call: _e.mock.On("TransferSchoolOwnership", c)}
+}
+
+func (_c *SchoolController_TransferSchoolOwnership_Call) Run(run func(c *gin.Context)) *SchoolController_TransferSchoolOwnership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *SchoolController_TransferSchoolOwnership_Call) Return() *SchoolController_TransferSchoolOwnership_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SchoolController_TransferSchoolOwnership_Call) RunAndReturn(run func(*gin.Context)) *SchoolController_TransferSchoolOwnership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSchool provides a mock function with given fields: c
+func (_m *SchoolController) UpdateSchool(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SchoolController_UpdateSchool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSchool'
+type SchoolController_UpdateSchool_Call struct {
+	*mock.Call
+}
+
+// UpdateSchool is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *SchoolController_Expecter) UpdateSchool(c interface{}) *SchoolController_UpdateSchool_Call {
+	return &SchoolController_UpdateSchool_Call{Call: _e.mock.On("UpdateSchool", c)}
+}
+
+func (_c *SchoolController_UpdateSchool_Call) Run(run func(c *gin.Context)) *SchoolController_UpdateSchool_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *SchoolController_UpdateSchool_Call) Return() *SchoolController_UpdateSchool_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SchoolController_UpdateSchool_Call) RunAndReturn(run func(*gin.Context)) *SchoolController_UpdateSchool_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSchoolController creates a new instance of SchoolController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSchoolController(t interface {
@@ -75,6 +75,145 @@ func (_c *SchoolRepository_CreateSchool_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// CreateSchoolMember provides a mock function with given fields: ctx, member
+func (_m *SchoolRepository) CreateSchoolMember(ctx context.Context, member domain.SchoolMember) (int, error) {
+	ret := _m.Called(ctx, member)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SchoolMember) (int, error)); ok {
+		return rf(ctx, member)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SchoolMember) int); ok {
+		r0 = rf(ctx, member)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.SchoolMember) error); ok {
+		r1 = rf(ctx, member)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SchoolRepository_CreateSchoolMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSchoolMember'
+type SchoolRepository_CreateSchoolMember_Call struct {
+	*mock.Call
+}
+
+// CreateSchoolMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member domain.SchoolMember
+func (_e *SchoolRepository_Expecter) CreateSchoolMember(ctx interface{}, member interface{}) *SchoolRepository_CreateSchoolMember_Call {
+	return &SchoolRepository_CreateSchoolMember_Call{Call: _e.mock.On("CreateSchoolMember", ctx, member)}
+}
+
+func (_c *SchoolRepository_CreateSchoolMember_Call) Run(run func(ctx context.Context, member domain.SchoolMember)) *SchoolRepository_CreateSchoolMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.SchoolMember))
+	})
+	return _c
+}
+
+func (_c *SchoolRepository_CreateSchoolMember_Call) Return(_a0 int, _a1 error) *SchoolRepository_CreateSchoolMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SchoolRepository_CreateSchoolMember_Call) RunAndReturn(run func(context.Context, domain.SchoolMember) (int, error)) *SchoolRepository_CreateSchoolMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSchool provides a mock function with given fields: ctx, schoolID
+func (_m *SchoolRepository) DeleteSchool(ctx context.Context, schoolID int) error {
+	ret := _m.Called(ctx, schoolID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, schoolID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchoolRepository_DeleteSchool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSchool'
+type SchoolRepository_DeleteSchool_Call struct {
+	*mock.Call
+}
+
+// DeleteSchool is a helper method to define mock.On call
+//   - ctx context.Context
+//   - schoolID int
+func (_e *SchoolRepository_Expecter) DeleteSchool(ctx interface{}, schoolID interface{}) *SchoolRepository_DeleteSchool_Call {
+	return &SchoolRepository_DeleteSchool_Call{Call: _e.mock.On("DeleteSchool", ctx, schoolID)}
+}
+
+func (_c *SchoolRepository_DeleteSchool_Call) Run(run func(ctx context.Context, schoolID int)) *SchoolRepository_DeleteSchool_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SchoolRepository_DeleteSchool_Call) Return(_a0 error) *SchoolRepository_DeleteSchool_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchoolRepository_DeleteSchool_Call) RunAndReturn(run func(context.Context, int) error) *SchoolRepository_DeleteSchool_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSchoolMember provides a mock function with given fields: ctx, memberID
+func (_m *SchoolRepository) DeleteSchoolMember(ctx context.Context, memberID int) error {
+	ret := _m.Called(ctx, memberID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, memberID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchoolRepository_DeleteSchoolMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSchoolMember'
+type SchoolRepository_DeleteSchoolMember_Call struct {
+	*mock.Call
+}
+
+// DeleteSchoolMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - memberID int
+func (_e *SchoolRepository_Expecter) DeleteSchoolMember(ctx interface{}, memberID interface{}) *SchoolRepository_DeleteSchoolMember_Call {
+	return &SchoolRepository_DeleteSchoolMember_Call{Call: _e.mock.On("DeleteSchoolMember", ctx, memberID)}
+}
+
+func (_c *SchoolRepository_DeleteSchoolMember_Call) Run(run func(ctx context.Context, memberID int)) *SchoolRepository_DeleteSchoolMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SchoolRepository_DeleteSchoolMember_Call) Return(_a0 error) *SchoolRepository_DeleteSchoolMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchoolRepository_DeleteSchoolMember_Call) RunAndReturn(run func(context.Context, int) error) *SchoolRepository_DeleteSchoolMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindSchoolByID provides a mock function with given fields: ctx, schoolID
 func (_m *SchoolRepository) FindSchoolByID(ctx context.Context, schoolID int) (*domain.School, error) {
 	ret := _m.Called(ctx, schoolID)
@@ -185,20 +324,130 @@ func (_c *SchoolRepository_FindSchoolByNameAndRegion_Call) RunAndReturn(run func
 	return _c
 }
 
+// FindSchoolMember provides a mock function with given fields: ctx, params
+func (_m *SchoolRepository) FindSchoolMember(ctx context.Context, params domain.FindSchoolMemberParams) (*domain.SchoolMember, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domain.SchoolMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.FindSchoolMemberParams) (*domain.SchoolMember, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.FindSchoolMemberParams) *domain.SchoolMember); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SchoolMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.FindSchoolMemberParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SchoolRepository_FindSchoolMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSchoolMember'
+type SchoolRepository_FindSchoolMember_Call struct {
+	*mock.Call
+}
+
+// FindSchoolMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.FindSchoolMemberParams
+func (_e *SchoolRepository_Expecter) FindSchoolMember(ctx interface{}, params interface{}) *SchoolRepository_FindSchoolMember_Call {
+	return &SchoolRepository_FindSchoolMember_Call{Call: _e.mock.On("FindSchoolMember", ctx, params)}
+}
+
+func (_c *SchoolRepository_FindSchoolMember_Call) Run(run func(ctx context.Context, params domain.FindSchoolMemberParams)) *SchoolRepository_FindSchoolMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.FindSchoolMemberParams))
+	})
+	return _c
+}
+
+func (_c *SchoolRepository_FindSchoolMember_Call) Return(_a0 *domain.SchoolMember, _a1 error) *SchoolRepository_FindSchoolMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SchoolRepository_FindSchoolMember_Call) RunAndReturn(run func(context.Context, domain.FindSchoolMemberParams) (*domain.SchoolMember, error)) *SchoolRepository_FindSchoolMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSchoolMembers provides a mock function with given fields: ctx, schoolID
+func (_m *SchoolRepository) ListSchoolMembers(ctx context.Context, schoolID int) ([]domain.SchoolMember, error) {
+	ret := _m.Called(ctx, schoolID)
+
+	var r0 []domain.SchoolMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.SchoolMember, error)); ok {
+		return rf(ctx, schoolID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.SchoolMember); ok {
+		r0 = rf(ctx, schoolID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SchoolMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, schoolID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SchoolRepository_ListSchoolMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSchoolMembers'
+type SchoolRepository_ListSchoolMembers_Call struct {
+	*mock.Call
+}
+
+// ListSchoolMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - schoolID int
+func (_e *SchoolRepository_Expecter) ListSchoolMembers(ctx interface{}, schoolID interface{}) *SchoolRepository_ListSchoolMembers_Call {
+	return &SchoolRepository_ListSchoolMembers_Call{Call: _e.mock.On("ListSchoolMembers", ctx, schoolID)}
+}
+
+func (_c *SchoolRepository_ListSchoolMembers_Call) Run(run func(ctx context.Context, schoolID int)) *SchoolRepository_ListSchoolMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SchoolRepository_ListSchoolMembers_Call) Return(_a0 []domain.SchoolMember, _a1 error) *SchoolRepository_ListSchoolMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SchoolRepository_ListSchoolMembers_Call) RunAndReturn(run func(context.Context, int) ([]domain.SchoolMember, error)) *SchoolRepository_ListSchoolMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListSchools provides a mock function with given fields: ctx, params
-func (_m *SchoolRepository) ListSchools(ctx context.Context, params domain.ListSchoolsParams) ([]domain.School, error) {
+func (_m *SchoolRepository) ListSchools(ctx context.Context, params domain.ListSchoolsParams) ([]domain.DirectorySchool, error) {
 	ret := _m.Called(ctx, params)
 
-	var r0 []domain.School
+	var r0 []domain.DirectorySchool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ListSchoolsParams) ([]domain.School, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListSchoolsParams) ([]domain.DirectorySchool, error)); ok {
 		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ListSchoolsParams) []domain.School); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListSchoolsParams) []domain.DirectorySchool); ok {
 		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.School)
+			r0 = ret.Get(0).([]domain.DirectorySchool)
 		}
 	}
 
@@ -230,12 +479,141 @@ func (_c *SchoolRepository_ListSchools_Call) Run(run func(ctx context.Context, p
 	return _c
 }
 
-func (_c *SchoolRepository_ListSchools_Call) Return(_a0 []domain.School, _a1 error) *SchoolRepository_ListSchools_Call {
+func (_c *SchoolRepository_ListSchools_Call) Return(_a0 []domain.DirectorySchool, _a1 error) *SchoolRepository_ListSchools_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *SchoolRepository_ListSchools_Call) RunAndReturn(run func(context.Context, domain.ListSchoolsParams) ([]domain.School, error)) *SchoolRepository_ListSchools_Call {
+func (_c *SchoolRepository_ListSchools_Call) RunAndReturn(run func(context.Context, domain.ListSchoolsParams) ([]domain.DirectorySchool, error)) *SchoolRepository_ListSchools_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreSchool provides a mock function with given fields: ctx, schoolID
+func (_m *SchoolRepository) RestoreSchool(ctx context.Context, schoolID int) error {
+	ret := _m.Called(ctx, schoolID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, schoolID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchoolRepository_RestoreSchool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSchool'
+type SchoolRepository_RestoreSchool_Call struct {
+	*mock.Call
+}
+
+// RestoreSchool is a helper method to define mock.On call
+//   - ctx context.Context
+//   - schoolID int
+func (_e *SchoolRepository_Expecter) RestoreSchool(ctx interface{}, schoolID interface{}) *SchoolRepository_RestoreSchool_Call {
+	return &SchoolRepository_RestoreSchool_Call{Call: _e.mock.On("RestoreSchool", ctx, schoolID)}
+}
+
+func (_c *SchoolRepository_RestoreSchool_Call) Run(run func(ctx context.Context, schoolID int)) *SchoolRepository_RestoreSchool_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SchoolRepository_RestoreSchool_Call) Return(_a0 error) *SchoolRepository_RestoreSchool_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchoolRepository_RestoreSchool_Call) RunAndReturn(run func(context.Context, int) error) *SchoolRepository_RestoreSchool_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferSchoolOwnership provides a mock function with given fields: ctx, params
+func (_m *SchoolRepository) TransferSchoolOwnership(ctx context.Context, params domain.TransferSchoolOwnershipParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TransferSchoolOwnershipParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchoolRepository_TransferSchoolOwnership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferSchoolOwnership'
+type SchoolRepository_TransferSchoolOwnership_Call struct {
+	*mock.Call
+}
+
+// TransferSchoolOwnership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.TransferSchoolOwnershipParams
+func (_e *SchoolRepository_Expecter) TransferSchoolOwnership(ctx interface{}, params interface{}) *SchoolRepository_TransferSchoolOwnership_Call {
+	return &SchoolRepository_TransferSchoolOwnership_Call{Call: _e.mock.On("TransferSchoolOwnership", ctx, params)}
+}
+
+func (_c *SchoolRepository_TransferSchoolOwnership_Call) Run(run func(ctx context.Context, params domain.TransferSchoolOwnershipParams)) *SchoolRepository_TransferSchoolOwnership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TransferSchoolOwnershipParams))
+	})
+	return _c
+}
+
+func (_c *SchoolRepository_TransferSchoolOwnership_Call) Return(_a0 error) *SchoolRepository_TransferSchoolOwnership_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchoolRepository_TransferSchoolOwnership_Call) RunAndReturn(run func(context.Context, domain.TransferSchoolOwnershipParams) error) *SchoolRepository_TransferSchoolOwnership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSchool provides a mock function with given fields: ctx, school
+func (_m *SchoolRepository) UpdateSchool(ctx context.Context, school domain.School) error {
+	ret := _m.Called(ctx, school)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.School) error); ok {
+		r0 = rf(ctx, school)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchoolRepository_UpdateSchool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSchool'
+type SchoolRepository_UpdateSchool_Call struct {
+	*mock.Call
+}
+
+// UpdateSchool is a helper method to define mock.On call
+//   - ctx context.Context
+//   - school domain.School
+func (_e *SchoolRepository_Expecter) UpdateSchool(ctx interface{}, school interface{}) *SchoolRepository_UpdateSchool_Call {
+	return &SchoolRepository_UpdateSchool_Call{Call: _e.mock.On("UpdateSchool", ctx, school)}
+}
+
+func (_c *SchoolRepository_UpdateSchool_Call) Run(run func(ctx context.Context, school domain.School)) *SchoolRepository_UpdateSchool_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.School))
+	})
+	return _c
+}
+
+func (_c *SchoolRepository_UpdateSchool_Call) Return(_a0 error) *SchoolRepository_UpdateSchool_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchoolRepository_UpdateSchool_Call) RunAndReturn(run func(context.Context, domain.School) error) *SchoolRepository_UpdateSchool_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -65,6 +65,197 @@ func (_c *SchoolService_CreateSchool_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// DeleteSchool provides a mock function with given fields: ctx, req
+func (_m *SchoolService) DeleteSchool(ctx context.Context, req domain.DeleteSchoolRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DeleteSchoolRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchoolService_DeleteSchool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSchool'
+type SchoolService_DeleteSchool_Call struct {
+	*mock.Call
+}
+
+// DeleteSchool is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.DeleteSchoolRequest
+func (_e *SchoolService_Expecter) DeleteSchool(ctx interface{}, req interface{}) *SchoolService_DeleteSchool_Call {
+	return &SchoolService_DeleteSchool_Call{Call: _e.mock.On("DeleteSchool", ctx, req)}
+}
+
+func (_c *SchoolService_DeleteSchool_Call) Run(run func(ctx context.Context, req domain.DeleteSchoolRequest)) *SchoolService_DeleteSchool_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DeleteSchoolRequest))
+	})
+	return _c
+}
+
+func (_c *SchoolService_DeleteSchool_Call) Return(_a0 error) *SchoolService_DeleteSchool_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchoolService_DeleteSchool_Call) RunAndReturn(run func(context.Context, domain.DeleteSchoolRequest) error) *SchoolService_DeleteSchool_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InviteSchoolMember provides a mock function with given fields: ctx, req
+func (_m *SchoolService) InviteSchoolMember(ctx context.Context, req domain.InviteSchoolMemberRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.InviteSchoolMemberRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchoolService_InviteSchoolMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InviteSchoolMember'
+type SchoolService_InviteSchoolMember_Call struct {
+	*mock.Call
+}
+
+// InviteSchoolMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.InviteSchoolMemberRequest
+func (_e *SchoolService_Expecter) InviteSchoolMember(ctx interface{}, req interface{}) *SchoolService_InviteSchoolMember_Call {
+	return &SchoolService_InviteSchoolMember_Call{Call: _e.mock.On("InviteSchoolMember", ctx, req)}
+}
+
+func (_c *SchoolService_InviteSchoolMember_Call) Run(run func(ctx context.Context, req domain.InviteSchoolMemberRequest)) *SchoolService_InviteSchoolMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.InviteSchoolMemberRequest))
+	})
+	return _c
+}
+
+func (_c *SchoolService_InviteSchoolMember_Call) Return(_a0 error) *SchoolService_InviteSchoolMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchoolService_InviteSchoolMember_Call) RunAndReturn(run func(context.Context, domain.InviteSchoolMemberRequest) error) *SchoolService_InviteSchoolMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRegions provides a mock function with given fields: ctx
+func (_m *SchoolService) ListRegions(ctx context.Context) (domain.ListRegionsResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 domain.ListRegionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (domain.ListRegionsResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) domain.ListRegionsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(domain.ListRegionsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SchoolService_ListRegions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRegions'
+type SchoolService_ListRegions_Call struct {
+	*mock.Call
+}
+
+// ListRegions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SchoolService_Expecter) ListRegions(ctx interface{}) *SchoolService_ListRegions_Call {
+	return &SchoolService_ListRegions_Call{Call: _e.mock.On("ListRegions", ctx)}
+}
+
+func (_c *SchoolService_ListRegions_Call) Run(run func(ctx context.Context)) *SchoolService_ListRegions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SchoolService_ListRegions_Call) Return(_a0 domain.ListRegionsResponse, _a1 error) *SchoolService_ListRegions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SchoolService_ListRegions_Call) RunAndReturn(run func(context.Context) (domain.ListRegionsResponse, error)) *SchoolService_ListRegions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSchoolMembers provides a mock function with given fields: ctx, req
+func (_m *SchoolService) ListSchoolMembers(ctx context.Context, req domain.ListSchoolMembersRequest) (domain.ListSchoolMembersResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ListSchoolMembersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListSchoolMembersRequest) (domain.ListSchoolMembersResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListSchoolMembersRequest) domain.ListSchoolMembersResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ListSchoolMembersResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListSchoolMembersRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SchoolService_ListSchoolMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSchoolMembers'
+type SchoolService_ListSchoolMembers_Call struct {
+	*mock.Call
+}
+
+// ListSchoolMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ListSchoolMembersRequest
+func (_e *SchoolService_Expecter) ListSchoolMembers(ctx interface{}, req interface{}) *SchoolService_ListSchoolMembers_Call {
+	return &SchoolService_ListSchoolMembers_Call{Call: _e.mock.On("ListSchoolMembers", ctx, req)}
+}
+
+func (_c *SchoolService_ListSchoolMembers_Call) Run(run func(ctx context.Context, req domain.ListSchoolMembersRequest)) *SchoolService_ListSchoolMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListSchoolMembersRequest))
+	})
+	return _c
+}
+
+func (_c *SchoolService_ListSchoolMembers_Call) Return(_a0 domain.ListSchoolMembersResponse, _a1 error) *SchoolService_ListSchoolMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SchoolService_ListSchoolMembers_Call) RunAndReturn(run func(context.Context, domain.ListSchoolMembersRequest) (domain.ListSchoolMembersResponse, error)) *SchoolService_ListSchoolMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListSchools provides a mock function with given fields: ctx, req
 func (_m *SchoolService) ListSchools(ctx context.Context, req domain.ListSchoolsRequest) (domain.ListSchoolsResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -118,6 +309,178 @@ func (_c *SchoolService_ListSchools_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// RemoveSchoolMember provides a mock function with given fields: ctx, req
+func (_m *SchoolService) RemoveSchoolMember(ctx context.Context, req domain.RemoveSchoolMemberRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RemoveSchoolMemberRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchoolService_RemoveSchoolMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSchoolMember'
+type SchoolService_RemoveSchoolMember_Call struct {
+	*mock.Call
+}
+
+// RemoveSchoolMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.RemoveSchoolMemberRequest
+func (_e *SchoolService_Expecter) RemoveSchoolMember(ctx interface{}, req interface{}) *SchoolService_RemoveSchoolMember_Call {
+	return &SchoolService_RemoveSchoolMember_Call{Call: _e.mock.On("RemoveSchoolMember", ctx, req)}
+}
+
+func (_c *SchoolService_RemoveSchoolMember_Call) Run(run func(ctx context.Context, req domain.RemoveSchoolMemberRequest)) *SchoolService_RemoveSchoolMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.RemoveSchoolMemberRequest))
+	})
+	return _c
+}
+
+func (_c *SchoolService_RemoveSchoolMember_Call) Return(_a0 error) *SchoolService_RemoveSchoolMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchoolService_RemoveSchoolMember_Call) RunAndReturn(run func(context.Context, domain.RemoveSchoolMemberRequest) error) *SchoolService_RemoveSchoolMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreSchool provides a mock function with given fields: ctx, req
+func (_m *SchoolService) RestoreSchool(ctx context.Context, req domain.RestoreSchoolRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RestoreSchoolRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchoolService_RestoreSchool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSchool'
+type SchoolService_RestoreSchool_Call struct {
+	*mock.Call
+}
+
+// RestoreSchool is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.RestoreSchoolRequest
+func (_e *SchoolService_Expecter) RestoreSchool(ctx interface{}, req interface{}) *SchoolService_RestoreSchool_Call {
+	return &SchoolService_RestoreSchool_Call{Call: _e.mock.On("RestoreSchool", ctx, req)}
+}
+
+func (_c *SchoolService_RestoreSchool_Call) Run(run func(ctx context.Context, req domain.RestoreSchoolRequest)) *SchoolService_RestoreSchool_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.RestoreSchoolRequest))
+	})
+	return _c
+}
+
+func (_c *SchoolService_RestoreSchool_Call) Return(_a0 error) *SchoolService_RestoreSchool_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchoolService_RestoreSchool_Call) RunAndReturn(run func(context.Context, domain.RestoreSchoolRequest) error) *SchoolService_RestoreSchool_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferSchoolOwnership provides a mock function with given fields: ctx, req
+func (_m *SchoolService) TransferSchoolOwnership(ctx context.Context, req domain.TransferSchoolOwnershipRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TransferSchoolOwnershipRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchoolService_TransferSchoolOwnership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferSchoolOwnership'
+type SchoolService_TransferSchoolOwnership_Call struct {
+	*mock.Call
+}
+
+// TransferSchoolOwnership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.TransferSchoolOwnershipRequest
+func (_e *SchoolService_Expecter) TransferSchoolOwnership(ctx interface{}, req interface{}) *SchoolService_TransferSchoolOwnership_Call {
+	return &SchoolService_TransferSchoolOwnership_Call{Call: _e.mock.On("TransferSchoolOwnership", ctx, req)}
+}
+
+func (_c *SchoolService_TransferSchoolOwnership_Call) Run(run func(ctx context.Context, req domain.TransferSchoolOwnershipRequest)) *SchoolService_TransferSchoolOwnership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TransferSchoolOwnershipRequest))
+	})
+	return _c
+}
+
+func (_c *SchoolService_TransferSchoolOwnership_Call) Return(_a0 error) *SchoolService_TransferSchoolOwnership_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchoolService_TransferSchoolOwnership_Call) RunAndReturn(run func(context.Context, domain.TransferSchoolOwnershipRequest) error) *SchoolService_TransferSchoolOwnership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSchool provides a mock function with given fields: ctx, req
+func (_m *SchoolService) UpdateSchool(ctx context.Context, req domain.UpdateSchoolRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UpdateSchoolRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchoolService_UpdateSchool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSchool'
+type SchoolService_UpdateSchool_Call struct {
+	*mock.Call
+}
+
+// UpdateSchool is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.UpdateSchoolRequest
+func (_e *SchoolService_Expecter) UpdateSchool(ctx interface{}, req interface{}) *SchoolService_UpdateSchool_Call {
+	return &SchoolService_UpdateSchool_Call{Call: _e.mock.On("UpdateSchool", ctx, req)}
+}
+
+func (_c *SchoolService_UpdateSchool_Call) Run(run func(ctx context.Context, req domain.UpdateSchoolRequest)) *SchoolService_UpdateSchool_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UpdateSchoolRequest))
+	})
+	return _c
+}
+
+func (_c *SchoolService_UpdateSchool_Call) Return(_a0 error) *SchoolService_UpdateSchool_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchoolService_UpdateSchool_Call) RunAndReturn(run func(context.Context, domain.UpdateSchoolRequest) error) *SchoolService_UpdateSchool_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSchoolService creates a new instance of SchoolService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSchoolService(t interface {
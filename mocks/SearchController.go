@@ -0,0 +1,101 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SearchController is an autogenerated mock type for the SearchController type
+type SearchController struct {
+	mock.Mock
+}
+
+type SearchController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SearchController) EXPECT() *SearchController_Expecter {
+	return &SearchController_Expecter{mock: &_m.Mock}
+}
+
+// SearchNews provides a mock function with given fields: c
+func (_m *SearchController) SearchNews(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SearchController_SearchNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchNews'
+type SearchController_SearchNews_Call struct {
+	*mock.Call
+}
+
+// SearchNews is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *SearchController_Expecter) SearchNews(c interface{}) *SearchController_SearchNews_Call {
+	return &SearchController_SearchNews_Call{Call: _e.mock.On("SearchNews", c)}
+}
+
+func (_c *SearchController_SearchNews_Call) Run(run func(c *gin.Context)) *SearchController_SearchNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *SearchController_SearchNews_Call) Return() *SearchController_SearchNews_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SearchController_SearchNews_Call) RunAndReturn(run func(*gin.Context)) *SearchController_SearchNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchSchools provides a mock function with given fields: c
+func (_m *SearchController) SearchSchools(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SearchController_SearchSchools_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchSchools'
+type SearchController_SearchSchools_Call struct {
+	*mock.Call
+}
+
+// SearchSchools is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *SearchController_Expecter) SearchSchools(c interface{}) *SearchController_SearchSchools_Call {
+	return &SearchController_SearchSchools_Call{Call: _e.mock.On("SearchSchools", c)}
+}
+
+func (_c *SearchController_SearchSchools_Call) Run(run func(c *gin.Context)) *SearchController_SearchSchools_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *SearchController_SearchSchools_Call) Return() *SearchController_SearchSchools_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SearchController_SearchSchools_Call) RunAndReturn(run func(*gin.Context)) *SearchController_SearchSchools_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSearchController creates a new instance of SearchController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSearchController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SearchController {
+	mock := &SearchController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,319 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SearchIndex is an autogenerated mock type for the SearchIndex type
+type SearchIndex struct {
+	mock.Mock
+}
+
+type SearchIndex_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SearchIndex) EXPECT() *SearchIndex_Expecter {
+	return &SearchIndex_Expecter{mock: &_m.Mock}
+}
+
+// IndexNews provides a mock function with given fields: ctx, news
+func (_m *SearchIndex) IndexNews(ctx context.Context, news domain.News) error {
+	ret := _m.Called(ctx, news)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.News) error); ok {
+		r0 = rf(ctx, news)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchIndex_IndexNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IndexNews'
+type SearchIndex_IndexNews_Call struct {
+	*mock.Call
+}
+
+// IndexNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - news domain.News
+func (_e *SearchIndex_Expecter) IndexNews(ctx interface{}, news interface{}) *SearchIndex_IndexNews_Call {
+	return &SearchIndex_IndexNews_Call{Call: _e.mock.On("IndexNews", ctx, news)}
+}
+
+func (_c *SearchIndex_IndexNews_Call) Run(run func(ctx context.Context, news domain.News)) *SearchIndex_IndexNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.News))
+	})
+	return _c
+}
+
+func (_c *SearchIndex_IndexNews_Call) Return(_a0 error) *SearchIndex_IndexNews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SearchIndex_IndexNews_Call) RunAndReturn(run func(context.Context, domain.News) error) *SearchIndex_IndexNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IndexSchool provides a mock function with given fields: ctx, school
+func (_m *SearchIndex) IndexSchool(ctx context.Context, school domain.School) error {
+	ret := _m.Called(ctx, school)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.School) error); ok {
+		r0 = rf(ctx, school)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchIndex_IndexSchool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IndexSchool'
+type SearchIndex_IndexSchool_Call struct {
+	*mock.Call
+}
+
+// IndexSchool is a helper method to define mock.On call
+//   - ctx context.Context
+//   - school domain.School
+func (_e *SearchIndex_Expecter) IndexSchool(ctx interface{}, school interface{}) *SearchIndex_IndexSchool_Call {
+	return &SearchIndex_IndexSchool_Call{Call: _e.mock.On("IndexSchool", ctx, school)}
+}
+
+func (_c *SearchIndex_IndexSchool_Call) Run(run func(ctx context.Context, school domain.School)) *SearchIndex_IndexSchool_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.School))
+	})
+	return _c
+}
+
+func (_c *SearchIndex_IndexSchool_Call) Return(_a0 error) *SearchIndex_IndexSchool_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SearchIndex_IndexSchool_Call) RunAndReturn(run func(context.Context, domain.School) error) *SearchIndex_IndexSchool_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveNews provides a mock function with given fields: ctx, newsID
+func (_m *SearchIndex) RemoveNews(ctx context.Context, newsID int) error {
+	ret := _m.Called(ctx, newsID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, newsID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchIndex_RemoveNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveNews'
+type SearchIndex_RemoveNews_Call struct {
+	*mock.Call
+}
+
+// RemoveNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newsID int
+func (_e *SearchIndex_Expecter) RemoveNews(ctx interface{}, newsID interface{}) *SearchIndex_RemoveNews_Call {
+	return &SearchIndex_RemoveNews_Call{Call: _e.mock.On("RemoveNews", ctx, newsID)}
+}
+
+func (_c *SearchIndex_RemoveNews_Call) Run(run func(ctx context.Context, newsID int)) *SearchIndex_RemoveNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SearchIndex_RemoveNews_Call) Return(_a0 error) *SearchIndex_RemoveNews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SearchIndex_RemoveNews_Call) RunAndReturn(run func(context.Context, int) error) *SearchIndex_RemoveNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveSchool provides a mock function with given fields: ctx, schoolID
+func (_m *SearchIndex) RemoveSchool(ctx context.Context, schoolID int) error {
+	ret := _m.Called(ctx, schoolID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, schoolID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchIndex_RemoveSchool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSchool'
+type SearchIndex_RemoveSchool_Call struct {
+	*mock.Call
+}
+
+// RemoveSchool is a helper method to define mock.On call
+//   - ctx context.Context
+//   - schoolID int
+func (_e *SearchIndex_Expecter) RemoveSchool(ctx interface{}, schoolID interface{}) *SearchIndex_RemoveSchool_Call {
+	return &SearchIndex_RemoveSchool_Call{Call: _e.mock.On("RemoveSchool", ctx, schoolID)}
+}
+
+func (_c *SearchIndex_RemoveSchool_Call) Run(run func(ctx context.Context, schoolID int)) *SearchIndex_RemoveSchool_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SearchIndex_RemoveSchool_Call) Return(_a0 error) *SearchIndex_RemoveSchool_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SearchIndex_RemoveSchool_Call) RunAndReturn(run func(context.Context, int) error) *SearchIndex_RemoveSchool_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchNews provides a mock function with given fields: ctx, params
+func (_m *SearchIndex) SearchNews(ctx context.Context, params domain.SearchNewsParams) ([]int, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SearchNewsParams) ([]int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SearchNewsParams) []int); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.SearchNewsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchIndex_SearchNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchNews'
+type SearchIndex_SearchNews_Call struct {
+	*mock.Call
+}
+
+// SearchNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.SearchNewsParams
+func (_e *SearchIndex_Expecter) SearchNews(ctx interface{}, params interface{}) *SearchIndex_SearchNews_Call {
+	return &SearchIndex_SearchNews_Call{Call: _e.mock.On("SearchNews", ctx, params)}
+}
+
+func (_c *SearchIndex_SearchNews_Call) Run(run func(ctx context.Context, params domain.SearchNewsParams)) *SearchIndex_SearchNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.SearchNewsParams))
+	})
+	return _c
+}
+
+func (_c *SearchIndex_SearchNews_Call) Return(_a0 []int, _a1 error) *SearchIndex_SearchNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SearchIndex_SearchNews_Call) RunAndReturn(run func(context.Context, domain.SearchNewsParams) ([]int, error)) *SearchIndex_SearchNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchSchools provides a mock function with given fields: ctx, params
+func (_m *SearchIndex) SearchSchools(ctx context.Context, params domain.SearchSchoolsParams) ([]int, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SearchSchoolsParams) ([]int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SearchSchoolsParams) []int); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.SearchSchoolsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchIndex_SearchSchools_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchSchools'
+type SearchIndex_SearchSchools_Call struct {
+	*mock.Call
+}
+
+// SearchSchools is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.SearchSchoolsParams
+func (_e *SearchIndex_Expecter) SearchSchools(ctx interface{}, params interface{}) *SearchIndex_SearchSchools_Call {
+	return &SearchIndex_SearchSchools_Call{Call: _e.mock.On("SearchSchools", ctx, params)}
+}
+
+func (_c *SearchIndex_SearchSchools_Call) Run(run func(ctx context.Context, params domain.SearchSchoolsParams)) *SearchIndex_SearchSchools_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.SearchSchoolsParams))
+	})
+	return _c
+}
+
+func (_c *SearchIndex_SearchSchools_Call) Return(_a0 []int, _a1 error) *SearchIndex_SearchSchools_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SearchIndex_SearchSchools_Call) RunAndReturn(run func(context.Context, domain.SearchSchoolsParams) ([]int, error)) *SearchIndex_SearchSchools_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSearchIndex creates a new instance of SearchIndex. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSearchIndex(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SearchIndex {
+	mock := &SearchIndex{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,312 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SearchRepository is an autogenerated mock type for the SearchRepository type
+type SearchRepository struct {
+	mock.Mock
+}
+
+type SearchRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SearchRepository) EXPECT() *SearchRepository_Expecter {
+	return &SearchRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListIndexNews provides a mock function with given fields: ctx, params
+func (_m *SearchRepository) ListIndexNews(ctx context.Context, params domain.ListIndexDocumentsParams) ([]domain.News, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.News
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListIndexDocumentsParams) ([]domain.News, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListIndexDocumentsParams) []domain.News); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.News)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListIndexDocumentsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchRepository_ListIndexNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIndexNews'
+type SearchRepository_ListIndexNews_Call struct {
+	*mock.Call
+}
+
+// ListIndexNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListIndexDocumentsParams
+func (_e *SearchRepository_Expecter) ListIndexNews(ctx interface{}, params interface{}) *SearchRepository_ListIndexNews_Call {
+	return &SearchRepository_ListIndexNews_Call{Call: _e.mock.On("ListIndexNews", ctx, params)}
+}
+
+func (_c *SearchRepository_ListIndexNews_Call) Run(run func(ctx context.Context, params domain.ListIndexDocumentsParams)) *SearchRepository_ListIndexNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListIndexDocumentsParams))
+	})
+	return _c
+}
+
+func (_c *SearchRepository_ListIndexNews_Call) Return(_a0 []domain.News, _a1 error) *SearchRepository_ListIndexNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SearchRepository_ListIndexNews_Call) RunAndReturn(run func(context.Context, domain.ListIndexDocumentsParams) ([]domain.News, error)) *SearchRepository_ListIndexNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIndexSchools provides a mock function with given fields: ctx, params
+func (_m *SearchRepository) ListIndexSchools(ctx context.Context, params domain.ListIndexDocumentsParams) ([]domain.School, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.School
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListIndexDocumentsParams) ([]domain.School, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListIndexDocumentsParams) []domain.School); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.School)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListIndexDocumentsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchRepository_ListIndexSchools_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIndexSchools'
+type SearchRepository_ListIndexSchools_Call struct {
+	*mock.Call
+}
+
+// ListIndexSchools is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListIndexDocumentsParams
+func (_e *SearchRepository_Expecter) ListIndexSchools(ctx interface{}, params interface{}) *SearchRepository_ListIndexSchools_Call {
+	return &SearchRepository_ListIndexSchools_Call{Call: _e.mock.On("ListIndexSchools", ctx, params)}
+}
+
+func (_c *SearchRepository_ListIndexSchools_Call) Run(run func(ctx context.Context, params domain.ListIndexDocumentsParams)) *SearchRepository_ListIndexSchools_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListIndexDocumentsParams))
+	})
+	return _c
+}
+
+func (_c *SearchRepository_ListIndexSchools_Call) Return(_a0 []domain.School, _a1 error) *SearchRepository_ListIndexSchools_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SearchRepository_ListIndexSchools_Call) RunAndReturn(run func(context.Context, domain.ListIndexDocumentsParams) ([]domain.School, error)) *SearchRepository_ListIndexSchools_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMemberSchoolIDs provides a mock function with given fields: ctx, params
+func (_m *SearchRepository) ListMemberSchoolIDs(ctx context.Context, params domain.ListMemberSchoolIDsParams) ([]int, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListMemberSchoolIDsParams) ([]int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListMemberSchoolIDsParams) []int); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListMemberSchoolIDsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchRepository_ListMemberSchoolIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMemberSchoolIDs'
+type SearchRepository_ListMemberSchoolIDs_Call struct {
+	*mock.Call
+}
+
+// ListMemberSchoolIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListMemberSchoolIDsParams
+func (_e *SearchRepository_Expecter) ListMemberSchoolIDs(ctx interface{}, params interface{}) *SearchRepository_ListMemberSchoolIDs_Call {
+	return &SearchRepository_ListMemberSchoolIDs_Call{Call: _e.mock.On("ListMemberSchoolIDs", ctx, params)}
+}
+
+func (_c *SearchRepository_ListMemberSchoolIDs_Call) Run(run func(ctx context.Context, params domain.ListMemberSchoolIDsParams)) *SearchRepository_ListMemberSchoolIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListMemberSchoolIDsParams))
+	})
+	return _c
+}
+
+func (_c *SearchRepository_ListMemberSchoolIDs_Call) Return(_a0 []int, _a1 error) *SearchRepository_ListMemberSchoolIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SearchRepository_ListMemberSchoolIDs_Call) RunAndReturn(run func(context.Context, domain.ListMemberSchoolIDsParams) ([]int, error)) *SearchRepository_ListMemberSchoolIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNewsByIDs provides a mock function with given fields: ctx, newsIDs
+func (_m *SearchRepository) ListNewsByIDs(ctx context.Context, newsIDs []int) ([]domain.News, error) {
+	ret := _m.Called(ctx, newsIDs)
+
+	var r0 []domain.News
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) ([]domain.News, error)); ok {
+		return rf(ctx, newsIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) []domain.News); ok {
+		r0 = rf(ctx, newsIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.News)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, newsIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchRepository_ListNewsByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNewsByIDs'
+type SearchRepository_ListNewsByIDs_Call struct {
+	*mock.Call
+}
+
+// ListNewsByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newsIDs []int
+func (_e *SearchRepository_Expecter) ListNewsByIDs(ctx interface{}, newsIDs interface{}) *SearchRepository_ListNewsByIDs_Call {
+	return &SearchRepository_ListNewsByIDs_Call{Call: _e.mock.On("ListNewsByIDs", ctx, newsIDs)}
+}
+
+func (_c *SearchRepository_ListNewsByIDs_Call) Run(run func(ctx context.Context, newsIDs []int)) *SearchRepository_ListNewsByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *SearchRepository_ListNewsByIDs_Call) Return(_a0 []domain.News, _a1 error) *SearchRepository_ListNewsByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SearchRepository_ListNewsByIDs_Call) RunAndReturn(run func(context.Context, []int) ([]domain.News, error)) *SearchRepository_ListNewsByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSchoolsByIDs provides a mock function with given fields: ctx, schoolIDs
+func (_m *SearchRepository) ListSchoolsByIDs(ctx context.Context, schoolIDs []int) ([]domain.School, error) {
+	ret := _m.Called(ctx, schoolIDs)
+
+	var r0 []domain.School
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) ([]domain.School, error)); ok {
+		return rf(ctx, schoolIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) []domain.School); ok {
+		r0 = rf(ctx, schoolIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.School)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, schoolIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchRepository_ListSchoolsByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSchoolsByIDs'
+type SearchRepository_ListSchoolsByIDs_Call struct {
+	*mock.Call
+}
+
+// ListSchoolsByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - schoolIDs []int
+func (_e *SearchRepository_Expecter) ListSchoolsByIDs(ctx interface{}, schoolIDs interface{}) *SearchRepository_ListSchoolsByIDs_Call {
+	return &SearchRepository_ListSchoolsByIDs_Call{Call: _e.mock.On("ListSchoolsByIDs", ctx, schoolIDs)}
+}
+
+func (_c *SearchRepository_ListSchoolsByIDs_Call) Run(run func(ctx context.Context, schoolIDs []int)) *SearchRepository_ListSchoolsByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *SearchRepository_ListSchoolsByIDs_Call) Return(_a0 []domain.School, _a1 error) *SearchRepository_ListSchoolsByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SearchRepository_ListSchoolsByIDs_Call) RunAndReturn(run func(context.Context, []int) ([]domain.School, error)) *SearchRepository_ListSchoolsByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSearchRepository creates a new instance of SearchRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSearchRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SearchRepository {
+	mock := &SearchRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,143 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SearchService is an autogenerated mock type for the SearchService type
+type SearchService struct {
+	mock.Mock
+}
+
+type SearchService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SearchService) EXPECT() *SearchService_Expecter {
+	return &SearchService_Expecter{mock: &_m.Mock}
+}
+
+// SearchNews provides a mock function with given fields: ctx, req
+func (_m *SearchService) SearchNews(ctx context.Context, req domain.SearchNewsRequest) (domain.SearchNewsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.SearchNewsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SearchNewsRequest) (domain.SearchNewsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SearchNewsRequest) domain.SearchNewsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.SearchNewsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.SearchNewsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchService_SearchNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchNews'
+type SearchService_SearchNews_Call struct {
+	*mock.Call
+}
+
+// SearchNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.SearchNewsRequest
+func (_e *SearchService_Expecter) SearchNews(ctx interface{}, req interface{}) *SearchService_SearchNews_Call {
+	return &SearchService_SearchNews_Call{Call: _e.mock.On("SearchNews", ctx, req)}
+}
+
+func (_c *SearchService_SearchNews_Call) Run(run func(ctx context.Context, req domain.SearchNewsRequest)) *SearchService_SearchNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.SearchNewsRequest))
+	})
+	return _c
+}
+
+func (_c *SearchService_SearchNews_Call) Return(_a0 domain.SearchNewsResponse, _a1 error) *SearchService_SearchNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SearchService_SearchNews_Call) RunAndReturn(run func(context.Context, domain.SearchNewsRequest) (domain.SearchNewsResponse, error)) *SearchService_SearchNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchSchools provides a mock function with given fields: ctx, req
+func (_m *SearchService) SearchSchools(ctx context.Context, req domain.SearchSchoolsRequest) (domain.SearchSchoolsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.SearchSchoolsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SearchSchoolsRequest) (domain.SearchSchoolsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SearchSchoolsRequest) domain.SearchSchoolsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.SearchSchoolsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.SearchSchoolsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchService_SearchSchools_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchSchools'
+type SearchService_SearchSchools_Call struct {
+	*mock.Call
+}
+
+// SearchSchools is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.SearchSchoolsRequest
+func (_e *SearchService_Expecter) SearchSchools(ctx interface{}, req interface{}) *SearchService_SearchSchools_Call {
+	return &SearchService_SearchSchools_Call{Call: _e.mock.On("SearchSchools", ctx, req)}
+}
+
+func (_c *SearchService_SearchSchools_Call) Run(run func(ctx context.Context, req domain.SearchSchoolsRequest)) *SearchService_SearchSchools_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.SearchSchoolsRequest))
+	})
+	return _c
+}
+
+func (_c *SearchService_SearchSchools_Call) Return(_a0 domain.SearchSchoolsResponse, _a1 error) *SearchService_SearchSchools_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SearchService_SearchSchools_Call) RunAndReturn(run func(context.Context, domain.SearchSchoolsRequest) (domain.SearchSchoolsResponse, error)) *SearchService_SearchSchools_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSearchService creates a new instance of SearchService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSearchService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SearchService {
+	mock := &SearchService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
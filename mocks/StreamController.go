@@ -0,0 +1,101 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StreamController is an autogenerated mock type for the StreamController type
+type StreamController struct {
+	mock.Mock
+}
+
+type StreamController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StreamController) EXPECT() *StreamController_Expecter {
+	return &StreamController_Expecter{mock: &_m.Mock}
+}
+
+// ConnectGateway provides a mock function with given fields: c
+func (_m *StreamController) ConnectGateway(c *gin.Context) {
+	_m.Called(c)
+}
+
+// StreamController_ConnectGateway_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConnectGateway'
+type StreamController_ConnectGateway_Call struct {
+	*mock.Call
+}
+
+// ConnectGateway is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *StreamController_Expecter) ConnectGateway(c interface{}) *StreamController_ConnectGateway_Call {
+	return &StreamController_ConnectGateway_Call{Call: _e.mock.On("ConnectGateway", c)}
+}
+
+func (_c *StreamController_ConnectGateway_Call) Run(run func(c *gin.Context)) *StreamController_ConnectGateway_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *StreamController_ConnectGateway_Call) Return() *StreamController_ConnectGateway_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *StreamController_ConnectGateway_Call) RunAndReturn(run func(*gin.Context)) *StreamController_ConnectGateway_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamSubscriptionNews provides a mock function with given fields: c
+func (_m *StreamController) StreamSubscriptionNews(c *gin.Context) {
+	_m.Called(c)
+}
+
+// StreamController_StreamSubscriptionNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamSubscriptionNews'
+type StreamController_StreamSubscriptionNews_Call struct {
+	*mock.Call
+}
+
+// StreamSubscriptionNews is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *StreamController_Expecter) StreamSubscriptionNews(c interface{}) *StreamController_StreamSubscriptionNews_Call {
+	return &StreamController_StreamSubscriptionNews_Call{Call: _e.mock.On("StreamSubscriptionNews", c)}
+}
+
+func (_c *StreamController_StreamSubscriptionNews_Call) Run(run func(c *gin.Context)) *StreamController_StreamSubscriptionNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *StreamController_StreamSubscriptionNews_Call) Return() *StreamController_StreamSubscriptionNews_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *StreamController_StreamSubscriptionNews_Call) RunAndReturn(run func(*gin.Context)) *StreamController_StreamSubscriptionNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStreamController creates a new instance of StreamController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStreamController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StreamController {
+	mock := &StreamController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
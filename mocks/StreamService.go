@@ -0,0 +1,231 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StreamService is an autogenerated mock type for the StreamService type
+type StreamService struct {
+	mock.Mock
+}
+
+type StreamService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StreamService) EXPECT() *StreamService_Expecter {
+	return &StreamService_Expecter{mock: &_m.Mock}
+}
+
+// OpenGatewaySession provides a mock function with given fields: ctx, userID
+func (_m *StreamService) OpenGatewaySession(ctx context.Context, userID int) (domain.GatewaySession, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 domain.GatewaySession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (domain.GatewaySession, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) domain.GatewaySession); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.GatewaySession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StreamService_OpenGatewaySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenGatewaySession'
+type StreamService_OpenGatewaySession_Call struct {
+	*mock.Call
+}
+
+// OpenGatewaySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *StreamService_Expecter) OpenGatewaySession(ctx interface{}, userID interface{}) *StreamService_OpenGatewaySession_Call {
+	return &StreamService_OpenGatewaySession_Call{Call: _e.mock.On("OpenGatewaySession", ctx, userID)}
+}
+
+func (_c *StreamService_OpenGatewaySession_Call) Run(run func(ctx context.Context, userID int)) *StreamService_OpenGatewaySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *StreamService_OpenGatewaySession_Call) Return(_a0 domain.GatewaySession, _a1 error) *StreamService_OpenGatewaySession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StreamService_OpenGatewaySession_Call) RunAndReturn(run func(context.Context, int) (domain.GatewaySession, error)) *StreamService_OpenGatewaySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenNewsStream provides a mock function with given fields: ctx, req
+func (_m *StreamService) OpenNewsStream(ctx context.Context, req domain.OpenNewsStreamRequest) (domain.NewsStream, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.NewsStream
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.OpenNewsStreamRequest) (domain.NewsStream, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.OpenNewsStreamRequest) domain.NewsStream); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.NewsStream)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.OpenNewsStreamRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StreamService_OpenNewsStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenNewsStream'
+type StreamService_OpenNewsStream_Call struct {
+	*mock.Call
+}
+
+// OpenNewsStream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.OpenNewsStreamRequest
+func (_e *StreamService_Expecter) OpenNewsStream(ctx interface{}, req interface{}) *StreamService_OpenNewsStream_Call {
+	return &StreamService_OpenNewsStream_Call{Call: _e.mock.On("OpenNewsStream", ctx, req)}
+}
+
+func (_c *StreamService_OpenNewsStream_Call) Run(run func(ctx context.Context, req domain.OpenNewsStreamRequest)) *StreamService_OpenNewsStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.OpenNewsStreamRequest))
+	})
+	return _c
+}
+
+func (_c *StreamService_OpenNewsStream_Call) Return(_a0 domain.NewsStream, _a1 error) *StreamService_OpenNewsStream_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StreamService_OpenNewsStream_Call) RunAndReturn(run func(context.Context, domain.OpenNewsStreamRequest) (domain.NewsStream, error)) *StreamService_OpenNewsStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishNewsEvent provides a mock function with given fields: ctx, event
+func (_m *StreamService) PublishNewsEvent(ctx context.Context, event domain.NewsEvent) error {
+	ret := _m.Called(ctx, event)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NewsEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamService_PublishNewsEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishNewsEvent'
+type StreamService_PublishNewsEvent_Call struct {
+	*mock.Call
+}
+
+// PublishNewsEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event domain.NewsEvent
+func (_e *StreamService_Expecter) PublishNewsEvent(ctx interface{}, event interface{}) *StreamService_PublishNewsEvent_Call {
+	return &StreamService_PublishNewsEvent_Call{Call: _e.mock.On("PublishNewsEvent", ctx, event)}
+}
+
+func (_c *StreamService_PublishNewsEvent_Call) Run(run func(ctx context.Context, event domain.NewsEvent)) *StreamService_PublishNewsEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.NewsEvent))
+	})
+	return _c
+}
+
+func (_c *StreamService_PublishNewsEvent_Call) Return(_a0 error) *StreamService_PublishNewsEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StreamService_PublishNewsEvent_Call) RunAndReturn(run func(context.Context, domain.NewsEvent) error) *StreamService_PublishNewsEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishSubscriptionEvent provides a mock function with given fields: ctx, event
+func (_m *StreamService) PublishSubscriptionEvent(ctx context.Context, event domain.SubscriptionEvent) error {
+	ret := _m.Called(ctx, event)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SubscriptionEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamService_PublishSubscriptionEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishSubscriptionEvent'
+type StreamService_PublishSubscriptionEvent_Call struct {
+	*mock.Call
+}
+
+// PublishSubscriptionEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event domain.SubscriptionEvent
+func (_e *StreamService_Expecter) PublishSubscriptionEvent(ctx interface{}, event interface{}) *StreamService_PublishSubscriptionEvent_Call {
+	return &StreamService_PublishSubscriptionEvent_Call{Call: _e.mock.On("PublishSubscriptionEvent", ctx, event)}
+}
+
+func (_c *StreamService_PublishSubscriptionEvent_Call) Run(run func(ctx context.Context, event domain.SubscriptionEvent)) *StreamService_PublishSubscriptionEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.SubscriptionEvent))
+	})
+	return _c
+}
+
+func (_c *StreamService_PublishSubscriptionEvent_Call) Return(_a0 error) *StreamService_PublishSubscriptionEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StreamService_PublishSubscriptionEvent_Call) RunAndReturn(run func(context.Context, domain.SubscriptionEvent) error) *StreamService_PublishSubscriptionEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStreamService creates a new instance of StreamService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStreamService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StreamService {
+	mock := &StreamService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
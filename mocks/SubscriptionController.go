@@ -86,6 +86,72 @@ func (_c *SubscriptionController_DeleteSubscription_Call) RunAndReturn(run func(
 	return _c
 }
 
+// ListSubscriptionFeed provides a mock function with given fields: c
+func (_m *SubscriptionController) ListSubscriptionFeed(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SubscriptionController_ListSubscriptionFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubscriptionFeed'
+type SubscriptionController_ListSubscriptionFeed_Call struct {
+	*mock.Call
+}
+
+// ListSubscriptionFeed is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *SubscriptionController_Expecter) ListSubscriptionFeed(c interface{}) *SubscriptionController_ListSubscriptionFeed_Call {
+	return &SubscriptionController_ListSubscriptionFeed_Call{Call: _e.mock.On("ListSubscriptionFeed", c)}
+}
+
+func (_c *SubscriptionController_ListSubscriptionFeed_Call) Run(run func(c *gin.Context)) *SubscriptionController_ListSubscriptionFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *SubscriptionController_ListSubscriptionFeed_Call) Return() *SubscriptionController_ListSubscriptionFeed_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SubscriptionController_ListSubscriptionFeed_Call) RunAndReturn(run func(*gin.Context)) *SubscriptionController_ListSubscriptionFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSubscriptionSchoolNews provides a mock function with given fields: c
+func (_m *SubscriptionController) ListSubscriptionSchoolNews(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SubscriptionController_ListSubscriptionSchoolNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubscriptionSchoolNews'
+type SubscriptionController_ListSubscriptionSchoolNews_Call struct {
+	*mock.Call
+}
+
+// ListSubscriptionSchoolNews is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *SubscriptionController_Expecter) ListSubscriptionSchoolNews(c interface{}) *SubscriptionController_ListSubscriptionSchoolNews_Call {
+	return &SubscriptionController_ListSubscriptionSchoolNews_Call{Call: _e.mock.On("ListSubscriptionSchoolNews", c)}
+}
+
+func (_c *SubscriptionController_ListSubscriptionSchoolNews_Call) Run(run func(c *gin.Context)) *SubscriptionController_ListSubscriptionSchoolNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *SubscriptionController_ListSubscriptionSchoolNews_Call) Return() *SubscriptionController_ListSubscriptionSchoolNews_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SubscriptionController_ListSubscriptionSchoolNews_Call) RunAndReturn(run func(*gin.Context)) *SubscriptionController_ListSubscriptionSchoolNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListSubscriptionSchools provides a mock function with given fields: c
 func (_m *SubscriptionController) ListSubscriptionSchools(c *gin.Context) {
 	_m.Called(c)
@@ -119,6 +185,72 @@ func (_c *SubscriptionController_ListSubscriptionSchools_Call) RunAndReturn(run
 	return _c
 }
 
+// MarkNewsRead provides a mock function with given fields: c
+func (_m *SubscriptionController) MarkNewsRead(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SubscriptionController_MarkNewsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkNewsRead'
+type SubscriptionController_MarkNewsRead_Call struct {
+	*mock.Call
+}
+
+// MarkNewsRead is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *SubscriptionController_Expecter) MarkNewsRead(c interface{}) *SubscriptionController_MarkNewsRead_Call {
+	return &SubscriptionController_MarkNewsRead_Call{Call: _e.mock.On("MarkNewsRead", c)}
+}
+
+func (_c *SubscriptionController_MarkNewsRead_Call) Run(run func(c *gin.Context)) *SubscriptionController_MarkNewsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *SubscriptionController_MarkNewsRead_Call) Return() *SubscriptionController_MarkNewsRead_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SubscriptionController_MarkNewsRead_Call) RunAndReturn(run func(*gin.Context)) *SubscriptionController_MarkNewsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSchoolNewsRead provides a mock function with given fields: c
+func (_m *SubscriptionController) MarkSchoolNewsRead(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SubscriptionController_MarkSchoolNewsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSchoolNewsRead'
+type SubscriptionController_MarkSchoolNewsRead_Call struct {
+	*mock.Call
+}
+
+// MarkSchoolNewsRead is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *SubscriptionController_Expecter) MarkSchoolNewsRead(c interface{}) *SubscriptionController_MarkSchoolNewsRead_Call {
+	return &SubscriptionController_MarkSchoolNewsRead_Call{Call: _e.mock.On("MarkSchoolNewsRead", c)}
+}
+
+func (_c *SubscriptionController_MarkSchoolNewsRead_Call) Run(run func(c *gin.Context)) *SubscriptionController_MarkSchoolNewsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *SubscriptionController_MarkSchoolNewsRead_Call) Return() *SubscriptionController_MarkSchoolNewsRead_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SubscriptionController_MarkSchoolNewsRead_Call) RunAndReturn(run func(*gin.Context)) *SubscriptionController_MarkSchoolNewsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSubscriptionController creates a new instance of SubscriptionController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSubscriptionController(t interface {
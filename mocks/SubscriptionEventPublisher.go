@@ -0,0 +1,80 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SubscriptionEventPublisher is an autogenerated mock type for the SubscriptionEventPublisher type
+type SubscriptionEventPublisher struct {
+	mock.Mock
+}
+
+type SubscriptionEventPublisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SubscriptionEventPublisher) EXPECT() *SubscriptionEventPublisher_Expecter {
+	return &SubscriptionEventPublisher_Expecter{mock: &_m.Mock}
+}
+
+// PublishSubscriptionEvent provides a mock function with given fields: ctx, event
+func (_m *SubscriptionEventPublisher) PublishSubscriptionEvent(ctx context.Context, event domain.SubscriptionEvent) error {
+	ret := _m.Called(ctx, event)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SubscriptionEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubscriptionEventPublisher_PublishSubscriptionEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishSubscriptionEvent'
+type SubscriptionEventPublisher_PublishSubscriptionEvent_Call struct {
+	*mock.Call
+}
+
+// PublishSubscriptionEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event domain.SubscriptionEvent
+func (_e *SubscriptionEventPublisher_Expecter) PublishSubscriptionEvent(ctx interface{}, event interface{}) *SubscriptionEventPublisher_PublishSubscriptionEvent_Call {
+	return &SubscriptionEventPublisher_PublishSubscriptionEvent_Call{Call: _e.mock.On("PublishSubscriptionEvent", ctx, event)}
+}
+
+func (_c *SubscriptionEventPublisher_PublishSubscriptionEvent_Call) Run(run func(ctx context.Context, event domain.SubscriptionEvent)) *SubscriptionEventPublisher_PublishSubscriptionEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.SubscriptionEvent))
+	})
+	return _c
+}
+
+func (_c *SubscriptionEventPublisher_PublishSubscriptionEvent_Call) Return(_a0 error) *SubscriptionEventPublisher_PublishSubscriptionEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubscriptionEventPublisher_PublishSubscriptionEvent_Call) RunAndReturn(run func(context.Context, domain.SubscriptionEvent) error) *SubscriptionEventPublisher_PublishSubscriptionEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSubscriptionEventPublisher creates a new instance of SubscriptionEventPublisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSubscriptionEventPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SubscriptionEventPublisher {
+	mock := &SubscriptionEventPublisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
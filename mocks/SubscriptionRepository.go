@@ -22,6 +22,61 @@ func (_m *SubscriptionRepository) EXPECT() *SubscriptionRepository_Expecter {
 	return &SubscriptionRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountUnreadNews provides a mock function with given fields: ctx, params
+func (_m *SubscriptionRepository) CountUnreadNews(ctx context.Context, params domain.CountUnreadNewsParams) (map[int]int, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 map[int]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CountUnreadNewsParams) (map[int]int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CountUnreadNewsParams) map[int]int); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.CountUnreadNewsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscriptionRepository_CountUnreadNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnreadNews'
+type SubscriptionRepository_CountUnreadNews_Call struct {
+	*mock.Call
+}
+
+// CountUnreadNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.CountUnreadNewsParams
+func (_e *SubscriptionRepository_Expecter) CountUnreadNews(ctx interface{}, params interface{}) *SubscriptionRepository_CountUnreadNews_Call {
+	return &SubscriptionRepository_CountUnreadNews_Call{Call: _e.mock.On("CountUnreadNews", ctx, params)}
+}
+
+func (_c *SubscriptionRepository_CountUnreadNews_Call) Run(run func(ctx context.Context, params domain.CountUnreadNewsParams)) *SubscriptionRepository_CountUnreadNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.CountUnreadNewsParams))
+	})
+	return _c
+}
+
+func (_c *SubscriptionRepository_CountUnreadNews_Call) Return(_a0 map[int]int, _a1 error) *SubscriptionRepository_CountUnreadNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubscriptionRepository_CountUnreadNews_Call) RunAndReturn(run func(context.Context, domain.CountUnreadNewsParams) (map[int]int, error)) *SubscriptionRepository_CountUnreadNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateSubscription provides a mock function with given fields: ctx, subscription
 func (_m *SubscriptionRepository) CreateSubscription(ctx context.Context, subscription domain.Subscription) (int, error) {
 	ret := _m.Called(ctx, subscription)
@@ -173,6 +228,116 @@ func (_c *SubscriptionRepository_FindSubscriptionByUserIDAndSchoolID_Call) RunAn
 	return _c
 }
 
+// ListReadNewsIDs provides a mock function with given fields: ctx, params
+func (_m *SubscriptionRepository) ListReadNewsIDs(ctx context.Context, params domain.ListReadNewsIDsParams) (map[int]bool, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 map[int]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListReadNewsIDsParams) (map[int]bool, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListReadNewsIDsParams) map[int]bool); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListReadNewsIDsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscriptionRepository_ListReadNewsIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReadNewsIDs'
+type SubscriptionRepository_ListReadNewsIDs_Call struct {
+	*mock.Call
+}
+
+// ListReadNewsIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListReadNewsIDsParams
+func (_e *SubscriptionRepository_Expecter) ListReadNewsIDs(ctx interface{}, params interface{}) *SubscriptionRepository_ListReadNewsIDs_Call {
+	return &SubscriptionRepository_ListReadNewsIDs_Call{Call: _e.mock.On("ListReadNewsIDs", ctx, params)}
+}
+
+func (_c *SubscriptionRepository_ListReadNewsIDs_Call) Run(run func(ctx context.Context, params domain.ListReadNewsIDsParams)) *SubscriptionRepository_ListReadNewsIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListReadNewsIDsParams))
+	})
+	return _c
+}
+
+func (_c *SubscriptionRepository_ListReadNewsIDs_Call) Return(_a0 map[int]bool, _a1 error) *SubscriptionRepository_ListReadNewsIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubscriptionRepository_ListReadNewsIDs_Call) RunAndReturn(run func(context.Context, domain.ListReadNewsIDsParams) (map[int]bool, error)) *SubscriptionRepository_ListReadNewsIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSubscriptionSchoolIDs provides a mock function with given fields: ctx, userID
+func (_m *SubscriptionRepository) ListSubscriptionSchoolIDs(ctx context.Context, userID int) ([]int, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]int, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []int); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscriptionRepository_ListSubscriptionSchoolIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubscriptionSchoolIDs'
+type SubscriptionRepository_ListSubscriptionSchoolIDs_Call struct {
+	*mock.Call
+}
+
+// ListSubscriptionSchoolIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *SubscriptionRepository_Expecter) ListSubscriptionSchoolIDs(ctx interface{}, userID interface{}) *SubscriptionRepository_ListSubscriptionSchoolIDs_Call {
+	return &SubscriptionRepository_ListSubscriptionSchoolIDs_Call{Call: _e.mock.On("ListSubscriptionSchoolIDs", ctx, userID)}
+}
+
+func (_c *SubscriptionRepository_ListSubscriptionSchoolIDs_Call) Run(run func(ctx context.Context, userID int)) *SubscriptionRepository_ListSubscriptionSchoolIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SubscriptionRepository_ListSubscriptionSchoolIDs_Call) Return(_a0 []int, _a1 error) *SubscriptionRepository_ListSubscriptionSchoolIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubscriptionRepository_ListSubscriptionSchoolIDs_Call) RunAndReturn(run func(context.Context, int) ([]int, error)) *SubscriptionRepository_ListSubscriptionSchoolIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListSubscriptionSchools provides a mock function with given fields: ctx, params
 func (_m *SubscriptionRepository) ListSubscriptionSchools(ctx context.Context, params domain.ListSubscriptionSchoolsParams) ([]domain.SubscriptionSchool, error) {
 	ret := _m.Called(ctx, params)
@@ -228,6 +393,92 @@ func (_c *SubscriptionRepository_ListSubscriptionSchools_Call) RunAndReturn(run
 	return _c
 }
 
+// MarkNewsRead provides a mock function with given fields: ctx, params
+func (_m *SubscriptionRepository) MarkNewsRead(ctx context.Context, params domain.MarkNewsReadParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MarkNewsReadParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubscriptionRepository_MarkNewsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkNewsRead'
+type SubscriptionRepository_MarkNewsRead_Call struct {
+	*mock.Call
+}
+
+// MarkNewsRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.MarkNewsReadParams
+func (_e *SubscriptionRepository_Expecter) MarkNewsRead(ctx interface{}, params interface{}) *SubscriptionRepository_MarkNewsRead_Call {
+	return &SubscriptionRepository_MarkNewsRead_Call{Call: _e.mock.On("MarkNewsRead", ctx, params)}
+}
+
+func (_c *SubscriptionRepository_MarkNewsRead_Call) Run(run func(ctx context.Context, params domain.MarkNewsReadParams)) *SubscriptionRepository_MarkNewsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MarkNewsReadParams))
+	})
+	return _c
+}
+
+func (_c *SubscriptionRepository_MarkNewsRead_Call) Return(_a0 error) *SubscriptionRepository_MarkNewsRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubscriptionRepository_MarkNewsRead_Call) RunAndReturn(run func(context.Context, domain.MarkNewsReadParams) error) *SubscriptionRepository_MarkNewsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSchoolNewsReadUntil provides a mock function with given fields: ctx, params
+func (_m *SubscriptionRepository) MarkSchoolNewsReadUntil(ctx context.Context, params domain.MarkSchoolNewsReadUntilParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MarkSchoolNewsReadUntilParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubscriptionRepository_MarkSchoolNewsReadUntil_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSchoolNewsReadUntil'
+type SubscriptionRepository_MarkSchoolNewsReadUntil_Call struct {
+	*mock.Call
+}
+
+// MarkSchoolNewsReadUntil is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.MarkSchoolNewsReadUntilParams
+func (_e *SubscriptionRepository_Expecter) MarkSchoolNewsReadUntil(ctx interface{}, params interface{}) *SubscriptionRepository_MarkSchoolNewsReadUntil_Call {
+	return &SubscriptionRepository_MarkSchoolNewsReadUntil_Call{Call: _e.mock.On("MarkSchoolNewsReadUntil", ctx, params)}
+}
+
+func (_c *SubscriptionRepository_MarkSchoolNewsReadUntil_Call) Run(run func(ctx context.Context, params domain.MarkSchoolNewsReadUntilParams)) *SubscriptionRepository_MarkSchoolNewsReadUntil_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MarkSchoolNewsReadUntilParams))
+	})
+	return _c
+}
+
+func (_c *SubscriptionRepository_MarkSchoolNewsReadUntil_Call) Return(_a0 error) *SubscriptionRepository_MarkSchoolNewsReadUntil_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubscriptionRepository_MarkSchoolNewsReadUntil_Call) RunAndReturn(run func(context.Context, domain.MarkSchoolNewsReadUntilParams) error) *SubscriptionRepository_MarkSchoolNewsReadUntil_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSubscriptionRepository creates a new instance of SubscriptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSubscriptionRepository(t interface {
@@ -108,6 +108,59 @@ func (_c *SubscriptionService_DeleteSubscription_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// ListSubscriptionFeed provides a mock function with given fields: ctx, req
+func (_m *SubscriptionService) ListSubscriptionFeed(ctx context.Context, req domain.ListSubscriptionFeedRequest) (domain.ListSubscriptionFeedResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ListSubscriptionFeedResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListSubscriptionFeedRequest) (domain.ListSubscriptionFeedResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListSubscriptionFeedRequest) domain.ListSubscriptionFeedResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ListSubscriptionFeedResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListSubscriptionFeedRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscriptionService_ListSubscriptionFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubscriptionFeed'
+type SubscriptionService_ListSubscriptionFeed_Call struct {
+	*mock.Call
+}
+
+// ListSubscriptionFeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ListSubscriptionFeedRequest
+func (_e *SubscriptionService_Expecter) ListSubscriptionFeed(ctx interface{}, req interface{}) *SubscriptionService_ListSubscriptionFeed_Call {
+	return &SubscriptionService_ListSubscriptionFeed_Call{Call: _e.mock.On("ListSubscriptionFeed", ctx, req)}
+}
+
+func (_c *SubscriptionService_ListSubscriptionFeed_Call) Run(run func(ctx context.Context, req domain.ListSubscriptionFeedRequest)) *SubscriptionService_ListSubscriptionFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListSubscriptionFeedRequest))
+	})
+	return _c
+}
+
+func (_c *SubscriptionService_ListSubscriptionFeed_Call) Return(_a0 domain.ListSubscriptionFeedResponse, _a1 error) *SubscriptionService_ListSubscriptionFeed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubscriptionService_ListSubscriptionFeed_Call) RunAndReturn(run func(context.Context, domain.ListSubscriptionFeedRequest) (domain.ListSubscriptionFeedResponse, error)) *SubscriptionService_ListSubscriptionFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListSubscriptionSchoolNews provides a mock function with given fields: ctx, req
 func (_m *SubscriptionService) ListSubscriptionSchoolNews(ctx context.Context, req domain.ListSubscriptionSchoolNewsRequest) (domain.ListSubscriptionSchoolNewsResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -214,6 +267,92 @@ func (_c *SubscriptionService_ListSubscriptionSchools_Call) RunAndReturn(run fun
 	return _c
 }
 
+// MarkNewsRead provides a mock function with given fields: ctx, req
+func (_m *SubscriptionService) MarkNewsRead(ctx context.Context, req domain.MarkNewsReadRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MarkNewsReadRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubscriptionService_MarkNewsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkNewsRead'
+type SubscriptionService_MarkNewsRead_Call struct {
+	*mock.Call
+}
+
+// MarkNewsRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.MarkNewsReadRequest
+func (_e *SubscriptionService_Expecter) MarkNewsRead(ctx interface{}, req interface{}) *SubscriptionService_MarkNewsRead_Call {
+	return &SubscriptionService_MarkNewsRead_Call{Call: _e.mock.On("MarkNewsRead", ctx, req)}
+}
+
+func (_c *SubscriptionService_MarkNewsRead_Call) Run(run func(ctx context.Context, req domain.MarkNewsReadRequest)) *SubscriptionService_MarkNewsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MarkNewsReadRequest))
+	})
+	return _c
+}
+
+func (_c *SubscriptionService_MarkNewsRead_Call) Return(_a0 error) *SubscriptionService_MarkNewsRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubscriptionService_MarkNewsRead_Call) RunAndReturn(run func(context.Context, domain.MarkNewsReadRequest) error) *SubscriptionService_MarkNewsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSchoolNewsRead provides a mock function with given fields: ctx, req
+func (_m *SubscriptionService) MarkSchoolNewsRead(ctx context.Context, req domain.MarkSchoolNewsReadRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MarkSchoolNewsReadRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubscriptionService_MarkSchoolNewsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSchoolNewsRead'
+type SubscriptionService_MarkSchoolNewsRead_Call struct {
+	*mock.Call
+}
+
+// MarkSchoolNewsRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.MarkSchoolNewsReadRequest
+func (_e *SubscriptionService_Expecter) MarkSchoolNewsRead(ctx interface{}, req interface{}) *SubscriptionService_MarkSchoolNewsRead_Call {
+	return &SubscriptionService_MarkSchoolNewsRead_Call{Call: _e.mock.On("MarkSchoolNewsRead", ctx, req)}
+}
+
+func (_c *SubscriptionService_MarkSchoolNewsRead_Call) Run(run func(ctx context.Context, req domain.MarkSchoolNewsReadRequest)) *SubscriptionService_MarkSchoolNewsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MarkSchoolNewsReadRequest))
+	})
+	return _c
+}
+
+func (_c *SubscriptionService_MarkSchoolNewsRead_Call) Return(_a0 error) *SubscriptionService_MarkSchoolNewsRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubscriptionService_MarkSchoolNewsRead_Call) RunAndReturn(run func(context.Context, domain.MarkSchoolNewsReadRequest) error) *SubscriptionService_MarkSchoolNewsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSubscriptionService creates a new instance of SubscriptionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSubscriptionService(t interface {
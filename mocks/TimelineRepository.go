@@ -0,0 +1,276 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TimelineRepository is an autogenerated mock type for the TimelineRepository type
+type TimelineRepository struct {
+	mock.Mock
+}
+
+type TimelineRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TimelineRepository) EXPECT() *TimelineRepository_Expecter {
+	return &TimelineRepository_Expecter{mock: &_m.Mock}
+}
+
+// BackfillTimelines provides a mock function with given fields: ctx, params
+func (_m *TimelineRepository) BackfillTimelines(ctx context.Context, params domain.BackfillTimelinesParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.BackfillTimelinesParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TimelineRepository_BackfillTimelines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BackfillTimelines'
+type TimelineRepository_BackfillTimelines_Call struct {
+	*mock.Call
+}
+
+// BackfillTimelines is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.BackfillTimelinesParams
+func (_e *TimelineRepository_Expecter) BackfillTimelines(ctx interface{}, params interface{}) *TimelineRepository_BackfillTimelines_Call {
+	return &TimelineRepository_BackfillTimelines_Call{Call: _e.mock.On("BackfillTimelines", ctx, params)}
+}
+
+func (_c *TimelineRepository_BackfillTimelines_Call) Run(run func(ctx context.Context, params domain.BackfillTimelinesParams)) *TimelineRepository_BackfillTimelines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.BackfillTimelinesParams))
+	})
+	return _c
+}
+
+func (_c *TimelineRepository_BackfillTimelines_Call) Return(_a0 error) *TimelineRepository_BackfillTimelines_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TimelineRepository_BackfillTimelines_Call) RunAndReturn(run func(context.Context, domain.BackfillTimelinesParams) error) *TimelineRepository_BackfillTimelines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTimelines provides a mock function with given fields: ctx, params
+func (_m *TimelineRepository) CreateTimelines(ctx context.Context, params domain.CreateTimelinesParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateTimelinesParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TimelineRepository_CreateTimelines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTimelines'
+type TimelineRepository_CreateTimelines_Call struct {
+	*mock.Call
+}
+
+// CreateTimelines is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.CreateTimelinesParams
+func (_e *TimelineRepository_Expecter) CreateTimelines(ctx interface{}, params interface{}) *TimelineRepository_CreateTimelines_Call {
+	return &TimelineRepository_CreateTimelines_Call{Call: _e.mock.On("CreateTimelines", ctx, params)}
+}
+
+func (_c *TimelineRepository_CreateTimelines_Call) Run(run func(ctx context.Context, params domain.CreateTimelinesParams)) *TimelineRepository_CreateTimelines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.CreateTimelinesParams))
+	})
+	return _c
+}
+
+func (_c *TimelineRepository_CreateTimelines_Call) Return(_a0 error) *TimelineRepository_CreateTimelines_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TimelineRepository_CreateTimelines_Call) RunAndReturn(run func(context.Context, domain.CreateTimelinesParams) error) *TimelineRepository_CreateTimelines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HideTimelinesByNewsID provides a mock function with given fields: ctx, newsID
+func (_m *TimelineRepository) HideTimelinesByNewsID(ctx context.Context, newsID int) error {
+	ret := _m.Called(ctx, newsID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, newsID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TimelineRepository_HideTimelinesByNewsID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HideTimelinesByNewsID'
+type TimelineRepository_HideTimelinesByNewsID_Call struct {
+	*mock.Call
+}
+
+// HideTimelinesByNewsID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newsID int
+func (_e *TimelineRepository_Expecter) HideTimelinesByNewsID(ctx interface{}, newsID interface{}) *TimelineRepository_HideTimelinesByNewsID_Call {
+	return &TimelineRepository_HideTimelinesByNewsID_Call{Call: _e.mock.On("HideTimelinesByNewsID", ctx, newsID)}
+}
+
+func (_c *TimelineRepository_HideTimelinesByNewsID_Call) Run(run func(ctx context.Context, newsID int)) *TimelineRepository_HideTimelinesByNewsID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *TimelineRepository_HideTimelinesByNewsID_Call) Return(_a0 error) *TimelineRepository_HideTimelinesByNewsID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TimelineRepository_HideTimelinesByNewsID_Call) RunAndReturn(run func(context.Context, int) error) *TimelineRepository_HideTimelinesByNewsID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTimelineNews provides a mock function with given fields: ctx, params
+func (_m *TimelineRepository) ListTimelineNews(ctx context.Context, params domain.ListTimelineNewsParams) ([]domain.News, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.News
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListTimelineNewsParams) ([]domain.News, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListTimelineNewsParams) []domain.News); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.News)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListTimelineNewsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimelineRepository_ListTimelineNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTimelineNews'
+type TimelineRepository_ListTimelineNews_Call struct {
+	*mock.Call
+}
+
+// ListTimelineNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListTimelineNewsParams
+func (_e *TimelineRepository_Expecter) ListTimelineNews(ctx interface{}, params interface{}) *TimelineRepository_ListTimelineNews_Call {
+	return &TimelineRepository_ListTimelineNews_Call{Call: _e.mock.On("ListTimelineNews", ctx, params)}
+}
+
+func (_c *TimelineRepository_ListTimelineNews_Call) Run(run func(ctx context.Context, params domain.ListTimelineNewsParams)) *TimelineRepository_ListTimelineNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListTimelineNewsParams))
+	})
+	return _c
+}
+
+func (_c *TimelineRepository_ListTimelineNews_Call) Return(_a0 []domain.News, _a1 error) *TimelineRepository_ListTimelineNews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimelineRepository_ListTimelineNews_Call) RunAndReturn(run func(context.Context, domain.ListTimelineNewsParams) ([]domain.News, error)) *TimelineRepository_ListTimelineNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTimelineNewsAfter provides a mock function with given fields: ctx, params
+func (_m *TimelineRepository) ListTimelineNewsAfter(ctx context.Context, params domain.ListTimelineNewsAfterParams) ([]domain.News, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.News
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListTimelineNewsAfterParams) ([]domain.News, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListTimelineNewsAfterParams) []domain.News); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.News)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListTimelineNewsAfterParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimelineRepository_ListTimelineNewsAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTimelineNewsAfter'
+type TimelineRepository_ListTimelineNewsAfter_Call struct {
+	*mock.Call
+}
+
+// ListTimelineNewsAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListTimelineNewsAfterParams
+func (_e *TimelineRepository_Expecter) ListTimelineNewsAfter(ctx interface{}, params interface{}) *TimelineRepository_ListTimelineNewsAfter_Call {
+	return &TimelineRepository_ListTimelineNewsAfter_Call{Call: _e.mock.On("ListTimelineNewsAfter", ctx, params)}
+}
+
+func (_c *TimelineRepository_ListTimelineNewsAfter_Call) Run(run func(ctx context.Context, params domain.ListTimelineNewsAfterParams)) *TimelineRepository_ListTimelineNewsAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListTimelineNewsAfterParams))
+	})
+	return _c
+}
+
+func (_c *TimelineRepository_ListTimelineNewsAfter_Call) Return(_a0 []domain.News, _a1 error) *TimelineRepository_ListTimelineNewsAfter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimelineRepository_ListTimelineNewsAfter_Call) RunAndReturn(run func(context.Context, domain.ListTimelineNewsAfterParams) ([]domain.News, error)) *TimelineRepository_ListTimelineNewsAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTimelineRepository creates a new instance of TimelineRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTimelineRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TimelineRepository {
+	mock := &TimelineRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,166 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TimelineService is an autogenerated mock type for the TimelineService type
+type TimelineService struct {
+	mock.Mock
+}
+
+type TimelineService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TimelineService) EXPECT() *TimelineService_Expecter {
+	return &TimelineService_Expecter{mock: &_m.Mock}
+}
+
+// BackfillSubscription provides a mock function with given fields: ctx, subscription
+func (_m *TimelineService) BackfillSubscription(ctx context.Context, subscription domain.Subscription) error {
+	ret := _m.Called(ctx, subscription)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Subscription) error); ok {
+		r0 = rf(ctx, subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TimelineService_BackfillSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BackfillSubscription'
+type TimelineService_BackfillSubscription_Call struct {
+	*mock.Call
+}
+
+// BackfillSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscription domain.Subscription
+func (_e *TimelineService_Expecter) BackfillSubscription(ctx interface{}, subscription interface{}) *TimelineService_BackfillSubscription_Call {
+	return &TimelineService_BackfillSubscription_Call{Call: _e.mock.On("BackfillSubscription", ctx, subscription)}
+}
+
+func (_c *TimelineService_BackfillSubscription_Call) Run(run func(ctx context.Context, subscription domain.Subscription)) *TimelineService_BackfillSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Subscription))
+	})
+	return _c
+}
+
+func (_c *TimelineService_BackfillSubscription_Call) Return(_a0 error) *TimelineService_BackfillSubscription_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TimelineService_BackfillSubscription_Call) RunAndReturn(run func(context.Context, domain.Subscription) error) *TimelineService_BackfillSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FanOutNews provides a mock function with given fields: ctx, news
+func (_m *TimelineService) FanOutNews(ctx context.Context, news domain.News) error {
+	ret := _m.Called(ctx, news)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.News) error); ok {
+		r0 = rf(ctx, news)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TimelineService_FanOutNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FanOutNews'
+type TimelineService_FanOutNews_Call struct {
+	*mock.Call
+}
+
+// FanOutNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - news domain.News
+func (_e *TimelineService_Expecter) FanOutNews(ctx interface{}, news interface{}) *TimelineService_FanOutNews_Call {
+	return &TimelineService_FanOutNews_Call{Call: _e.mock.On("FanOutNews", ctx, news)}
+}
+
+func (_c *TimelineService_FanOutNews_Call) Run(run func(ctx context.Context, news domain.News)) *TimelineService_FanOutNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.News))
+	})
+	return _c
+}
+
+func (_c *TimelineService_FanOutNews_Call) Return(_a0 error) *TimelineService_FanOutNews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TimelineService_FanOutNews_Call) RunAndReturn(run func(context.Context, domain.News) error) *TimelineService_FanOutNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HideNews provides a mock function with given fields: ctx, newsID
+func (_m *TimelineService) HideNews(ctx context.Context, newsID int) error {
+	ret := _m.Called(ctx, newsID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, newsID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TimelineService_HideNews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HideNews'
+type TimelineService_HideNews_Call struct {
+	*mock.Call
+}
+
+// HideNews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newsID int
+func (_e *TimelineService_Expecter) HideNews(ctx interface{}, newsID interface{}) *TimelineService_HideNews_Call {
+	return &TimelineService_HideNews_Call{Call: _e.mock.On("HideNews", ctx, newsID)}
+}
+
+func (_c *TimelineService_HideNews_Call) Run(run func(ctx context.Context, newsID int)) *TimelineService_HideNews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *TimelineService_HideNews_Call) Return(_a0 error) *TimelineService_HideNews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TimelineService_HideNews_Call) RunAndReturn(run func(context.Context, int) error) *TimelineService_HideNews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTimelineService creates a new instance of TimelineService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTimelineService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TimelineService {
+	mock := &TimelineService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,176 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TokenDenylist is an autogenerated mock type for the TokenDenylist type
+type TokenDenylist struct {
+	mock.Mock
+}
+
+type TokenDenylist_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TokenDenylist) EXPECT() *TokenDenylist_Expecter {
+	return &TokenDenylist_Expecter{mock: &_m.Mock}
+}
+
+// IsTokenRevoked provides a mock function with given fields: ctx, params
+func (_m *TokenDenylist) IsTokenRevoked(ctx context.Context, params domain.IsTokenRevokedParams) (bool, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IsTokenRevokedParams) (bool, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IsTokenRevokedParams) bool); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.IsTokenRevokedParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenDenylist_IsTokenRevoked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsTokenRevoked'
+type TokenDenylist_IsTokenRevoked_Call struct {
+	*mock.Call
+}
+
+// IsTokenRevoked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.IsTokenRevokedParams
+func (_e *TokenDenylist_Expecter) IsTokenRevoked(ctx interface{}, params interface{}) *TokenDenylist_IsTokenRevoked_Call {
+	return &TokenDenylist_IsTokenRevoked_Call{Call: _e.mock.On("IsTokenRevoked", ctx, params)}
+}
+
+func (_c *TokenDenylist_IsTokenRevoked_Call) Run(run func(ctx context.Context, params domain.IsTokenRevokedParams)) *TokenDenylist_IsTokenRevoked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IsTokenRevokedParams))
+	})
+	return _c
+}
+
+func (_c *TokenDenylist_IsTokenRevoked_Call) Return(_a0 bool, _a1 error) *TokenDenylist_IsTokenRevoked_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TokenDenylist_IsTokenRevoked_Call) RunAndReturn(run func(context.Context, domain.IsTokenRevokedParams) (bool, error)) *TokenDenylist_IsTokenRevoked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeToken provides a mock function with given fields: ctx, params
+func (_m *TokenDenylist) RevokeToken(ctx context.Context, params domain.RevokeTokenParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RevokeTokenParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TokenDenylist_RevokeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeToken'
+type TokenDenylist_RevokeToken_Call struct {
+	*mock.Call
+}
+
+// RevokeToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.RevokeTokenParams
+func (_e *TokenDenylist_Expecter) RevokeToken(ctx interface{}, params interface{}) *TokenDenylist_RevokeToken_Call {
+	return &TokenDenylist_RevokeToken_Call{Call: _e.mock.On("RevokeToken", ctx, params)}
+}
+
+func (_c *TokenDenylist_RevokeToken_Call) Run(run func(ctx context.Context, params domain.RevokeTokenParams)) *TokenDenylist_RevokeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.RevokeTokenParams))
+	})
+	return _c
+}
+
+func (_c *TokenDenylist_RevokeToken_Call) Return(_a0 error) *TokenDenylist_RevokeToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenDenylist_RevokeToken_Call) RunAndReturn(run func(context.Context, domain.RevokeTokenParams) error) *TokenDenylist_RevokeToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeUserTokens provides a mock function with given fields: ctx, params
+func (_m *TokenDenylist) RevokeUserTokens(ctx context.Context, params domain.RevokeUserTokensParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RevokeUserTokensParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TokenDenylist_RevokeUserTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserTokens'
+type TokenDenylist_RevokeUserTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeUserTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.RevokeUserTokensParams
+func (_e *TokenDenylist_Expecter) RevokeUserTokens(ctx interface{}, params interface{}) *TokenDenylist_RevokeUserTokens_Call {
+	return &TokenDenylist_RevokeUserTokens_Call{Call: _e.mock.On("RevokeUserTokens", ctx, params)}
+}
+
+func (_c *TokenDenylist_RevokeUserTokens_Call) Run(run func(ctx context.Context, params domain.RevokeUserTokensParams)) *TokenDenylist_RevokeUserTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.RevokeUserTokensParams))
+	})
+	return _c
+}
+
+func (_c *TokenDenylist_RevokeUserTokens_Call) Return(_a0 error) *TokenDenylist_RevokeUserTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenDenylist_RevokeUserTokens_Call) RunAndReturn(run func(context.Context, domain.RevokeUserTokensParams) error) *TokenDenylist_RevokeUserTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTokenDenylist creates a new instance of TokenDenylist. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTokenDenylist(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TokenDenylist {
+	mock := &TokenDenylist{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -86,6 +86,105 @@ func (_c *UserController_LoginUser_Call) RunAndReturn(run func(*gin.Context)) *U
 	return _c
 }
 
+// LogoutUser provides a mock function with given fields: c
+func (_m *UserController) LogoutUser(c *gin.Context) {
+	_m.Called(c)
+}
+
+// UserController_LogoutUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogoutUser'
+type UserController_LogoutUser_Call struct {
+	*mock.Call
+}
+
+// LogoutUser is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *UserController_Expecter) LogoutUser(c interface{}) *UserController_LogoutUser_Call {
+	return &UserController_LogoutUser_Call{Call: _e.mock.On("LogoutUser", c)}
+}
+
+func (_c *UserController_LogoutUser_Call) Run(run func(c *gin.Context)) *UserController_LogoutUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *UserController_LogoutUser_Call) Return() *UserController_LogoutUser_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UserController_LogoutUser_Call) RunAndReturn(run func(*gin.Context)) *UserController_LogoutUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshToken provides a mock function with given fields: c
+func (_m *UserController) RefreshToken(c *gin.Context) {
+	_m.Called(c)
+}
+
+// UserController_RefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshToken'
+type UserController_RefreshToken_Call struct {
+	*mock.Call
+}
+
+// RefreshToken is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *UserController_Expecter) RefreshToken(c interface{}) *UserController_RefreshToken_Call {
+	return &UserController_RefreshToken_Call{Call: _e.mock.On("RefreshToken", c)}
+}
+
+func (_c *UserController_RefreshToken_Call) Run(run func(c *gin.Context)) *UserController_RefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *UserController_RefreshToken_Call) Return() *UserController_RefreshToken_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UserController_RefreshToken_Call) RunAndReturn(run func(*gin.Context)) *UserController_RefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeUserSessions provides a mock function with given fields: c
+func (_m *UserController) RevokeUserSessions(c *gin.Context) {
+	_m.Called(c)
+}
+
+// UserController_RevokeUserSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserSessions'
+type UserController_RevokeUserSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeUserSessions is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *UserController_Expecter) RevokeUserSessions(c interface{}) *UserController_RevokeUserSessions_Call {
+	return &UserController_RevokeUserSessions_Call{Call: _e.mock.On("RevokeUserSessions", c)}
+}
+
+func (_c *UserController_RevokeUserSessions_Call) Run(run func(c *gin.Context)) *UserController_RevokeUserSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *UserController_RevokeUserSessions_Call) Return() *UserController_RevokeUserSessions_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UserController_RevokeUserSessions_Call) RunAndReturn(run func(*gin.Context)) *UserController_RevokeUserSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUserController creates a new instance of UserController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserController(t interface {
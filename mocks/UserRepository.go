@@ -22,6 +22,59 @@ func (_m *UserRepository) EXPECT() *UserRepository_Expecter {
 	return &UserRepository_Expecter{mock: &_m.Mock}
 }
 
+// CreateRefreshToken provides a mock function with given fields: ctx, token
+func (_m *UserRepository) CreateRefreshToken(ctx context.Context, token domain.RefreshToken) (int, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RefreshToken) (int, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RefreshToken) int); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.RefreshToken) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_CreateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRefreshToken'
+type UserRepository_CreateRefreshToken_Call struct {
+	*mock.Call
+}
+
+// CreateRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token domain.RefreshToken
+func (_e *UserRepository_Expecter) CreateRefreshToken(ctx interface{}, token interface{}) *UserRepository_CreateRefreshToken_Call {
+	return &UserRepository_CreateRefreshToken_Call{Call: _e.mock.On("CreateRefreshToken", ctx, token)}
+}
+
+func (_c *UserRepository_CreateRefreshToken_Call) Run(run func(ctx context.Context, token domain.RefreshToken)) *UserRepository_CreateRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.RefreshToken))
+	})
+	return _c
+}
+
+func (_c *UserRepository_CreateRefreshToken_Call) Return(_a0 int, _a1 error) *UserRepository_CreateRefreshToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_CreateRefreshToken_Call) RunAndReturn(run func(context.Context, domain.RefreshToken) (int, error)) *UserRepository_CreateRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateUser provides a mock function with given fields: ctx, user
 func (_m *UserRepository) CreateUser(ctx context.Context, user domain.User) (int, error) {
 	ret := _m.Called(ctx, user)
@@ -75,6 +128,116 @@ func (_c *UserRepository_CreateUser_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// FindRefreshTokenByHash provides a mock function with given fields: ctx, tokenHash
+func (_m *UserRepository) FindRefreshTokenByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	var r0 *domain.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.RefreshToken, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.RefreshToken); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RefreshToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_FindRefreshTokenByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRefreshTokenByHash'
+type UserRepository_FindRefreshTokenByHash_Call struct {
+	*mock.Call
+}
+
+// FindRefreshTokenByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *UserRepository_Expecter) FindRefreshTokenByHash(ctx interface{}, tokenHash interface{}) *UserRepository_FindRefreshTokenByHash_Call {
+	return &UserRepository_FindRefreshTokenByHash_Call{Call: _e.mock.On("FindRefreshTokenByHash", ctx, tokenHash)}
+}
+
+func (_c *UserRepository_FindRefreshTokenByHash_Call) Run(run func(ctx context.Context, tokenHash string)) *UserRepository_FindRefreshTokenByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepository_FindRefreshTokenByHash_Call) Return(_a0 *domain.RefreshToken, _a1 error) *UserRepository_FindRefreshTokenByHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_FindRefreshTokenByHash_Call) RunAndReturn(run func(context.Context, string) (*domain.RefreshToken, error)) *UserRepository_FindRefreshTokenByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUserByID provides a mock function with given fields: ctx, userID
+func (_m *UserRepository) FindUserByID(ctx context.Context, userID int) (*domain.User, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.User, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.User); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_FindUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByID'
+type UserRepository_FindUserByID_Call struct {
+	*mock.Call
+}
+
+// FindUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *UserRepository_Expecter) FindUserByID(ctx interface{}, userID interface{}) *UserRepository_FindUserByID_Call {
+	return &UserRepository_FindUserByID_Call{Call: _e.mock.On("FindUserByID", ctx, userID)}
+}
+
+func (_c *UserRepository_FindUserByID_Call) Run(run func(ctx context.Context, userID int)) *UserRepository_FindUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *UserRepository_FindUserByID_Call) Return(_a0 *domain.User, _a1 error) *UserRepository_FindUserByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_FindUserByID_Call) RunAndReturn(run func(context.Context, int) (*domain.User, error)) *UserRepository_FindUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindUserByUserName provides a mock function with given fields: ctx, userName
 func (_m *UserRepository) FindUserByUserName(ctx context.Context, userName string) (*domain.User, error) {
 	ret := _m.Called(ctx, userName)
@@ -130,6 +293,145 @@ func (_c *UserRepository_FindUserByUserName_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// RevokeRefreshTokenFamily provides a mock function with given fields: ctx, familyID
+func (_m *UserRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	ret := _m.Called(ctx, familyID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_RevokeRefreshTokenFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeRefreshTokenFamily'
+type UserRepository_RevokeRefreshTokenFamily_Call struct {
+	*mock.Call
+}
+
+// RevokeRefreshTokenFamily is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID string
+func (_e *UserRepository_Expecter) RevokeRefreshTokenFamily(ctx interface{}, familyID interface{}) *UserRepository_RevokeRefreshTokenFamily_Call {
+	return &UserRepository_RevokeRefreshTokenFamily_Call{Call: _e.mock.On("RevokeRefreshTokenFamily", ctx, familyID)}
+}
+
+func (_c *UserRepository_RevokeRefreshTokenFamily_Call) Run(run func(ctx context.Context, familyID string)) *UserRepository_RevokeRefreshTokenFamily_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepository_RevokeRefreshTokenFamily_Call) Return(_a0 error) *UserRepository_RevokeRefreshTokenFamily_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_RevokeRefreshTokenFamily_Call) RunAndReturn(run func(context.Context, string) error) *UserRepository_RevokeRefreshTokenFamily_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeUserRefreshTokens provides a mock function with given fields: ctx, userID
+func (_m *UserRepository) RevokeUserRefreshTokens(ctx context.Context, userID int) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_RevokeUserRefreshTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserRefreshTokens'
+type UserRepository_RevokeUserRefreshTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeUserRefreshTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *UserRepository_Expecter) RevokeUserRefreshTokens(ctx interface{}, userID interface{}) *UserRepository_RevokeUserRefreshTokens_Call {
+	return &UserRepository_RevokeUserRefreshTokens_Call{Call: _e.mock.On("RevokeUserRefreshTokens", ctx, userID)}
+}
+
+func (_c *UserRepository_RevokeUserRefreshTokens_Call) Run(run func(ctx context.Context, userID int)) *UserRepository_RevokeUserRefreshTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *UserRepository_RevokeUserRefreshTokens_Call) Return(_a0 error) *UserRepository_RevokeUserRefreshTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_RevokeUserRefreshTokens_Call) RunAndReturn(run func(context.Context, int) error) *UserRepository_RevokeUserRefreshTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateRefreshToken provides a mock function with given fields: ctx, params
+func (_m *UserRepository) RotateRefreshToken(ctx context.Context, params domain.RotateRefreshTokenParams) (bool, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RotateRefreshTokenParams) (bool, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RotateRefreshTokenParams) bool); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.RotateRefreshTokenParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_RotateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateRefreshToken'
+type UserRepository_RotateRefreshToken_Call struct {
+	*mock.Call
+}
+
+// RotateRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.RotateRefreshTokenParams
+func (_e *UserRepository_Expecter) RotateRefreshToken(ctx interface{}, params interface{}) *UserRepository_RotateRefreshToken_Call {
+	return &UserRepository_RotateRefreshToken_Call{Call: _e.mock.On("RotateRefreshToken", ctx, params)}
+}
+
+func (_c *UserRepository_RotateRefreshToken_Call) Run(run func(ctx context.Context, params domain.RotateRefreshTokenParams)) *UserRepository_RotateRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.RotateRefreshTokenParams))
+	})
+	return _c
+}
+
+func (_c *UserRepository_RotateRefreshToken_Call) Return(_a0 bool, _a1 error) *UserRepository_RotateRefreshToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_RotateRefreshToken_Call) RunAndReturn(run func(context.Context, domain.RotateRefreshTokenParams) (bool, error)) *UserRepository_RotateRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUserRepository creates a new instance of UserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserRepository(t interface {
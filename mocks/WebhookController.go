@@ -0,0 +1,167 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookController is an autogenerated mock type for the WebhookController type
+type WebhookController struct {
+	mock.Mock
+}
+
+type WebhookController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookController) EXPECT() *WebhookController_Expecter {
+	return &WebhookController_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhook provides a mock function with given fields: c
+func (_m *WebhookController) CreateWebhook(c *gin.Context) {
+	_m.Called(c)
+}
+
+// WebhookController_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type WebhookController_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *WebhookController_Expecter) CreateWebhook(c interface{}) *WebhookController_CreateWebhook_Call {
+	return &WebhookController_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", c)}
+}
+
+func (_c *WebhookController_CreateWebhook_Call) Run(run func(c *gin.Context)) *WebhookController_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookController_CreateWebhook_Call) Return() *WebhookController_CreateWebhook_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *WebhookController_CreateWebhook_Call) RunAndReturn(run func(*gin.Context)) *WebhookController_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function with given fields: c
+func (_m *WebhookController) DeleteWebhook(c *gin.Context) {
+	_m.Called(c)
+}
+
+// WebhookController_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type WebhookController_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *WebhookController_Expecter) DeleteWebhook(c interface{}) *WebhookController_DeleteWebhook_Call {
+	return &WebhookController_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", c)}
+}
+
+func (_c *WebhookController_DeleteWebhook_Call) Run(run func(c *gin.Context)) *WebhookController_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookController_DeleteWebhook_Call) Return() *WebhookController_DeleteWebhook_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *WebhookController_DeleteWebhook_Call) RunAndReturn(run func(*gin.Context)) *WebhookController_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhookDeliveries provides a mock function with given fields: c
+func (_m *WebhookController) ListWebhookDeliveries(c *gin.Context) {
+	_m.Called(c)
+}
+
+// WebhookController_ListWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookDeliveries'
+type WebhookController_ListWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListWebhookDeliveries is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *WebhookController_Expecter) ListWebhookDeliveries(c interface{}) *WebhookController_ListWebhookDeliveries_Call {
+	return &WebhookController_ListWebhookDeliveries_Call{Call: _e.mock.On("ListWebhookDeliveries", c)}
+}
+
+func (_c *WebhookController_ListWebhookDeliveries_Call) Run(run func(c *gin.Context)) *WebhookController_ListWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookController_ListWebhookDeliveries_Call) Return() *WebhookController_ListWebhookDeliveries_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *WebhookController_ListWebhookDeliveries_Call) RunAndReturn(run func(*gin.Context)) *WebhookController_ListWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhooks provides a mock function with given fields: c
+func (_m *WebhookController) ListWebhooks(c *gin.Context) {
+	_m.Called(c)
+}
+
+// WebhookController_ListWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhooks'
+type WebhookController_ListWebhooks_Call struct {
+	*mock.Call
+}
+
+// ListWebhooks is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *WebhookController_Expecter) ListWebhooks(c interface{}) *WebhookController_ListWebhooks_Call {
+	return &WebhookController_ListWebhooks_Call{Call: _e.mock.On("ListWebhooks", c)}
+}
+
+func (_c *WebhookController_ListWebhooks_Call) Run(run func(c *gin.Context)) *WebhookController_ListWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookController_ListWebhooks_Call) Return() *WebhookController_ListWebhooks_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *WebhookController_ListWebhooks_Call) RunAndReturn(run func(*gin.Context)) *WebhookController_ListWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookController creates a new instance of WebhookController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookController {
+	mock := &WebhookController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,439 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookRepository is an autogenerated mock type for the WebhookRepository type
+type WebhookRepository struct {
+	mock.Mock
+}
+
+type WebhookRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookRepository) EXPECT() *WebhookRepository_Expecter {
+	return &WebhookRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimWebhookDeliveries provides a mock function with given fields: ctx, params
+func (_m *WebhookRepository) ClaimWebhookDeliveries(ctx context.Context, params domain.ClaimWebhookDeliveriesParams) ([]domain.PendingWebhookDelivery, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.PendingWebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ClaimWebhookDeliveriesParams) ([]domain.PendingWebhookDelivery, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ClaimWebhookDeliveriesParams) []domain.PendingWebhookDelivery); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PendingWebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ClaimWebhookDeliveriesParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_ClaimWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimWebhookDeliveries'
+type WebhookRepository_ClaimWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// ClaimWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ClaimWebhookDeliveriesParams
+func (_e *WebhookRepository_Expecter) ClaimWebhookDeliveries(ctx interface{}, params interface{}) *WebhookRepository_ClaimWebhookDeliveries_Call {
+	return &WebhookRepository_ClaimWebhookDeliveries_Call{Call: _e.mock.On("ClaimWebhookDeliveries", ctx, params)}
+}
+
+func (_c *WebhookRepository_ClaimWebhookDeliveries_Call) Run(run func(ctx context.Context, params domain.ClaimWebhookDeliveriesParams)) *WebhookRepository_ClaimWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ClaimWebhookDeliveriesParams))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ClaimWebhookDeliveries_Call) Return(_a0 []domain.PendingWebhookDelivery, _a1 error) *WebhookRepository_ClaimWebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_ClaimWebhookDeliveries_Call) RunAndReturn(run func(context.Context, domain.ClaimWebhookDeliveriesParams) ([]domain.PendingWebhookDelivery, error)) *WebhookRepository_ClaimWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWebhook provides a mock function with given fields: ctx, webhook
+func (_m *WebhookRepository) CreateWebhook(ctx context.Context, webhook domain.Webhook) (int, error) {
+	ret := _m.Called(ctx, webhook)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Webhook) (int, error)); ok {
+		return rf(ctx, webhook)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Webhook) int); ok {
+		r0 = rf(ctx, webhook)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Webhook) error); ok {
+		r1 = rf(ctx, webhook)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type WebhookRepository_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook domain.Webhook
+func (_e *WebhookRepository_Expecter) CreateWebhook(ctx interface{}, webhook interface{}) *WebhookRepository_CreateWebhook_Call {
+	return &WebhookRepository_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, webhook)}
+}
+
+func (_c *WebhookRepository_CreateWebhook_Call) Run(run func(ctx context.Context, webhook domain.Webhook)) *WebhookRepository_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Webhook))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_CreateWebhook_Call) Return(_a0 int, _a1 error) *WebhookRepository_CreateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_CreateWebhook_Call) RunAndReturn(run func(context.Context, domain.Webhook) (int, error)) *WebhookRepository_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWebhookDeliveries provides a mock function with given fields: ctx, params
+func (_m *WebhookRepository) CreateWebhookDeliveries(ctx context.Context, params domain.CreateWebhookDeliveriesParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateWebhookDeliveriesParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_CreateWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhookDeliveries'
+type WebhookRepository_CreateWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// CreateWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.CreateWebhookDeliveriesParams
+func (_e *WebhookRepository_Expecter) CreateWebhookDeliveries(ctx interface{}, params interface{}) *WebhookRepository_CreateWebhookDeliveries_Call {
+	return &WebhookRepository_CreateWebhookDeliveries_Call{Call: _e.mock.On("CreateWebhookDeliveries", ctx, params)}
+}
+
+func (_c *WebhookRepository_CreateWebhookDeliveries_Call) Run(run func(ctx context.Context, params domain.CreateWebhookDeliveriesParams)) *WebhookRepository_CreateWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.CreateWebhookDeliveriesParams))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_CreateWebhookDeliveries_Call) Return(_a0 error) *WebhookRepository_CreateWebhookDeliveries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_CreateWebhookDeliveries_Call) RunAndReturn(run func(context.Context, domain.CreateWebhookDeliveriesParams) error) *WebhookRepository_CreateWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function with given fields: ctx, webhookID
+func (_m *WebhookRepository) DeleteWebhook(ctx context.Context, webhookID int) error {
+	ret := _m.Called(ctx, webhookID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, webhookID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type WebhookRepository_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookID int
+func (_e *WebhookRepository_Expecter) DeleteWebhook(ctx interface{}, webhookID interface{}) *WebhookRepository_DeleteWebhook_Call {
+	return &WebhookRepository_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, webhookID)}
+}
+
+func (_c *WebhookRepository_DeleteWebhook_Call) Run(run func(ctx context.Context, webhookID int)) *WebhookRepository_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_DeleteWebhook_Call) Return(_a0 error) *WebhookRepository_DeleteWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_DeleteWebhook_Call) RunAndReturn(run func(context.Context, int) error) *WebhookRepository_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindWebhookByID provides a mock function with given fields: ctx, webhookID
+func (_m *WebhookRepository) FindWebhookByID(ctx context.Context, webhookID int) (*domain.Webhook, error) {
+	ret := _m.Called(ctx, webhookID)
+
+	var r0 *domain.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Webhook, error)); ok {
+		return rf(ctx, webhookID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Webhook); ok {
+		r0 = rf(ctx, webhookID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, webhookID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_FindWebhookByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWebhookByID'
+type WebhookRepository_FindWebhookByID_Call struct {
+	*mock.Call
+}
+
+// FindWebhookByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookID int
+func (_e *WebhookRepository_Expecter) FindWebhookByID(ctx interface{}, webhookID interface{}) *WebhookRepository_FindWebhookByID_Call {
+	return &WebhookRepository_FindWebhookByID_Call{Call: _e.mock.On("FindWebhookByID", ctx, webhookID)}
+}
+
+func (_c *WebhookRepository_FindWebhookByID_Call) Run(run func(ctx context.Context, webhookID int)) *WebhookRepository_FindWebhookByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_FindWebhookByID_Call) Return(_a0 *domain.Webhook, _a1 error) *WebhookRepository_FindWebhookByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_FindWebhookByID_Call) RunAndReturn(run func(context.Context, int) (*domain.Webhook, error)) *WebhookRepository_FindWebhookByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhookDeliveries provides a mock function with given fields: ctx, params
+func (_m *WebhookRepository) ListWebhookDeliveries(ctx context.Context, params domain.ListWebhookDeliveriesParams) ([]domain.WebhookDelivery, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListWebhookDeliveriesParams) ([]domain.WebhookDelivery, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListWebhookDeliveriesParams) []domain.WebhookDelivery); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListWebhookDeliveriesParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_ListWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookDeliveries'
+type WebhookRepository_ListWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListWebhookDeliveriesParams
+func (_e *WebhookRepository_Expecter) ListWebhookDeliveries(ctx interface{}, params interface{}) *WebhookRepository_ListWebhookDeliveries_Call {
+	return &WebhookRepository_ListWebhookDeliveries_Call{Call: _e.mock.On("ListWebhookDeliveries", ctx, params)}
+}
+
+func (_c *WebhookRepository_ListWebhookDeliveries_Call) Run(run func(ctx context.Context, params domain.ListWebhookDeliveriesParams)) *WebhookRepository_ListWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListWebhookDeliveriesParams))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ListWebhookDeliveries_Call) Return(_a0 []domain.WebhookDelivery, _a1 error) *WebhookRepository_ListWebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_ListWebhookDeliveries_Call) RunAndReturn(run func(context.Context, domain.ListWebhookDeliveriesParams) ([]domain.WebhookDelivery, error)) *WebhookRepository_ListWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhooks provides a mock function with given fields: ctx, params
+func (_m *WebhookRepository) ListWebhooks(ctx context.Context, params domain.ListWebhooksParams) ([]domain.Webhook, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListWebhooksParams) ([]domain.Webhook, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListWebhooksParams) []domain.Webhook); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListWebhooksParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_ListWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhooks'
+type WebhookRepository_ListWebhooks_Call struct {
+	*mock.Call
+}
+
+// ListWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListWebhooksParams
+func (_e *WebhookRepository_Expecter) ListWebhooks(ctx interface{}, params interface{}) *WebhookRepository_ListWebhooks_Call {
+	return &WebhookRepository_ListWebhooks_Call{Call: _e.mock.On("ListWebhooks", ctx, params)}
+}
+
+func (_c *WebhookRepository_ListWebhooks_Call) Run(run func(ctx context.Context, params domain.ListWebhooksParams)) *WebhookRepository_ListWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListWebhooksParams))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ListWebhooks_Call) Return(_a0 []domain.Webhook, _a1 error) *WebhookRepository_ListWebhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_ListWebhooks_Call) RunAndReturn(run func(context.Context, domain.ListWebhooksParams) ([]domain.Webhook, error)) *WebhookRepository_ListWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhookDelivery provides a mock function with given fields: ctx, delivery
+func (_m *WebhookRepository) UpdateWebhookDelivery(ctx context.Context, delivery domain.WebhookDelivery) error {
+	ret := _m.Called(ctx, delivery)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.WebhookDelivery) error); ok {
+		r0 = rf(ctx, delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_UpdateWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhookDelivery'
+type WebhookRepository_UpdateWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhookDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery domain.WebhookDelivery
+func (_e *WebhookRepository_Expecter) UpdateWebhookDelivery(ctx interface{}, delivery interface{}) *WebhookRepository_UpdateWebhookDelivery_Call {
+	return &WebhookRepository_UpdateWebhookDelivery_Call{Call: _e.mock.On("UpdateWebhookDelivery", ctx, delivery)}
+}
+
+func (_c *WebhookRepository_UpdateWebhookDelivery_Call) Run(run func(ctx context.Context, delivery domain.WebhookDelivery)) *WebhookRepository_UpdateWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.WebhookDelivery))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_UpdateWebhookDelivery_Call) Return(_a0 error) *WebhookRepository_UpdateWebhookDelivery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_UpdateWebhookDelivery_Call) RunAndReturn(run func(context.Context, domain.WebhookDelivery) error) *WebhookRepository_UpdateWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookRepository creates a new instance of WebhookRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookRepository {
+	mock := &WebhookRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
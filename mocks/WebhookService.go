@@ -0,0 +1,282 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "classting/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookService is an autogenerated mock type for the WebhookService type
+type WebhookService struct {
+	mock.Mock
+}
+
+type WebhookService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookService) EXPECT() *WebhookService_Expecter {
+	return &WebhookService_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhook provides a mock function with given fields: ctx, req
+func (_m *WebhookService) CreateWebhook(ctx context.Context, req domain.CreateWebhookRequest) (domain.CreateWebhookResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.CreateWebhookResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateWebhookRequest) (domain.CreateWebhookResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateWebhookRequest) domain.CreateWebhookResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.CreateWebhookResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.CreateWebhookRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type WebhookService_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.CreateWebhookRequest
+func (_e *WebhookService_Expecter) CreateWebhook(ctx interface{}, req interface{}) *WebhookService_CreateWebhook_Call {
+	return &WebhookService_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, req)}
+}
+
+func (_c *WebhookService_CreateWebhook_Call) Run(run func(ctx context.Context, req domain.CreateWebhookRequest)) *WebhookService_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.CreateWebhookRequest))
+	})
+	return _c
+}
+
+func (_c *WebhookService_CreateWebhook_Call) Return(_a0 domain.CreateWebhookResponse, _a1 error) *WebhookService_CreateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_CreateWebhook_Call) RunAndReturn(run func(context.Context, domain.CreateWebhookRequest) (domain.CreateWebhookResponse, error)) *WebhookService_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function with given fields: ctx, req
+func (_m *WebhookService) DeleteWebhook(ctx context.Context, req domain.DeleteWebhookRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DeleteWebhookRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookService_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type WebhookService_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.DeleteWebhookRequest
+func (_e *WebhookService_Expecter) DeleteWebhook(ctx interface{}, req interface{}) *WebhookService_DeleteWebhook_Call {
+	return &WebhookService_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, req)}
+}
+
+func (_c *WebhookService_DeleteWebhook_Call) Run(run func(ctx context.Context, req domain.DeleteWebhookRequest)) *WebhookService_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DeleteWebhookRequest))
+	})
+	return _c
+}
+
+func (_c *WebhookService_DeleteWebhook_Call) Return(_a0 error) *WebhookService_DeleteWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookService_DeleteWebhook_Call) RunAndReturn(run func(context.Context, domain.DeleteWebhookRequest) error) *WebhookService_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhookDeliveries provides a mock function with given fields: ctx, req
+func (_m *WebhookService) ListWebhookDeliveries(ctx context.Context, req domain.ListWebhookDeliveriesRequest) (domain.ListWebhookDeliveriesResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ListWebhookDeliveriesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListWebhookDeliveriesRequest) (domain.ListWebhookDeliveriesResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListWebhookDeliveriesRequest) domain.ListWebhookDeliveriesResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ListWebhookDeliveriesResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListWebhookDeliveriesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_ListWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookDeliveries'
+type WebhookService_ListWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ListWebhookDeliveriesRequest
+func (_e *WebhookService_Expecter) ListWebhookDeliveries(ctx interface{}, req interface{}) *WebhookService_ListWebhookDeliveries_Call {
+	return &WebhookService_ListWebhookDeliveries_Call{Call: _e.mock.On("ListWebhookDeliveries", ctx, req)}
+}
+
+func (_c *WebhookService_ListWebhookDeliveries_Call) Run(run func(ctx context.Context, req domain.ListWebhookDeliveriesRequest)) *WebhookService_ListWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListWebhookDeliveriesRequest))
+	})
+	return _c
+}
+
+func (_c *WebhookService_ListWebhookDeliveries_Call) Return(_a0 domain.ListWebhookDeliveriesResponse, _a1 error) *WebhookService_ListWebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_ListWebhookDeliveries_Call) RunAndReturn(run func(context.Context, domain.ListWebhookDeliveriesRequest) (domain.ListWebhookDeliveriesResponse, error)) *WebhookService_ListWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhooks provides a mock function with given fields: ctx, req
+func (_m *WebhookService) ListWebhooks(ctx context.Context, req domain.ListWebhooksRequest) (domain.ListWebhooksResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ListWebhooksResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListWebhooksRequest) (domain.ListWebhooksResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListWebhooksRequest) domain.ListWebhooksResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ListWebhooksResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListWebhooksRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_ListWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhooks'
+type WebhookService_ListWebhooks_Call struct {
+	*mock.Call
+}
+
+// ListWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ListWebhooksRequest
+func (_e *WebhookService_Expecter) ListWebhooks(ctx interface{}, req interface{}) *WebhookService_ListWebhooks_Call {
+	return &WebhookService_ListWebhooks_Call{Call: _e.mock.On("ListWebhooks", ctx, req)}
+}
+
+func (_c *WebhookService_ListWebhooks_Call) Run(run func(ctx context.Context, req domain.ListWebhooksRequest)) *WebhookService_ListWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListWebhooksRequest))
+	})
+	return _c
+}
+
+func (_c *WebhookService_ListWebhooks_Call) Return(_a0 domain.ListWebhooksResponse, _a1 error) *WebhookService_ListWebhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_ListWebhooks_Call) RunAndReturn(run func(context.Context, domain.ListWebhooksRequest) (domain.ListWebhooksResponse, error)) *WebhookService_ListWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishNewsEvent provides a mock function with given fields: ctx, event
+func (_m *WebhookService) PublishNewsEvent(ctx context.Context, event domain.NewsEvent) error {
+	ret := _m.Called(ctx, event)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.NewsEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookService_PublishNewsEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishNewsEvent'
+type WebhookService_PublishNewsEvent_Call struct {
+	*mock.Call
+}
+
+// PublishNewsEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event domain.NewsEvent
+func (_e *WebhookService_Expecter) PublishNewsEvent(ctx interface{}, event interface{}) *WebhookService_PublishNewsEvent_Call {
+	return &WebhookService_PublishNewsEvent_Call{Call: _e.mock.On("PublishNewsEvent", ctx, event)}
+}
+
+func (_c *WebhookService_PublishNewsEvent_Call) Run(run func(ctx context.Context, event domain.NewsEvent)) *WebhookService_PublishNewsEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.NewsEvent))
+	})
+	return _c
+}
+
+func (_c *WebhookService_PublishNewsEvent_Call) Return(_a0 error) *WebhookService_PublishNewsEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookService_PublishNewsEvent_Call) RunAndReturn(run func(context.Context, domain.NewsEvent) error) *WebhookService_PublishNewsEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookService creates a new instance of WebhookService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookService {
+	mock := &WebhookService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
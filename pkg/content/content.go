@@ -0,0 +1,54 @@
+package content
+
+import (
+	"bytes"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+// markdown GFM(표, 취소선, 자동 링크) 확장을 사용하고 본문의 HTML은 렌더링하지 않는다.
+var markdown = goldmark.New(goldmark.WithExtensions(extension.GFM))
+
+// policy 렌더링한 HTML에 남길 태그와 속성, javascript: 같은 위험한 URL 스킴은 제거된다.
+var policy = bluemonday.UGCPolicy()
+
+// RenderMarkdown 마크다운을 HTML로 변환하고 허용된 태그만 남긴다.
+// 본문은 입력한 그대로 저장하므로 `Vec<String>`, `a<b` 같은 텍스트는 보존되고 본문에 섞인 HTML은 여기서 걸러진다.
+func RenderMarkdown(s string) string {
+	var rendered bytes.Buffer
+	if err := markdown.Convert([]byte(s), &rendered); err != nil {
+		return RenderPlain(s)
+	}
+
+	return string(bytes.TrimSpace(policy.SanitizeBytes(rendered.Bytes())))
+}
+
+// RenderPlain 일반 텍스트를 이스케이프하고 빈 줄은 문단, 줄바꿈은 <br>로 변환한다.
+func RenderPlain(s string) string {
+	var buf strings.Builder
+
+	for _, paragraph := range strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph == "" {
+			continue
+		}
+		buf.WriteString("<p>")
+		buf.WriteString(strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>"))
+		buf.WriteString("</p>")
+	}
+
+	return buf.String()
+}
+
+// Truncate 문자 단위로 최대 n자까지 자른다. 한글처럼 여러 바이트인 문자가 중간에서 잘리지 않는다.
+func Truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+
+	return string([]rune(s)[:n])
+}
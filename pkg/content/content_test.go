@@ -0,0 +1,126 @@
+package content
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			name: "PASS - 코드 스팬의 꺾쇠는 이스케이프하고 보존",
+			s:    "`Vec<String>`를 반환",
+			want: "<p><code>Vec&lt;String&gt;</code>를 반환</p>",
+		},
+		{
+			name: "PASS - 태그가 아닌 부등호는 보존",
+			s:    "a<b 이고 b>c",
+			want: "<p>a&lt;b 이고 b&gt;c</p>",
+		},
+		{
+			name: "PASS - 코드 블록의 꺾쇠는 이스케이프하고 보존",
+			s:    "```go\nif a<b {}\n```",
+			want: "<pre><code>if a&lt;b {}\n</code></pre>",
+		},
+		{
+			name: "PASS - 본문에 섞인 스크립트는 제거",
+			s:    "<script>alert(1)</script>\n\n본문",
+			want: "<p>본문</p>",
+		},
+		{
+			name: "PASS - 이벤트 핸들러 속성이 있는 태그는 제거",
+			s:    "<img src=x onerror=alert(1)>",
+			want: "",
+		},
+		{
+			name: "PASS - javascript 링크는 텍스트만 남김",
+			s:    "**클래스팅** [링크](javascript:alert(1))",
+			want: "<p><strong>클래스팅</strong> 링크</p>",
+		},
+		{
+			name: "PASS - 자동 링크와 취소선",
+			s:    "~~취소~~ https://classting.com",
+			want: `<p><del>취소</del> <a href="https://classting.com" rel="nofollow">https://classting.com</a></p>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			got := RenderMarkdown(tt.s)
+
+			// then
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRenderPlain(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			name: "PASS - 빈 줄은 문단, 줄바꿈은 <br>로 변환",
+			s:    "첫째 문단\r\n\r\n둘째 문단\n둘째 줄",
+			want: "<p>첫째 문단</p><p>둘째 문단<br>둘째 줄</p>",
+		},
+		{
+			name: "PASS - 태그와 부등호는 이스케이프",
+			s:    "a<b <script>alert(1)</script>",
+			want: "<p>a&lt;b &lt;script&gt;alert(1)&lt;/script&gt;</p>",
+		},
+		{
+			name: "PASS - 빈 본문",
+			s:    " \n\n ",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			got := RenderPlain(tt.s)
+
+			// then
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		n    int
+		want string
+	}{
+		{
+			name: "PASS - 최대 길이 이하",
+			s:    "unexpected status code 500",
+			n:    255,
+			want: "unexpected status code 500",
+		},
+		{
+			name: "PASS - 한글은 바이트가 아닌 문자 단위로 자름",
+			s:    "연결이 거부되었습니다",
+			n:    3,
+			want: "연결이",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			got := Truncate(tt.s, tt.n)
+
+			// then
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
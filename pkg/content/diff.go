@@ -0,0 +1,104 @@
+package content
+
+import "strings"
+
+type DiffOp string
+
+const (
+	DiffOpEqual  DiffOp = "equal"
+	DiffOpInsert DiffOp = "insert"
+	DiffOpDelete DiffOp = "delete"
+)
+
+type DiffLine struct {
+	Op   DiffOp
+	Text string
+}
+
+// maxDiffCells LCS 표의 최대 크기, 넘으면 줄 단위 비교 없이 이전 내용 삭제와 새 내용 추가로 표시한다.
+// 표는 uint16 한 슬라이스에 담으므로 요청 하나가 쓰는 메모리는 2MB를 넘지 않는다.
+// 두 변이 곱해서 이 크기 이하면 짧은 변은 1000줄 이하이고 LCS 길이도 uint16을 넘지 않는다.
+const maxDiffCells = 1_000_000
+
+// DiffLines 두 텍스트를 줄 단위로 비교한다. 앞뒤로 같은 줄은 제외하고 남은 부분만 LCS로 비교한다.
+func DiffLines(before, after string) []DiffLine {
+	a, b := splitLines(before), splitLines(after)
+
+	var prefix int
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	var suffix int
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	lines := make([]DiffLine, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		lines = append(lines, DiffLine{Op: DiffOpEqual, Text: line})
+	}
+	lines = append(lines, diffLCS(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		lines = append(lines, DiffLine{Op: DiffOpEqual, Text: line})
+	}
+
+	return lines
+}
+
+func diffLCS(a, b []string) []DiffLine {
+	var lines []DiffLine
+	if (len(a)+1)*(len(b)+1) > maxDiffCells {
+		for _, line := range a {
+			lines = append(lines, DiffLine{Op: DiffOpDelete, Text: line})
+		}
+		for _, line := range b {
+			lines = append(lines, DiffLine{Op: DiffOpInsert, Text: line})
+		}
+		return lines
+	}
+
+	// lcs[i*width+j] a[i:]와 b[j:]의 최장 공통 부분 수열 길이
+	width := len(b) + 1
+	lcs := make([]uint16, (len(a)+1)*width)
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i*width+j] = lcs[(i+1)*width+j+1] + 1
+			} else {
+				lcs[i*width+j] = max(lcs[(i+1)*width+j], lcs[i*width+j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, DiffLine{Op: DiffOpEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[(i+1)*width+j] >= lcs[i*width+j+1]:
+			lines = append(lines, DiffLine{Op: DiffOpDelete, Text: a[i]})
+			i++
+		default:
+			lines = append(lines, DiffLine{Op: DiffOpInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, DiffLine{Op: DiffOpDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, DiffLine{Op: DiffOpInsert, Text: b[j]})
+	}
+
+	return lines
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
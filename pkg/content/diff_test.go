@@ -0,0 +1,123 @@
+package content
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	// numberedLines 1부터 n까지 prefix를 붙인 줄을 만든다.
+	numberedLines := func(prefix string, n int) string {
+		lines := make([]string, n)
+		for i := range lines {
+			lines[i] = prefix + strconv.Itoa(i+1)
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	tests := []struct {
+		name   string
+		before string
+		after  string
+		want   []DiffLine
+	}{
+		{
+			name:   "PASS - 바뀐 줄만 삭제와 추가로 표시",
+			before: "가\r\n나\r\n다",
+			after:  "가\n라\n다",
+			want: []DiffLine{
+				{Op: DiffOpEqual, Text: "가"},
+				{Op: DiffOpDelete, Text: "나"},
+				{Op: DiffOpInsert, Text: "라"},
+				{Op: DiffOpEqual, Text: "다"},
+			},
+		},
+		{
+			name:   "PASS - 가운데 같은 줄은 LCS로 찾음",
+			before: "가\n나\n다\n라",
+			after:  "마\n나\n다\n바",
+			want: []DiffLine{
+				{Op: DiffOpDelete, Text: "가"},
+				{Op: DiffOpInsert, Text: "마"},
+				{Op: DiffOpEqual, Text: "나"},
+				{Op: DiffOpEqual, Text: "다"},
+				{Op: DiffOpDelete, Text: "라"},
+				{Op: DiffOpInsert, Text: "바"},
+			},
+		},
+		{
+			name:   "PASS - 빈 내용에서 추가",
+			before: "",
+			after:  "가\n나",
+			want: []DiffLine{
+				{Op: DiffOpInsert, Text: "가"},
+				{Op: DiffOpInsert, Text: "나"},
+			},
+		},
+		{
+			name:   "PASS - 같은 내용",
+			before: "가\n나",
+			after:  "가\n나",
+			want: []DiffLine{
+				{Op: DiffOpEqual, Text: "가"},
+				{Op: DiffOpEqual, Text: "나"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			got := DiffLines(tt.before, tt.after)
+
+			// then
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("PASS - LCS 표가 최대 크기를 넘으면 이전 내용 삭제와 새 내용 추가로 표시", func(t *testing.T) {
+		// given
+		before := "처음\n" + numberedLines("이전 ", 1000) + "\n끝"
+		after := "처음\n" + numberedLines("이후 ", 1000) + "\n끝"
+
+		// when
+		got := DiffLines(before, after)
+
+		// then
+		if !assert.Len(t, got, 2002) {
+			return
+		}
+		assert.Equal(t, DiffLine{Op: DiffOpEqual, Text: "처음"}, got[0])
+		assert.Equal(t, DiffLine{Op: DiffOpDelete, Text: "이전 1"}, got[1])
+		assert.Equal(t, DiffLine{Op: DiffOpDelete, Text: "이전 1000"}, got[1000])
+		assert.Equal(t, DiffLine{Op: DiffOpInsert, Text: "이후 1"}, got[1001])
+		assert.Equal(t, DiffLine{Op: DiffOpInsert, Text: "이후 1000"}, got[2000])
+		assert.Equal(t, DiffLine{Op: DiffOpEqual, Text: "끝"}, got[2001])
+	})
+
+	t.Run("PASS - 최대 크기 이하면 줄 단위로 비교", func(t *testing.T) {
+		// given
+		before := numberedLines("줄 ", 999)
+		after := strings.NewReplacer("줄 1\n", "바뀐 줄 1\n", "줄 500\n", "바뀐 줄 500\n", "줄 999", "바뀐 줄 999").Replace(before)
+
+		// when
+		got := DiffLines(before, after)
+
+		// then
+		if !assert.Len(t, got, 1002) {
+			return
+		}
+		assert.Equal(t, []DiffLine{
+			{Op: DiffOpDelete, Text: "줄 1"},
+			{Op: DiffOpInsert, Text: "바뀐 줄 1"},
+			{Op: DiffOpEqual, Text: "줄 2"},
+		}, got[:3])
+		assert.Equal(t, []DiffLine{
+			{Op: DiffOpDelete, Text: "줄 500"},
+			{Op: DiffOpInsert, Text: "바뀐 줄 500"},
+		}, got[500:502])
+		assert.Equal(t, DiffLine{Op: DiffOpInsert, Text: "바뀐 줄 999"}, got[1001])
+	})
+}
@@ -0,0 +1,203 @@
+package db
+
+import (
+	"classting/pkg/pagination"
+	"strings"
+)
+
+// Cond 플레이스홀더(?)로 값을 받는 조건식. SQL에는 컬럼, 연산자처럼 코드에 고정된 문자열만 쓰고 요청 값은 Args로 넘긴다.
+type Cond struct {
+	SQL  string
+	Args []any
+}
+
+// Expr expr의 플레이스홀더 순서대로 args를 넘긴다.
+func Expr(expr string, args ...any) Cond {
+	return Cond{SQL: expr, Args: args}
+}
+
+func Eq(column string, value any) Cond {
+	return Expr(column+" = ?", value)
+}
+
+// In values가 비어 있으면 어떤 행도 고르지 않는다.
+func In[T any](column string, values []T) Cond {
+	if len(values) == 0 {
+		return Expr("FALSE")
+	}
+
+	return Expr(column+" IN ("+placeholders(len(values))+")", anys(values)...)
+}
+
+// NotIn values가 비어 있으면 조건을 붙이지 않는다.
+func NotIn[T any](column string, values []T) Cond {
+	if len(values) == 0 {
+		return Cond{}
+	}
+
+	return Expr(column+" NOT IN ("+placeholders(len(values))+")", anys(values)...)
+}
+
+// Or 조건 중 하나라도 맞는 행을 고른다.
+func Or(conds ...Cond) Cond {
+	return join(" OR ", conds)
+}
+
+// And Or 안에서 여러 조건을 함께 비교할 때 사용한다. Query.Where에 넘긴 조건은 AND로 이어진다.
+func And(conds ...Cond) Cond {
+	return join(" AND ", conds)
+}
+
+// Key 커서의 정렬 값과 비교할 컬럼, Int이면 정렬 값을 정수로 비교한다.
+type Key struct {
+	Column string
+	Int    bool
+}
+
+// Query SELECT 쿼리에 조건, 그룹, 정렬, 조회 개수를 붙이고 플레이스홀더에 바인딩할 인자를 쿼리에 나오는 순서대로 모은다.
+type Query struct {
+	base    string
+	args    []any
+	where   []Cond
+	groupBy []string
+	orderBy []string
+	limit   int
+}
+
+// Select base에는 WHERE 앞까지(SELECT ... FROM ... JOIN ...)를 쓰고 base의 플레이스홀더 인자를 args로 넘긴다.
+func Select(base string, args ...any) *Query {
+	return &Query{
+		base: base,
+		args: args,
+	}
+}
+
+// Where 조건을 AND로 잇는다. SQL이 비어 있는 조건은 건너뛴다.
+func (q *Query) Where(conds ...Cond) *Query {
+	for _, cond := range conds {
+		if cond.SQL != "" {
+			q.where = append(q.where, cond)
+		}
+	}
+
+	return q
+}
+
+func (q *Query) GroupBy(columns ...string) *Query {
+	q.groupBy = append(q.groupBy, columns...)
+
+	return q
+}
+
+func (q *Query) OrderBy(column string, desc bool) *Query {
+	if desc {
+		q.orderBy = append(q.orderBy, column+" DESC")
+	} else {
+		q.orderBy = append(q.orderBy, column+" ASC")
+	}
+
+	return q
+}
+
+// Limit 0 이하이면 LIMIT을 붙이지 않는다.
+func (q *Query) Limit(limit int) *Query {
+	q.limit = limit
+
+	return q
+}
+
+// Seek 아이디 순 목록에서 커서 다음의 행을 고르고 정렬을 붙인다. desc는 목록의 정렬 방향이다.
+func (q *Query) Seek(cursor *pagination.Cursor, desc bool, id string) *Query {
+	return q.SeekKey(cursor, desc, Key{}, id)
+}
+
+// SeekKey key 순으로 정렬하고 정렬 값이 같으면 아이디 순으로 정렬한 목록에서 커서 다음의 행을 고른다.
+// 이전 페이지를 가리키는 커서는 반대 방향으로 조회하고 pagination.NewPage에서 목록 순서로 되돌린다.
+func (q *Query) SeekKey(cursor *pagination.Cursor, desc bool, key Key, id string) *Query {
+	order := desc != cursor.Backward()
+
+	if cursor != nil {
+		operator := cursor.Operator(desc)
+		after := Expr(id+" "+operator+" ?", cursor.ID)
+		if key.Column != "" {
+			var value any = cursor.Key
+			if key.Int {
+				value = cursor.KeyInt()
+			}
+			after = Or(
+				Expr(key.Column+" "+operator+" ?", value),
+				And(Eq(key.Column, value), after),
+			)
+		}
+		q.Where(after)
+	}
+
+	if key.Column != "" {
+		q.OrderBy(key.Column, order)
+	}
+
+	return q.OrderBy(id, order)
+}
+
+// Build 쿼리 문자열과 바인딩 인자를 반환한다.
+func (q *Query) Build() (string, []any) {
+	var b strings.Builder
+	args := append([]any(nil), q.args...)
+
+	b.WriteString(q.base)
+	for i, cond := range q.where {
+		if i == 0 {
+			b.WriteString(" WHERE ")
+		} else {
+			b.WriteString(" AND ")
+		}
+		b.WriteString(cond.SQL)
+		args = append(args, cond.Args...)
+	}
+	if len(q.groupBy) > 0 {
+		b.WriteString(" GROUP BY ")
+		b.WriteString(strings.Join(q.groupBy, ", "))
+	}
+	if len(q.orderBy) > 0 {
+		b.WriteString(" ORDER BY ")
+		b.WriteString(strings.Join(q.orderBy, ", "))
+	}
+	if q.limit > 0 {
+		b.WriteString(" LIMIT ?")
+		args = append(args, q.limit)
+	}
+
+	return b.String(), args
+}
+
+func join(sep string, conds []Cond) Cond {
+	var (
+		parts []string
+		args  []any
+	)
+	for _, cond := range conds {
+		if cond.SQL == "" {
+			continue
+		}
+		parts = append(parts, cond.SQL)
+		args = append(args, cond.Args...)
+	}
+	if len(parts) == 0 {
+		return Cond{}
+	}
+
+	return Cond{SQL: "(" + strings.Join(parts, sep) + ")", Args: args}
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func anys[T any](values []T) []any {
+	args := make([]any, 0, len(values))
+	for _, value := range values {
+		args = append(args, value)
+	}
+
+	return args
+}
@@ -0,0 +1,183 @@
+package db
+
+import (
+	"classting/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestQuery_Build(t *testing.T) {
+	const base = "SELECT id FROM news"
+
+	tests := []struct {
+		name      string
+		query     func() *Query
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			name:      "PASS - 조건이 없는 쿼리",
+			query:     func() *Query { return Select(base) },
+			wantQuery: "SELECT id FROM news",
+		},
+		{
+			name: "PASS - base의 인자 다음에 조건 인자를 순서대로 붙임",
+			query: func() *Query {
+				return Select("SELECT id FROM news JOIN reads ON reads.news_id = news.id AND reads.user_id = ?", 7).
+					Where(Eq("news.school_id", 1), Expr("news.delete_date IS NULL"), Eq("news.status", "PUBLISHED"))
+			},
+			wantQuery: "SELECT id FROM news JOIN reads ON reads.news_id = news.id AND reads.user_id = ? WHERE news.school_id = ? AND news.delete_date IS NULL AND news.status = ?",
+			wantArgs:  []any{7, 1, "PUBLISHED"},
+		},
+		{
+			name:      "PASS - In",
+			query:     func() *Query { return Select(base).Where(In("id", []int{1, 2, 3})) },
+			wantQuery: "SELECT id FROM news WHERE id IN (?, ?, ?)",
+			wantArgs:  []any{1, 2, 3},
+		},
+		{
+			name:      "PASS - 빈 In은 어떤 행도 고르지 않음",
+			query:     func() *Query { return Select(base).Where(In("id", []int{}), Eq("school_id", 1)) },
+			wantQuery: "SELECT id FROM news WHERE FALSE AND school_id = ?",
+			wantArgs:  []any{1},
+		},
+		{
+			name:      "PASS - NotIn",
+			query:     func() *Query { return Select(base).Where(Eq("school_id", 1), NotIn("id", []int{4, 5})) },
+			wantQuery: "SELECT id FROM news WHERE school_id = ? AND id NOT IN (?, ?)",
+			wantArgs:  []any{1, 4, 5},
+		},
+		{
+			name:      "PASS - 빈 NotIn은 조건을 붙이지 않음",
+			query:     func() *Query { return Select(base).Where(NotIn("id", []int(nil)), Eq("school_id", 1)) },
+			wantQuery: "SELECT id FROM news WHERE school_id = ?",
+			wantArgs:  []any{1},
+		},
+		{
+			name: "PASS - Or 안의 And를 괄호로 묶음",
+			query: func() *Query {
+				return Select(base).Where(
+					Eq("school_id", 1),
+					Or(Expr("title < ?", "b"), And(Eq("title", "b"), Expr("id < ?", 3))),
+				)
+			},
+			wantQuery: "SELECT id FROM news WHERE school_id = ? AND (title < ? OR (title = ? AND id < ?))",
+			wantArgs:  []any{1, "b", "b", 3},
+		},
+		{
+			name: "PASS - Or, And에서 빈 조건은 건너뛰고 모두 비면 조건을 붙이지 않음",
+			query: func() *Query {
+				return Select(base).Where(
+					Or(NotIn("id", []int{}), Eq("school_id", 1)),
+					And(NotIn("id", []int{})),
+				)
+			},
+			wantQuery: "SELECT id FROM news WHERE (school_id = ?)",
+			wantArgs:  []any{1},
+		},
+		{
+			name: "PASS - GroupBy, OrderBy, Limit",
+			query: func() *Query {
+				return Select("SELECT school_id, COUNT(*) FROM news").
+					GroupBy("school_id").
+					OrderBy("school_id", false).
+					Limit(10)
+			},
+			wantQuery: "SELECT school_id, COUNT(*) FROM news GROUP BY school_id ORDER BY school_id ASC LIMIT ?",
+			wantArgs:  []any{10},
+		},
+		{
+			name:      "PASS - 0 이하의 Limit은 붙이지 않음",
+			query:     func() *Query { return Select(base).OrderBy("id", true).Limit(0) },
+			wantQuery: "SELECT id FROM news ORDER BY id DESC",
+		},
+		{
+			name:      "PASS - 커서 없는 Seek은 정렬만 붙임",
+			query:     func() *Query { return Select(base).Seek(nil, true, "id").Limit(11) },
+			wantQuery: "SELECT id FROM news ORDER BY id DESC LIMIT ?",
+			wantArgs:  []any{11},
+		},
+		{
+			name: "PASS - 내림차순 목록의 다음 페이지",
+			query: func() *Query {
+				return Select(base).Where(Eq("school_id", 1)).
+					Seek(&pagination.Cursor{ID: 5, Direction: pagination.Forward}, true, "id").
+					Limit(11)
+			},
+			wantQuery: "SELECT id FROM news WHERE school_id = ? AND id < ? ORDER BY id DESC LIMIT ?",
+			wantArgs:  []any{1, 5, 11},
+		},
+		{
+			name: "PASS - 내림차순 목록의 이전 페이지는 오름차순으로 조회",
+			query: func() *Query {
+				return Select(base).
+					Seek(&pagination.Cursor{ID: 5, Direction: pagination.Backward}, true, "id").
+					Limit(11)
+			},
+			wantQuery: "SELECT id FROM news WHERE id > ? ORDER BY id ASC LIMIT ?",
+			wantArgs:  []any{5, 11},
+		},
+		{
+			name: "PASS - 오름차순 목록의 이전 페이지는 내림차순으로 조회",
+			query: func() *Query {
+				return Select(base).
+					Seek(&pagination.Cursor{ID: 5, Direction: pagination.Backward}, false, "id").
+					Limit(11)
+			},
+			wantQuery: "SELECT id FROM news WHERE id < ? ORDER BY id DESC LIMIT ?",
+			wantArgs:  []any{5, 11},
+		},
+		{
+			name: "PASS - 문자열 정렬 값의 다음 페이지",
+			query: func() *Query {
+				return Select("SELECT id FROM schools").
+					SeekKey(&pagination.Cursor{Key: "클래스팅", ID: 3, Direction: pagination.Forward}, false, Key{Column: "name"}, "id").
+					Limit(11)
+			},
+			wantQuery: "SELECT id FROM schools WHERE (name > ? OR (name = ? AND id > ?)) ORDER BY name ASC, id ASC LIMIT ?",
+			wantArgs:  []any{"클래스팅", "클래스팅", 3, 11},
+		},
+		{
+			name: "PASS - 정수 정렬 값의 이전 페이지",
+			query: func() *Query {
+				return Select("SELECT id FROM schools").
+					SeekKey(&pagination.Cursor{Key: "42", ID: 3, Direction: pagination.Backward}, true, Key{Column: "subscriber_count", Int: true}, "id").
+					Limit(11)
+			},
+			wantQuery: "SELECT id FROM schools WHERE (subscriber_count > ? OR (subscriber_count = ? AND id > ?)) ORDER BY subscriber_count ASC, id ASC LIMIT ?",
+			wantArgs:  []any{42, 42, 3, 11},
+		},
+		{
+			name: "PASS - 커서 없는 SeekKey는 정렬 값, 아이디 순으로 정렬",
+			query: func() *Query {
+				return Select("SELECT id FROM schools").SeekKey(nil, true, Key{Column: "subscriber_count", Int: true}, "id")
+			},
+			wantQuery: "SELECT id FROM schools ORDER BY subscriber_count DESC, id DESC",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			gotQuery, gotArgs := tt.query().Build()
+
+			// then
+			assert.Equal(t, tt.wantQuery, gotQuery)
+			assert.Equal(t, tt.wantArgs, gotArgs)
+		})
+	}
+}
+
+func TestQuery_Build_Repeatable(t *testing.T) {
+	// given
+	q := Select("SELECT id FROM news WHERE user_id = ?", 1).Limit(10)
+
+	// when
+	firstQuery, firstArgs := q.Build()
+	secondQuery, secondArgs := q.Build()
+
+	// then
+	assert.Equal(t, firstQuery, secondQuery)
+	assert.Equal(t, []any{1, 10}, firstArgs)
+	assert.Equal(t, firstArgs, secondArgs)
+}
@@ -0,0 +1,21 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+// WithTx fn이 에러 없이 끝나면 커밋하고 에러가 발생하면 롤백한다.
+func WithTx(ctx context.Context, sqlDB *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,207 @@
+package jwtkey
+
+import (
+	"classting/config"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"math/big"
+	"os"
+)
+
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// Key 검증용 공개키와 서명할 수 있는 경우 개인키를 갖는다.
+type Key struct {
+	ID         string
+	Method     jwt.SigningMethod
+	PrivateKey crypto.Signer
+	PublicKey  crypto.PublicKey
+}
+
+// KeySet 서명에 사용하는 하나의 키와 검증에 사용하는 여러 키를 관리한다.
+// 키를 교체할 때는 새 키로 서명하고 이전 키는 발행된 토큰이 만료될 때까지 검증용으로 남겨둔다.
+type KeySet struct {
+	signingKey *Key
+	keys       map[string]*Key
+	order      []string
+}
+
+// JWK RFC 7517 형식의 공개키
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// Load 설정된 PEM 파일에서 키를 읽는다. 설정된 키가 없으면 nil을 반환하고 HS256 시크릿을 사용한다.
+func Load(cfg config.Auth) (*KeySet, error) {
+	if len(cfg.Keys) == 0 {
+		return nil, nil
+	}
+
+	keySet := &KeySet{
+		keys: make(map[string]*Key),
+	}
+	for _, keyConfig := range cfg.Keys {
+		key, err := loadKey(keyConfig)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := keySet.keys[key.ID]; ok {
+			return nil, fmt.Errorf("jwtkey: duplicate key id %q", key.ID)
+		}
+		keySet.keys[key.ID] = key
+		keySet.order = append(keySet.order, key.ID)
+	}
+
+	signingKey, ok := keySet.keys[cfg.SigningKeyID]
+	if !ok {
+		return nil, fmt.Errorf("jwtkey: signing key %q not found", cfg.SigningKeyID)
+	}
+	if signingKey.PrivateKey == nil {
+		return nil, fmt.Errorf("jwtkey: signing key %q has no private key", cfg.SigningKeyID)
+	}
+	keySet.signingKey = signingKey
+
+	return keySet, nil
+}
+
+func loadKey(keyConfig config.SigningKey) (*Key, error) {
+	if keyConfig.ID == "" {
+		return nil, fmt.Errorf("jwtkey: key id is required")
+	}
+	if keyConfig.PrivateKeyPath == "" && keyConfig.PublicKeyPath == "" {
+		return nil, fmt.Errorf("jwtkey: key %q has no key file", keyConfig.ID)
+	}
+
+	key := &Key{
+		ID: keyConfig.ID,
+	}
+
+	var parsePrivateKey func(pem []byte) (crypto.Signer, error)
+	var parsePublicKey func(pem []byte) (crypto.PublicKey, error)
+	switch keyConfig.Algorithm {
+	case AlgorithmRS256:
+		key.Method = jwt.SigningMethodRS256
+		parsePrivateKey = func(pem []byte) (crypto.Signer, error) {
+			return jwt.ParseRSAPrivateKeyFromPEM(pem)
+		}
+		parsePublicKey = func(pem []byte) (crypto.PublicKey, error) {
+			return jwt.ParseRSAPublicKeyFromPEM(pem)
+		}
+	case AlgorithmEdDSA:
+		key.Method = jwt.SigningMethodEdDSA
+		parsePrivateKey = func(pem []byte) (crypto.Signer, error) {
+			privateKey, err := jwt.ParseEdPrivateKeyFromPEM(pem)
+			if err != nil {
+				return nil, err
+			}
+			signer, ok := privateKey.(crypto.Signer)
+			if !ok {
+				return nil, fmt.Errorf("not a signer")
+			}
+			return signer, nil
+		}
+		parsePublicKey = jwt.ParseEdPublicKeyFromPEM
+	default:
+		return nil, fmt.Errorf("jwtkey: key %q has unsupported algorithm %q", keyConfig.ID, keyConfig.Algorithm)
+	}
+
+	if keyConfig.PrivateKeyPath != "" {
+		pem, err := os.ReadFile(keyConfig.PrivateKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("jwtkey: read key %q: %w", keyConfig.ID, err)
+		}
+		privateKey, err := parsePrivateKey(pem)
+		if err != nil {
+			return nil, fmt.Errorf("jwtkey: parse key %q: %w", keyConfig.ID, err)
+		}
+		key.PrivateKey = privateKey
+		key.PublicKey = privateKey.Public()
+
+		return key, nil
+	}
+
+	pem, err := os.ReadFile(keyConfig.PublicKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("jwtkey: read key %q: %w", keyConfig.ID, err)
+	}
+	key.PublicKey, err = parsePublicKey(pem)
+	if err != nil {
+		return nil, fmt.Errorf("jwtkey: parse key %q: %w", keyConfig.ID, err)
+	}
+
+	return key, nil
+}
+
+// Sign 서명 키로 토큰을 서명하고 헤더에 kid를 남긴다.
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(ks.signingKey.Method, claims)
+	token.Header["kid"] = ks.signingKey.ID
+
+	return token.SignedString(ks.signingKey.PrivateKey)
+}
+
+// Keyfunc 토큰 헤더의 kid로 검증 키를 찾는다. 키에 설정된 알고리즘과 다른 토큰은 거부한다.
+func (ks *KeySet) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok {
+		return nil, fmt.Errorf("kid header not found")
+	}
+
+	key, ok := ks.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown kid %q", kid)
+	}
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+
+	return key.PublicKey, nil
+}
+
+// JWKS 검증에 사용하는 모든 공개키를 설정된 순서대로 반환한다.
+func (ks *KeySet) JWKS() JWKS {
+	jwks := JWKS{
+		Keys: make([]JWK, 0, len(ks.order)),
+	}
+	for _, kid := range ks.order {
+		key := ks.keys[kid]
+		jwk := JWK{
+			KeyID:     key.ID,
+			Use:       "sig",
+			Algorithm: key.Method.Alg(),
+		}
+
+		switch publicKey := key.PublicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+		}
+
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+
+	return jwks
+}
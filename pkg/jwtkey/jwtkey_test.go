@@ -0,0 +1,354 @@
+package jwtkey
+
+import (
+	"classting/config"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type testKeys struct {
+	rsaKey        *rsa.PrivateKey
+	rsaPrivate    string
+	rsaPublic     string
+	edKey         ed25519.PrivateKey
+	edPrivate     string
+	edPublic      string
+	invalidPEM    string
+	missingKeyPEM string
+}
+
+// writeTestKeys 테스트마다 RS256, EdDSA 키를 새로 만들어 PEM 파일로 쓴다.
+func writeTestKeys(t *testing.T) testKeys {
+	t.Helper()
+	dir := t.TempDir()
+	write := func(name, blockType string, der []byte) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	var keys testKeys
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaPrivate, _ := x509.MarshalPKCS8PrivateKey(rsaKey)
+	rsaPublic, _ := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	keys.rsaKey = rsaKey
+	keys.rsaPrivate = write("rs256.pem", "PRIVATE KEY", rsaPrivate)
+	keys.rsaPublic = write("rs256.pub.pem", "PUBLIC KEY", rsaPublic)
+
+	edPublicKey, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edPrivate, _ := x509.MarshalPKCS8PrivateKey(edKey)
+	edPublic, _ := x509.MarshalPKIXPublicKey(edPublicKey)
+	keys.edKey = edKey
+	keys.edPrivate = write("eddsa.pem", "PRIVATE KEY", edPrivate)
+	keys.edPublic = write("eddsa.pub.pem", "PUBLIC KEY", edPublic)
+
+	keys.invalidPEM = filepath.Join(dir, "invalid.pem")
+	if err := os.WriteFile(keys.invalidPEM, []byte("not a pem"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	keys.missingKeyPEM = filepath.Join(dir, "missing.pem")
+
+	return keys
+}
+
+func TestLoad(t *testing.T) {
+	keys := writeTestKeys(t)
+
+	tests := []struct {
+		name        string
+		cfg         config.Auth
+		wantNil     bool
+		wantSigning string
+		wantKIDs    []string
+		wantErr     bool
+	}{
+		{
+			name:    "PASS - 키가 없으면 HS256 시크릿 사용",
+			cfg:     config.Auth{Secret: "classting_test_secret"},
+			wantNil: true,
+		},
+		{
+			name: "PASS - RS256 개인키로 서명하고 EdDSA 공개키로 검증",
+			cfg: config.Auth{
+				SigningKeyID: "rsa",
+				Keys: []config.SigningKey{
+					{ID: "rsa", Algorithm: AlgorithmRS256, PrivateKeyPath: keys.rsaPrivate},
+					{ID: "ed", Algorithm: AlgorithmEdDSA, PublicKeyPath: keys.edPublic},
+				},
+			},
+			wantSigning: "rsa",
+			wantKIDs:    []string{"rsa", "ed"},
+		},
+		{
+			name: "PASS - EdDSA 개인키로 서명하고 이전 RS256 공개키로 검증",
+			cfg: config.Auth{
+				SigningKeyID: "ed",
+				Keys: []config.SigningKey{
+					{ID: "rsa", Algorithm: AlgorithmRS256, PublicKeyPath: keys.rsaPublic},
+					{ID: "ed", Algorithm: AlgorithmEdDSA, PrivateKeyPath: keys.edPrivate},
+				},
+			},
+			wantSigning: "ed",
+			wantKIDs:    []string{"rsa", "ed"},
+		},
+		{
+			name: "FAIL - 서명 키에 개인키가 없음",
+			cfg: config.Auth{
+				SigningKeyID: "rsa",
+				Keys: []config.SigningKey{
+					{ID: "rsa", Algorithm: AlgorithmRS256, PublicKeyPath: keys.rsaPublic},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 서명 키를 찾을 수 없음",
+			cfg: config.Auth{
+				SigningKeyID: "unknown",
+				Keys: []config.SigningKey{
+					{ID: "rsa", Algorithm: AlgorithmRS256, PrivateKeyPath: keys.rsaPrivate},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 중복된 키 아이디",
+			cfg: config.Auth{
+				SigningKeyID: "rsa",
+				Keys: []config.SigningKey{
+					{ID: "rsa", Algorithm: AlgorithmRS256, PrivateKeyPath: keys.rsaPrivate},
+					{ID: "rsa", Algorithm: AlgorithmEdDSA, PublicKeyPath: keys.edPublic},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 지원하지 않는 알고리즘",
+			cfg: config.Auth{
+				SigningKeyID: "rsa",
+				Keys: []config.SigningKey{
+					{ID: "rsa", Algorithm: "HS256", PrivateKeyPath: keys.rsaPrivate},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 알고리즘과 다른 종류의 키",
+			cfg: config.Auth{
+				SigningKeyID: "rsa",
+				Keys: []config.SigningKey{
+					{ID: "rsa", Algorithm: AlgorithmRS256, PrivateKeyPath: keys.edPrivate},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - PEM 형식이 아닌 파일",
+			cfg: config.Auth{
+				SigningKeyID: "ed",
+				Keys: []config.SigningKey{
+					{ID: "ed", Algorithm: AlgorithmEdDSA, PrivateKeyPath: keys.invalidPEM},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 키 파일이 없음",
+			cfg: config.Auth{
+				SigningKeyID: "ed",
+				Keys: []config.SigningKey{
+					{ID: "ed", Algorithm: AlgorithmEdDSA, PrivateKeyPath: keys.missingKeyPEM},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 키 파일 경로가 설정되지 않음",
+			cfg: config.Auth{
+				SigningKeyID: "ed",
+				Keys: []config.SigningKey{
+					{ID: "ed", Algorithm: AlgorithmEdDSA},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 키 아이디가 없음",
+			cfg: config.Auth{
+				Keys: []config.SigningKey{
+					{Algorithm: AlgorithmEdDSA, PrivateKeyPath: keys.edPrivate},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			got, err := Load(tt.cfg)
+
+			// then
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			if tt.wantNil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.wantSigning, got.signingKey.ID)
+			assert.Equal(t, tt.wantKIDs, got.order)
+		})
+	}
+}
+
+func TestKeySet_Keyfunc(t *testing.T) {
+	keys := writeTestKeys(t)
+	keySet, err := Load(config.Auth{
+		SigningKeyID: "ed",
+		Keys: []config.SigningKey{
+			{ID: "rsa", Algorithm: AlgorithmRS256, PublicKeyPath: keys.rsaPublic},
+			{ID: "ed", Algorithm: AlgorithmEdDSA, PrivateKeyPath: keys.edPrivate},
+		},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	claims := jwt.MapClaims{"userID": 1}
+	sign := func(method jwt.SigningMethod, kid any, key any) string {
+		token := jwt.NewWithClaims(method, claims)
+		if kid != nil {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+	signed, err := keySet.Sign(claims)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{
+			name:  "PASS - 서명 키로 서명한 토큰",
+			token: signed,
+		},
+		{
+			name:  "PASS - 검증용으로 남겨 둔 이전 키로 서명된 토큰",
+			token: sign(jwt.SigningMethodRS256, "rsa", keys.rsaKey),
+		},
+		{
+			name:    "FAIL - kid가 없는 토큰",
+			token:   sign(jwt.SigningMethodEdDSA, nil, keys.edKey),
+			wantErr: true,
+		},
+		{
+			name:    "FAIL - 알 수 없는 kid",
+			token:   sign(jwt.SigningMethodEdDSA, "unknown", keys.edKey),
+			wantErr: true,
+		},
+		{
+			name:    "FAIL - 키에 설정된 알고리즘과 다른 토큰",
+			token:   sign(jwt.SigningMethodRS256, "ed", keys.rsaKey),
+			wantErr: true,
+		},
+		{
+			name:    "FAIL - 공개키를 HS256 시크릿으로 사용한 토큰",
+			token:   sign(jwt.SigningMethodHS256, "rsa", []byte("classting_test_secret")),
+			wantErr: true,
+		},
+		{
+			name:    "FAIL - kid와 다른 키로 서명한 토큰",
+			token:   sign(jwt.SigningMethodEdDSA, "ed", otherKey),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			token, err := jwt.Parse(tt.token, keySet.Keyfunc)
+
+			// then
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, token.Valid)
+		})
+	}
+}
+
+func TestKeySet_JWKS(t *testing.T) {
+	// given
+	keys := writeTestKeys(t)
+	keySet, err := Load(config.Auth{
+		SigningKeyID: "ed",
+		Keys: []config.SigningKey{
+			{ID: "rsa", Algorithm: AlgorithmRS256, PublicKeyPath: keys.rsaPublic},
+			{ID: "ed", Algorithm: AlgorithmEdDSA, PrivateKeyPath: keys.edPrivate},
+		},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// when
+	got := keySet.JWKS()
+
+	// then
+	assert.Equal(t, JWKS{
+		Keys: []JWK{
+			{
+				KeyType:   "RSA",
+				KeyID:     "rsa",
+				Use:       "sig",
+				Algorithm: AlgorithmRS256,
+				N:         base64.RawURLEncoding.EncodeToString(keys.rsaKey.N.Bytes()),
+				E:         base64.RawURLEncoding.EncodeToString(big.NewInt(int64(keys.rsaKey.E)).Bytes()),
+			},
+			{
+				KeyType:   "OKP",
+				KeyID:     "ed",
+				Use:       "sig",
+				Algorithm: AlgorithmEdDSA,
+				Curve:     "Ed25519",
+				X:         base64.RawURLEncoding.EncodeToString(keys.edKey.Public().(ed25519.PublicKey)),
+			},
+		},
+	}, got)
+	assert.Equal(t, "AQAB", got.Keys[0].E)
+}
@@ -0,0 +1,390 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"sort"
+	"time"
+)
+
+var (
+	ErrChecksumMismatch = errors.New("migrate: applied migration file has been modified")
+	ErrMissingMigration = errors.New("migrate: applied migration file not found")
+	ErrLockTimeout      = errors.New("migrate: timed out waiting for another migration")
+	// ErrUnversionedSchema 마이그레이션 도입 전에 init.sql로 만든 데이터베이스는 Baseline으로 적용 기록을 먼저 남겨야 한다.
+	ErrUnversionedSchema = errors.New("migrate: database has tables but no applied migrations, run baseline first")
+)
+
+const (
+	// lockTimeout 동시에 실행된 다른 인스턴스가 마이그레이션을 끝낼 때까지 기다리는 시간(초)
+	lockTimeout = 60
+	// seedName schema_seeds에 샘플 데이터 적용 여부를 기록하는 이름
+	seedName = "seed"
+)
+
+// Migrator schema_migrations 테이블에 적용한 버전을 기록하며 마이그레이션을 적용하고 되돌린다.
+// 여러 인스턴스가 동시에 시작해도 한 곳에서만 실행되도록 MySQL 잠금(GET_LOCK)을 잡고 실행한다.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// Status 마이그레이션 파일별 적용 여부, Missing은 적용했지만 파일이 없는 버전이다.
+type Status struct {
+	Migration
+	Applied   bool
+	ApplyDate time.Time
+	Modified  bool
+	Missing   bool
+}
+
+// SeedStatus 샘플 데이터 적용 기록, Skipped는 Baseline으로 기존 데이터를 유지하기로 해 넣지 않은 경우다.
+type SeedStatus struct {
+	Checksum  string
+	Skipped   bool
+	ApplyDate time.Time
+}
+
+type record struct {
+	version   int
+	name      string
+	checksum  string
+	applyDate time.Time
+}
+
+func New(db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+// Up 적용하지 않은 마이그레이션을 버전 순으로 모두 적용하고 이번에 적용한 마이그레이션을 반환한다.
+// DDL은 MySQL에서 트랜잭션으로 묶이지 않으므로 실패한 마이그레이션은 기록하지 않고 직접 정리해야 한다.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		records, err := m.verify(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			// 기록이 없는데 테이블이 있으면 0001부터 다시 만들다 실패하므로 적용 전에 멈춘다.
+			unversioned, err := hasTables(ctx, conn)
+			if err != nil {
+				return err
+			}
+			if unversioned {
+				return ErrUnversionedSchema
+			}
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := records[migration.Version]; ok {
+				continue
+			}
+			if err := execScript(ctx, conn, migration.Up); err != nil {
+				return fmt.Errorf("migrate: %s up: %w", migration, err)
+			}
+			if _, err := conn.ExecContext(ctx, insertMigrationQuery, migration.Version, migration.Name, migration.Checksum); err != nil {
+				return err
+			}
+			applied = append(applied, migration)
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down 마지막으로 적용한 마이그레이션부터 steps개를 되돌리고 되돌린 마이그레이션을 반환한다.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var reverted []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		records, err := m.verify(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration := m.migrations[i]
+			if _, ok := records[migration.Version]; !ok {
+				continue
+			}
+			if err := execScript(ctx, conn, migration.Down); err != nil {
+				return fmt.Errorf("migrate: %s down: %w", migration, err)
+			}
+			if _, err := conn.ExecContext(ctx, deleteMigrationQuery, migration.Version); err != nil {
+				return err
+			}
+			reverted = append(reverted, migration)
+		}
+
+		return nil
+	})
+
+	return reverted, err
+}
+
+// Baseline 마이그레이션 도입 전에 init.sql로 만든 데이터베이스처럼 적용 기록 없이 테이블이 있으면
+// version까지의 마이그레이션을 실행하지 않고 적용한 것으로 기록하고, 기존 데이터를 유지하도록 샘플 데이터는 넣지 않은 것으로 기록한다.
+// 이미 적용 기록이 있거나 빈 데이터베이스면 아무것도 하지 않으므로 매번 실행해도 안전하다.
+func (m *Migrator) Baseline(ctx context.Context, version int) ([]Migration, error) {
+	if !slices.ContainsFunc(m.migrations, func(migration Migration) bool { return migration.Version == version }) {
+		return nil, fmt.Errorf("migrate: baseline version %d not found", version)
+	}
+
+	var baselined []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		records, err := selectRecords(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if len(records) > 0 {
+			return nil
+		}
+		unversioned, err := hasTables(ctx, conn)
+		if err != nil || !unversioned {
+			return err
+		}
+
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback()
+
+		for _, migration := range m.migrations {
+			if migration.Version > version {
+				break
+			}
+			if _, err := tx.ExecContext(ctx, insertMigrationQuery, migration.Version, migration.Name, migration.Checksum); err != nil {
+				return err
+			}
+			baselined = append(baselined, migration)
+		}
+		if _, err := tx.ExecContext(ctx, upsertSeedQuery, seedName, "", true); err != nil {
+			return err
+		}
+
+		return tx.Commit()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return baselined, nil
+}
+
+// Status 마이그레이션 파일과 적용 기록을 버전 순으로 비교한다.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		records, err := selectRecords(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			status := Status{Migration: migration}
+			if record, ok := records[migration.Version]; ok {
+				status.Applied = true
+				status.ApplyDate = record.applyDate
+				status.Modified = record.checksum != migration.Checksum
+				delete(records, migration.Version)
+			}
+			statuses = append(statuses, status)
+		}
+		for _, record := range records {
+			statuses = append(statuses, Status{
+				Migration: Migration{Version: record.version, Name: record.name, Checksum: record.checksum},
+				Applied:   true,
+				ApplyDate: record.applyDate,
+				Missing:   true,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Version < statuses[j].Version
+	})
+
+	return statuses, nil
+}
+
+// Seed 마이그레이션과 같은 잠금 안에서 script를 한 트랜잭션으로 실행하고 적용 기록을 남긴다.
+func (m *Migrator) Seed(ctx context.Context, script string) error {
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		return seed(ctx, conn, script)
+	})
+}
+
+// SeedOnce 샘플 데이터 적용 기록이 없을 때만 script를 실행하고 실행했는지 반환한다.
+// 실패하면 기록도 함께 롤백되어 다음 실행에서 다시 시도한다.
+func (m *Migrator) SeedOnce(ctx context.Context, script string) (bool, error) {
+	var seeded bool
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		status, err := selectSeed(ctx, conn)
+		if err != nil || status != nil {
+			return err
+		}
+		if err := seed(ctx, conn, script); err != nil {
+			return err
+		}
+		seeded = true
+
+		return nil
+	})
+
+	return seeded, err
+}
+
+// SeedStatus 샘플 데이터 적용 기록, 기록이 없으면 nil을 반환한다.
+func (m *Migrator) SeedStatus(ctx context.Context) (*SeedStatus, error) {
+	var status *SeedStatus
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		var err error
+		status, err = selectSeed(ctx, conn)
+
+		return err
+	})
+
+	return status, err
+}
+
+// verify 적용한 마이그레이션 파일이 없어지거나 바뀌었으면 더 진행하지 않는다.
+func (m *Migrator) verify(ctx context.Context, conn *sql.Conn) (map[int]record, error) {
+	records, err := selectRecords(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make(map[int]Migration, len(m.migrations))
+	for _, migration := range m.migrations {
+		migrations[migration.Version] = migration
+	}
+	for _, record := range records {
+		migration, ok := migrations[record.version]
+		if !ok {
+			return nil, fmt.Errorf("%w: %04d_%s", ErrMissingMigration, record.version, record.name)
+		}
+		if migration.Checksum != record.checksum {
+			return nil, fmt.Errorf("%w: %s", ErrChecksumMismatch, migration)
+		}
+	}
+
+	return records, nil
+}
+
+// withLock 잠금은 커넥션 단위라 잠금, 실행, 해제를 같은 커넥션에서 한다.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var locked sql.NullInt64
+	if err := conn.QueryRowContext(ctx, getLockQuery, lockTimeout).Scan(&locked); err != nil {
+		return err
+	}
+	if locked.Int64 != 1 {
+		return ErrLockTimeout
+	}
+	defer conn.ExecContext(context.Background(), releaseLockQuery)
+
+	if _, err := conn.ExecContext(ctx, createMigrationsTableQuery); err != nil {
+		return err
+	}
+	if _, err := conn.ExecContext(ctx, createSeedsTableQuery); err != nil {
+		return err
+	}
+
+	return fn(conn)
+}
+
+func selectRecords(ctx context.Context, conn *sql.Conn) (map[int]record, error) {
+	rows, err := conn.QueryContext(ctx, selectMigrationsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := make(map[int]record)
+	for rows.Next() {
+		var r record
+		if err := rows.Scan(&r.version, &r.name, &r.checksum, &r.applyDate); err != nil {
+			return nil, err
+		}
+		records[r.version] = r
+	}
+
+	return records, rows.Err()
+}
+
+func execScript(ctx context.Context, conn *sql.Conn, script string) error {
+	for _, statement := range statements(script) {
+		if _, err := conn.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func hasTables(ctx context.Context, conn *sql.Conn) (bool, error) {
+	var count int
+	if err := conn.QueryRowContext(ctx, countTablesQuery).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func selectSeed(ctx context.Context, conn *sql.Conn) (*SeedStatus, error) {
+	var status SeedStatus
+	err := conn.QueryRowContext(ctx, selectSeedQuery, seedName).Scan(&status.Checksum, &status.Skipped, &status.ApplyDate)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// seed 샘플 데이터와 적용 기록을 한 트랜잭션으로 넣는다.
+func seed(ctx context.Context, conn *sql.Conn, script string) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, statement := range statements(script) {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("migrate: seed: %w", err)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, upsertSeedQuery, seedName, checksum([]byte(script)), false); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,120 @@
+package migrate
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name         string
+		fsys         fstest.MapFS
+		wantVersions []int
+		wantErr      bool
+	}{
+		{
+			name: "PASS - 버전 순으로 읽고 sql이 아닌 파일은 무시",
+			fsys: fstest.MapFS{
+				"0002_user_name.up.sql":   {Data: []byte("ALTER TABLE users ADD COLUMN name VARCHAR(255);")},
+				"0002_user_name.down.sql": {Data: []byte("ALTER TABLE users DROP COLUMN name;")},
+				"0001_init.up.sql":        {Data: []byte("CREATE TABLE users (id INT);")},
+				"0001_init.down.sql":      {Data: []byte("DROP TABLE users;")},
+				"README.md":               {Data: []byte("# migrations")},
+			},
+			wantVersions: []int{1, 2},
+		},
+		{
+			name: "FAIL - down 파일이 없음",
+			fsys: fstest.MapFS{
+				"0001_init.up.sql": {Data: []byte("CREATE TABLE users (id INT);")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 잘못된 파일 이름",
+			fsys: fstest.MapFS{
+				"init.up.sql":   {Data: []byte("CREATE TABLE users (id INT);")},
+				"init.down.sql": {Data: []byte("DROP TABLE users;")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 같은 버전의 up, down 이름이 다름",
+			fsys: fstest.MapFS{
+				"0001_init.up.sql":    {Data: []byte("CREATE TABLE users (id INT);")},
+				"0001_users.down.sql": {Data: []byte("DROP TABLE users;")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 0 버전",
+			fsys: fstest.MapFS{
+				"0000_init.up.sql":   {Data: []byte("CREATE TABLE users (id INT);")},
+				"0000_init.down.sql": {Data: []byte("DROP TABLE users;")},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			got, err := Load(tt.fsys)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				return
+			}
+			assert.Equal(t, tt.wantVersions, versionsOf(got))
+			for _, migration := range got {
+				assert.Equal(t, checksum([]byte(migration.Up)), migration.Checksum)
+			}
+		})
+	}
+}
+
+func TestStatements(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   []string
+	}{
+		{
+			name:   "PASS - 주석과 빈 줄은 건너뜀",
+			script: "-- 유저\n\nCREATE TABLE users (id INT);\n  -- 학교\nCREATE TABLE schools (id INT);\n",
+			want:   []string{"CREATE TABLE users (id INT)", "CREATE TABLE schools (id INT)"},
+		},
+		{
+			name:   "PASS - 여러 줄 문장은 세미콜론으로 끝나는 줄까지 한 문장",
+			script: "CREATE TABLE users (\n  id INT,\n  name VARCHAR(255)\n);\n",
+			want:   []string{"CREATE TABLE users (\n  id INT,\n  name VARCHAR(255)\n)"},
+		},
+		{
+			name:   "PASS - 줄 중간의 세미콜론에서는 나누지 않음",
+			script: "INSERT INTO news (title) VALUES ('a; b');\n",
+			want:   []string{"INSERT INTO news (title) VALUES ('a; b')"},
+		},
+		{
+			name:   "PASS - 세미콜론 없는 마지막 문장",
+			script: "DROP TABLE schools;\nDROP TABLE users",
+			want:   []string{"DROP TABLE schools", "DROP TABLE users"},
+		},
+		{
+			name:   "PASS - 주석만 있는 스크립트",
+			script: "-- 비어 있음\n",
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			got := statements(tt.script)
+
+			// then
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,435 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+var testMigrations = fstest.MapFS{
+	"0001_init.up.sql":        {Data: []byte("-- 유저, 학교\nCREATE TABLE users (id INT);\n\nCREATE TABLE schools (id INT);\n")},
+	"0001_init.down.sql":      {Data: []byte("DROP TABLE schools;\nDROP TABLE users;\n")},
+	"0002_user_name.up.sql":   {Data: []byte("ALTER TABLE users ADD COLUMN name VARCHAR(255);\n")},
+	"0002_user_name.down.sql": {Data: []byte("ALTER TABLE users DROP COLUMN name;\n")},
+}
+
+type migratorTestSuite struct {
+	sqlDB      *sql.DB
+	sqlMock    sqlmock.Sqlmock
+	migrator   *Migrator
+	migrations []Migration
+}
+
+func setupMigratorTestSuite(t *testing.T) migratorTestSuite {
+	var us migratorTestSuite
+
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	migrator, err := New(mockDB, testMigrations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	us.sqlDB = mockDB
+	us.sqlMock = mock
+	us.migrator = migrator
+	us.migrations = migrator.migrations
+
+	return us
+}
+
+// expectLock 잠금을 잡고 기록 테이블을 만드는 쿼리를 기대한다.
+func expectLock(ts migratorTestSuite) {
+	ts.sqlMock.ExpectQuery(regexp.QuoteMeta(getLockQuery)).WithArgs(lockTimeout).WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(1))
+	ts.sqlMock.ExpectExec(regexp.QuoteMeta(createMigrationsTableQuery)).WillReturnResult(sqlmock.NewResult(0, 0))
+	ts.sqlMock.ExpectExec(regexp.QuoteMeta(createSeedsTableQuery)).WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func expectRelease(ts migratorTestSuite) {
+	ts.sqlMock.ExpectExec(regexp.QuoteMeta(releaseLockQuery)).WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+// expectRecords versions를 파일과 같은 체크섬으로 적용한 기록을 반환한다.
+func expectRecords(ts migratorTestSuite, versions ...int) {
+	rows := sqlmock.NewRows([]string{"version", "name", "checksum", "apply_date"})
+	for _, version := range versions {
+		migration := ts.migrations[version-1]
+		rows.AddRow(migration.Version, migration.Name, migration.Checksum, time.Now())
+	}
+	ts.sqlMock.ExpectQuery(regexp.QuoteMeta(selectMigrationsQuery)).WillReturnRows(rows)
+}
+
+func expectTables(ts migratorTestSuite, count int) {
+	ts.sqlMock.ExpectQuery(regexp.QuoteMeta(countTablesQuery)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
+}
+
+func expectExec(ts migratorTestSuite, query string, args ...any) {
+	ts.sqlMock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(toDriverValues(args)...).WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+// toDriverValues int 인자를 드라이버가 받는 int64로 바꾼다.
+func toDriverValues(args []any) []driver.Value {
+	values := make([]driver.Value, 0, len(args))
+	for _, arg := range args {
+		if value, ok := arg.(int); ok {
+			arg = int64(value)
+		}
+		values = append(values, arg)
+	}
+	return values
+}
+
+func versionsOf(migrations []Migration) []int {
+	versions := []int{}
+	for _, migration := range migrations {
+		versions = append(versions, migration.Version)
+	}
+	return versions
+}
+
+func TestMigrator_Up(t *testing.T) {
+	tests := []struct {
+		name         string
+		mock         func(ts migratorTestSuite)
+		wantVersions []int
+		wantErr      error
+	}{
+		{
+			name: "PASS - 빈 데이터베이스에 모든 마이그레이션을 문장별로 적용",
+			mock: func(ts migratorTestSuite) {
+				expectLock(ts)
+				expectRecords(ts)
+				expectTables(ts, 0)
+				expectExec(ts, "CREATE TABLE users (id INT)")
+				expectExec(ts, "CREATE TABLE schools (id INT)")
+				expectExec(ts, insertMigrationQuery, 1, "init", ts.migrations[0].Checksum)
+				expectExec(ts, "ALTER TABLE users ADD COLUMN name VARCHAR(255)")
+				expectExec(ts, insertMigrationQuery, 2, "user_name", ts.migrations[1].Checksum)
+				expectRelease(ts)
+			},
+			wantVersions: []int{1, 2},
+		},
+		{
+			name: "PASS - 적용하지 않은 마이그레이션만 적용",
+			mock: func(ts migratorTestSuite) {
+				expectLock(ts)
+				expectRecords(ts, 1)
+				expectExec(ts, "ALTER TABLE users ADD COLUMN name VARCHAR(255)")
+				expectExec(ts, insertMigrationQuery, 2, "user_name", ts.migrations[1].Checksum)
+				expectRelease(ts)
+			},
+			wantVersions: []int{2},
+		},
+		{
+			name: "FAIL - 다른 인스턴스가 잠금을 잡고 있음",
+			mock: func(ts migratorTestSuite) {
+				ts.sqlMock.ExpectQuery(regexp.QuoteMeta(getLockQuery)).WithArgs(lockTimeout).WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(0))
+			},
+			wantVersions: []int{},
+			wantErr:      ErrLockTimeout,
+		},
+		{
+			name: "FAIL - 적용한 마이그레이션 파일이 바뀜",
+			mock: func(ts migratorTestSuite) {
+				expectLock(ts)
+				ts.sqlMock.ExpectQuery(regexp.QuoteMeta(selectMigrationsQuery)).WillReturnRows(
+					sqlmock.NewRows([]string{"version", "name", "checksum", "apply_date"}).AddRow(1, "init", "modified", time.Now()),
+				)
+				expectRelease(ts)
+			},
+			wantVersions: []int{},
+			wantErr:      ErrChecksumMismatch,
+		},
+		{
+			name: "FAIL - 적용한 마이그레이션 파일이 없음",
+			mock: func(ts migratorTestSuite) {
+				expectLock(ts)
+				ts.sqlMock.ExpectQuery(regexp.QuoteMeta(selectMigrationsQuery)).WillReturnRows(
+					sqlmock.NewRows([]string{"version", "name", "checksum", "apply_date"}).AddRow(3, "removed", "checksum", time.Now()),
+				)
+				expectRelease(ts)
+			},
+			wantVersions: []int{},
+			wantErr:      ErrMissingMigration,
+		},
+		{
+			name: "FAIL - 적용 기록 없이 테이블이 있는 데이터베이스",
+			mock: func(ts migratorTestSuite) {
+				expectLock(ts)
+				expectRecords(ts)
+				expectTables(ts, 12)
+				expectRelease(ts)
+			},
+			wantVersions: []int{},
+			wantErr:      ErrUnversionedSchema,
+		},
+		{
+			name: "FAIL - 실패한 마이그레이션은 기록하지 않음",
+			mock: func(ts migratorTestSuite) {
+				expectLock(ts)
+				expectRecords(ts, 1)
+				ts.sqlMock.ExpectExec(regexp.QuoteMeta("ALTER TABLE users ADD COLUMN name VARCHAR(255)")).WillReturnError(errors.New("duplicate column"))
+				expectRelease(ts)
+			},
+			wantVersions: []int{},
+			wantErr:      errors.New("migrate: 0002_user_name up: duplicate column"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupMigratorTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.migrator.Up(context.Background())
+
+			// then
+			assert.Equal(t, tt.wantVersions, versionsOf(got))
+			assertError(t, tt.wantErr, err)
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMigrator_Down(t *testing.T) {
+	tests := []struct {
+		name         string
+		steps        int
+		mock         func(ts migratorTestSuite)
+		wantVersions []int
+	}{
+		{
+			name:  "PASS - 마지막 마이그레이션 되돌리기",
+			steps: 1,
+			mock: func(ts migratorTestSuite) {
+				expectLock(ts)
+				expectRecords(ts, 1, 2)
+				expectExec(ts, "ALTER TABLE users DROP COLUMN name")
+				expectExec(ts, deleteMigrationQuery, 2)
+				expectRelease(ts)
+			},
+			wantVersions: []int{2},
+		},
+		{
+			name:  "PASS - 적용한 마이그레이션보다 많이 되돌리면 모두 역순으로 되돌림",
+			steps: 5,
+			mock: func(ts migratorTestSuite) {
+				expectLock(ts)
+				expectRecords(ts, 1, 2)
+				expectExec(ts, "ALTER TABLE users DROP COLUMN name")
+				expectExec(ts, deleteMigrationQuery, 2)
+				expectExec(ts, "DROP TABLE schools")
+				expectExec(ts, "DROP TABLE users")
+				expectExec(ts, deleteMigrationQuery, 1)
+				expectRelease(ts)
+			},
+			wantVersions: []int{2, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupMigratorTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.migrator.Down(context.Background(), tt.steps)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantVersions, versionsOf(got))
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMigrator_Baseline(t *testing.T) {
+	tests := []struct {
+		name         string
+		version      int
+		mock         func(ts migratorTestSuite)
+		wantVersions []int
+		wantErr      bool
+	}{
+		{
+			name:    "PASS - init.sql로 만든 데이터베이스를 기준 버전까지 적용한 것으로 기록하고 샘플 데이터는 넣지 않음",
+			version: 1,
+			mock: func(ts migratorTestSuite) {
+				expectLock(ts)
+				expectRecords(ts)
+				expectTables(ts, 12)
+				ts.sqlMock.ExpectBegin()
+				expectExec(ts, insertMigrationQuery, 1, "init", ts.migrations[0].Checksum)
+				expectExec(ts, upsertSeedQuery, seedName, "", true)
+				ts.sqlMock.ExpectCommit()
+				expectRelease(ts)
+			},
+			wantVersions: []int{1},
+		},
+		{
+			name:    "PASS - 적용 기록이 있으면 아무것도 하지 않음",
+			version: 1,
+			mock: func(ts migratorTestSuite) {
+				expectLock(ts)
+				expectRecords(ts, 1)
+				expectRelease(ts)
+			},
+			wantVersions: []int{},
+		},
+		{
+			name:    "PASS - 빈 데이터베이스는 아무것도 하지 않음",
+			version: 1,
+			mock: func(ts migratorTestSuite) {
+				expectLock(ts)
+				expectRecords(ts)
+				expectTables(ts, 0)
+				expectRelease(ts)
+			},
+			wantVersions: []int{},
+		},
+		{
+			name:         "FAIL - 없는 버전",
+			version:      9,
+			mock:         func(ts migratorTestSuite) {},
+			wantVersions: []int{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupMigratorTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.migrator.Baseline(context.Background(), tt.version)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantVersions, versionsOf(got))
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMigrator_SeedOnce(t *testing.T) {
+	const script = "INSERT INTO users (id) VALUES (1);\nINSERT INTO schools (id) VALUES (1);\n"
+	seedColumns := []string{"checksum", "skipped", "apply_date"}
+
+	tests := []struct {
+		name       string
+		mock       func(ts migratorTestSuite)
+		wantSeeded bool
+		wantErr    bool
+	}{
+		{
+			name: "PASS - 기록이 없으면 샘플 데이터와 기록을 한 트랜잭션으로 넣음",
+			mock: func(ts migratorTestSuite) {
+				expectLock(ts)
+				ts.sqlMock.ExpectQuery(regexp.QuoteMeta(selectSeedQuery)).WithArgs(seedName).WillReturnRows(sqlmock.NewRows(seedColumns))
+				ts.sqlMock.ExpectBegin()
+				expectExec(ts, "INSERT INTO users (id) VALUES (1)")
+				expectExec(ts, "INSERT INTO schools (id) VALUES (1)")
+				expectExec(ts, upsertSeedQuery, seedName, checksum([]byte(script)), false)
+				ts.sqlMock.ExpectCommit()
+				expectRelease(ts)
+			},
+			wantSeeded: true,
+		},
+		{
+			name: "PASS - 이미 넣은 샘플 데이터는 다시 넣지 않음",
+			mock: func(ts migratorTestSuite) {
+				expectLock(ts)
+				ts.sqlMock.ExpectQuery(regexp.QuoteMeta(selectSeedQuery)).WithArgs(seedName).
+					WillReturnRows(sqlmock.NewRows(seedColumns).AddRow(checksum([]byte(script)), false, time.Now()))
+				expectRelease(ts)
+			},
+		},
+		{
+			name: "PASS - baseline으로 건너뛴 샘플 데이터는 넣지 않음",
+			mock: func(ts migratorTestSuite) {
+				expectLock(ts)
+				ts.sqlMock.ExpectQuery(regexp.QuoteMeta(selectSeedQuery)).WithArgs(seedName).
+					WillReturnRows(sqlmock.NewRows(seedColumns).AddRow("", true, time.Now()))
+				expectRelease(ts)
+			},
+		},
+		{
+			name: "FAIL - 실패하면 기록 없이 롤백",
+			mock: func(ts migratorTestSuite) {
+				expectLock(ts)
+				ts.sqlMock.ExpectQuery(regexp.QuoteMeta(selectSeedQuery)).WithArgs(seedName).WillReturnRows(sqlmock.NewRows(seedColumns))
+				ts.sqlMock.ExpectBegin()
+				expectExec(ts, "INSERT INTO users (id) VALUES (1)")
+				ts.sqlMock.ExpectExec(regexp.QuoteMeta("INSERT INTO schools (id) VALUES (1)")).WillReturnError(errors.New("duplicate entry"))
+				ts.sqlMock.ExpectRollback()
+				expectRelease(ts)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupMigratorTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.migrator.SeedOnce(context.Background(), script)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantSeeded, got)
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMigrator_Status(t *testing.T) {
+	// given
+	ts := setupMigratorTestSuite(t)
+	applyDate := time.Now()
+	expectLock(ts)
+	ts.sqlMock.ExpectQuery(regexp.QuoteMeta(selectMigrationsQuery)).WillReturnRows(
+		sqlmock.NewRows([]string{"version", "name", "checksum", "apply_date"}).
+			AddRow(1, "init", "modified", applyDate).
+			AddRow(3, "removed", "checksum", applyDate),
+	)
+	expectRelease(ts)
+
+	// when
+	got, err := ts.migrator.Status(context.Background())
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []Status{
+		{Migration: ts.migrations[0], Applied: true, ApplyDate: applyDate, Modified: true},
+		{Migration: ts.migrations[1]},
+		{Migration: Migration{Version: 3, Name: "removed", Checksum: "checksum"}, Applied: true, ApplyDate: applyDate, Missing: true},
+	}, got)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func assertError(t *testing.T, want, got error) {
+	t.Helper()
+	switch {
+	case want == nil:
+		assert.NoError(t, got)
+	case errors.Is(got, want):
+	default:
+		assert.EqualError(t, got, want.Error())
+	}
+}
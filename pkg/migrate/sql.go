@@ -0,0 +1,32 @@
+package migrate
+
+const (
+	createMigrationsTableQuery = `CREATE TABLE IF NOT EXISTS schema_migrations
+(
+    version    INT PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL,
+    checksum   CHAR(64)     NOT NULL,
+    apply_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+)`
+	selectMigrationsQuery = `SELECT version, name, checksum, apply_date FROM schema_migrations ORDER BY version`
+	insertMigrationQuery  = `INSERT INTO schema_migrations (version, name, checksum) VALUES (?, ?, ?)`
+	deleteMigrationQuery  = `DELETE FROM schema_migrations WHERE version = ?`
+
+	// countTablesQuery 마이그레이션 기록 테이블을 뺀 테이블 수, 기록 없이 테이블이 있으면 init.sql로 만든 데이터베이스다.
+	countTablesQuery = `SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name NOT IN ('schema_migrations', 'schema_seeds')`
+
+	createSeedsTableQuery = `CREATE TABLE IF NOT EXISTS schema_seeds
+(
+    name       VARCHAR(64) PRIMARY KEY,
+    checksum   CHAR(64)    NOT NULL,
+    skipped    BOOLEAN     NOT NULL DEFAULT FALSE,
+    apply_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+)`
+	selectSeedQuery = `SELECT checksum, skipped, apply_date FROM schema_seeds WHERE name = ?`
+	upsertSeedQuery = `INSERT INTO schema_seeds (name, checksum, skipped) VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE checksum = VALUES(checksum), skipped = VALUES(skipped), apply_date = CURRENT_TIMESTAMP`
+
+	// 잠금 이름은 서버 전체에서 공유되므로 데이터베이스 이름을 붙인다.
+	getLockQuery     = `SELECT GET_LOCK(CONCAT('schema_migrations.', DATABASE()), ?)`
+	releaseLockQuery = `SELECT RELEASE_LOCK(CONCAT('schema_migrations.', DATABASE()))`
+)
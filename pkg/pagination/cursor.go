@@ -0,0 +1,121 @@
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidCursor = errors.New("pagination: invalid cursor")
+
+// keyPurpose 커서 서명 키를 만들 때 쓰는 용도 구분 값
+const keyPurpose = "classting/pagination/cursor"
+
+// Direction 커서 이후로 이어지는 페이지의 방향
+type Direction string
+
+const (
+	Forward  Direction = "next"
+	Backward Direction = "prev"
+)
+
+// Cursor 페이지 경계에 있는 행의 정렬 값(Key)과 아이디.
+// Filter에는 목록을 조회한 조건의 지문을 담아 다른 조건의 목록에서 커서를 재사용하지 못하게 한다.
+type Cursor struct {
+	Key       string    `json:"k,omitempty"`
+	ID        int       `json:"i"`
+	Direction Direction `json:"d"`
+	Filter    string    `json:"f,omitempty"`
+}
+
+// Backward 이전 페이지를 가리키는 커서인지 확인한다. 커서가 없으면 첫 페이지를 정방향으로 조회한다.
+func (c *Cursor) Backward() bool {
+	return c != nil && c.Direction == Backward
+}
+
+// Operator 커서 다음 행을 고르는 비교 연산자, desc는 목록의 정렬 방향이다.
+func (c *Cursor) Operator(desc bool) string {
+	if desc != c.Backward() {
+		return "<"
+	}
+
+	return ">"
+}
+
+// Order 조회 쿼리의 정렬 방향, 이전 페이지는 반대로 조회한 뒤 NewPage에서 목록 순서로 되돌린다.
+func (c *Cursor) Order(desc bool) string {
+	if desc != c.Backward() {
+		return "DESC"
+	}
+
+	return "ASC"
+}
+
+// KeyInt 정수 정렬 값을 반환한다. 서명을 확인한 커서의 Key는 인코딩할 때의 값 그대로다.
+func (c *Cursor) KeyInt() int {
+	key, _ := strconv.Atoi(c.Key)
+
+	return key
+}
+
+// Fingerprint 조회 조건을 짧은 지문으로 만든다. 목록마다 이름을 첫 값으로 넣어 다른 목록의 커서와 구분한다.
+func Fingerprint(values ...any) string {
+	h := sha256.New()
+	for _, value := range values {
+		_, _ = fmt.Fprintf(h, "%v\x00", value)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil)[:9])
+}
+
+// encode 커서를 JSON으로 직렬화해 "본문.서명" 형식의 base64 문자열로 만든다.
+func encode(secret []byte, cursor Cursor) string {
+	b, _ := json.Marshal(cursor)
+	payload := base64.RawURLEncoding.EncodeToString(b)
+
+	return payload + "." + sign(secret, payload)
+}
+
+func decode(secret []byte, s string) (Cursor, error) {
+	payload, signature, ok := strings.Cut(s, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(sign(secret, payload))) {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(b, &cursor); err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	if cursor.ID <= 0 || (cursor.Direction != Forward && cursor.Direction != Backward) {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return cursor, nil
+}
+
+// deriveKey 설정한 시크릿에서 커서 서명 전용 키를 만든다.
+// pagination.secret이 없어 auth.secret을 쓰더라도 토큰 서명에 쓰는 키로 커서를 직접 서명하지 않는다.
+func deriveKey(secret string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(keyPurpose))
+
+	return mac.Sum(nil)
+}
+
+// sign 커서 본문을 HMAC-SHA256으로 서명한다.
+func sign(secret []byte, payload string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,120 @@
+package pagination
+
+import (
+	"slices"
+)
+
+const (
+	// DefaultSize 페이지 크기를 설정하지 않았을 때 한 페이지에 조회하는 행 수
+	DefaultSize = 10
+	// DefaultMaxSize 요청으로 늘릴 수 있는 페이지 크기의 기본 상한
+	DefaultMaxSize = 50
+)
+
+// Paginator 커서를 서명, 검증하고 요청한 페이지 크기를 설정 범위 안으로 맞춘다.
+type Paginator struct {
+	secret      []byte
+	defaultSize int
+	maxSize     int
+}
+
+// New 페이지 크기가 0 이하이면 기본값을 사용한다. 커서는 secret에서 만든 커서 전용 키로 서명한다.
+func New(secret string, defaultSize, maxSize int) *Paginator {
+	if defaultSize <= 0 {
+		defaultSize = DefaultSize
+	}
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+
+	return &Paginator{
+		secret:      deriveKey(secret),
+		defaultSize: defaultSize,
+		maxSize:     max(maxSize, defaultSize),
+	}
+}
+
+// Request 조회할 페이지의 커서와 크기, 커서가 없으면 첫 페이지다.
+type Request struct {
+	Cursor *Cursor
+	Size   int
+}
+
+// Limit 다음 페이지가 있는지 알 수 있도록 페이지 크기보다 한 행 더 조회한다.
+func (r Request) Limit() int {
+	return r.Size + 1
+}
+
+// Request 클라이언트가 보낸 커서를 검증한다. 서명이 다르거나 filter와 다른 조건으로 만든 커서는 ErrInvalidCursor를 반환한다.
+func (p *Paginator) Request(cursor string, size int, filter string) (Request, error) {
+	req := Request{Size: p.defaultSize}
+	if size > 0 {
+		req.Size = min(size, p.maxSize)
+	}
+
+	if cursor == "" {
+		return req, nil
+	}
+
+	decoded, err := decode(p.secret, cursor)
+	if err != nil {
+		return Request{}, err
+	}
+	if decoded.Filter != filter {
+		return Request{}, ErrInvalidCursor
+	}
+	req.Cursor = &decoded
+
+	return req, nil
+}
+
+// Encode 다음, 이전 페이지가 없으면 nil을 반환한다.
+func (p *Paginator) Encode(cursor *Cursor) *string {
+	if cursor == nil {
+		return nil
+	}
+
+	encoded := encode(p.secret, *cursor)
+
+	return &encoded
+}
+
+// Page 목록 순서로 정렬한 한 페이지의 행과 앞뒤 페이지 커서
+type Page[T any] struct {
+	Items []T
+	Next  *Cursor
+	Prev  *Cursor
+}
+
+// NewPage Limit만큼 조회한 rows로 페이지를 만든다. cursorOf는 행의 정렬 값, 아이디와 조회 조건 지문을 채운다.
+func NewPage[T any](rows []T, req Request, cursorOf func(T) Cursor) Page[T] {
+	hasMore := len(rows) > req.Size
+	if hasMore {
+		rows = rows[:req.Size]
+	}
+
+	backward := req.Cursor.Backward()
+	if backward {
+		rows = slices.Clone(rows)
+		slices.Reverse(rows)
+	}
+
+	page := Page[T]{Items: rows}
+	if len(rows) == 0 {
+		return page
+	}
+
+	// 이전 페이지로 왔다면 다음 페이지가, 커서를 따라 왔다면 이전 페이지가 항상 있다.
+	if hasMore || backward {
+		next := cursorOf(rows[len(rows)-1])
+		next.Direction = Forward
+		page.Next = &next
+	}
+	if (backward && hasMore) || (!backward && req.Cursor != nil) {
+		prev := cursorOf(rows[0])
+		prev.Direction = Backward
+		page.Prev = &prev
+	}
+
+	return page
+}
@@ -0,0 +1,228 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestPaginator_Request(t *testing.T) {
+	paginator := New("classting_test_secret", 10, 50)
+	filter := Fingerprint("news", 1, "")
+	valid := *paginator.Encode(&Cursor{ID: 5, Direction: Forward, Filter: filter})
+	payload, signature, _ := strings.Cut(valid, ".")
+
+	tests := []struct {
+		name    string
+		cursor  string
+		size    int
+		filter  string
+		want    Request
+		wantErr bool
+	}{
+		{
+			name:   "PASS - 커서가 없으면 첫 페이지를 기본 크기로 조회",
+			filter: filter,
+			want:   Request{Size: 10},
+		},
+		{
+			name:   "PASS - 요청한 페이지 크기",
+			size:   20,
+			filter: filter,
+			want:   Request{Size: 20},
+		},
+		{
+			name:   "PASS - 최대 크기를 넘는 페이지 크기는 최대 크기로 줄임",
+			size:   1000,
+			filter: filter,
+			want:   Request{Size: 50},
+		},
+		{
+			name:   "PASS - 서명한 커서",
+			cursor: valid,
+			filter: filter,
+			want:   Request{Size: 10, Cursor: &Cursor{ID: 5, Direction: Forward, Filter: filter}},
+		},
+		{
+			name: "FAIL - 본문을 바꾼 커서",
+			cursor: base64.RawURLEncoding.EncodeToString([]byte(`{"i":1,"d":"next","f":"`+filter+`"}`)) +
+				"." + signature,
+			filter:  filter,
+			wantErr: true,
+		},
+		{
+			name:    "FAIL - 커서 전용 키가 아닌 시크릿으로 서명한 커서",
+			cursor:  payload + "." + sign([]byte("classting_test_secret"), payload),
+			filter:  filter,
+			wantErr: true,
+		},
+		{
+			name:    "FAIL - 다른 키로 서명한 커서",
+			cursor:  *New("other_secret", 10, 50).Encode(&Cursor{ID: 5, Direction: Forward, Filter: filter}),
+			filter:  filter,
+			wantErr: true,
+		},
+		{
+			name:    "FAIL - 서명이 없는 커서",
+			cursor:  payload,
+			filter:  filter,
+			wantErr: true,
+		},
+		{
+			name:    "FAIL - 다른 조회 조건으로 만든 커서",
+			cursor:  valid,
+			filter:  Fingerprint("news", 2, ""),
+			wantErr: true,
+		},
+		{
+			name:    "FAIL - 다른 목록의 커서",
+			cursor:  *paginator.Encode(&Cursor{ID: 5, Direction: Forward, Filter: Fingerprint("feed", 1)}),
+			filter:  filter,
+			wantErr: true,
+		},
+		{
+			name:    "FAIL - 방향이 잘못된 커서",
+			cursor:  *paginator.Encode(&Cursor{ID: 5, Direction: "up", Filter: filter}),
+			filter:  filter,
+			wantErr: true,
+		},
+		{
+			name:    "FAIL - 아이디가 없는 커서",
+			cursor:  *paginator.Encode(&Cursor{Direction: Forward, Filter: filter}),
+			filter:  filter,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			got, err := paginator.Request(tt.cursor, tt.size, tt.filter)
+
+			// then
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidCursor)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, got.Size+1, got.Limit())
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name        string
+		defaultSize int
+		maxSize     int
+		wantDefault int
+		wantMax     int
+	}{
+		{
+			name:        "PASS - 설정하지 않으면 기본값",
+			wantDefault: DefaultSize,
+			wantMax:     DefaultMaxSize,
+		},
+		{
+			name:        "PASS - 최대 크기는 기본 크기보다 작아지지 않음",
+			defaultSize: 30,
+			maxSize:     20,
+			wantDefault: 30,
+			wantMax:     30,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			got := New("classting_test_secret", tt.defaultSize, tt.maxSize)
+
+			// then
+			assert.Equal(t, tt.wantDefault, got.defaultSize)
+			assert.Equal(t, tt.wantMax, got.maxSize)
+		})
+	}
+}
+
+func TestDeriveKey(t *testing.T) {
+	// given
+	secret := "classting_test_secret"
+
+	// when
+	key := deriveKey(secret)
+
+	// then
+	assert.Len(t, key, 32)
+	assert.NotEqual(t, []byte(secret), key)
+	assert.Equal(t, key, deriveKey(secret))
+	assert.NotEqual(t, key, deriveKey("other_secret"))
+}
+
+func TestNewPage(t *testing.T) {
+	cursorOf := func(id int) Cursor {
+		return Cursor{ID: id, Filter: "f"}
+	}
+
+	tests := []struct {
+		name     string
+		rows     []int
+		req      Request
+		want     []int
+		wantNext *Cursor
+		wantPrev *Cursor
+	}{
+		{
+			name:     "PASS - 첫 페이지에 다음 페이지가 있음",
+			rows:     []int{5, 4, 3},
+			req:      Request{Size: 2},
+			want:     []int{5, 4},
+			wantNext: &Cursor{ID: 4, Direction: Forward, Filter: "f"},
+		},
+		{
+			name: "PASS - 첫 페이지가 마지막 페이지",
+			rows: []int{5, 4},
+			req:  Request{Size: 2},
+			want: []int{5, 4},
+		},
+		{
+			name:     "PASS - 커서를 따라 온 마지막 페이지",
+			rows:     []int{3},
+			req:      Request{Size: 2, Cursor: &Cursor{ID: 4, Direction: Forward}},
+			want:     []int{3},
+			wantPrev: &Cursor{ID: 3, Direction: Backward, Filter: "f"},
+		},
+		{
+			name:     "PASS - 이전 페이지는 목록 순서로 되돌리고 앞에 페이지가 더 있음",
+			rows:     []int{3, 4, 5},
+			req:      Request{Size: 2, Cursor: &Cursor{ID: 2, Direction: Backward}},
+			want:     []int{4, 3},
+			wantNext: &Cursor{ID: 3, Direction: Forward, Filter: "f"},
+			wantPrev: &Cursor{ID: 4, Direction: Backward, Filter: "f"},
+		},
+		{
+			name:     "PASS - 이전 페이지가 첫 페이지",
+			rows:     []int{3, 4},
+			req:      Request{Size: 2, Cursor: &Cursor{ID: 2, Direction: Backward}},
+			want:     []int{4, 3},
+			wantNext: &Cursor{ID: 3, Direction: Forward, Filter: "f"},
+		},
+		{
+			name: "PASS - 빈 페이지",
+			req:  Request{Size: 2, Cursor: &Cursor{ID: 1, Direction: Forward}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			got := NewPage(tt.rows, tt.req, cursorOf)
+
+			// then
+			assert.Equal(t, tt.want, got.Items)
+			assert.Equal(t, tt.wantNext, got.Next)
+			assert.Equal(t, tt.wantPrev, got.Prev)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package pubsub
+
+import (
+	"sync"
+)
+
+// Hub 토픽 단위로 메시지를 구독자에게 전달하는 프로세스 내 pub/sub.
+// 발행은 구독자를 기다리지 않으며 버퍼가 가득 찬 느린 구독자는 구독이 해제된다.
+type Hub[T any] struct {
+	mu         sync.RWMutex
+	topics     map[string]map[*Subscriber[T]]struct{}
+	bufferSize int
+	closed     bool
+}
+
+func NewHub[T any](bufferSize int) *Hub[T] {
+	return &Hub[T]{
+		topics:     make(map[string]map[*Subscriber[T]]struct{}),
+		bufferSize: max(bufferSize, 1),
+	}
+}
+
+// Subscribe 주어진 토픽을 구독하는 구독자를 생성한다. 허브가 종료된 경우 닫힌 구독자를 반환한다.
+func (h *Hub[T]) Subscribe(topics ...string) *Subscriber[T] {
+	s := &Subscriber[T]{
+		hub:      h,
+		messages: make(chan T, h.bufferSize),
+		topics:   make(map[string]struct{}),
+		done:     make(chan struct{}),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		s.closed = true
+		close(s.messages)
+		close(s.done)
+		return s
+	}
+
+	for _, topic := range topics {
+		h.join(s, topic)
+	}
+
+	return s
+}
+
+// Publish 토픽의 모든 구독자에게 메시지를 전달한다.
+func (h *Hub[T]) Publish(topic string, msg T) {
+	var slow []*Subscriber[T]
+
+	h.mu.RLock()
+	for s := range h.topics[topic] {
+		select {
+		case s.messages <- msg:
+		default:
+			slow = append(slow, s)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, s := range slow {
+		s.Close()
+	}
+}
+
+// Close 모든 구독자를 종료하고 이후의 구독을 거부한다.
+func (h *Hub[T]) Close() {
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		return
+	}
+	h.closed = true
+
+	subscribers := make(map[*Subscriber[T]]struct{})
+	for _, topicSubscribers := range h.topics {
+		for s := range topicSubscribers {
+			subscribers[s] = struct{}{}
+		}
+	}
+	h.mu.Unlock()
+
+	for s := range subscribers {
+		s.Close()
+	}
+}
+
+func (h *Hub[T]) join(s *Subscriber[T], topic string) {
+	if _, ok := h.topics[topic]; !ok {
+		h.topics[topic] = make(map[*Subscriber[T]]struct{})
+	}
+	h.topics[topic][s] = struct{}{}
+	s.topics[topic] = struct{}{}
+}
+
+func (h *Hub[T]) leave(s *Subscriber[T], topic string) {
+	delete(h.topics[topic], s)
+	if len(h.topics[topic]) == 0 {
+		delete(h.topics, topic)
+	}
+	delete(s.topics, topic)
+}
+
+type Subscriber[T any] struct {
+	hub      *Hub[T]
+	messages chan T
+	topics   map[string]struct{}
+	done     chan struct{}
+	closed   bool
+}
+
+// Messages 구독한 토픽의 메시지를 전달한다. 구독이 해제되면 채널이 닫힌다.
+func (s *Subscriber[T]) Messages() <-chan T {
+	return s.messages
+}
+
+// Done 구독이 해제되면 닫힌다.
+func (s *Subscriber[T]) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *Subscriber[T]) Join(topic string) {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.hub.join(s, topic)
+}
+
+func (s *Subscriber[T]) Leave(topic string) {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	s.hub.leave(s, topic)
+}
+
+// Close 구독을 해제한다. 여러 번 호출해도 안전하다.
+func (s *Subscriber[T]) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+
+	for topic := range s.topics {
+		s.hub.leave(s, topic)
+	}
+	close(s.messages)
+	close(s.done)
+}
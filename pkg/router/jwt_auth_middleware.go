@@ -3,21 +3,39 @@ package router
 import (
 	"classting/domain"
 	cerrors "classting/pkg/cerrors"
+	"classting/pkg/jwtkey"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/samber/lo"
 	"net/http"
 	"strings"
+	"time"
 )
 
-func parseJWTToken(tokenString string, secret string) (*jwt.Token, error) {
-	return jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+// JWTAuth 라우트마다 JWTMiddleware를 만들 때 같은 검증 키와 폐기 목록을 사용하도록 서버 시작 시 한 번 만든다.
+type JWTAuth struct {
+	keyfunc  jwt.Keyfunc
+	denylist domain.TokenDenylist
+}
+
+// NewJWTAuth keySet이 있으면 키 셋의 공개키로만 검증하고, 없으면 secret으로 HS256 토큰을 검증한다.
+// denylist가 nil이면 폐기 여부를 확인하지 않는다.
+func NewJWTAuth(secret string, keySet *jwtkey.KeySet, denylist domain.TokenDenylist) *JWTAuth {
+	keyfunc := func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
 		}
 		return []byte(secret), nil
-	})
+	}
+	if keySet != nil {
+		keyfunc = keySet.Keyfunc
+	}
+
+	return &JWTAuth{
+		keyfunc:  keyfunc,
+		denylist: denylist,
+	}
 }
 
 func extractInfoFromToken(token *jwt.Token) (int, domain.UserType, error) {
@@ -49,37 +67,108 @@ func extractInfoFromToken(token *jwt.Token) (int, domain.UserType, error) {
 	return int(userID), domain.UserType(userType), nil
 }
 
-func JWTMiddleware(secret string, userTypes []domain.UserType) gin.HandlerFunc {
+// extractSessionFromToken 로그아웃 처리를 위한 토큰 ID, 발행 시각, 만료 시각을 꺼낸다.
+// 토큰 ID가 없는 토큰은 개별 폐기는 할 수 없지만 유저의 전체 세션 폐기에는 포함된다.
+func extractSessionFromToken(token *jwt.Token) (string, time.Time, time.Time, error) {
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return "", time.Time{}, time.Time{}, fmt.Errorf("Invalid token claims")
+	}
+
+	tokenID, _ := claims["jti"].(string)
+
+	var issueDate time.Time
+	iat, err := claims.GetIssuedAt()
+	if err != nil {
+		return "", time.Time{}, time.Time{}, err
+	}
+	if iat != nil {
+		issueDate = iat.Time
+	}
+
+	exp, err := claims.GetExpirationTime()
+	if err != nil {
+		return "", time.Time{}, time.Time{}, err
+	}
+	if exp == nil {
+		return "", time.Time{}, time.Time{}, fmt.Errorf("Exp claim not found")
+	}
+
+	return tokenID, issueDate, exp.Time, nil
+}
+
+// WebSocketAuthProtocol 브라우저의 웹소켓은 Authorization 헤더를 보낼 수 없어
+// new WebSocket(url, ["bearer", accessToken])처럼 서브프로토콜로 토큰을 보낸다.
+const WebSocketAuthProtocol = "bearer"
+
+// bearerToken Authorization 헤더의 토큰을 꺼내고, 헤더가 없는 웹소켓 연결 요청은 서브프로토콜의 토큰을 꺼낸다.
+func bearerToken(c *gin.Context) (string, bool) {
+	if authHeader := c.GetHeader("Authorization"); authHeader != "" {
+		parts := strings.Split(authHeader, " ")
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			return "", false
+		}
+		return parts[1], true
+	}
+
+	if strings.EqualFold(c.GetHeader("Upgrade"), "websocket") {
+		protocols := strings.Split(c.GetHeader("Sec-WebSocket-Protocol"), ",")
+		if len(protocols) == 2 && strings.TrimSpace(protocols[0]) == WebSocketAuthProtocol {
+			return strings.TrimSpace(protocols[1]), true
+		}
+	}
+
+	return "", false
+}
+
+func (a *JWTAuth) JWTMiddleware(userTypes []domain.UserType) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		authHeader := c.GetHeader("Authorization")
-		if authHeader == "" {
+		tokenString, ok := bearerToken(c)
+		if !ok {
 			c.JSON(cerrors.NewSentinelAPIError(http.StatusUnauthorized, "로그인 후 이용해주세요"))
 			c.Abort()
 			return
 		}
 
-		parts := strings.Split(authHeader, " ")
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			c.JSON(cerrors.NewSentinelAPIError(http.StatusUnauthorized, "로그인 후 이용해주세요"))
+		token, err := jwt.Parse(tokenString, a.keyfunc)
+		if err != nil {
+			c.JSON(cerrors.NewSentinelAPIError(http.StatusUnauthorized, "올바르지 않은 토큰입니다"))
 			c.Abort()
 			return
 		}
 
-		tokenString := parts[1]
-		token, err := parseJWTToken(tokenString, secret)
+		userID, usertype, err := extractInfoFromToken(token)
 		if err != nil {
 			c.JSON(cerrors.NewSentinelAPIError(http.StatusUnauthorized, "올바르지 않은 토큰입니다"))
 			c.Abort()
 			return
 		}
 
-		userID, usertype, err := extractInfoFromToken(token)
+		tokenID, issueDate, expireDate, err := extractSessionFromToken(token)
 		if err != nil {
 			c.JSON(cerrors.NewSentinelAPIError(http.StatusUnauthorized, "올바르지 않은 토큰입니다"))
 			c.Abort()
 			return
 		}
 
+		if a.denylist != nil {
+			revoked, err := a.denylist.IsTokenRevoked(c.Request.Context(), domain.IsTokenRevokedParams{
+				TokenID:   tokenID,
+				UserID:    userID,
+				IssueDate: issueDate,
+			})
+			if err != nil {
+				c.JSON(cerrors.ToSentinelAPIError(err))
+				c.Abort()
+				return
+			}
+			if revoked {
+				c.JSON(cerrors.NewSentinelAPIError(http.StatusUnauthorized, "만료된 토큰입니다. 다시 로그인해주세요."))
+				c.Abort()
+				return
+			}
+		}
+
 		if !lo.Contains(userTypes, usertype) {
 			c.JSON(cerrors.NewSentinelAPIError(http.StatusUnauthorized, "권한이 없습니다."))
 			c.Abort()
@@ -89,6 +178,8 @@ func JWTMiddleware(secret string, userTypes []domain.UserType) gin.HandlerFunc {
 		c.Set("userID", userID)
 		c.Set("userType", usertype)
 		c.Set("tokenString", tokenString)
+		c.Set("tokenID", tokenID)
+		c.Set("tokenExpireDate", expireDate)
 
 		c.Next()
 	}
@@ -3,13 +3,14 @@ package router
 import (
 	"classting/config"
 	"classting/docs"
+	"classting/pkg/jwtkey"
 	"github.com/gin-gonic/gin"
 	swaggerfiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"net/http"
 )
 
-func NewServeRouter(cfg *config.Config) *gin.Engine {
+func NewServeRouter(cfg *config.Config, keySet *jwtkey.KeySet) *gin.Engine {
 	r := gin.Default()
 
 	docs.SwaggerInfo.Title = "classting 백엔드 엔지니어 과제 REST API"
@@ -21,5 +22,15 @@ func NewServeRouter(cfg *config.Config) *gin.Engine {
 
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
 
+	// 다른 서비스가 시크릿 없이 토큰을 검증할 수 있도록 공개키를 공개한다.
+	r.GET("/.well-known/jwks.json", func(c *gin.Context) {
+		jwks := jwtkey.JWKS{Keys: []jwtkey.JWK{}}
+		if keySet != nil {
+			jwks = keySet.JWKS()
+		}
+		c.Header("Cache-Control", "public, max-age=300")
+		c.JSON(http.StatusOK, jwks)
+	})
+
 	return r
 }
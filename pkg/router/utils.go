@@ -1,8 +1,10 @@
 package router
 
 import (
+	"classting/domain"
 	cerrors "classting/pkg/cerrors"
 	"github.com/gin-gonic/gin"
+	"time"
 )
 
 func GetUserIDFromContext(c *gin.Context) (int, error) {
@@ -20,3 +22,51 @@ func GetUserIDFromContext(c *gin.Context) (int, error) {
 
 	return userIDInt, nil
 }
+
+func GetUserTypeFromContext(c *gin.Context) (domain.UserType, error) {
+	const op cerrors.Op = "router/GetUserTypeFromContext"
+
+	userType, ok := c.Get("userType")
+	if !ok {
+		return "", cerrors.E(op, cerrors.Internal, "서버에 문제가 발생했습니다.")
+	}
+
+	userTypeValue, ok := userType.(domain.UserType)
+	if !ok {
+		return "", cerrors.E(op, cerrors.Internal, "서버에 문제가 발생했습니다.")
+	}
+
+	return userTypeValue, nil
+}
+
+func GetTokenIDFromContext(c *gin.Context) (string, error) {
+	const op cerrors.Op = "router/GetTokenIDFromContext"
+
+	tokenID, ok := c.Get("tokenID")
+	if !ok {
+		return "", cerrors.E(op, cerrors.Internal, "서버에 문제가 발생했습니다.")
+	}
+
+	tokenIDString, ok := tokenID.(string)
+	if !ok {
+		return "", cerrors.E(op, cerrors.Internal, "서버에 문제가 발생했습니다.")
+	}
+
+	return tokenIDString, nil
+}
+
+func GetTokenExpireDateFromContext(c *gin.Context) (time.Time, error) {
+	const op cerrors.Op = "router/GetTokenExpireDateFromContext"
+
+	expireDate, ok := c.Get("tokenExpireDate")
+	if !ok {
+		return time.Time{}, cerrors.E(op, cerrors.Internal, "서버에 문제가 발생했습니다.")
+	}
+
+	expireDateTime, ok := expireDate.(time.Time)
+	if !ok {
+		return time.Time{}, cerrors.E(op, cerrors.Internal, "서버에 문제가 발생했습니다.")
+	}
+
+	return expireDateTime, nil
+}
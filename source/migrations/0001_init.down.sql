@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS subscriptions;
+DROP TABLE IF EXISTS news;
+DROP TABLE IF EXISTS schools;
+DROP TABLE IF EXISTS users;
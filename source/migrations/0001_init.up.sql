@@ -0,0 +1,48 @@
+CREATE TABLE users
+(
+    id          INT AUTO_INCREMENT PRIMARY KEY,
+    user_name   VARCHAR(255) UNIQUE NOT NULL,
+    password    VARCHAR(255)        NOT NULL,
+    user_type   ENUM ('ADMIN', 'STUDENT') DEFAULT 'STUDENT',
+    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    delete_date TIMESTAMP NULL
+);
+
+CREATE TABLE schools
+(
+    id          INT AUTO_INCREMENT PRIMARY KEY,
+    name        VARCHAR(255) NOT NULL,
+    region      VARCHAR(255) NOT NULL,
+    user_id     INT          NOT NULL,
+    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    delete_date TIMESTAMP NULL,
+    UNIQUE KEY `unique_school_region` (`name`, `region`),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+CREATE TABLE news
+(
+    id          INT AUTO_INCREMENT PRIMARY KEY,
+    title       VARCHAR(255) NOT NULL,
+    user_id     INT          NOT NULL,
+    school_id   INT          NOT NULL,
+    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    delete_date TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (school_id) REFERENCES schools (id)
+);
+
+CREATE TABLE subscriptions
+(
+    id          INT AUTO_INCREMENT PRIMARY KEY,
+    user_id     INT          NOT NULL,
+    school_id   INT          NOT NULL,
+    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    delete_date TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (school_id) REFERENCES schools (id)
+);
@@ -0,0 +1,25 @@
+CREATE TABLE timelines
+(
+    id          INT AUTO_INCREMENT PRIMARY KEY,
+    user_id     INT NOT NULL,
+    school_id   INT NOT NULL,
+    news_id     INT NOT NULL,
+    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    delete_date TIMESTAMP NULL,
+    UNIQUE KEY `unique_timeline_user_news` (`user_id`, `news_id`),
+    KEY `index_timeline_user_school` (`user_id`, `school_id`),
+    KEY `index_timeline_news` (`news_id`),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (school_id) REFERENCES schools (id),
+    FOREIGN KEY (news_id) REFERENCES news (id)
+);
+
+-- 기존 구독자의 타임라인에 구독 중에 올라온 소식을 채운다.
+INSERT INTO timelines (user_id, school_id, news_id)
+SELECT subscriptions.user_id, news.school_id, news.id
+FROM subscriptions
+         JOIN news ON news.school_id = subscriptions.school_id
+WHERE subscriptions.delete_date IS NULL
+  AND news.delete_date IS NULL
+  AND news.create_date >= subscriptions.create_date;
@@ -0,0 +1,35 @@
+CREATE TABLE webhooks
+(
+    id          INT AUTO_INCREMENT PRIMARY KEY,
+    school_id   INT          NOT NULL,
+    user_id     INT          NOT NULL,
+    url         VARCHAR(2048) NOT NULL,
+    secret      VARCHAR(255) NOT NULL,
+    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    delete_date TIMESTAMP NULL,
+    KEY `index_webhook_school` (`school_id`),
+    FOREIGN KEY (school_id) REFERENCES schools (id),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+CREATE TABLE webhook_deliveries
+(
+    id                INT AUTO_INCREMENT PRIMARY KEY,
+    webhook_id        INT          NOT NULL,
+    event_id          INT          NOT NULL,
+    event_type        VARCHAR(64)  NOT NULL,
+    news_id           INT          NOT NULL,
+    payload           TEXT         NOT NULL,
+    status            ENUM ('PENDING', 'SUCCEEDED', 'DEAD') DEFAULT 'PENDING',
+    attempts          INT          NOT NULL DEFAULT 0,
+    next_attempt_date TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    response_code     INT          NULL,
+    last_error        VARCHAR(255) NULL,
+    create_date       TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_date       TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY `unique_webhook_delivery_event` (`webhook_id`, `event_id`),
+    KEY `index_webhook_delivery_status` (`status`, `next_attempt_date`),
+    FOREIGN KEY (webhook_id) REFERENCES webhooks (id),
+    FOREIGN KEY (news_id) REFERENCES news (id)
+);
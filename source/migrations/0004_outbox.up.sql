@@ -0,0 +1,14 @@
+CREATE TABLE outbox
+(
+    id             INT AUTO_INCREMENT PRIMARY KEY,
+    aggregate_type VARCHAR(64)  NOT NULL,
+    aggregate_id   INT          NOT NULL,
+    event_type     VARCHAR(64)  NOT NULL,
+    payload        TEXT         NOT NULL,
+    attempts       INT          NOT NULL DEFAULT 0,
+    last_error     VARCHAR(255) NULL,
+    dispatch_date  TIMESTAMP    NULL,
+    create_date    TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_date    TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    KEY `index_outbox_dispatch` (`dispatch_date`, `id`)
+);
@@ -0,0 +1,15 @@
+CREATE TABLE refresh_tokens
+(
+    id          INT AUTO_INCREMENT PRIMARY KEY,
+    user_id     INT         NOT NULL,
+    family_id   VARCHAR(64) NOT NULL,
+    token_hash  CHAR(64)    NOT NULL,
+    expire_date TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    rotate_date TIMESTAMP   NULL,
+    revoke_date TIMESTAMP   NULL,
+    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY `unique_refresh_token_hash` (`token_hash`),
+    KEY `index_refresh_token_family` (`family_id`),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
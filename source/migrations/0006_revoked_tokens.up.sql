@@ -0,0 +1,18 @@
+CREATE TABLE revoked_tokens
+(
+    token_id    VARCHAR(64) PRIMARY KEY,
+    expire_date TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    KEY `index_revoked_token_expire` (`expire_date`)
+);
+
+CREATE TABLE revoked_user_tokens
+(
+    user_id       INT       PRIMARY KEY,
+    issued_before TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expire_date   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    create_date   TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_date   TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    KEY `index_revoked_user_token_expire` (`expire_date`),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
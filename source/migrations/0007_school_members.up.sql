@@ -0,0 +1,17 @@
+CREATE TABLE school_members
+(
+    id          INT AUTO_INCREMENT PRIMARY KEY,
+    school_id   INT                               NOT NULL,
+    user_id     INT                               NOT NULL,
+    role        ENUM ('OWNER', 'EDITOR', 'VIEWER') NOT NULL,
+    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY `unique_school_member` (`school_id`, `user_id`),
+    FOREIGN KEY (school_id) REFERENCES schools (id),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+-- 기존 학교는 만든 관리자를 OWNER로 등록한다.
+INSERT IGNORE INTO school_members (school_id, user_id, role)
+SELECT id, user_id, 'OWNER'
+FROM schools;
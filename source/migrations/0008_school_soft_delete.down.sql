@@ -0,0 +1,4 @@
+ALTER TABLE schools
+    DROP KEY `unique_school_region`,
+    ADD UNIQUE KEY `unique_school_region` (`name`, `region`),
+    DROP COLUMN active;
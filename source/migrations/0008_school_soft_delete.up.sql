@@ -0,0 +1,5 @@
+-- 삭제되지 않은 학교만 1, 삭제된 학교는 NULL이라 지역, 학교명 유니크에서 제외된다.
+ALTER TABLE schools
+    ADD COLUMN active TINYINT AS (IF(delete_date IS NULL, 1, NULL)) STORED AFTER delete_date,
+    DROP KEY `unique_school_region`,
+    ADD UNIQUE KEY `unique_school_region` (`name`, `region`, `active`);
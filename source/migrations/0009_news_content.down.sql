@@ -0,0 +1,4 @@
+ALTER TABLE news
+    DROP COLUMN content_format,
+    DROP COLUMN body,
+    DROP COLUMN summary;
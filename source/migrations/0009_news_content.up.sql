@@ -0,0 +1,4 @@
+ALTER TABLE news
+    ADD COLUMN summary        VARCHAR(255)               NOT NULL DEFAULT '' AFTER title,
+    ADD COLUMN body           TEXT                       NOT NULL AFTER summary,
+    ADD COLUMN content_format ENUM ('PLAIN', 'MARKDOWN') NOT NULL DEFAULT 'PLAIN' AFTER body;
@@ -0,0 +1,17 @@
+CREATE TABLE attachments
+(
+    id           INT AUTO_INCREMENT PRIMARY KEY,
+    news_id      INT          NOT NULL,
+    user_id      INT          NOT NULL,
+    file_name    VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size         BIGINT       NOT NULL,
+    storage_key  VARCHAR(255) NOT NULL,
+    create_date  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_date  TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    delete_date  TIMESTAMP NULL,
+    UNIQUE KEY `unique_attachment_storage_key` (`storage_key`),
+    KEY `index_attachment_news` (`news_id`),
+    FOREIGN KEY (news_id) REFERENCES news (id),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
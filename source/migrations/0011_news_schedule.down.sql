@@ -0,0 +1,4 @@
+ALTER TABLE news
+    DROP KEY index_news_status_publish_date,
+    DROP COLUMN publish_date,
+    DROP COLUMN status;
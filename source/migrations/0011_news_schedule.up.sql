@@ -0,0 +1,7 @@
+ALTER TABLE news
+    ADD COLUMN status       ENUM ('DRAFT', 'SCHEDULED', 'PUBLISHED', 'ARCHIVED') NOT NULL DEFAULT 'PUBLISHED' AFTER content_format,
+    ADD COLUMN publish_date TIMESTAMP NULL AFTER status,
+    ADD KEY index_news_status_publish_date (status, publish_date);
+
+-- 기존 소식은 작성하면서 바로 게시됐다.
+UPDATE news SET publish_date = create_date WHERE publish_date IS NULL;
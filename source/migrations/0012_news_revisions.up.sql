@@ -0,0 +1,18 @@
+ALTER TABLE news
+    ADD COLUMN edit_date TIMESTAMP NULL AFTER publish_date;
+
+CREATE TABLE news_revisions
+(
+    id             INT AUTO_INCREMENT PRIMARY KEY,
+    news_id        INT                         NOT NULL,
+    revision       INT                         NOT NULL,
+    editor_id      INT                         NOT NULL,
+    title          VARCHAR(255)                NOT NULL,
+    summary        VARCHAR(255)                NOT NULL DEFAULT '',
+    body           TEXT                        NOT NULL,
+    content_format ENUM ('PLAIN', 'MARKDOWN')  NOT NULL DEFAULT 'PLAIN',
+    create_date    TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY unique_news_revision (news_id, revision),
+    FOREIGN KEY (news_id) REFERENCES news (id),
+    FOREIGN KEY (editor_id) REFERENCES users (id)
+);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS news_reads;
+
+ALTER TABLE subscriptions
+    DROP COLUMN last_read_news_id;
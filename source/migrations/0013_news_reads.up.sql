@@ -0,0 +1,16 @@
+-- last_read_news_id 이하 ID의 소식은 모두 읽은 것으로 본다.
+ALTER TABLE subscriptions
+    ADD COLUMN last_read_news_id INT NOT NULL DEFAULT 0 AFTER school_id;
+
+-- news_reads subscriptions.last_read_news_id 이후의 소식 중 개별로 읽은 소식
+CREATE TABLE news_reads
+(
+    user_id     INT NOT NULL,
+    school_id   INT NOT NULL,
+    news_id     INT NOT NULL,
+    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, news_id),
+    KEY index_news_reads_user_school (user_id, school_id, news_id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (news_id) REFERENCES news (id)
+);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS news_read_events;
+
+ALTER TABLE subscriptions
+    DROP KEY index_subscription_school_delete,
+    DROP KEY index_subscription_school_create,
+    DROP KEY index_subscription_user_school;
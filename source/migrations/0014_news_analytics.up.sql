@@ -0,0 +1,18 @@
+-- 구독자 증감을 집계할 수 있도록 구독 취소 시 delete_date만 기록한다.
+ALTER TABLE subscriptions
+    ADD KEY index_subscription_user_school (user_id, school_id),
+    ADD KEY index_subscription_school_create (school_id, create_date),
+    ADD KEY index_subscription_school_delete (school_id, delete_date);
+
+-- news_read_events 소식 통계를 위해 구독자가 소식을 읽을 때마다 남기는 기록
+CREATE TABLE news_read_events
+(
+    id          INT AUTO_INCREMENT PRIMARY KEY,
+    news_id     INT NOT NULL,
+    school_id   INT NOT NULL,
+    user_id     INT NOT NULL,
+    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    KEY index_news_read_events_news_user (news_id, user_id, create_date),
+    FOREIGN KEY (news_id) REFERENCES news (id),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
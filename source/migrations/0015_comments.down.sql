@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS comments;
+
+ALTER TABLE news
+    DROP COLUMN comments_enabled;
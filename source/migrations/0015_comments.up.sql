@@ -0,0 +1,19 @@
+-- 소식별로 댓글 작성을 허용할지 정한다.
+ALTER TABLE news
+    ADD COLUMN comments_enabled BOOLEAN NOT NULL DEFAULT TRUE AFTER edit_date;
+
+CREATE TABLE comments
+(
+    id          INT AUTO_INCREMENT PRIMARY KEY,
+    news_id     INT           NOT NULL,
+    user_id     INT           NOT NULL,
+    body        VARCHAR(1000) NOT NULL,
+    -- 숨긴 댓글은 학교 멤버에게만 보인다.
+    hidden      BOOLEAN       NOT NULL DEFAULT FALSE,
+    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    delete_date TIMESTAMP NULL,
+    KEY index_comment_news (news_id, id),
+    FOREIGN KEY (news_id) REFERENCES news (id),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
@@ -0,0 +1,11 @@
+-- news_reactions 유저는 소식마다 이모지별로 한 번씩만 반응할 수 있다.
+CREATE TABLE news_reactions
+(
+    news_id     INT         NOT NULL,
+    user_id     INT         NOT NULL,
+    emoji       VARCHAR(32) NOT NULL,
+    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (news_id, user_id, emoji),
+    FOREIGN KEY (news_id) REFERENCES news (id),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS news_pins;
+
+ALTER TABLE news
+    DROP COLUMN priority;
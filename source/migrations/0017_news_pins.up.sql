@@ -0,0 +1,16 @@
+ALTER TABLE news
+    ADD COLUMN priority ENUM ('NORMAL', 'URGENT') NOT NULL DEFAULT 'NORMAL' AFTER comments_enabled;
+
+-- news_pins 학교 소식 목록 상단에 고정한 소식, 만료 시각이 없으면 해제할 때까지 고정된다.
+CREATE TABLE news_pins
+(
+    news_id     INT PRIMARY KEY,
+    school_id   INT       NOT NULL,
+    user_id     INT       NOT NULL,
+    expire_date TIMESTAMP NULL,
+    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    KEY index_news_pin_school (school_id),
+    FOREIGN KEY (news_id) REFERENCES news (id),
+    FOREIGN KEY (school_id) REFERENCES schools (id),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
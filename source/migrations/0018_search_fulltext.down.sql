@@ -0,0 +1,5 @@
+ALTER TABLE news
+    DROP KEY fulltext_news_content;
+
+ALTER TABLE schools
+    DROP KEY fulltext_school_name_region;
@@ -0,0 +1,6 @@
+-- 띄어쓰기 없는 한글도 검색되도록 두 글자 단위(ngram)로 색인한다.
+ALTER TABLE schools
+    ADD FULLTEXT KEY fulltext_school_name_region (name, region) WITH PARSER ngram;
+
+ALTER TABLE news
+    ADD FULLTEXT KEY fulltext_news_content (title, summary, body) WITH PARSER ngram;
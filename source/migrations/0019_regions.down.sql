@@ -0,0 +1,9 @@
+ALTER TABLE schools
+    DROP FOREIGN KEY fk_school_region,
+    DROP KEY index_school_subscriber_count,
+    DROP KEY index_school_name,
+    DROP KEY index_school_region,
+    DROP COLUMN subscriber_count,
+    MODIFY COLUMN region VARCHAR(255) NOT NULL;
+
+DROP TABLE IF EXISTS regions;
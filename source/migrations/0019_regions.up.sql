@@ -0,0 +1,88 @@
+-- region_aliases 학교 지역으로 저장된 약칭, 정식 명칭, 이전 명칭을 약칭으로 바꾸는 표, domain/domain_region.go의 NormalizeRegion과 같아야 한다.
+DROP TEMPORARY TABLE IF EXISTS region_aliases;
+CREATE TEMPORARY TABLE region_aliases
+(
+    alias VARCHAR(255) PRIMARY KEY,
+    code  VARCHAR(16) NOT NULL
+);
+
+INSERT INTO region_aliases (alias, code)
+VALUES ('서울', '서울'), ('서울특별시', '서울'), ('서울시', '서울'),
+       ('부산', '부산'), ('부산광역시', '부산'),
+       ('대구', '대구'), ('대구광역시', '대구'),
+       ('인천', '인천'), ('인천광역시', '인천'),
+       ('광주', '광주'), ('광주광역시', '광주'),
+       ('대전', '대전'), ('대전광역시', '대전'),
+       ('울산', '울산'), ('울산광역시', '울산'),
+       ('세종', '세종'), ('세종특별자치시', '세종'), ('세종시', '세종'),
+       ('경기', '경기'), ('경기도', '경기'),
+       ('강원', '강원'), ('강원특별자치도', '강원'), ('강원도', '강원'),
+       ('충북', '충북'), ('충청북도', '충북'),
+       ('충남', '충남'), ('충청남도', '충남'),
+       ('전북', '전북'), ('전북특별자치도', '전북'), ('전라북도', '전북'),
+       ('전남', '전남'), ('전라남도', '전남'),
+       ('경북', '경북'), ('경상북도', '경북'),
+       ('경남', '경남'), ('경상남도', '경남'),
+       ('제주', '제주'), ('제주특별자치도', '제주'), ('제주도', '제주');
+
+-- 분류에 없는 지역의 학교가 있으면 테이블을 바꾸기 전에 제약 조건 에러로 멈춘다. 해당 학교의 지역을 직접 고친 뒤 다시 적용한다.
+DROP TEMPORARY TABLE IF EXISTS unmapped_school_regions;
+CREATE TEMPORARY TABLE unmapped_school_regions
+(
+    region VARCHAR(255) NULL,
+    CONSTRAINT school_region_not_in_region_aliases CHECK (region IS NULL)
+);
+
+INSERT INTO unmapped_school_regions (region)
+SELECT DISTINCT schools.region
+FROM schools
+WHERE REPLACE(schools.region, ' ', '') NOT IN (SELECT alias FROM region_aliases);
+
+-- regions 시/도 단위 지역 분류, domain/domain_region.go의 목록과 같아야 한다. 지역은 학교 생성에 필요해 스키마와 함께 넣는다.
+CREATE TABLE regions
+(
+    code       VARCHAR(16) PRIMARY KEY,
+    name       VARCHAR(32) NOT NULL,
+    sort_order INT         NOT NULL
+);
+
+-- 지역 분류 (시/도)
+INSERT INTO regions (code, name, sort_order)
+VALUES ('서울', '서울특별시', 1),
+       ('부산', '부산광역시', 2),
+       ('대구', '대구광역시', 3),
+       ('인천', '인천광역시', 4),
+       ('광주', '광주광역시', 5),
+       ('대전', '대전광역시', 6),
+       ('울산', '울산광역시', 7),
+       ('세종', '세종특별자치시', 8),
+       ('경기', '경기도', 9),
+       ('강원', '강원특별자치도', 10),
+       ('충북', '충청북도', 11),
+       ('충남', '충청남도', 12),
+       ('전북', '전북특별자치도', 13),
+       ('전남', '전라남도', 14),
+       ('경북', '경상북도', 15),
+       ('경남', '경상남도', 16),
+       ('제주', '제주특별자치도', 17);
+
+-- 기존 학교의 지역을 약칭으로 바꾼다.
+UPDATE schools
+    JOIN region_aliases ON region_aliases.alias = REPLACE(schools.region, ' ', '')
+SET schools.region      = region_aliases.code,
+    schools.update_date = schools.update_date
+WHERE schools.region <> region_aliases.code;
+
+DROP TEMPORARY TABLE region_aliases;
+DROP TEMPORARY TABLE unmapped_school_regions;
+
+-- 구독자 수 순으로 정렬할 수 있도록 구독, 구독 취소 시 함께 갱신한다.
+ALTER TABLE schools
+    MODIFY COLUMN region VARCHAR(16) NOT NULL,
+    ADD COLUMN subscriber_count INT NOT NULL DEFAULT 0 AFTER user_id,
+    ADD KEY index_school_region (region, id),
+    ADD KEY index_school_name (name, id),
+    ADD KEY index_school_subscriber_count (subscriber_count, id),
+    ADD CONSTRAINT fk_school_region FOREIGN KEY (region) REFERENCES regions (code);
+
+UPDATE schools SET subscriber_count = (SELECT COUNT(*) FROM subscriptions WHERE subscriptions.school_id = schools.id AND subscriptions.delete_date IS NULL);
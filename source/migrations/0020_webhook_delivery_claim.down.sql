@@ -0,0 +1,3 @@
+ALTER TABLE webhook_deliveries
+    DROP KEY `index_webhook_delivery_claim`,
+    DROP COLUMN claim_token;
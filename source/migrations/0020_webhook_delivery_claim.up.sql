@@ -0,0 +1,4 @@
+-- claim_token 여러 인스턴스가 같은 전송 기록을 중복으로 보내지 않도록 전송할 인스턴스가 남기는 토큰
+ALTER TABLE webhook_deliveries
+    ADD COLUMN claim_token CHAR(32) NULL,
+    ADD KEY `index_webhook_delivery_claim` (`claim_token`);
@@ -0,0 +1,5 @@
+ALTER TABLE outbox
+    DROP KEY `index_outbox_claim`,
+    DROP COLUMN dispatched_sinks,
+    DROP COLUMN lease_date,
+    DROP COLUMN claim_token;
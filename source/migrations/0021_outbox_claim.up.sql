@@ -0,0 +1,7 @@
+-- claim_token, lease_date 여러 인스턴스가 같은 이벤트를 중복으로 전달하지 않도록 전달할 인스턴스가 남기는 토큰과 리스 만료 시각
+-- dispatched_sinks 재전달 시 건너뛸 수 있도록 전달에 성공한 싱크 이름을 쉼표로 구분해 기록
+ALTER TABLE outbox
+    ADD COLUMN claim_token CHAR(32) NULL,
+    ADD COLUMN lease_date TIMESTAMP NULL,
+    ADD COLUMN dispatched_sinks VARCHAR(255) NOT NULL DEFAULT '',
+    ADD KEY `index_outbox_claim` (`claim_token`);
@@ -1,51 +1,4 @@
-CREATE TABLE users
-(
-    id          INT AUTO_INCREMENT PRIMARY KEY,
-    user_name   VARCHAR(255) UNIQUE NOT NULL,
-    password    VARCHAR(255)        NOT NULL,
-    user_type   ENUM ('ADMIN', 'STUDENT') DEFAULT 'STUDENT',
-    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    update_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-    delete_date TIMESTAMP NULL
-);
-
-CREATE TABLE schools
-(
-    id          INT AUTO_INCREMENT PRIMARY KEY,
-    name        VARCHAR(255) NOT NULL,
-    region      VARCHAR(255) NOT NULL,
-    user_id     INT          NOT NULL,
-    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    update_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-    delete_date TIMESTAMP NULL,
-    UNIQUE KEY `unique_school_region` (`name`, `region`),
-    FOREIGN KEY (user_id) REFERENCES users (id)
-);
-
-CREATE TABLE news
-(
-    id          INT AUTO_INCREMENT PRIMARY KEY,
-    title       VARCHAR(255) NOT NULL,
-    user_id     INT          NOT NULL,
-    school_id   INT          NOT NULL,
-    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    update_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-    delete_date TIMESTAMP NULL,
-    FOREIGN KEY (user_id) REFERENCES users (id),
-    FOREIGN KEY (school_id) REFERENCES schools (id)
-);
-
-CREATE TABLE subscriptions
-(
-    id          INT AUTO_INCREMENT PRIMARY KEY,
-    user_id     INT          NOT NULL,
-    school_id   INT          NOT NULL,
-    create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    update_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-    delete_date TIMESTAMP NULL,
-    FOREIGN KEY (user_id) REFERENCES users (id),
-    FOREIGN KEY (school_id) REFERENCES schools (id)
-);
+-- 개발, 테스트용 샘플 데이터. 스키마 마이그레이션을 적용한 빈 데이터베이스에 make migrate-seed 로 넣는다.
 
 -- 유저 생성 (관리자 3, 학생 1)
 INSERT INTO users (user_name, password, user_type) VALUES ('classting_admin_1', '$2a$10$Q2w/PDGj.iS1vKCMRnIJ1uuTG7jzQn13Mu4IplYgwmyTtod7yCHAu', 'ADMIN');
@@ -80,6 +33,9 @@ INSERT INTO schools (name, region, user_id) VALUES ('admin_3_페이지네이션_
 INSERT INTO schools (name, region, user_id) VALUES ('admin_3_페이지네이션_확인_학교_18', '울산', 3);
 INSERT INTO schools (name, region, user_id) VALUES ('admin_3_페이지네이션_확인_학교_19', '울산', 3);
 INSERT INTO schools (name, region, user_id) VALUES ('admin_3_페이지네이션_확인_학교_20', '울산', 3);
+
+-- 샘플 학교의 관리자를 OWNER로 등록 (기존 학교는 0007 마이그레이션이 등록한다)
+INSERT IGNORE INTO school_members (school_id, user_id, role) SELECT id, user_id, 'OWNER' FROM schools;
 -- 학교 구독 (학생 1)
 INSERT INTO subscriptions (user_id, school_id) VALUES (4, 1);
 INSERT INTO subscriptions (user_id, school_id) VALUES (4, 2);
@@ -104,27 +60,27 @@ INSERT INTO subscriptions (user_id, school_id) VALUES (4, 20);
 INSERT INTO subscriptions (user_id, school_id) VALUES (4, 21);
 INSERT INTO subscriptions (user_id, school_id) VALUES (4, 22);
 INSERT INTO subscriptions (user_id, school_id) VALUES (4, 23);
+UPDATE schools SET subscriber_count = (SELECT COUNT(*) FROM subscriptions WHERE subscriptions.school_id = schools.id AND subscriptions.delete_date IS NULL);
 -- 뉴스 생성
-INSERT INTO news (title, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_1 1page', 1, 1);
-INSERT INTO news (title, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_2 1page', 1, 1);
-INSERT INTO news (title, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_3 1page', 1, 1);
-INSERT INTO news (title, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_4 1page', 1, 1);
-INSERT INTO news (title, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_5 1page', 1, 1);
-INSERT INTO news (title, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_6 1page', 1, 1);
-INSERT INTO news (title, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_7 1page', 1, 1);
-INSERT INTO news (title, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_8 1page', 1, 1);
-INSERT INTO news (title, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_9 1page', 1, 1);
-INSERT INTO news (title, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_10 1page', 1, 1);
-INSERT INTO news (title, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_11 2page', 1, 1);
-
-INSERT INTO news (title, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 대전학교_뉴스_1', 1, 2);
-INSERT INTO news (title, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 대전학교_뉴스_2', 1, 2);
-INSERT INTO news (title, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 대전학교_뉴스_3', 1, 2);
-INSERT INTO news (title, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 대전학교_뉴스_4', 1, 2);
-INSERT INTO news (title, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 대전학교_뉴스_5', 1, 2);
-
-INSERT INTO news (title, user_id, school_id) VALUES ('admin_2_뉴스가_있는_클래스팅 인천학교_뉴스_1', 2, 3);
-
+INSERT INTO news (title, body, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_1 1page', 'admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_1 1page 본문', 1, 1);
+INSERT INTO news (title, body, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_2 1page', 'admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_2 1page 본문', 1, 1);
+INSERT INTO news (title, body, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_3 1page', 'admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_3 1page 본문', 1, 1);
+INSERT INTO news (title, body, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_4 1page', 'admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_4 1page 본문', 1, 1);
+INSERT INTO news (title, body, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_5 1page', 'admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_5 1page 본문', 1, 1);
+INSERT INTO news (title, body, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_6 1page', 'admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_6 1page 본문', 1, 1);
+INSERT INTO news (title, body, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_7 1page', 'admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_7 1page 본문', 1, 1);
+INSERT INTO news (title, body, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_8 1page', 'admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_8 1page 본문', 1, 1);
+INSERT INTO news (title, body, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_9 1page', 'admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_9 1page 본문', 1, 1);
+INSERT INTO news (title, body, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_10 1page', 'admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_10 1page 본문', 1, 1);
+INSERT INTO news (title, body, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_11 2page', 'admin_1_뉴스가_있는_클래스팅 서울학교_뉴스_11 2page 본문', 1, 1);
 
+INSERT INTO news (title, body, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 대전학교_뉴스_1', 'admin_1_뉴스가_있는_클래스팅 대전학교_뉴스_1 본문', 1, 2);
+INSERT INTO news (title, body, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 대전학교_뉴스_2', 'admin_1_뉴스가_있는_클래스팅 대전학교_뉴스_2 본문', 1, 2);
+INSERT INTO news (title, body, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 대전학교_뉴스_3', 'admin_1_뉴스가_있는_클래스팅 대전학교_뉴스_3 본문', 1, 2);
+INSERT INTO news (title, body, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 대전학교_뉴스_4', 'admin_1_뉴스가_있는_클래스팅 대전학교_뉴스_4 본문', 1, 2);
+INSERT INTO news (title, body, user_id, school_id) VALUES ('admin_1_뉴스가_있는_클래스팅 대전학교_뉴스_5', 'admin_1_뉴스가_있는_클래스팅 대전학교_뉴스_5 본문', 1, 2);
 
+INSERT INTO news (title, body, user_id, school_id) VALUES ('admin_2_뉴스가_있는_클래스팅 인천학교_뉴스_1', 'admin_2_뉴스가_있는_클래스팅 인천학교_뉴스_1 본문', 2, 3);
 
+-- 구독자 타임라인 생성
+INSERT INTO timelines (user_id, school_id, news_id) SELECT subscriptions.user_id, news.school_id, news.id FROM subscriptions JOIN news ON news.school_id = subscriptions.school_id;
@@ -0,0 +1,24 @@
+package source
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Seed 개발, 테스트용 샘플 데이터
+//
+//go:embed seed.sql
+var Seed string
+
+// Migrations 버전_이름.up.sql, 버전_이름.down.sql 형식의 스키마 마이그레이션 파일
+func Migrations() fs.FS {
+	sub, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		panic(err)
+	}
+
+	return sub
+}